@@ -147,6 +147,22 @@ func (c *BitcoindClient) GetBlock(hash *chainhash.Hash) (*wire.MsgBlock, error)
 	return c.chainConn.client.GetBlock(hash)
 }
 
+// EstimateFeeRate returns the fee rate, per kilobyte, bitcoind estimates a
+// transaction must pay to be mined within confTarget blocks.
+func (c *BitcoindClient) EstimateFeeRate(confTarget uint32) (czzutil.Amount, error) {
+	feeRate, err := c.chainConn.client.EstimateFee(int64(confTarget))
+	if err != nil {
+		return 0, err
+	}
+
+	// bitcoind reports a negative fee rate when it has not seen enough
+	// transactions to estimate one.
+	if feeRate <= 0 {
+		return 0, ErrFeeEstimateUnavailable
+	}
+	return czzutil.NewAmount(feeRate)
+}
+
 // GetBlockVerbose returns a verbose block from the hash.
 func (c *BitcoindClient) GetBlockVerbose(
 	hash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error) {
@@ -21,12 +21,11 @@ import (
 // are now within our look-ahead.
 //
 // We track internal and external addresses separately in order to conserve the
-// amount of space occupied in memory. Specifically, the account and branch
-// combined contribute only 1-bit of information when using the default scopes
-// used by the wallet. Thus we can avoid storing an additional 64-bits per
+// amount of space occupied in memory. Specifically, the branch contributes only
+// 1-bit of information. Thus we can avoid storing an additional 32-bits per
 // address of interest by not storing the full derivation paths, and instead
-// opting to allow the caller to contextually infer the account (DefaultAccount)
-// and branch (Internal or External).
+// opting to allow the caller to contextually infer the branch (Internal or
+// External).
 type BlockFilterer struct {
 	// Params specifies the chain params of the current network.
 	Params *chaincfg.Params
@@ -44,13 +43,13 @@ type BlockFilterer struct {
 	// outpoint we own.
 	WatchedOutPoints map[wire.OutPoint]czzutil.Address
 
-	// FoundExternal is a two-layer map recording the scope and index of
-	// external addresses found in a single block.
-	FoundExternal map[waddrmgr.KeyScope]map[uint32]struct{}
+	// FoundExternal is a two-layer map recording the scoped account and
+	// index of external addresses found in a single block.
+	FoundExternal map[waddrmgr.ScopedAccount]map[uint32]struct{}
 
-	// FoundInternal is a two-layer map recording the scope and index of
-	// internal addresses found in a single block.
-	FoundInternal map[waddrmgr.KeyScope]map[uint32]struct{}
+	// FoundInternal is a two-layer map recording the scoped account and
+	// index of internal addresses found in a single block.
+	FoundInternal map[waddrmgr.ScopedAccount]map[uint32]struct{}
 
 	// FoundOutPoints is a set of outpoints found in a single block whose
 	// address belongs to the wallet.
@@ -85,8 +84,8 @@ func NewBlockFilterer(params *chaincfg.Params,
 		inReverseFilter[addr.EncodeAddress()] = scopedIndex
 	}
 
-	foundExternal := make(map[waddrmgr.KeyScope]map[uint32]struct{})
-	foundInternal := make(map[waddrmgr.KeyScope]map[uint32]struct{})
+	foundExternal := make(map[waddrmgr.ScopedAccount]map[uint32]struct{})
+	foundInternal := make(map[waddrmgr.ScopedAccount]map[uint32]struct{})
 	foundOutPoints := make(map[wire.OutPoint]czzutil.Address)
 
 	return &BlockFilterer{
@@ -197,21 +196,31 @@ func (bf *BlockFilterer) FilterOutputAddrs(addrs []czzutil.Address) bool {
 }
 
 // foundExternal marks the scoped index as found within the block filterer's
-// FoundExternal map. If this the first index found for a particular scope, the
-// scope's second layer map will be initialized before marking the index.
+// FoundExternal map. If this the first index found for a particular scoped
+// account, the account's second layer map will be initialized before marking
+// the index.
 func (bf *BlockFilterer) foundExternal(scopedIndex waddrmgr.ScopedIndex) {
-	if _, ok := bf.FoundExternal[scopedIndex.Scope]; !ok {
-		bf.FoundExternal[scopedIndex.Scope] = make(map[uint32]struct{})
+	scopedAccount := waddrmgr.ScopedAccount{
+		Scope:   scopedIndex.Scope,
+		Account: scopedIndex.Account,
 	}
-	bf.FoundExternal[scopedIndex.Scope][scopedIndex.Index] = struct{}{}
+	if _, ok := bf.FoundExternal[scopedAccount]; !ok {
+		bf.FoundExternal[scopedAccount] = make(map[uint32]struct{})
+	}
+	bf.FoundExternal[scopedAccount][scopedIndex.Index] = struct{}{}
 }
 
 // foundInternal marks the scoped index as found within the block filterer's
-// FoundInternal map. If this the first index found for a particular scope, the
-// scope's second layer map will be initialized before marking the index.
+// FoundInternal map. If this the first index found for a particular scoped
+// account, the account's second layer map will be initialized before marking
+// the index.
 func (bf *BlockFilterer) foundInternal(scopedIndex waddrmgr.ScopedIndex) {
-	if _, ok := bf.FoundInternal[scopedIndex.Scope]; !ok {
-		bf.FoundInternal[scopedIndex.Scope] = make(map[uint32]struct{})
+	scopedAccount := waddrmgr.ScopedAccount{
+		Scope:   scopedIndex.Scope,
+		Account: scopedIndex.Account,
+	}
+	if _, ok := bf.FoundInternal[scopedAccount]; !ok {
+		bf.FoundInternal[scopedAccount] = make(map[uint32]struct{})
 	}
-	bf.FoundInternal[scopedIndex.Scope][scopedIndex.Index] = struct{}{}
+	bf.FoundInternal[scopedAccount][scopedIndex.Index] = struct{}{}
 }
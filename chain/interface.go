@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/classzz/classzz/chaincfg/chainhash"
@@ -15,6 +16,10 @@ import (
 // the chain.
 const isCurrentDelta = 2 * time.Hour
 
+// ErrFeeEstimateUnavailable is returned by EstimateFeeRate when the backend
+// has no fee estimate.
+var ErrFeeEstimateUnavailable = errors.New("fee estimate unavailable")
+
 // BackEnds returns a list of the available back ends.
 // TODO: Refactor each into a driver and use dynamic registration.
 func BackEnds() []string {
@@ -40,6 +45,7 @@ type Interface interface {
 	FilterBlocks(*FilterBlocksRequest) (*FilterBlocksResponse, error)
 	BlockStamp() (*waddrmgr.BlockStamp, error)
 	SendRawTransaction(*wire.MsgTx, bool) (*chainhash.Hash, error)
+	EstimateFeeRate(confTarget uint32) (czzutil.Amount, error)
 	Rescan(*chainhash.Hash, []czzutil.Address, map[wire.OutPoint]czzutil.Address) error
 	NotifyReceived([]czzutil.Address) error
 	NotifyBlocks() error
@@ -89,8 +95,8 @@ type (
 	FilterBlocksResponse struct {
 		BatchIndex         uint32
 		BlockMeta          wtxmgr.BlockMeta
-		FoundExternalAddrs map[waddrmgr.KeyScope]map[uint32]struct{}
-		FoundInternalAddrs map[waddrmgr.KeyScope]map[uint32]struct{}
+		FoundExternalAddrs map[waddrmgr.ScopedAccount]map[uint32]struct{}
+		FoundInternalAddrs map[waddrmgr.ScopedAccount]map[uint32]struct{}
 		FoundOutPoints     map[wire.OutPoint]czzutil.Address
 		RelevantTxns       []*wire.MsgTx
 	}
@@ -63,6 +63,12 @@ func (s *NeutrinoClient) BackEnd() string {
 	return "neutrino"
 }
 
+// EstimateFeeRate is part of the chain.Interface interface.  Fee rates can not
+// be estimated by a light client, so ErrFeeEstimateUnavailable is returned.
+func (s *NeutrinoClient) EstimateFeeRate(confTarget uint32) (czzutil.Amount, error) {
+	return 0, ErrFeeEstimateUnavailable
+}
+
 // Start replicates the RPC client's Start method.
 func (s *NeutrinoClient) Start() error {
 	if err := s.CS.Start(); err != nil {
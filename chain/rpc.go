@@ -95,6 +95,22 @@ func (c *RPCClient) BackEnd() string {
 	return "bchd"
 }
 
+// EstimateFeeRate returns the fee rate, per kilobyte, the chain server
+// estimates a transaction must pay to be mined within confTarget blocks.
+func (c *RPCClient) EstimateFeeRate(confTarget uint32) (czzutil.Amount, error) {
+	feeRate, err := c.Client.EstimateFee(int64(confTarget))
+	if err != nil {
+		return 0, err
+	}
+
+	// The server reports a negative fee rate when it has not seen enough
+	// transactions to estimate one.
+	if feeRate <= 0 {
+		return 0, ErrFeeEstimateUnavailable
+	}
+	return czzutil.NewAmount(feeRate)
+}
+
 // Start attempts to establish a client connection with the remote server.
 // If successful, handler goroutines are started to process notifications
 // sent by the server.  After a limited number of connection attempts, this
@@ -32,6 +32,9 @@ const (
 	defaultLogFilename      = "czzwallet.log"
 	defaultRPCMaxClients    = 10
 	defaultRPCMaxWebsockets = 25
+	defaultCoinSelection    = wallet.CoinSelectionLargestFirst
+	defaultConsolidateFee   = 0.00005
+	defaultMaxFeeRate       = 0.001
 )
 
 var (
@@ -45,21 +48,32 @@ var (
 
 type config struct {
 	// General application behavior
-	ConfigFile    *cfgutil.ExplicitString `short:"C" long:"configfile" description:"Path to configuration file"`
-	ShowVersion   bool                    `short:"V" long:"version" description:"Display version information and exit"`
-	Create        bool                    `long:"create" description:"Create the wallet if it does not exist"`
-	CreateTemp    bool                    `long:"createtemp" description:"Create a temporary simulation wallet (pass=password) in the data directory indicated; must call with --datadir"`
-	AppDataDir    *cfgutil.ExplicitString `short:"A" long:"appdata" description:"Application data directory for wallet config, databases and logs"`
-	TestNet3      bool                    `long:"testnet" description:"Use the test Bitcoin network (version 3) (default mainnet)"`
-	SimNet        bool                    `long:"simnet" description:"Use the simulation test network (default mainnet)"`
-	NoInitialLoad bool                    `long:"noinitialload" description:"Defer wallet creation/opening on startup and enable loading wallets over RPC"`
-	DebugLevel    string                  `short:"d" long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
-	LogDir        string                  `long:"logdir" description:"Directory to log output."`
-	Profile       string                  `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
-	DBTimeout     time.Duration           `long:"dbtimeout" description:"The timeout value to use when opening the wallet database."`
+	ConfigFile      *cfgutil.ExplicitString `short:"C" long:"configfile" description:"Path to configuration file"`
+	ShowVersion     bool                    `short:"V" long:"version" description:"Display version information and exit"`
+	Create          bool                    `long:"create" description:"Create the wallet if it does not exist"`
+	CreateWatchOnly bool                    `long:"createwatchonly" description:"Create a watching-only wallet from account extended public keys if the wallet does not exist"`
+	CreateTemp      bool                    `long:"createtemp" description:"Create a temporary simulation wallet (pass=password) in the data directory indicated; must call with --datadir"`
+	AppDataDir      *cfgutil.ExplicitString `short:"A" long:"appdata" description:"Application data directory for wallet config, databases and logs"`
+	TestNet3        bool                    `long:"testnet" description:"Use the test Bitcoin network (version 3) (default mainnet)"`
+	SimNet          bool                    `long:"simnet" description:"Use the simulation test network (default mainnet)"`
+	NoInitialLoad   bool                    `long:"noinitialload" description:"Defer wallet creation/opening on startup and enable loading wallets over RPC"`
+	DebugLevel      string                  `short:"d" long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
+	LogDir          string                  `long:"logdir" description:"Directory to log output."`
+	Profile         string                  `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	DBTimeout       time.Duration           `long:"dbtimeout" description:"The timeout value to use when opening the wallet database."`
 
 	// Wallet options
-	WalletPass string `long:"walletpass" default-mask:"-" description:"The public wallet password -- Only required if the wallet was created with one"`
+	WalletPass            string        `long:"walletpass" default-mask:"-" description:"The public wallet password -- Only required if the wallet was created with one"`
+	CoinSelection         string        `long:"coinselection" description:"Default strategy used to select the outputs spent by transactions {largest, smallest, bnb, random}"`
+	MaxFeeRate            float64       `long:"maxfeerate" description:"Highest estimated fee rate (in CZZ/kB) paid by transactions -- 0 for no limit"`
+	ConsolidateThreshold  int           `long:"consolidatethreshold" description:"Consolidate the unspent outputs of an account once it holds more than this many -- 0 disables consolidation"`
+	ConsolidateMaxFeeRate float64       `long:"consolidatemaxfeerate" description:"Highest estimated fee rate (in CZZ/kB) at which outputs are consolidated -- 0 for no limit"`
+	ConsolidateMaxInputs  int           `long:"consolidatemaxinputs" description:"Maximum number of outputs merged by a single consolidation transaction"`
+	ConsolidateInterval   time.Duration `long:"consolidateinterval" description:"Time between checks of the number of unspent outputs to consolidate.  Valid time units are {s, m, h}"`
+	PayoutQueue           bool          `long:"payoutqueue" description:"Send the payouts enqueued over RPC in batches"`
+	PayoutInterval        time.Duration `long:"payoutinterval" description:"Time between two sends of the queued payouts.  Valid time units are {s, m, h}"`
+	PayoutBatchSize       int           `long:"payoutbatchsize" description:"Number of queued payouts of an account sent early, and maximum number of payouts sent by a single transaction"`
+	PayoutRetention       time.Duration `long:"payoutretention" description:"Time payouts remain recorded, and their keys idempotent, once mined or cancelled.  Valid time units are {s, m, h}"`
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Hostname/IP and port of btcd RPC server to connect to (default localhost:8334, testnet: localhost:18334, simnet: localhost:18556)"`
@@ -260,6 +274,14 @@ func loadConfig() (*config, []string, error) {
 		AppDataDir:             cfgutil.NewExplicitString(defaultAppDataDir),
 		LogDir:                 defaultLogDir,
 		WalletPass:             wallet.InsecurePubPassphrase,
+		CoinSelection:          defaultCoinSelection,
+		MaxFeeRate:             defaultMaxFeeRate,
+		ConsolidateMaxFeeRate:  defaultConsolidateFee,
+		ConsolidateMaxInputs:   wallet.DefaultConsolidationMaxInputs,
+		ConsolidateInterval:    wallet.DefaultConsolidationInterval,
+		PayoutInterval:         wallet.DefaultPayoutInterval,
+		PayoutBatchSize:        wallet.DefaultPayoutBatchSize,
+		PayoutRetention:        wallet.DefaultPayoutRetention,
 		CAFile:                 cfgutil.NewExplicitString(""),
 		RPCKey:                 cfgutil.NewExplicitString(defaultRPCKeyFile),
 		RPCCert:                cfgutil.NewExplicitString(defaultRPCCertFile),
@@ -397,6 +419,58 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Validate the coin selection strategy.
+	if _, err := wallet.CoinSelectorByName(cfg.CoinSelection); err != nil {
+		err := fmt.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	if _, err := czzutil.NewAmount(cfg.MaxFeeRate); err != nil ||
+		cfg.MaxFeeRate < 0 {
+
+		str := "%s: invalid maxfeerate %v"
+		err := fmt.Errorf(str, funcName, cfg.MaxFeeRate)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	// Validate the consolidation options.
+	if cfg.ConsolidateThreshold < 0 || cfg.ConsolidateMaxInputs <= 0 ||
+		cfg.ConsolidateInterval <= 0 {
+
+		str := "%s: consolidatethreshold may not be negative, and " +
+			"consolidatemaxinputs and consolidateinterval must be " +
+			"positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+	if _, err := czzutil.NewAmount(cfg.ConsolidateMaxFeeRate); err != nil ||
+		cfg.ConsolidateMaxFeeRate < 0 {
+
+		str := "%s: invalid consolidatemaxfeerate %v"
+		err := fmt.Errorf(str, funcName, cfg.ConsolidateMaxFeeRate)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	// Validate the payout queue options.
+	if cfg.PayoutInterval <= 0 || cfg.PayoutBatchSize <= 0 ||
+		cfg.PayoutRetention <= 0 {
+
+		str := "%s: payoutinterval, payoutbatchsize and " +
+			"payoutretention must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
 	// Exit if you try to use a simulation wallet with a standard
 	// data directory.
 	if !(cfg.AppDataDir.ExplicitlySet() || cfg.DataDir.ExplicitlySet()) && cfg.CreateTemp {
@@ -423,6 +497,13 @@ func loadConfig() (*config, []string, error) {
 		fmt.Fprintln(os.Stderr, err)
 		return nil, nil, err
 	}
+	if cfg.CreateWatchOnly && (cfg.Create || cfg.CreateTemp) {
+		err := fmt.Errorf("the flag --createwatchonly can not be " +
+			"specified together with --create or --createtemp. Use " +
+			"--help for more information")
+		fmt.Fprintln(os.Stderr, err)
+		return nil, nil, err
+	}
 
 	dbFileExists, err := cfgutil.FileExists(dbPath)
 	if err != nil {
@@ -453,7 +534,7 @@ func loadConfig() (*config, []string, error) {
 				return nil, nil, err
 			}
 		}
-	} else if cfg.Create {
+	} else if cfg.Create || cfg.CreateWatchOnly {
 		// Error if the create flag is set and the wallet already
 		// exists.
 		if dbFileExists {
@@ -469,8 +550,14 @@ func loadConfig() (*config, []string, error) {
 			return nil, nil, err
 		}
 
-		// Perform the initial wallet creation wizard.
-		if err := createWallet(&cfg); err != nil {
+		// Perform the initial wallet creation wizard, which only
+		// asks for account public keys when creating a watching-only
+		// wallet.
+		create := createWallet
+		if cfg.CreateWatchOnly {
+			create = createWatchingOnlyWallet
+		}
+		if err := create(&cfg); err != nil {
 			fmt.Fprintln(os.Stderr, "Unable to create wallet:", err)
 			return nil, nil, err
 		}
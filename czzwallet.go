@@ -14,6 +14,7 @@ import (
 	"runtime"
 	"sync"
 
+	"github.com/classzz/czzutil"
 	"github.com/classzz/czzwallet/chain"
 	"github.com/classzz/czzwallet/rpc/legacyrpc"
 	"github.com/classzz/czzwallet/wallet"
@@ -89,6 +90,43 @@ func walletMain() error {
 	}
 
 	loader.RunAfterLoad(func(w *wallet.Wallet) {
+		// The coin selection strategy was validated when loading the
+		// config.
+		coinSelector, _ := wallet.CoinSelectorByName(cfg.CoinSelection)
+		w.SetCoinSelector(coinSelector)
+
+		// The fee rate ceiling was validated when loading the config.
+		maxFeeRate, _ := czzutil.NewAmount(cfg.MaxFeeRate)
+		w.SetMaxFeeRate(maxFeeRate)
+
+		if cfg.ConsolidateThreshold > 0 {
+			// The fee rate ceiling was validated when loading the
+			// config.
+			maxFeeRate, _ := czzutil.NewAmount(cfg.ConsolidateMaxFeeRate)
+			err := w.StartConsolidation(wallet.ConsolidationConfig{
+				Threshold:             cfg.ConsolidateThreshold,
+				MaxFeeRate:            maxFeeRate,
+				MaxInputs:             cfg.ConsolidateMaxInputs,
+				RequiredConfirmations: 1,
+				Interval:              cfg.ConsolidateInterval,
+			})
+			if err != nil {
+				log.Errorf("Unable to start consolidation: %v", err)
+			}
+		}
+
+		if cfg.PayoutQueue {
+			err := w.StartPayoutQueue(wallet.PayoutQueueConfig{
+				Interval:              cfg.PayoutInterval,
+				BatchSize:             cfg.PayoutBatchSize,
+				RequiredConfirmations: 1,
+				Retention:             cfg.PayoutRetention,
+			})
+			if err != nil {
+				log.Errorf("Unable to start payout queue: %v", err)
+			}
+		}
+
 		startWalletRPCServices(w, rpcs, legacyRPCServer)
 	})
 
@@ -0,0 +1,198 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package bip39 implements the mnemonic encoding of wallet seeds described by
+// BIP0039, using its English word list.
+//
+// A mnemonic encodes random entropy together with a checksum as a sentence of
+// words, which is easier to back up reliably than a hexadecimal seed.  The
+// seed of the wallet is derived from the mnemonic and an optional passphrase,
+// and is used as the seed of the hdkeychain master key like any other seed.
+package bip39
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// MinEntropyBytes is the minimum number of bytes of entropy encoded by
+	// a mnemonic, which is encoded as 12 words.
+	MinEntropyBytes = 16
+
+	// MaxEntropyBytes is the maximum number of bytes of entropy encoded by
+	// a mnemonic, which is encoded as 24 words.
+	MaxEntropyBytes = 32
+
+	// RecommendedEntropyBytes is the recommended number of bytes of
+	// entropy of new mnemonics.
+	RecommendedEntropyBytes = 32
+
+	// SeedBytes is the length of the seeds derived from mnemonics.
+	SeedBytes = 64
+
+	// bitsPerWord is the number of bits encoded by each word.
+	bitsPerWord = 11
+
+	// seedIterations is the number of PBKDF2 iterations deriving the seed
+	// from a mnemonic.
+	seedIterations = 2048
+)
+
+var (
+	// ErrEntropyLength is returned when the entropy of a mnemonic does not
+	// have a valid length.
+	ErrEntropyLength = fmt.Errorf("entropy must be between %d and %d "+
+		"bytes and a multiple of 4 bytes", MinEntropyBytes,
+		MaxEntropyBytes)
+
+	// ErrMnemonicLength is returned when a mnemonic does not have a valid
+	// number of words.
+	ErrMnemonicLength = errors.New("mnemonic must have 12, 15, 18, 21 " +
+		"or 24 words")
+
+	// ErrUnknownWord is returned when a mnemonic contains a word which is
+	// not part of the word list.
+	ErrUnknownWord = errors.New("unknown mnemonic word")
+
+	// ErrChecksum is returned when the checksum of a mnemonic does not
+	// match the entropy it encodes.
+	ErrChecksum = errors.New("invalid mnemonic checksum")
+
+	// ErrPassphrase is returned when a mnemonic passphrase contains
+	// characters other than printable ASCII characters.  Passphrases are
+	// used as they are, so they are restricted to the characters which
+	// are not changed by the Unicode normalization of BIP0039.
+	ErrPassphrase = errors.New("mnemonic passphrase must only contain " +
+		"printable ASCII characters")
+)
+
+// wordIndexes maps each word of the word list to its index.
+var wordIndexes = make(map[string]uint16, len(wordList))
+
+func init() {
+	for i, word := range wordList {
+		wordIndexes[word] = uint16(i)
+	}
+}
+
+// GenerateEntropy returns size bytes of random entropy for a new mnemonic.
+func GenerateEntropy(size int) ([]byte, error) {
+	if !validEntropyLength(size) {
+		return nil, ErrEntropyLength
+	}
+	entropy := make([]byte, size)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, err
+	}
+	return entropy, nil
+}
+
+// validEntropyLength returns whether size is a valid number of bytes of
+// entropy of a mnemonic.
+func validEntropyLength(size int) bool {
+	return size >= MinEntropyBytes && size <= MaxEntropyBytes &&
+		size%4 == 0
+}
+
+// NewMnemonic returns the mnemonic encoding entropy.  The words of the
+// mnemonic are separated by single spaces.
+func NewMnemonic(entropy []byte) (string, error) {
+	if !validEntropyLength(len(entropy)) {
+		return "", ErrEntropyLength
+	}
+
+	// The entropy is followed by the first bit of its hash for every 32
+	// bits of entropy, and every 11 bits select a word.
+	checksum := sha256.Sum256(entropy)
+	data := make([]byte, len(entropy)+1)
+	copy(data, entropy)
+	data[len(entropy)] = checksum[0]
+
+	numBits := len(entropy)*8 + len(entropy)/4
+	words := make([]string, numBits/bitsPerWord)
+	for i := range words {
+		var index uint16
+		for j := 0; j < bitsPerWord; j++ {
+			index = index<<1 | uint16(bit(data, i*bitsPerWord+j))
+		}
+		words[i] = wordList[index]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// EntropyFromMnemonic returns the entropy encoded by a mnemonic after checking
+// its words and its checksum.  Words are separated by any whitespace, and are
+// not case sensitive.
+func EntropyFromMnemonic(mnemonic string) ([]byte, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	numBits := len(words) * bitsPerWord
+	if numBits%33 != 0 || !validEntropyLength(numBits/33*4) {
+		return nil, ErrMnemonicLength
+	}
+
+	data := make([]byte, (numBits+7)/8)
+	for i, word := range words {
+		index, ok := wordIndexes[word]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownWord, word)
+		}
+		for j := 0; j < bitsPerWord; j++ {
+			if index&(1<<(bitsPerWord-1-j)) != 0 {
+				pos := i*bitsPerWord + j
+				data[pos/8] |= 1 << (7 - pos%8)
+			}
+		}
+	}
+
+	entropy := data[:numBits/33*4]
+	checksumBits := uint(len(entropy) / 4)
+	checksum := sha256.Sum256(entropy)
+	if data[len(entropy)]>>(8-checksumBits) != checksum[0]>>(8-checksumBits) {
+		return nil, ErrChecksum
+	}
+	return entropy, nil
+}
+
+// NewSeed returns the seed derived from a mnemonic and an optional passphrase
+// after checking the mnemonic.  Different passphrases derive unrelated seeds
+// from the same mnemonic.
+func NewSeed(mnemonic string, passphrase []byte) ([]byte, error) {
+	if _, err := EntropyFromMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	if err := CheckPassphrase(passphrase); err != nil {
+		return nil, err
+	}
+
+	words := strings.Fields(strings.ToLower(mnemonic))
+	salt := append([]byte("mnemonic"), passphrase...)
+	return pbkdf2.Key(
+		[]byte(strings.Join(words, " ")), salt, seedIterations,
+		SeedBytes, sha512.New,
+	), nil
+}
+
+// CheckPassphrase returns ErrPassphrase if passphrase cannot be used as the
+// passphrase of a mnemonic.
+func CheckPassphrase(passphrase []byte) error {
+	for _, c := range passphrase {
+		if c < 0x20 || c > 0x7e {
+			return ErrPassphrase
+		}
+	}
+	return nil
+}
+
+// bit returns the bit at position pos of data, starting from the most
+// significant bit of the first byte.
+func bit(data []byte, pos int) byte {
+	return data[pos/8] >> (7 - pos%8) & 1
+}
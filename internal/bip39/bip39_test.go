@@ -0,0 +1,166 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bip39
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestVectors checks the mnemonics and seeds of the test vectors of BIP0039,
+// which all use the passphrase "TREZOR".
+func TestVectors(t *testing.T) {
+	tests := []struct {
+		entropy  string
+		mnemonic string
+		seed     string
+	}{{
+		entropy:  "00000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	}, {
+		entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		seed:     "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	}, {
+		entropy:  "ffffffffffffffffffffffffffffffff",
+		mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+		seed:     "ac27495480225222079d7be181583751e86f571027b0497b5b5d11218e0a8a13332572917f0f8e5a589620c6f15b11c61dee327651a14c34e18231052e48c069",
+	}, {
+		entropy:  "808080808080808080808080808080808080808080808080",
+		mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter always",
+		seed:     "107d7c02a5aa6f38c58083ff74f04c607c2d2c0ecc55501dadd72d025b751bc27fe913ffb796f841c49b1d33b610cf0e91d3aa239027f5e99fe4ce9e5088cd65",
+	}, {
+		entropy:  "0000000000000000000000000000000000000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		seed:     "bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+	}, {
+		entropy:  "7ac45cfe7722ee6c7ba84fbc2d5bd61b45cb2fe5eb65aa78",
+		mnemonic: "kiss carry display unusual confirm curtain upgrade antique rotate hello void custom frequent obey nut hole price segment",
+		seed:     "ed56ff6c833c07982eb7119a8f48fd363c4a9b1601cd2de736b01045c5eb8ab4f57b079403485d1c4924f0790dc10a971763337cb9f9c62226f64fff26397c79",
+	}, {
+		entropy:  "18ab19a9f54a9274f03e5209a2ac8a91",
+		mnemonic: "board flee heavy tunnel powder denial science ski answer betray cargo cat",
+		seed:     "6eff1bb21562918509c73cb990260db07c0ce34ff0e3cc4a8cb3276129fbcb300bddfe005831350efd633909f476c45c88253276d9fd0df6ef48609e8bb7dca8",
+	}, {
+		entropy:  "15da872c95a13dd738fbf50e427583ad61f18fd99f628c417a61cf8343c90419",
+		mnemonic: "beyond stage sleep clip because twist token leaf atom beauty genius food business side grid unable middle armed observe pair crouch tonight away coconut",
+		seed:     "b15509eaa2d09d3efd3e006ef42151b30367dc6e3aa5e44caba3fe4d3e352e65101fbdb86a96776b91946ff06f8eac594dc6ee1d3e82a42dfe1b40fef6bcc3fd",
+	}}
+
+	for i, test := range tests {
+		entropy, _ := hex.DecodeString(test.entropy)
+		mnemonic, err := NewMnemonic(entropy)
+		if err != nil {
+			t.Fatalf("#%d: unable to create mnemonic: %v", i, err)
+		}
+		if mnemonic != test.mnemonic {
+			t.Fatalf("#%d: expected mnemonic %q, got %q", i,
+				test.mnemonic, mnemonic)
+		}
+
+		decoded, err := EntropyFromMnemonic(test.mnemonic)
+		if err != nil {
+			t.Fatalf("#%d: unable to decode mnemonic: %v", i, err)
+		}
+		if hex.EncodeToString(decoded) != test.entropy {
+			t.Fatalf("#%d: expected entropy %s, got %x", i,
+				test.entropy, decoded)
+		}
+
+		// The case and spacing of the words does not change the seed.
+		entered := "  " + strings.ToUpper(test.mnemonic) + "\n"
+		seed, err := NewSeed(entered, []byte("TREZOR"))
+		if err != nil {
+			t.Fatalf("#%d: unable to derive seed: %v", i, err)
+		}
+		if hex.EncodeToString(seed) != test.seed {
+			t.Fatalf("#%d: expected seed %s, got %x", i, test.seed,
+				seed)
+		}
+	}
+}
+
+// TestInvalidMnemonics checks that mnemonics with unknown words, a wrong
+// number of words or a wrong checksum are rejected.
+func TestInvalidMnemonics(t *testing.T) {
+	tests := []struct {
+		mnemonic string
+		err      error
+	}{{
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		err:      ErrMnemonicLength,
+	}, {
+		mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow yellow",
+		err:      ErrMnemonicLength,
+	}, {
+		mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice caged above",
+		err:      ErrUnknownWord,
+	}, {
+		mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo, wrong",
+		err:      ErrUnknownWord,
+	}, {
+		mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo",
+		err:      ErrChecksum,
+	}, {
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon letter",
+		err:      ErrChecksum,
+	}, {
+		mnemonic: "jello better achieve collect unaware mountain thought cargo oxygen act hood bridge",
+		err:      ErrUnknownWord,
+	}}
+
+	for i, test := range tests {
+		_, err := EntropyFromMnemonic(test.mnemonic)
+		if !errors.Is(err, test.err) {
+			t.Fatalf("#%d: expected %v, got %v", i, test.err, err)
+		}
+		_, err = NewSeed(test.mnemonic, nil)
+		if !errors.Is(err, test.err) {
+			t.Fatalf("#%d: expected %v, got %v", i, test.err, err)
+		}
+	}
+
+	if _, err := NewMnemonic(make([]byte, 17)); err != ErrEntropyLength {
+		t.Fatalf("expected ErrEntropyLength, got %v", err)
+	}
+	mnemonic, err := NewMnemonic(make([]byte, 16))
+	if err != nil {
+		t.Fatalf("unable to create mnemonic: %v", err)
+	}
+	if _, err := NewSeed(mnemonic, []byte("päss")); err != ErrPassphrase {
+		t.Fatalf("expected ErrPassphrase, got %v", err)
+	}
+}
+
+// TestGenerateEntropy checks that generated entropy round trips through its
+// mnemonic.
+func TestGenerateEntropy(t *testing.T) {
+	for size := MinEntropyBytes; size <= MaxEntropyBytes; size += 4 {
+		entropy, err := GenerateEntropy(size)
+		if err != nil {
+			t.Fatalf("unable to generate entropy: %v", err)
+		}
+		mnemonic, err := NewMnemonic(entropy)
+		if err != nil {
+			t.Fatalf("unable to create mnemonic: %v", err)
+		}
+		if n := len(strings.Fields(mnemonic)); n != size*3/4 {
+			t.Fatalf("expected %d words, got %d", size*3/4, n)
+		}
+		decoded, err := EntropyFromMnemonic(mnemonic)
+		if err != nil {
+			t.Fatalf("unable to decode mnemonic: %v", err)
+		}
+		if hex.EncodeToString(decoded) != hex.EncodeToString(entropy) {
+			t.Fatalf("expected entropy %x, got %x", entropy, decoded)
+		}
+	}
+	if _, err := GenerateEntropy(MaxEntropyBytes + 4); err != ErrEntropyLength {
+		t.Fatalf("expected ErrEntropyLength, got %v", err)
+	}
+}
@@ -7,13 +7,18 @@ package prompt
 import (
 	"bufio"
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/internal/bip39"
 	"github.com/classzz/czzwallet/internal/legacy/keystore"
+	"github.com/classzz/czzwallet/internal/shamir"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
@@ -258,10 +263,14 @@ func PublicPass(reader *bufio.Reader, privPass []byte,
 }
 
 // Seed prompts the user whether they want to use an existing wallet generation
-// seed.  When the user answers no, a seed will be generated and displayed to
-// the user along with prompting them for confirmation.  When the user answers
-// yes, a the user is prompted for it.  All prompts are repeated until the user
-// enters a valid response.
+// seed.  When the user answers no, a BIP0039 mnemonic will be generated and
+// displayed to the user along with prompting them for confirmation, and the
+// seed is derived from the mnemonic and an optional passphrase.  The user may
+// instead have a new seed split into Shamir shares, of which only the shares
+// are displayed.  When the user answers yes, the user is prompted for either a
+// hexadecimal seed, a mnemonic, whose words and checksum are checked, or seed
+// shares, which are prompted for until enough are entered to recover the seed.
+// All prompts are repeated until the user enters a valid response.
 func Seed(reader *bufio.Reader) ([]byte, error) {
 	// Ascertain the wallet generation seed.
 	useUserSeed, err := promptListBool(reader, "Do you have an "+
@@ -270,52 +279,99 @@ func Seed(reader *bufio.Reader) ([]byte, error) {
 		return nil, err
 	}
 	if !useUserSeed {
-		seed, err := hdkeychain.GenerateSeed(hdkeychain.RecommendedSeedLen)
+		splitSeed, err := promptListBool(reader, "Do you want to split "+
+			"the wallet seed into shares instead of using a mnemonic?",
+			"no")
+		if err != nil {
+			return nil, err
+		}
+		if splitSeed {
+			return newSplitSeed(reader)
+		}
+
+		entropy, err := bip39.GenerateEntropy(
+			bip39.RecommendedEntropyBytes,
+		)
+		if err != nil {
+			return nil, err
+		}
+		mnemonic, err := bip39.NewMnemonic(entropy)
 		if err != nil {
 			return nil, err
 		}
 
-		fmt.Println("Your wallet generation seed is:")
-		fmt.Printf("%x\n", seed)
-		fmt.Println("IMPORTANT: Keep the seed in a safe place as you\n" +
+		fmt.Println("Your wallet generation seed is the mnemonic:")
+		fmt.Println(mnemonic)
+		fmt.Println("IMPORTANT: Keep the mnemonic in a safe place as you\n" +
 			"will NOT be able to restore your wallet without it.")
 		fmt.Println("Please keep in mind that anyone who has access\n" +
-			"to the seed can also restore your wallet thereby\n" +
+			"to the mnemonic can also restore your wallet thereby\n" +
 			"giving them access to all your funds, so it is\n" +
 			"imperative that you keep it in a secure location.")
 
-		for {
-			fmt.Print(`Once you have stored the seed in a safe ` +
-				`and secure location, enter "OK" to continue: `)
-			confirmSeed, err := reader.ReadString('\n')
-			if err != nil {
-				return nil, err
-			}
-			confirmSeed = strings.TrimSpace(confirmSeed)
-			confirmSeed = strings.Trim(confirmSeed, `"`)
-			if confirmSeed == "OK" {
-				break
-			}
+		if err := confirmStored(reader, "the mnemonic"); err != nil {
+			return nil, err
 		}
 
-		return seed, nil
+		passphrase, err := mnemonicPassphrase(reader, true)
+		if err != nil {
+			return nil, err
+		}
+		return bip39.NewSeed(mnemonic, passphrase)
 	}
 
 	for {
-		fmt.Print("Enter existing wallet seed: ")
+		fmt.Print("Enter existing wallet seed, mnemonic or seed share: ")
 		seedStr, err := reader.ReadString('\n')
 		if err != nil {
 			return nil, err
 		}
 		seedStr = strings.TrimSpace(strings.ToLower(seedStr))
 
+		// A seed of dash separated fields is a seed share, which is
+		// combined with more shares until their threshold is reached.
+		if strings.Contains(seedStr, "-") {
+			share, err := shamir.ParseShare(seedStr)
+			if err != nil {
+				fmt.Printf("Invalid seed share specified: %v\n", err)
+				continue
+			}
+			seed, err := seedFromShares(reader, share)
+			if err != nil {
+				return nil, err
+			}
+			if len(seed) < hdkeychain.MinSeedBytes ||
+				len(seed) > hdkeychain.MaxSeedBytes {
+
+				fmt.Println("Invalid seed shares specified.  The " +
+					"recovered seed does not have a valid length")
+				continue
+			}
+			return seed, nil
+		}
+
+		// A seed of several words is a mnemonic.
+		if len(strings.Fields(seedStr)) > 1 {
+			_, err := bip39.EntropyFromMnemonic(seedStr)
+			if err != nil {
+				fmt.Printf("Invalid mnemonic specified: %v\n", err)
+				continue
+			}
+			passphrase, err := mnemonicPassphrase(reader, false)
+			if err != nil {
+				return nil, err
+			}
+			return bip39.NewSeed(seedStr, passphrase)
+		}
+
 		seed, err := hex.DecodeString(seedStr)
 		if err != nil || len(seed) < hdkeychain.MinSeedBytes ||
 			len(seed) > hdkeychain.MaxSeedBytes {
 
 			fmt.Printf("Invalid seed specified.  Must be a "+
 				"hexadecimal value that is at least %d bits and "+
-				"at most %d bits\n", hdkeychain.MinSeedBytes*8,
+				"at most %d bits, a mnemonic or a seed share\n",
+				hdkeychain.MinSeedBytes*8,
 				hdkeychain.MaxSeedBytes*8)
 			continue
 		}
@@ -323,3 +379,306 @@ func Seed(reader *bufio.Reader) ([]byte, error) {
 		return seed, nil
 	}
 }
+
+// mnemonicPassphrase prompts the user for the optional passphrase which is used
+// together with a mnemonic to derive the wallet seed.  A nil passphrase is
+// returned when the user does not use one.
+func mnemonicPassphrase(reader *bufio.Reader, confirm bool) ([]byte, error) {
+	usePass, err := promptListBool(reader, "Does the mnemonic use an "+
+		"additional passphrase?", "no")
+	if err != nil || !usePass {
+		return nil, err
+	}
+	if confirm {
+		fmt.Println("IMPORTANT: The passphrase is required together " +
+			"with the mnemonic to restore your wallet.")
+	}
+
+	for {
+		pass, err := promptPass(reader, "Enter the mnemonic passphrase",
+			confirm)
+		if err != nil {
+			return nil, err
+		}
+		if err := bip39.CheckPassphrase(pass); err != nil {
+			fmt.Println(err)
+			continue
+		}
+		return pass, nil
+	}
+}
+
+// newSplitSeed generates a new wallet seed and splits it into shares, any
+// threshold of which restore the wallet.  Only the shares are shown to the
+// user, so the full seed never needs to be kept in a single place.
+func newSplitSeed(reader *bufio.Reader) ([]byte, error) {
+	count, err := promptInt(reader, "Enter the number of shares", 2,
+		shamir.MaxShares)
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := promptInt(reader, "Enter the number of shares "+
+		"required to restore the wallet", shamir.MinThreshold, count)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.RecommendedSeedLen)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := shamir.Split(seed, threshold, count)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Your wallet generation seed is split into %d shares, "+
+		"any %d of which restore your wallet:\n", count, threshold)
+	for _, share := range shares {
+		fmt.Println(share)
+	}
+	fmt.Println("IMPORTANT: Keep each share in a different safe place as\n" +
+		"you will NOT be able to restore your wallet without enough\n" +
+		"of them.  Anyone who has access to enough shares can also\n" +
+		"restore your wallet thereby giving them access to all your\n" +
+		"funds.")
+
+	if err := confirmStored(reader, "the shares"); err != nil {
+		return nil, err
+	}
+	return seed, nil
+}
+
+// seedFromShares prompts the user for more shares of the same seed as first
+// until their threshold is reached, and returns the seed they recover.
+func seedFromShares(reader *bufio.Reader, first *shamir.Share) ([]byte, error) {
+	shares := []*shamir.Share{first}
+	indexes := map[uint8]struct{}{first.Index: {}}
+	for len(shares) < int(first.Threshold) {
+		fmt.Printf("Enter seed share %d of %d: ", len(shares)+1,
+			first.Threshold)
+		shareStr, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		share, err := shamir.ParseShare(shareStr)
+		if err != nil {
+			fmt.Printf("Invalid seed share specified: %v\n", err)
+			continue
+		}
+		if share.ID != first.ID || share.Threshold != first.Threshold ||
+			len(share.Value) != len(first.Value) {
+
+			fmt.Println("Seed share does not belong to the same seed")
+			continue
+		}
+		if _, ok := indexes[share.Index]; ok {
+			fmt.Println("Seed share was already entered")
+			continue
+		}
+		indexes[share.Index] = struct{}{}
+		shares = append(shares, share)
+	}
+
+	return shamir.Combine(shares)
+}
+
+// confirmStored prompts the user to confirm that they stored what describes
+// the wallet seed.
+func confirmStored(reader *bufio.Reader, what string) error {
+	for {
+		fmt.Printf(`Once you have stored %s in a safe and secure `+
+			`location, enter "OK" to continue: `, what)
+		confirmSeed, err := reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		confirmSeed = strings.TrimSpace(confirmSeed)
+		confirmSeed = strings.Trim(confirmSeed, `"`)
+		if confirmSeed == "OK" {
+			return nil
+		}
+	}
+}
+
+// promptInt prompts the user for an integer between min and max with the
+// given prefix.  The function will repeat the prompt to the user until they
+// enter a valid response.
+func promptInt(reader *bufio.Reader, prefix string, min, max int) (int, error) {
+	for {
+		fmt.Printf("%s (%d-%d): ", prefix, min, max)
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(reply))
+		if err == nil && n >= min && n <= max {
+			return n, nil
+		}
+	}
+}
+
+// AccountKey is the extended public key of an account entered by the user,
+// together with the key scope the account is imported into.
+type AccountKey struct {
+	// Name is the name of the account.
+	Name string
+
+	// Purpose and Coin are the purpose and coin type of the key scope of
+	// the account.
+	Purpose uint32
+	Coin    uint32
+
+	// ExtendedKey is the extended public key of the account.
+	ExtendedKey *hdkeychain.ExtendedKey
+
+	// MasterKeyFingerprint is the fingerprint of the master key the account
+	// key is derived from, or zero when it is unknown.
+	MasterKeyFingerprint uint32
+}
+
+// AccountKeys prompts the user for the extended public keys of the accounts of
+// a watching-only wallet until the user enters an empty line after at least
+// one of them.  For each account, the user is also prompted for its key scope,
+// the fingerprint of its master key as returned by getaccountxpub, and its
+// name.  Private keys are rejected.  All prompts are repeated until the user
+// enters a valid response.
+func AccountKeys(reader *bufio.Reader) ([]*AccountKey, error) {
+	var accounts []*AccountKey
+	for {
+		prefix := "Enter the extended public key of an account"
+		if len(accounts) > 0 {
+			prefix += ", or press enter when done"
+		}
+		fmt.Print(prefix + ": ")
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		reply = strings.TrimSpace(reply)
+		if reply == "" {
+			if len(accounts) > 0 {
+				return accounts, nil
+			}
+			continue
+		}
+
+		key, err := hdkeychain.NewKeyFromString(reply)
+		if err != nil {
+			fmt.Printf("Invalid extended public key: %v\n", err)
+			continue
+		}
+		if key.IsPrivate() {
+			fmt.Println("A watching-only wallet may not hold private " +
+				"keys.")
+			continue
+		}
+
+		account := &AccountKey{ExtendedKey: key}
+		account.Purpose, account.Coin, err = promptKeyScope(reader)
+		if err != nil {
+			return nil, err
+		}
+		account.MasterKeyFingerprint, err = promptFingerprint(reader)
+		if err != nil {
+			return nil, err
+		}
+		account.Name, err = promptAccountName(reader)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+}
+
+// promptKeyScope prompts the user for the key scope of an account, entered as
+// the purpose and coin type steps of its derivation path.  The BIP0044 key
+// scope m/44'/0' is used when the user enters nothing.
+func promptKeyScope(reader *bufio.Reader) (uint32, uint32, error) {
+	for {
+		fmt.Print("Enter the key scope of the account as " +
+			"m/purpose'/coin_type' [m/44'/0']: ")
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return 0, 0, err
+		}
+		reply = strings.TrimSpace(reply)
+		if reply == "" {
+			return 44, 0, nil
+		}
+
+		steps := strings.Split(strings.TrimPrefix(reply, "m/"), "/")
+		if len(steps) != 2 {
+			continue
+		}
+		purpose, err := strconv.ParseUint(
+			strings.TrimRight(steps[0], "'h"), 10, 31,
+		)
+		if err != nil {
+			continue
+		}
+		coin, err := strconv.ParseUint(
+			strings.TrimRight(steps[1], "'h"), 10, 31,
+		)
+		if err != nil {
+			continue
+		}
+		return uint32(purpose), uint32(coin), nil
+	}
+}
+
+// promptFingerprint prompts the user for the hexadecimal fingerprint of the
+// master key an account key is derived from.  Zero is returned when the user
+// enters nothing.
+func promptFingerprint(reader *bufio.Reader) (uint32, error) {
+	for {
+		fmt.Print("Enter the master key fingerprint of the account in " +
+			"hex, or press enter if it is unknown: ")
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return 0, err
+		}
+		reply = strings.TrimSpace(reply)
+		if reply == "" {
+			return 0, nil
+		}
+
+		fingerprint, err := hex.DecodeString(reply)
+		if err == nil && len(fingerprint) == 4 {
+			return binary.LittleEndian.Uint32(fingerprint), nil
+		}
+	}
+}
+
+// promptAccountName prompts the user for the name of an account.
+func promptAccountName(reader *bufio.Reader) (string, error) {
+	for {
+		fmt.Print("Enter the name of the account: ")
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if name := strings.TrimSpace(reply); name != "" {
+			return name, nil
+		}
+	}
+}
+
+// Birthday prompts the user for the birthday of a watching-only wallet, the
+// date before which none of its accounts were used.  The addresses of the
+// accounts are recovered from this date when the wallet first syncs with the
+// chain.  The prompt is repeated until the user enters a valid date.
+func Birthday(reader *bufio.Reader) (time.Time, error) {
+	for {
+		fmt.Print("Enter the date the accounts were first used as " +
+			"YYYY-MM-DD: ")
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return time.Time{}, err
+		}
+		bday, err := time.Parse("2006-01-02", strings.TrimSpace(reply))
+		if err == nil {
+			return bday, nil
+		}
+	}
+}
@@ -12,8 +12,8 @@ import (
 	"os"
 	"strings"
 
-	"github.com/classzz/classzz/btcjson"
 	"github.com/classzz/czzwallet/internal/rpchelp"
+	"github.com/classzz/czzwallet/rpc/walletjson"
 )
 
 var outputFile = func() *os.File {
@@ -41,7 +41,7 @@ func writeLocaleHelp(locale, goLocale string, descs map[string]string) {
 	writefln("return map[string]string{")
 	for i := range rpchelp.Methods {
 		m := &rpchelp.Methods[i]
-		helpText, err := btcjson.GenerateHelp(m.Method, descs, m.ResultTypes...)
+		helpText, err := walletjson.GenerateHelp(m.Method, descs, m.ResultTypes...)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -63,7 +63,7 @@ func writeUsage() {
 	usageStrs := make([]string, len(rpchelp.Methods))
 	var err error
 	for i := range rpchelp.Methods {
-		usageStrs[i], err = btcjson.MethodUsageText(rpchelp.Methods[i].Method)
+		usageStrs[i], err = walletjson.MethodUsageText(rpchelp.Methods[i].Method)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -7,6 +7,12 @@
 package rpchelp
 
 var helpDescsEnUS = map[string]string{
+	// AbandonTransactionCmd help.
+	"abandontransaction--synopsis": "Removes an unmined wallet transaction, and all unmined transactions spending its outputs, from the wallet.\n" +
+		"The outputs spent by the removed transactions become spendable again, and the transactions are listed as abandoned by listtransactions.\n" +
+		"Transactions should only be abandoned if they are not in the mempool of the consensus server.",
+	"abandontransaction-txid": "The hash of the unmined transaction",
+
 	// AddMultisigAddressCmd help.
 	"addmultisigaddress--synopsis": "Generates and imports a multisig address and redeeming script to the 'imported' account.",
 	"addmultisigaddress-account":   "DEPRECATED -- Unused (all imported addresses belong to the imported account)",
@@ -14,6 +20,39 @@ var helpDescsEnUS = map[string]string{
 	"addmultisigaddress-nrequired": "The number of signatures required to redeem outputs paid to this address",
 	"addmultisigaddress--result0":  "The imported pay-to-script-hash address",
 
+	// AddTimeLockAddressCmd help.
+	"addtimelockaddress--synopsis": "Generates and imports a pay-to-script-hash address paying to a key once a CLTV or CSV time lock has expired to the 'imported' account.\n" +
+		"Outputs paid to the address are never selected to fund wallet transactions, and can only be spent by signing a transaction satisfying the lock.",
+	"addtimelockaddress-key":      "Pubkey or pay-to-pubkey-hash address of the wallet controlling the address",
+	"addtimelockaddress-locktype": "The type of time lock: cltv locks until an absolute block height or time, csv locks for a relative number of blocks or 512-second intervals",
+	"addtimelockaddress-value":    "The lock time (cltv) or encoded sequence lock (csv) of the time lock",
+
+	// AddTimeLockAddressResult help.
+	"addtimelockaddressresult-address":      "The imported pay-to-script-hash address",
+	"addtimelockaddressresult-redeemScript": "The script required to redeem outputs paid to the address",
+
+	// BackupWalletCmd help.
+	"backupwallet--synopsis":   "Writes a consistent copy of the wallet database to a file while the wallet is running, replacing any existing file.",
+	"backupwallet-destination": "Path of the backup file to write",
+
+	// BumpFeeCmd help.
+	"bumpfee--synopsis": "Bumps the fee of an unmined wallet transaction by publishing a child transaction (child-pays-for-parent).\n" +
+		"The child spends a wallet output of the transaction and pays enough fee for both transactions together to reach the fee rate.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"bumpfee-txid":    "The hash of the unmined transaction",
+	"bumpfee-options": "Options for the fee bump",
+
+	// BumpFeeOptions help.
+	"bumpfeeoptions-feeRate":    "Fee rate in bitcoin per kilobyte the parent and child transaction together should pay, defaults to the fee rate estimated for confTarget",
+	"bumpfeeoptions-confTarget": "Number of blocks the parent and child transaction should be mined within, used to estimate the fee rate when feeRate is not set (default=6)",
+
+	// BumpFeeResult help.
+	"bumpfeeresult-txid":           "The hash of the child transaction",
+	"bumpfeeresult-parenttxid":     "The hash of the transaction whose fee was bumped",
+	"bumpfeeresult-fee":            "The fee paid by the child transaction valued in bitcoin",
+	"bumpfeeresult-parentfee":      "The fee paid by the parent transaction valued in bitcoin, or zero if it spends outputs not controlled by the wallet",
+	"bumpfeeresult-packagefeerate": "The fee rate of the parent, its unmined ancestors and the child transaction together in bitcoin per kilobyte",
+
 	// CreateMultisigCmd help.
 	"createmultisig--synopsis": "Generate a multisig address and redeem script.",
 	"createmultisig-keys":      "Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address",
@@ -28,6 +67,12 @@ var helpDescsEnUS = map[string]string{
 	"dumpprivkey-address":   "The address to return a private key for",
 	"dumpprivkey--result0":  "The WIF-encoded private key",
 
+	// DumpWalletCmd help.
+	"dumpwallet--synopsis": "Writes a text export of all wallet keys to a new file, including the private key of every address in WIF, or its public key if the wallet has no private key for it, the derivation path and account of HD keys, imported scripts, and the wallet birthday.\n" +
+		"The HD seed itself is not exported.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"dumpwallet-filename": "Path of the file to write, which must not already exist",
+
 	// GetAccountCmd help.
 	"getaccount--synopsis": "DEPRECATED -- Lookup the account name that some wallet address belongs to.",
 	"getaccount-address":   "The address to query the account for",
@@ -75,7 +120,7 @@ var helpDescsEnUS = map[string]string{
 	"infowalletresult-testnet":         "Whether or not server is using testnet",
 	"infowalletresult-relayfee":        "The minimum relay fee for non-free transactions in BTC/KB",
 	"infowalletresult-errors":          "Any current errors",
-	"infowalletresult-paytxfee":        "The increment used each time more fee is required for an authored transaction",
+	"infowalletresult-paytxfee":        "The fee rate per kilobyte currently paid by authored transactions",
 	"infowalletresult-balance":         "The balance of all accounts calculated with one block confirmation",
 	"infowalletresult-walletversion":   "The version of the address manager database",
 	"infowalletresult-unlocked_until":  "Unset",
@@ -109,6 +154,31 @@ var helpDescsEnUS = map[string]string{
 	"gettransaction-txid":             "Hash of the transaction to query",
 	"gettransaction-includewatchonly": "Also consider transactions involving watched addresses",
 
+	// GetWalletInfoCmd help.
+	"getwalletinfo--synopsis": "Returns a JSON object with the balances, lock state and sync state of the wallet.",
+
+	// GetWalletInfoResult help.
+	"getwalletinforesult-balance":             "The balance of all mature outputs with at least one confirmation valued in bitcoin",
+	"getwalletinforesult-unconfirmed_balance": "The balance of all outputs of unmined transactions valued in bitcoin",
+	"getwalletinforesult-immature_balance":    "The balance of all coinbase outputs that have not matured yet valued in bitcoin",
+	"getwalletinforesult-frozen_balance":      "The balance of all frozen outputs, not included in the other balances, valued in bitcoin",
+	"getwalletinforesult-txcount":             "The number of transactions recorded by the wallet",
+	"getwalletinforesult-accounts":            "The number of accounts of every key scope of the wallet",
+	"getwalletinforesult-locked":              "Whether the wallet is locked",
+	"getwalletinforesult-unlocked_until":      "The Unix time the wallet will be relocked, or 0 if the wallet is locked or unlocked without a time limit",
+	"getwalletinforesult-watchonly":           "Whether the wallet is watching-only",
+	"getwalletinforesult-birthday":            "The Unix time of the wallet birthday",
+	"getwalletinforesult-birthdayheight":      "The height of the block matching the wallet birthday, or 0 if not known yet",
+	"getwalletinforesult-birthdayhash":        "The hash of the block matching the wallet birthday, or all zeros if not known yet",
+	"getwalletinforesult-syncheight":          "The height of the block the wallet is synced to",
+	"getwalletinforesult-synchash":            "The hash of the block the wallet is synced to",
+	"getwalletinforesult-rescanning":          "Whether a rescan is running",
+	"getwalletinforesult-recovering":          "Whether the wallet is recovering addresses from its seed",
+
+	// ScopeAccountsResult help.
+	"scopeaccountsresult-keyscope": "The key scope, as purpose/coin",
+	"scopeaccountsresult-accounts": "The number of accounts of the key scope, not counting the imported account",
+
 	// HelpCmd help.
 	"help--synopsis":   "Returns a list of all commands or help for a specified command.",
 	"help-command":     "The command to retrieve help for",
@@ -130,6 +200,7 @@ var helpDescsEnUS = map[string]string{
 	"gettransactionresult-timereceived":    "The earliest Unix time this transaction was known to exist",
 	"gettransactionresult-details":         "Additional details for each recorded wallet credit and debit",
 	"gettransactionresult-hex":             "The transaction encoded as a hexadecimal string",
+	"gettransactionresult-data":            "The hex-encoded data carried by each null-data (OP_RETURN) output of the transaction",
 
 	// GetTransactionDetailsResult help.
 	"gettransactiondetailsresult-account":           "DEPRECATED -- Unset",
@@ -146,6 +217,12 @@ var helpDescsEnUS = map[string]string{
 	"importprivkey-label":     "Unused (must be unset or 'imported')",
 	"importprivkey-rescan":    "Rescan the blockchain (since the genesis block) for outputs controlled by the imported key",
 
+	// ImportWalletCmd help.
+	"importwallet--synopsis": "Imports all keys and scripts of a file written by dumpwallet to the 'imported' account, followed by a single rescan from the earliest key timestamp.\n" +
+		"Keys derived from the seed of this wallet are instead restored into their accounts, which are created with the names recorded by the dump if missing.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"importwallet-filename": "Path of the wallet dump to import",
+
 	// KeypoolRefillCmd help.
 	"keypoolrefill--synopsis": "DEPRECATED -- This request does nothing since no keypool is maintained.",
 	"keypoolrefill-newsize":   "Unused",
@@ -157,8 +234,19 @@ var helpDescsEnUS = map[string]string{
 	"listaccounts--result0--key":   "The account name",
 	"listaccounts--result0--value": "The account balance valued in bitcoin",
 
+	// ListAddressGroupingsCmd help.
+	"listaddressgroupings--synopsis": "Returns groups of wallet addresses that the public can link to each other because they were spent together as inputs of a transaction or received its change.",
+	"listaddressgroupings--result0":  "An array of address groups, each an array of [address, amount, label] entries with the balance of the address valued in bitcoin and the account of the address as its label",
+
 	// ListLockUnspentCmd help.
-	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
+	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session, and of persistently locked outputs.",
+
+	// LockedOutputResult help.
+	"lockedoutputresult-txid":       "The transaction hash of the locked output",
+	"lockedoutputresult-vout":       "The output index of the locked output",
+	"lockedoutputresult-persistent": "Whether the lock is saved across wallet restarts",
+	"lockedoutputresult-reason":     "The reason of a persistent lock",
+	"lockedoutputresult-expiry":     "The unix time a persistent lock expires at, omitted if it does not expire",
 
 	// TransactionInput help.
 	"transactioninput-txid": "The transaction hash of the referenced output",
@@ -206,17 +294,17 @@ var helpDescsEnUS = map[string]string{
 	// ListTransactionsResult help.
 	"listtransactionsresult-account":            "DEPRECATED -- Unset",
 	"listtransactionsresult-address":            "Payment address for a transaction output",
-	"listtransactionsresult-category":           `The kind of transaction: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, or "recv" for all other received outputs.  Note: A single output may be included multiple times under different categories`,
+	"listtransactionsresult-category":           `The kind of transaction: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, "conflicted" for transactions removed because they conflict with a mined transaction, or "recv" for all other received outputs.  Note: A single output may be included multiple times under different categories`,
 	"listtransactionsresult-amount":             "The value of the transaction output valued in bitcoin",
 	"listtransactionsresult-fee":                "The total input value minus the total output value for sent transactions",
-	"listtransactionsresult-confirmations":      "The number of block confirmations of the transaction",
+	"listtransactionsresult-confirmations":      "The number of block confirmations of the transaction, or minus the number of block confirmations of the conflicting transaction for conflicted transactions",
 	"listtransactionsresult-generated":          "Whether the transaction output is a coinbase output",
 	"listtransactionsresult-blockhash":          "The hash of the block this transaction is mined in, or the empty string if unmined",
 	"listtransactionsresult-blockindex":         "Unset",
 	"listtransactionsresult-blocktime":          "The Unix time of the block header this transaction is mined in, or 0 if unmined",
 	"listtransactionsresult-txid":               "The hash of the transaction",
 	"listtransactionsresult-vout":               "The transaction output index",
-	"listtransactionsresult-walletconflicts":    "Unset",
+	"listtransactionsresult-walletconflicts":    "The hashes of the mined transactions a conflicted transaction conflicts with",
 	"listtransactionsresult-time":               "The earliest Unix time this transaction was known to exist",
 	"listtransactionsresult-timereceived":       "The earliest Unix time this transaction was known to exist",
 	"listtransactionsresult-involveswatchonly":  "Unset",
@@ -224,7 +312,8 @@ var helpDescsEnUS = map[string]string{
 	"listtransactionsresult-otheraccount":       "Unset",
 	"listtransactionsresult-trusted":            "Unset",
 	"listtransactionsresult-bip125-replaceable": "Unset",
-	"listtransactionsresult-abandoned":          "Unset",
+	"listtransactionsresult-abandoned":          "Whether the transaction was abandoned with abandontransaction",
+	"listtransactionsresult-data":               "The hex-encoded data carried by a null-data (OP_RETURN) output",
 
 	// ListTransactionsCmd help.
 	"listtransactions--synopsis":        "Returns a JSON array of objects containing verbose details for wallet transactions.",
@@ -251,13 +340,19 @@ var helpDescsEnUS = map[string]string{
 	"listunspentresult-spendable":     "Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)",
 
 	// LockUnspentCmd help.
-	"lockunspent--synopsis": "Locks or unlocks an unspent output.\n" +
+	// The command is registered as lockunspentext to extend the parameters
+	// of the btcjson command.
+	"lockunspentext--synopsis": "Locks or unlocks an unspent output.\n" +
 		"Locked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\n" +
-		"Locked outputs are volatile and are not saved across wallet restarts.\n" +
-		"If unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.",
-	"lockunspent-unlock":       "True to unlock outputs, false to lock",
-	"lockunspent-transactions": "Transaction outputs to lock or unlock",
-	"lockunspent--result0":     "The boolean 'true'",
+		"Locked outputs are volatile and are not saved across wallet restarts, unless they are locked with persistent set to true.\n" +
+		"If unlock is true and no transaction outputs are specified, all outputs locked for the wallet session are marked unlocked, or all persistently locked outputs if persistent is also true.\n" +
+		"Unlocking specified transaction outputs removes both their session and persistent locks.",
+	"lockunspentext-unlock":       "True to unlock outputs, false to lock",
+	"lockunspentext-transactions": "Transaction outputs to lock or unlock",
+	"lockunspentext-persistent":   "Save the locks in the wallet database so they survive wallet restarts, or when unlocking all outputs, unlock the persistently locked outputs instead of the session locks",
+	"lockunspentext-reason":       "The reason of the persistent locks",
+	"lockunspentext-expiry":       "The unix time the persistent locks expire at, or 0 for locks which do not expire",
+	"lockunspentext--result0":     "The boolean 'true'",
 
 	// SendFromCmd help.
 	"sendfrom--synopsis": "DEPRECATED -- Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
@@ -271,30 +366,44 @@ var helpDescsEnUS = map[string]string{
 	"sendfrom--result0":    "The transaction hash of the sent transaction",
 
 	// SendManyCmd help.
-	"sendmany--synopsis": "Authors, signs, and sends a transaction that outputs to many payment addresses.\n" +
+	// The command is registered as sendmanyext to extend the parameters
+	// of the btcjson command.
+	"sendmanyext--synopsis": "Authors, signs, and sends a transaction that outputs to many payment addresses.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendmany-fromaccount":    "DEPRECATED -- Account to pick unspent outputs from",
-	"sendmany-amounts":        "Pairs of payment addresses and the output amount to pay each",
-	"sendmany-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address",
-	"sendmany-amounts--key":   "Address to pay",
-	"sendmany-amounts--value": "Amount to send to the payment address valued in bitcoin",
-	"sendmany-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
-	"sendmany-comment":        "Unused",
-	"sendmany--result0":       "The transaction hash of the sent transaction",
+	"sendmanyext-fromaccount":     "DEPRECATED -- Account to pick unspent outputs from",
+	"sendmanyext-amounts":         "Pairs of payment addresses and the output amount to pay each",
+	"sendmanyext-amounts--desc":   "JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address",
+	"sendmanyext-amounts--key":    "Address to pay",
+	"sendmanyext-amounts--value":  "Amount to send to the payment address valued in bitcoin",
+	"sendmanyext-minconf":         "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"sendmanyext-comment":         "Unused",
+	"sendmanyext-coinselection":   "Coin selection strategy used to pick the unspent outputs (largest, smallest, bnb or random), defaults to the wallet's strategy",
+	"sendmanyext-conftarget":      "Number of blocks the transaction should be mined within, used to estimate its fee rate",
+	"sendmanyext-subtractfeefrom": "Addresses whose amounts the fee is subtracted from, split in proportion to the amounts, instead of adding the fee on top of the amounts",
+	"sendmanyext-locktime":        "The lock time of the transaction, a block height below 500000000 or a unix time otherwise, defaulting to 0; the transaction is published immediately, so a lock time that has not yet matured is rejected as non-final",
+	"sendmanyext-data":            "Hex-encoded data of up to 220 bytes carried by an additional zero-value null-data (OP_RETURN) output",
+	"sendmanyext--result0":        "The transaction hash of the sent transaction",
 
 	// SendToAddressCmd help.
-	"sendtoaddress--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
+	// The command is registered as sendtoaddressext to extend the
+	// parameters of the btcjson command.
+	"sendtoaddressext--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"Unlike sendfrom, outputs are always chosen from the default account.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendtoaddress-address":   "Address to pay",
-	"sendtoaddress-amount":    "Amount to send to the payment address valued in bitcoin",
-	"sendtoaddress-comment":   "Unused",
-	"sendtoaddress-commentto": "Unused",
-	"sendtoaddress--result0":  "The transaction hash of the sent transaction",
+	"sendtoaddressext-address":               "Address to pay",
+	"sendtoaddressext-amount":                "Amount to send to the payment address valued in bitcoin",
+	"sendtoaddressext-comment":               "Unused",
+	"sendtoaddressext-commentto":             "Unused",
+	"sendtoaddressext-conftarget":            "Number of blocks the transaction should be mined within, used to estimate its fee rate",
+	"sendtoaddressext-subtractfeefromamount": "Subtract the fee from the amount instead of adding it on top of the amount",
+	"sendtoaddressext-sendall":               "Send all spendable funds of the default account to the address, with the fee subtracted and no change, ignoring the amount",
+	"sendtoaddressext-locktime":              "The lock time of the transaction, a block height below 500000000 or a unix time otherwise, defaulting to 0; the transaction is published immediately, so a lock time that has not yet matured is rejected as non-final; cannot be combined with sendall",
+	"sendtoaddressext-data":                  "Hex-encoded data of up to 220 bytes carried by an additional zero-value null-data (OP_RETURN) output; cannot be combined with sendall",
+	"sendtoaddressext--result0":              "The transaction hash of the sent transaction",
 
 	// SetTxFeeCmd help.
-	"settxfee--synopsis": "Modify the increment used each time more fee is required for an authored transaction.",
-	"settxfee-amount":    "The new fee increment valued in bitcoin",
+	"settxfee--synopsis": "Set the fee rate per kilobyte paid by authored transactions instead of an estimated fee rate.  An amount of zero estimates the fee rate again.",
+	"settxfee-amount":    "The new fee rate per kilobyte valued in bitcoin",
 	"settxfee--result0":  "The boolean 'true'",
 
 	// SignMessageCmd help.
@@ -366,6 +475,14 @@ var helpDescsEnUS = map[string]string{
 	"walletpassphrasechange-oldpassphrase": "The old wallet passphrase",
 	"walletpassphrasechange-newpassphrase": "The new wallet passphrase",
 
+	// CreateMultiSigAccountCmd help.
+	"createmultisigaccount--synopsis": "Creates a new multisig account whose addresses are pay-to-script-hash multisig addresses of the keys derived from the account extended public keys of its cosigners.\n" +
+		"Every cosigner creates the account with the same keys, in any order, to derive the same addresses.\n" +
+		"The wallet signs for the keys of its own accounts, whose extended public keys are returned by getaccountxpub.",
+	"createmultisigaccount-account":   "Name of the new account",
+	"createmultisigaccount-nrequired": "The number of signatures required to spend from the addresses of the account",
+	"createmultisigaccount-keys":      "The account extended public keys of the cosigners",
+
 	// CreateNewAccountCmd help.
 	"createnewaccount--synopsis": "Creates a new account.\n" +
 		"The wallet must be unlocked for this request to succeed.",
@@ -384,11 +501,57 @@ var helpDescsEnUS = map[string]string{
 	"getbestblockresult-hash":   "The hash of the block",
 	"getbestblockresult-height": "The blockchain height of the block",
 
+	// GetAccountXpubCmd help.
+	"getaccountxpub--synopsis": "Returns the extended public key of an account together with the fingerprint of the master key it is derived from and its derivation path, as needed to create watch-only wallets and to configure external signers.",
+	"getaccountxpub-account":   "The name of the account",
+	"getaccountxpub-purpose":   "The purpose of the key scope of the account",
+	"getaccountxpub-cointype":  "The coin type of the key scope of the account",
+
+	// GetAccountXpubResult help.
+	"getaccountxpubresult-xpub":              "The extended public key of the account",
+	"getaccountxpubresult-masterfingerprint": "The fingerprint of the master key the account key is derived from, encoded in hexadecimal, or omitted when unknown",
+	"getaccountxpubresult-path":              "The derivation path of the account key, m/purpose'/coin_type'/account'",
+	"getaccountxpubresult-accountnumber":     "The number of the account",
+
+	// GetConsolidationStatusCmd help.
+	"getconsolidationstatus--synopsis": "Returns the configuration of the background consolidation of unspent outputs, the outcome of its last check and its most recent consolidation transactions.",
+
+	// GetConsolidationStatusResult help.
+	"getconsolidationstatusresult-enabled":        "Whether unspent outputs are consolidated in the background",
+	"getconsolidationstatusresult-threshold":      "The number of spendable outputs an account must hold above which they are consolidated",
+	"getconsolidationstatusresult-maxfeerate":     "The highest estimated fee rate in bitcoin per kilobyte at which outputs are consolidated, or 0 for no limit",
+	"getconsolidationstatusresult-maxinputs":      "The largest number of outputs merged by a single consolidation transaction",
+	"getconsolidationstatusresult-interval":       "The number of seconds between two checks of the number of unspent outputs",
+	"getconsolidationstatusresult-lastrun":        "The Unix time of the last check, or 0 if outputs were never checked",
+	"getconsolidationstatusresult-feerate":        "The fee rate in bitcoin per kilobyte estimated at the last check",
+	"getconsolidationstatusresult-lasterror":      "The error the last check failed with, if any",
+	"getconsolidationstatusresult-accounts":       "The number of spendable outputs held by each account at the last check",
+	"getconsolidationstatusresult-consolidations": "The most recent consolidation transactions, oldest first",
+
+	// ConsolidationAccountResult help.
+	"consolidationaccountresult-keyscope": "The key scope of the account, as purpose/coin",
+	"consolidationaccountresult-account":  "The name of the account",
+	"consolidationaccountresult-outputs":  "The number of spendable outputs held by the account",
+
+	// ConsolidationResult help.
+	"consolidationresult-txid":     "The hash of the consolidation transaction",
+	"consolidationresult-keyscope": "The key scope of the consolidated account, as purpose/coin",
+	"consolidationresult-account":  "The name of the consolidated account",
+	"consolidationresult-inputs":   "The number of outputs merged by the transaction",
+	"consolidationresult-amount":   "The value of the merged output valued in bitcoin",
+	"consolidationresult-fee":      "The fee paid by the transaction valued in bitcoin",
+	"consolidationresult-time":     "The Unix time the transaction was created",
+
 	// GetUnconfirmedBalanceCmd help.
 	"getunconfirmedbalance--synopsis": "Calculates the unspent output value of all unmined transaction outputs for an account.",
 	"getunconfirmedbalance-account":   "The account to query the unconfirmed balance for (default=\"default\")",
 	"getunconfirmedbalance--result0":  "Total amount of all unmined unspent outputs of the account valued in bitcoin.",
 
+	// ListAccountAddressGroupingsCmd help.
+	"listaccountaddressgroupings--synopsis": "Returns the address groups, as returned by listaddressgroupings, that contain an address of an account, including the addresses of other accounts linked to it.",
+	"listaccountaddressgroupings-account":   "The account whose address groups are returned",
+	"listaccountaddressgroupings--result0":  "An array of address groups in the format of listaddressgroupings",
+
 	// ListAddressTransactionsCmd help.
 	"listaddresstransactions--synopsis": "Returns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.",
 	"listaddresstransactions-addresses": "Addresses to filter transaction results by",
@@ -398,12 +561,269 @@ var helpDescsEnUS = map[string]string{
 	"listalltransactions--synopsis": "Returns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.",
 	"listalltransactions-account":   "Unused (must be unset or \"*\")",
 
+	// PreviewTransactionCmd help.
+	"previewtransaction--synopsis": "Previews the transaction sendmany would send, without signing or broadcasting it.\n" +
+		"Returns the unsigned transaction, the outputs it spends, its estimated size, fee and change.\n" +
+		"The change address is not persisted and no outputs are locked.",
+	"previewtransaction-amounts":         "Pairs of payment addresses and the output amount to pay each",
+	"previewtransaction-amounts--desc":   "JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address",
+	"previewtransaction-amounts--key":    "Address to pay",
+	"previewtransaction-amounts--value":  "Amount to send to the payment address valued in bitcoin",
+	"previewtransaction-account":         "Account to pick unspent outputs from",
+	"previewtransaction-minconf":         "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"previewtransaction-coinselection":   "Coin selection strategy used to pick the unspent outputs (largest, smallest, bnb or random), defaults to the wallet's strategy",
+	"previewtransaction-conftarget":      "Number of blocks the transaction should be mined within, used to estimate its fee rate",
+	"previewtransaction-subtractfeefrom": "Addresses whose amounts the fee is subtracted from, split in proportion to the amounts, instead of adding the fee on top of the amounts",
+	"previewtransaction-sendall":         "Send all spendable funds of the account to the single address, with the fee subtracted and no change, ignoring the amount",
+
+	// PreviewTransactionResult help.
+	"previewtransactionresult-hex":       "The serialized unsigned transaction in hexadecimal",
+	"previewtransactionresult-inputs":    "The outputs spent by the transaction, in input order",
+	"previewtransactionresult-size":      "The estimated size of the signed transaction in bytes",
+	"previewtransactionresult-fee":       "The fee paid by the transaction valued in bitcoin",
+	"previewtransactionresult-change":    "The amount returned to the wallet as change valued in bitcoin",
+	"previewtransactionresult-changepos": "The index of the change output, or -1 if there is no change",
+
+	// PreviewTransactionInput help.
+	"previewtransactioninput-txid":         "The hash of the transaction of the spent output",
+	"previewtransactioninput-vout":         "The index of the spent output",
+	"previewtransactioninput-amount":       "The value of the spent output valued in bitcoin",
+	"previewtransactioninput-scriptpubkey": "The output script of the spent output in hexadecimal",
+
 	// RenameAccountCmd help.
 	"renameaccount--synopsis":  "Renames an account.",
 	"renameaccount-oldaccount": "The old account name to rename",
 	"renameaccount-newaccount": "The new name for the account",
 
+	// SplitSeedCmd help.
+	"splitseed--synopsis": "Splits the seed of the wallet into Shamir secret shares, any threshold of which restore the wallet when entered during wallet creation.\n" +
+		"The wallet does not store its seed, so it must be provided, and it is only split when the wallet is unlocked and it is the seed the wallet was created from.\n" +
+		"The seed is sent in plaintext, so this should only be called over a secure connection to a trusted server.",
+	"splitseed-seed":               "The hex-encoded seed or the BIP0039 mnemonic of the wallet",
+	"splitseed-threshold":          "The number of shares required to restore the wallet, at least 2",
+	"splitseed-shares":             "The number of shares to split the seed into",
+	"splitseed-mnemonicpassphrase": "The passphrase used together with the mnemonic",
+	"splitseed--result0":           "The encoded seed shares",
+
 	// WalletIsLockedCmd help.
 	"walletislocked--synopsis": "Returns whether or not the wallet is locked.",
 	"walletislocked--result0":  "Whether the wallet is locked",
+
+	// WalletCreateFundedPsbtCmd help.
+	"walletcreatefundedpsbt--synopsis": "Creates and funds a transaction in the Partially Signed Transaction (PSBT) format.\n" +
+		"Inputs are selected from the account when none are specified, a change output is added when necessary, and all wallet inputs are leased to prevent their reuse until the PSBT is published or the leases are released.",
+	"walletcreatefundedpsbt-inputs":         "Inputs to spend (may be empty to let the wallet select inputs)",
+	"walletcreatefundedpsbt-outputs":        "Pairs of payment addresses and the output amount to pay each",
+	"walletcreatefundedpsbt-outputs--desc":  "JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address",
+	"walletcreatefundedpsbt-outputs--key":   "Address to pay",
+	"walletcreatefundedpsbt-outputs--value": "Amount to send to the payment address valued in bitcoin",
+	"walletcreatefundedpsbt-locktime":       "Transaction lock time (default=0); inputs without a sequence number are made non-final so that it is enforced",
+	"walletcreatefundedpsbt-options":        "Options for funding the transaction",
+
+	// PsbtInput help.
+	"psbtinput-txid":     "The transaction hash of the referenced output",
+	"psbtinput-vout":     "The output index of the referenced output",
+	"psbtinput-sequence": "The sequence number of the input (default=final, or non-final when a lock time is set)",
+
+	// WalletCreateFundedPsbtOpts help.
+	"walletcreatefundedpsbtopts-account":       "The account to fund the transaction from (default=\"default\")",
+	"walletcreatefundedpsbtopts-minconf":       "Minimum number of block confirmations required before a transaction output is eligible to be spent (default=1)",
+	"walletcreatefundedpsbtopts-feeRate":       "Fee rate in bitcoin per kilobyte (default=estimated fee rate)",
+	"walletcreatefundedpsbtopts-leaseId":       "Lease id of 32 bytes encoded in hexadecimal to lease the inputs with, which is needed to release them with abandonpsbt or releaseoutput if the PSBT is not published (default=the wallet's PSBT lease id)",
+	"walletcreatefundedpsbtopts-leaseDuration": "The number of seconds the inputs are leased for unless the PSBT is published first (default=600)",
+	"walletcreatefundedpsbtopts-version":       "Transaction version, 1 or 2 (default=1); relative lock times are only enforced for version 2",
+
+	// WalletCreateFundedPsbtResult help.
+	"walletcreatefundedpsbtresult-psbt":      "The base64-encoded funded PSBT",
+	"walletcreatefundedpsbtresult-fee":       "The fee paid by the transaction valued in bitcoin",
+	"walletcreatefundedpsbtresult-changepos": "The index of the change output, or -1 if no change output was added",
+
+	// WalletProcessPsbtCmd help.
+	"walletprocesspsbt--synopsis": "Signs the inputs of a PSBT that belong to the wallet.\n" +
+		"The wallet must be unlocked for this request to succeed when signing.",
+	"walletprocesspsbt-psbt":     "The base64-encoded PSBT",
+	"walletprocesspsbt-sign":     "Sign the inputs of the PSBT that the wallet can sign",
+	"walletprocesspsbt-finalize": "Finalize the inputs of the PSBT when possible",
+
+	// WalletProcessPsbtResult help.
+	"walletprocesspsbtresult-psbt":     "The base64-encoded processed PSBT",
+	"walletprocesspsbtresult-complete": "Whether all inputs of the PSBT have been finalized",
+
+	// FinalizePsbtCmd help.
+	"finalizepsbt--synopsis": "Signs and finalizes all wallet inputs of a PSBT, and optionally extracts the network serialized transaction.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"finalizepsbt-psbt":    "The base64-encoded PSBT",
+	"finalizepsbt-extract": "Extract the final transaction when the PSBT is complete",
+
+	// FinalizePsbtResult help.
+	"finalizepsbtresult-psbt":     "The base64-encoded PSBT, when it was not extracted",
+	"finalizepsbtresult-hex":      "The hex-encoded final transaction, when it was extracted",
+	"finalizepsbtresult-complete": "Whether all inputs of the PSBT have been finalized",
+
+	// AbandonPsbtCmd help.
+	"abandonpsbt--synopsis": "Releases the leases taken by walletcreatefundedpsbt on the inputs of a PSBT that will not be published, making them available for coin selection again.\n" +
+		"Inputs leased under other lease ids are left untouched.",
+	"abandonpsbt-psbt":    "The base64-encoded PSBT",
+	"abandonpsbt-leaseid": "Lease id of 32 bytes encoded in hexadecimal the inputs were leased with (default=the wallet's PSBT lease id)",
+
+	// CombineRawTransactionCmd help.
+	"combinerawtransaction--synopsis": "Combines copies of a transaction signed by different cosigners into a single transaction.\n" +
+		"The signatures of inputs spending multisig scripts are merged in the order of the keys of the script.",
+	"combinerawtransaction-hextxs":   "The hex-encoded copies of the transaction",
+	"combinerawtransaction--result0": "The hex-encoded combined transaction",
+
+	// CreateRawTransactionCmd help.
+	// The command is registered as createrawtransactionext to extend the
+	// parameters of the btcjson command.
+	"createrawtransactionext--synopsis": "Returns a new unsigned transaction spending the given inputs to the given amounts.\n" +
+		"The inputs are not required to be controlled by the wallet, and no inputs or change are added.",
+	"createrawtransactionext-inputs":         "Inputs to spend",
+	"createrawtransactionext-amounts":        "Pairs of payment addresses and the output amount to pay each",
+	"createrawtransactionext-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address",
+	"createrawtransactionext-amounts--key":   "Address to pay",
+	"createrawtransactionext-amounts--value": "Amount to send to the payment address valued in bitcoin",
+	"createrawtransactionext-locktime":       "Transaction lock time (default=0); inputs without a sequence number are made non-final so that it is enforced",
+	"createrawtransactionext-version":        "Transaction version, 1 or 2 (default=1); relative lock times are only enforced for version 2",
+	"createrawtransactionext--result0":       "The hex-encoded unsigned transaction",
+
+	// RawTxInput help.
+	"rawtxinput-txid":     "The transaction hash of the referenced output",
+	"rawtxinput-vout":     "The output index of the referenced output",
+	"rawtxinput-sequence": "The sequence number of the input (default=final, or non-final when a lock time is set)",
+
+	// DecodeRawTransactionCmd help.
+	"decoderawtransaction--synopsis": "Returns a JSON object describing the given hex-encoded transaction.",
+	"decoderawtransaction-hextx":     "The hex-encoded transaction",
+
+	// TxRawDecodeResult help.
+	"txrawdecoderesult-txid":     "The hash of the transaction",
+	"txrawdecoderesult-version":  "The transaction version",
+	"txrawdecoderesult-locktime": "The transaction lock time",
+	"txrawdecoderesult-vin":      "The transaction inputs",
+	"txrawdecoderesult-vout":     "The transaction outputs",
+
+	// Vin help.
+	"vin-coinbase":  "The hex-encoded signature script of a coinbase input",
+	"vin-txid":      "The hash of the transaction of the spent output",
+	"vin-vout":      "The index of the spent output",
+	"vin-scriptSig": "The signature script of the input",
+	"vin-sequence":  "The sequence number of the input",
+
+	// ScriptSig help.
+	"scriptsig-asm": "The disassembly of the script",
+	"scriptsig-hex": "The hex-encoded script",
+
+	// Vout help.
+	"vout-value":        "The value of the output valued in bitcoin",
+	"vout-n":            "The index of the output",
+	"vout-scriptPubKey": "The output script",
+
+	// ScriptPubKeyResult help.
+	"scriptpubkeyresult-asm":       "The disassembly of the script",
+	"scriptpubkeyresult-hex":       "The hex-encoded script",
+	"scriptpubkeyresult-reqSigs":   "The number of signatures required to spend the output",
+	"scriptpubkeyresult-type":      "The type of the script (e.g. 'pubkeyhash')",
+	"scriptpubkeyresult-addresses": "The addresses paid by the script",
+
+	// FundRawTransactionCmd help.
+	// The command is registered as fundrawtransactionext to replace the
+	// parameters of the btcjson command, so help is keyed by that name.
+	"fundrawtransactionext--synopsis": "Adds inputs and a change output to a raw transaction so that it pays for its outputs and the fee.\n" +
+		"The inputs of the transaction are kept and must be controlled by the wallet, more inputs are selected from the account when needed, and the transaction is returned unsigned.",
+	"fundrawtransactionext-hextx":   "The hex-encoded transaction to fund",
+	"fundrawtransactionext-options": "Options for funding the transaction",
+
+	// FundRawTransactionOpts help.
+	"fundrawtransactionopts-account":        "The account to select inputs from (default=\"default\")",
+	"fundrawtransactionopts-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent (default=1)",
+	"fundrawtransactionopts-changeAccount":  "The account to return change to (default=the account inputs are selected from)",
+	"fundrawtransactionopts-changePosition": "The index of the change output (default=random)",
+	"fundrawtransactionopts-feeRate":        "Fee rate in bitcoin per kilobyte (default=estimated fee rate)",
+
+	// FundRawTransactionResult help.
+	"fundrawtransactionresult-hex":       "The hex-encoded funded transaction",
+	"fundrawtransactionresult-fee":       "The fee paid by the transaction valued in bitcoin",
+	"fundrawtransactionresult-changepos": "The index of the change output, or -1 if no change output was added",
+
+	// EnqueuePayoutCmd help.
+	"enqueuepayout--synopsis": "Queues a payment to be sent with the other queued payments of the account by a single transaction at the next flush of the payout queue.\n" +
+		"Enqueueing a payment with the key of an identical payment returns the recorded payment instead of queueing it again.\n" +
+		"Payments are pruned once the configured retention has elapsed since they were mined or cancelled, after which their key may be reused.",
+	"enqueuepayout-key":     "Idempotency key identifying the payment",
+	"enqueuepayout-address": "Address to pay",
+	"enqueuepayout-amount":  "Amount to send to the payment address valued in bitcoin",
+	"enqueuepayout-account": "Account funding the payment",
+
+	// CancelPayoutCmd help.
+	"cancelpayout--synopsis": "Cancels a queued or failed payment that has not been sent yet.",
+	"cancelpayout-key":       "Idempotency key of the payment",
+
+	// GetPayoutCmd help.
+	"getpayout--synopsis": "Returns the state of a payment enqueued in the payout queue.",
+	"getpayout-key":       "Idempotency key of the payment",
+
+	// ListPayoutsCmd help.
+	"listpayouts--synopsis": "Returns all payments enqueued in the payout queue, oldest first.",
+
+	// PayoutResult help.
+	"payoutresult-key":     "Idempotency key of the payment",
+	"payoutresult-address": "Address paid",
+	"payoutresult-amount":  "Amount paid valued in bitcoin",
+	"payoutresult-account": "Account funding the payment",
+	"payoutresult-state":   "State of the payment (queued, broadcast, confirmed, cancelled or failed, in which case it is sent again at the next flush)",
+	"payoutresult-txid":    "Hash of the transaction sending the payment, once broadcast",
+	"payoutresult-error":   "Reason the last attempt to send a failed payment did not succeed",
+	"payoutresult-time":    "Unix time the payment was enqueued",
+
+	// LeaseOutputCmd help.
+	"leaseoutput--synopsis": "Leases an unspent output to a lease id, preventing it from being chosen for transaction inputs of authored transactions until the lease expires or is released.\n" +
+		"Leases are saved across wallet restarts, and leasing an output again with the same id extends its lease.",
+	"leaseoutput-id":       "Lease id of 32 bytes encoded in hexadecimal",
+	"leaseoutput-txid":     "The transaction hash of the leased output",
+	"leaseoutput-vout":     "The output index of the leased output",
+	"leaseoutput-duration": "The number of seconds the output is leased for",
+
+	// ReleaseOutputCmd help.
+	"releaseoutput--synopsis": "Releases the lease of an output, making it available for coin selection again if it remains unspent.",
+	"releaseoutput-id":        "Lease id the output was leased with",
+	"releaseoutput-txid":      "The transaction hash of the leased output",
+	"releaseoutput-vout":      "The output index of the leased output",
+	"releaseoutput--result0":  "The boolean 'true'",
+
+	// ListLeasesCmd help.
+	"listleases--synopsis": "Returns all output leases which have not expired.",
+
+	// LeaseResult help.
+	"leaseresult-id":         "Lease id of the output",
+	"leaseresult-txid":       "The transaction hash of the leased output",
+	"leaseresult-vout":       "The output index of the leased output",
+	"leaseresult-expiration": "Unix time the lease expires at",
+
+	// ImportDescriptorsCmd help.
+	"importdescriptors--synopsis": "Imports the outputs described by output script descriptors as watch-only outputs.\n" +
+		"Ranged pkh() descriptors of an account public key create a watch-only account, and ranged sh(sortedmulti()) descriptors create a multisig account, named by the label of the request.\n" +
+		"Other descriptors import their public key or redeem script into the imported account.\n" +
+		"The first 20 addresses of both branches of the accounts of ranged descriptors are derived, and the imported addresses are watched for new transactions.\n" +
+		"Descriptors with a timestamp also start a rescan from the block of that time for previous outputs paying to them.",
+	"importdescriptors-requests": "The descriptors to import",
+
+	// ImportDescriptorRequest help.
+	"importdescriptorrequest-desc":      "The output script descriptor, with an optional checksum",
+	"importdescriptorrequest-label":     "The name of the account created for ranged descriptors",
+	"importdescriptorrequest-timestamp": "The Unix time of the earliest output paying to the descriptor, from which the wallet is rescanned, or omitted if the descriptor has no previous outputs",
+
+	// ImportDescriptorResult help.
+	"importdescriptorresult-success":  "Whether the descriptor was imported",
+	"importdescriptorresult-account":  "The name of the account created for the descriptor",
+	"importdescriptorresult-keyscope": "The key scope of the account created for the descriptor",
+	"importdescriptorresult-error":    "The reason the descriptor could not be imported",
+
+	// ListDescriptorsCmd help.
+	"listdescriptors--synopsis": "Returns the output script descriptors of the accounts of every key scope, including the fingerprints of the master keys the account keys originate from when known.",
+
+	// DescriptorResult help.
+	"descriptorresult-desc":          "The output script descriptor of the account, with its checksum",
+	"descriptorresult-keyscope":      "The key scope of the account",
+	"descriptorresult-account":       "The name of the account",
+	"descriptorresult-accountnumber": "The number of the account",
 }
@@ -6,7 +6,10 @@
 
 package rpchelp
 
-import "github.com/classzz/classzz/btcjson"
+import (
+	"github.com/classzz/classzz/btcjson"
+	"github.com/classzz/czzwallet/rpc/walletjson"
+)
 
 // Common return types.
 var (
@@ -14,7 +17,7 @@ var (
 	returnsNumber      = []interface{}{(*float64)(nil)}
 	returnsString      = []interface{}{(*string)(nil)}
 	returnsStringArray = []interface{}{(*[]string)(nil)}
-	returnsLTRArray    = []interface{}{(*[]btcjson.ListTransactionsResult)(nil)}
+	returnsLTRArray    = []interface{}{(*[]walletjson.ListTransactionsResult)(nil)}
 )
 
 // Methods contains all methods and result types that help is generated for,
@@ -23,9 +26,14 @@ var Methods = []struct {
 	Method      string
 	ResultTypes []interface{}
 }{
+	{"abandontransaction", nil},
 	{"addmultisigaddress", returnsString},
+	{"addtimelockaddress", []interface{}{(*walletjson.AddTimeLockAddressResult)(nil)}},
+	{"backupwallet", nil},
+	{"bumpfee", []interface{}{(*walletjson.BumpFeeResult)(nil)}},
 	{"createmultisig", []interface{}{(*btcjson.CreateMultiSigResult)(nil)}},
 	{"dumpprivkey", returnsString},
+	{"dumpwallet", nil},
 	{"getaccount", returnsString},
 	{"getaccountaddress", returnsString},
 	{"getaddressesbyaccount", returnsStringArray},
@@ -37,15 +45,18 @@ var Methods = []struct {
 	{"getrawchangeaddress", returnsString},
 	{"getreceivedbyaccount", returnsNumber},
 	{"getreceivedbyaddress", returnsNumber},
-	{"gettransaction", []interface{}{(*btcjson.GetTransactionResult)(nil)}},
+	{"gettransaction", []interface{}{(*walletjson.GetTransactionResult)(nil)}},
+	{"getwalletinfo", []interface{}{(*walletjson.GetWalletInfoResult)(nil)}},
 	{"help", append(returnsString, returnsString[0])},
 	{"importprivkey", nil},
+	{"importwallet", nil},
 	{"keypoolrefill", nil},
 	{"listaccounts", []interface{}{(*map[string]float64)(nil)}},
-	{"listlockunspent", []interface{}{(*[]btcjson.TransactionInput)(nil)}},
+	{"listaddressgroupings", []interface{}{(*[][][]interface{})(nil)}},
+	{"listlockunspent", []interface{}{(*[]walletjson.LockedOutputResult)(nil)}},
 	{"listreceivedbyaccount", []interface{}{(*[]btcjson.ListReceivedByAccountResult)(nil)}},
 	{"listreceivedbyaddress", []interface{}{(*[]btcjson.ListReceivedByAddressResult)(nil)}},
-	{"listsinceblock", []interface{}{(*btcjson.ListSinceBlockResult)(nil)}},
+	{"listsinceblock", []interface{}{(*walletjson.ListSinceBlockResult)(nil)}},
 	{"listtransactions", returnsLTRArray},
 	{"listunspent", []interface{}{(*btcjson.ListUnspentResult)(nil)}},
 	{"lockunspent", returnsBool},
@@ -60,14 +71,37 @@ var Methods = []struct {
 	{"walletlock", nil},
 	{"walletpassphrase", nil},
 	{"walletpassphrasechange", nil},
+	{"createmultisigaccount", nil},
 	{"createnewaccount", nil},
 	{"exportwatchingwallet", returnsString},
 	{"getbestblock", []interface{}{(*btcjson.GetBestBlockResult)(nil)}},
+	{"getaccountxpub", []interface{}{(*walletjson.GetAccountXpubResult)(nil)}},
+	{"getconsolidationstatus", []interface{}{(*walletjson.GetConsolidationStatusResult)(nil)}},
 	{"getunconfirmedbalance", returnsNumber},
+	{"listaccountaddressgroupings", []interface{}{(*[][][]interface{})(nil)}},
 	{"listaddresstransactions", returnsLTRArray},
 	{"listalltransactions", returnsLTRArray},
+	{"previewtransaction", []interface{}{(*walletjson.PreviewTransactionResult)(nil)}},
 	{"renameaccount", nil},
+	{"splitseed", returnsStringArray},
 	{"walletislocked", returnsBool},
+	{"walletcreatefundedpsbt", []interface{}{(*walletjson.WalletCreateFundedPsbtResult)(nil)}},
+	{"walletprocesspsbt", []interface{}{(*walletjson.WalletProcessPsbtResult)(nil)}},
+	{"finalizepsbt", []interface{}{(*walletjson.FinalizePsbtResult)(nil)}},
+	{"abandonpsbt", nil},
+	{"combinerawtransaction", returnsString},
+	{"createrawtransaction", returnsString},
+	{"decoderawtransaction", []interface{}{(*btcjson.TxRawDecodeResult)(nil)}},
+	{"fundrawtransaction", []interface{}{(*walletjson.FundRawTransactionResult)(nil)}},
+	{"enqueuepayout", []interface{}{(*walletjson.PayoutResult)(nil)}},
+	{"cancelpayout", []interface{}{(*walletjson.PayoutResult)(nil)}},
+	{"getpayout", []interface{}{(*walletjson.PayoutResult)(nil)}},
+	{"listpayouts", []interface{}{(*[]walletjson.PayoutResult)(nil)}},
+	{"leaseoutput", []interface{}{(*walletjson.LeaseResult)(nil)}},
+	{"releaseoutput", returnsBool},
+	{"listleases", []interface{}{(*[]walletjson.LeaseResult)(nil)}},
+	{"importdescriptors", []interface{}{(*[]walletjson.ImportDescriptorResult)(nil)}},
+	{"listdescriptors", []interface{}{(*[]walletjson.DescriptorResult)(nil)}},
 }
 
 // HelpDescs contains the locale-specific help strings along with the locale.
@@ -0,0 +1,55 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package shamir
+
+// expTable and logTable hold the powers of the generator 3 of the
+// multiplicative group of GF(256) and their logarithms.
+var (
+	expTable [255]byte
+	logTable [256]byte
+)
+
+func init() {
+	x := byte(1)
+	for i := range expTable {
+		expTable[i] = x
+		logTable[x] = byte(i)
+
+		// Multiply by the generator, which is x + 1, reducing by the
+		// polynomial of the field.
+		carry := x & 0x80
+		x2 := x << 1
+		if carry != 0 {
+			x2 ^= 0x1b
+		}
+		x ^= x2
+	}
+}
+
+// mul returns the product of a and b in GF(256).
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])+int(logTable[b]))%255]
+}
+
+// div returns the quotient of a by the nonzero b in GF(256).
+func div(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])+255-int(logTable[b]))%255]
+}
+
+// evaluate returns the value at x of the polynomial with the given
+// coefficients, ordered from the constant term, using Horner's method.
+func evaluate(coefficients []byte, x byte) byte {
+	var y byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coefficients[i]
+	}
+	return y
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package shamir implements Shamir's secret sharing over GF(256), which splits
+// a wallet seed into shares so that any threshold of them recovers the seed,
+// while fewer shares reveal nothing about it.
+//
+// Each byte of the secret is the constant term of a random polynomial whose
+// degree is one less than the threshold, and a share holds the value of every
+// polynomial at the index of the share.  The field is the one of AES, reduced
+// by the polynomial x^8 + x^4 + x^3 + x + 1, as in SLIP-0039.
+package shamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxShares is the maximum number of shares a secret is split into.
+	MaxShares = 255
+
+	// MinThreshold is the minimum number of shares required to recover a
+	// secret.  Every share of a secret split with a threshold of one is a
+	// plain copy of the secret.
+	MinThreshold = 2
+
+	// checksumSize is the number of bytes of the checksum of an encoded
+	// share.
+	checksumSize = 4
+)
+
+var (
+	// ErrInvalidThreshold is returned when a secret is split with a
+	// threshold which is not between MinThreshold and the number of
+	// shares.
+	ErrInvalidThreshold = fmt.Errorf("threshold must be between %d and "+
+		"the number of shares", MinThreshold)
+
+	// ErrTooManyShares is returned when a secret is split into more than
+	// MaxShares shares.
+	ErrTooManyShares = fmt.Errorf("secret cannot be split into more "+
+		"than %d shares", MaxShares)
+
+	// ErrTooFewShares is returned when fewer shares than their threshold
+	// are combined.
+	ErrTooFewShares = errors.New("not enough shares to recover the secret")
+
+	// ErrShareMismatch is returned when combined shares are not shares of
+	// the same split secret, or include the same share twice.
+	ErrShareMismatch = errors.New("shares do not belong to the same " +
+		"secret")
+
+	// ErrMalformedShare is returned when an encoded share cannot be
+	// parsed.
+	ErrMalformedShare = errors.New("malformed share")
+
+	// ErrShareChecksum is returned when the checksum of an encoded share
+	// does not match its contents.
+	ErrShareChecksum = errors.New("invalid share checksum")
+)
+
+// Share is one of the shares of a split secret.
+type Share struct {
+	// ID identifies the shares of the same split secret.  It is chosen at
+	// random when the secret is split.
+	ID uint16
+
+	// Threshold is the number of shares required to recover the secret.
+	Threshold uint8
+
+	// Index is the nonzero point at which the polynomials of the secret
+	// are evaluated for this share.
+	Index uint8
+
+	// Value holds the values of the polynomials of each byte of the
+	// secret at Index.
+	Value []byte
+}
+
+// Split splits secret into count shares, any threshold of which recover it.
+func Split(secret []byte, threshold, count int) ([]*Share, error) {
+	return split(rand.Reader, secret, threshold, count)
+}
+
+// split splits secret into count shares, reading the identifier of the shares
+// and the coefficients of the polynomials from rand.
+func split(rand io.Reader, secret []byte, threshold,
+	count int) ([]*Share, error) {
+
+	if len(secret) == 0 {
+		return nil, errors.New("secret must not be empty")
+	}
+	if count > MaxShares {
+		return nil, ErrTooManyShares
+	}
+	if threshold < MinThreshold || threshold > count {
+		return nil, ErrInvalidThreshold
+	}
+
+	var id [2]byte
+	if _, err := io.ReadFull(rand, id[:]); err != nil {
+		return nil, err
+	}
+	shares := make([]*Share, count)
+	for i := range shares {
+		shares[i] = &Share{
+			ID:        uint16(id[0])<<8 | uint16(id[1]),
+			Threshold: uint8(threshold),
+			Index:     uint8(i + 1),
+			Value:     make([]byte, len(secret)),
+		}
+	}
+
+	// The polynomial of each byte has the byte as its constant term, and
+	// random coefficients for the terms of higher degree.
+	coefficients := make([]byte, threshold)
+	for j, b := range secret {
+		coefficients[0] = b
+		if _, err := io.ReadFull(rand, coefficients[1:]); err != nil {
+			return nil, err
+		}
+		for _, share := range shares {
+			share.Value[j] = evaluate(coefficients, share.Index)
+		}
+	}
+	for i := range coefficients {
+		coefficients[i] = 0
+	}
+
+	return shares, nil
+}
+
+// Combine recovers the secret split into shares.  At least as many shares as
+// their threshold are required, and only that many are used.
+func Combine(shares []*Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ErrTooFewShares
+	}
+	first := shares[0]
+	if len(shares) < int(first.Threshold) {
+		return nil, ErrTooFewShares
+	}
+	shares = shares[:first.Threshold]
+
+	seen := make(map[uint8]struct{}, len(shares))
+	for _, share := range shares {
+		if share.ID != first.ID || share.Threshold != first.Threshold ||
+			len(share.Value) != len(first.Value) || share.Index == 0 {
+
+			return nil, ErrShareMismatch
+		}
+		if _, ok := seen[share.Index]; ok {
+			return nil, ErrShareMismatch
+		}
+		seen[share.Index] = struct{}{}
+	}
+
+	// Interpolate the polynomials at zero using the Lagrange basis
+	// polynomials of the share indexes.
+	secret := make([]byte, len(first.Value))
+	for i, share := range shares {
+		basis := byte(1)
+		for m, other := range shares {
+			if m == i {
+				continue
+			}
+			basis = mul(basis, div(other.Index, other.Index^share.Index))
+		}
+		for j, y := range share.Value {
+			secret[j] ^= mul(y, basis)
+		}
+	}
+	return secret, nil
+}
+
+// serialize returns the serialization of the share without its checksum.
+func (s *Share) serialize() []byte {
+	b := make([]byte, 4, 4+len(s.Value))
+	b[0] = byte(s.ID >> 8)
+	b[1] = byte(s.ID)
+	b[2] = s.Threshold
+	b[3] = s.Index
+	return append(b, s.Value...)
+}
+
+// String encodes the share as its identifier, threshold and index, followed by
+// its hex-encoded value and checksum, separated by dashes.
+func (s *Share) String() string {
+	checksum := sha256.Sum256(s.serialize())
+	value := append(append([]byte{}, s.Value...), checksum[:checksumSize]...)
+	return fmt.Sprintf("%04x-%d-%d-%x", s.ID, s.Threshold, s.Index, value)
+}
+
+// ParseShare parses a share encoded by String.
+func ParseShare(encoded string) (*Share, error) {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(encoded)), "-")
+	if len(parts) != 4 {
+		return nil, ErrMalformedShare
+	}
+	id, err := strconv.ParseUint(parts[0], 16, 16)
+	if err != nil {
+		return nil, ErrMalformedShare
+	}
+	threshold, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil || threshold == 0 {
+		return nil, ErrMalformedShare
+	}
+	index, err := strconv.ParseUint(parts[2], 10, 8)
+	if err != nil || index == 0 {
+		return nil, ErrMalformedShare
+	}
+	value, err := hex.DecodeString(parts[3])
+	if err != nil || len(value) <= checksumSize {
+		return nil, ErrMalformedShare
+	}
+
+	share := &Share{
+		ID:        uint16(id),
+		Threshold: uint8(threshold),
+		Index:     uint8(index),
+		Value:     value[:len(value)-checksumSize],
+	}
+	checksum := sha256.Sum256(share.serialize())
+	if !bytes.Equal(checksum[:checksumSize], value[len(share.Value):]) {
+		return nil, ErrShareChecksum
+	}
+	return share, nil
+}
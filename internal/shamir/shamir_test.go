@@ -0,0 +1,195 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package shamir
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// TestField checks the arithmetic of GF(256) against the examples of FIPS-197.
+func TestField(t *testing.T) {
+	tests := []struct {
+		a, b, product byte
+	}{
+		{0x57, 0x83, 0xc1},
+		{0x57, 0x13, 0xfe},
+		{0x53, 0xca, 0x01},
+		{0x02, 0x80, 0x1b},
+		{0x00, 0x83, 0x00},
+	}
+	for _, test := range tests {
+		if p := mul(test.a, test.b); p != test.product {
+			t.Fatalf("%02x * %02x: expected %02x, got %02x",
+				test.a, test.b, test.product, p)
+		}
+		if test.a == 0 || test.b == 0 {
+			continue
+		}
+		if q := div(test.product, test.b); q != test.a {
+			t.Fatalf("%02x / %02x: expected %02x, got %02x",
+				test.product, test.b, test.a, q)
+		}
+	}
+}
+
+// TestVectors checks the shares of secrets split with fixed coefficients, and
+// that the secrets are recovered from any threshold of their shares.
+func TestVectors(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		threshold int
+		random    string
+		shares    []string
+	}{{
+		name:      "2 of 3",
+		secret:    "42",
+		threshold: 2,
+		random:    "123401",
+		shares:    []string{"43", "40", "41"},
+	}, {
+		// f(x) = 2x + 3x^2
+		name:      "3 of 4",
+		secret:    "00",
+		threshold: 3,
+		random:    "12340203",
+		shares:    []string{"01", "08", "09", "38"},
+	}, {
+		name:      "2 of 3 with two bytes",
+		secret:    "5357",
+		threshold: 2,
+		random:    "1234ca83",
+		shares:    []string{"99d4", "dc4a", "16c9"},
+	}}
+
+	for _, test := range tests {
+		secret, _ := hex.DecodeString(test.secret)
+		random, _ := hex.DecodeString(test.random)
+		shares, err := split(
+			bytes.NewReader(random), secret, test.threshold,
+			len(test.shares),
+		)
+		if err != nil {
+			t.Fatalf("%s: unable to split secret: %v", test.name, err)
+		}
+		for i, share := range shares {
+			if share.ID != 0x1234 || share.Index != uint8(i+1) ||
+				hex.EncodeToString(share.Value) != test.shares[i] {
+
+				t.Fatalf("%s: expected share %d to be %s, got %x",
+					test.name, i+1, test.shares[i], share.Value)
+			}
+		}
+
+		// Every combination of threshold shares recovers the secret.
+		for _, combination := range combinations(shares, test.threshold) {
+			recovered, err := Combine(combination)
+			if err != nil {
+				t.Fatalf("%s: unable to combine shares: %v",
+					test.name, err)
+			}
+			if !bytes.Equal(recovered, secret) {
+				t.Fatalf("%s: expected secret %x, got %x",
+					test.name, secret, recovered)
+			}
+		}
+		_, err = Combine(shares[:test.threshold-1])
+		if err != ErrTooFewShares {
+			t.Fatalf("%s: expected ErrTooFewShares, got %v",
+				test.name, err)
+		}
+	}
+}
+
+// combinations returns every combination of k of the shares.
+func combinations(shares []*Share, k int) [][]*Share {
+	if k == 0 {
+		return [][]*Share{nil}
+	}
+	var result [][]*Share
+	for i := 0; i+k <= len(shares); i++ {
+		for _, rest := range combinations(shares[i+1:], k-1) {
+			combination := append([]*Share{shares[i]}, rest...)
+			result = append(result, combination)
+		}
+	}
+	return result
+}
+
+// TestEncoding checks the encoding of a share, and that shares which do not
+// belong together are not combined.
+func TestEncoding(t *testing.T) {
+	share := &Share{
+		ID:        0x1234,
+		Threshold: 2,
+		Index:     1,
+		Value:     []byte{0x99, 0xd4},
+	}
+	const encoded = "1234-2-1-99d4cce4591a"
+	if s := share.String(); s != encoded {
+		t.Fatalf("expected %s, got %s", encoded, s)
+	}
+	parsed, err := ParseShare(" 1234-2-1-99D4CCE4591A\n")
+	if err != nil {
+		t.Fatalf("unable to parse share: %v", err)
+	}
+	if parsed.String() != encoded {
+		t.Fatalf("expected %s, got %s", encoded, parsed)
+	}
+
+	malformed := []struct {
+		encoded string
+		err     error
+	}{
+		{"1234-2-1", ErrMalformedShare},
+		{"1234-0-1-99d4cce4591a", ErrMalformedShare},
+		{"1234-2-0-99d4cce4591a", ErrMalformedShare},
+		{"1234-2-1-99d4", ErrMalformedShare},
+		{"1234-2-1-99d4cce4591b", ErrShareChecksum},
+		{"1234-2-2-99d4cce4591a", ErrShareChecksum},
+	}
+	for _, test := range malformed {
+		if _, err := ParseShare(test.encoded); err != test.err {
+			t.Fatalf("%s: expected %v, got %v", test.encoded,
+				test.err, err)
+		}
+	}
+
+	secret := []byte("wallet seed")
+	shares1, err := Split(secret, 2, 3)
+	if err != nil {
+		t.Fatalf("unable to split secret: %v", err)
+	}
+	shares2, err := Split(secret, 2, 3)
+	if err != nil {
+		t.Fatalf("unable to split secret: %v", err)
+	}
+	shares2[1].ID = shares1[0].ID + 1
+	_, err = Combine([]*Share{shares1[0], shares2[1]})
+	if !errors.Is(err, ErrShareMismatch) {
+		t.Fatalf("expected ErrShareMismatch, got %v", err)
+	}
+	_, err = Combine([]*Share{shares1[0], shares1[0]})
+	if !errors.Is(err, ErrShareMismatch) {
+		t.Fatalf("expected ErrShareMismatch, got %v", err)
+	}
+	recovered, err := Combine([]*Share{shares1[2], shares1[0]})
+	if err != nil || !bytes.Equal(recovered, secret) {
+		t.Fatalf("unable to recover secret: %x (%v)", recovered, err)
+	}
+
+	if _, err := Split(secret, 3, 2); err != ErrInvalidThreshold {
+		t.Fatalf("expected ErrInvalidThreshold, got %v", err)
+	}
+	if _, err := Split(secret, 1, 2); err != ErrInvalidThreshold {
+		t.Fatalf("expected ErrInvalidThreshold, got %v", err)
+	}
+	if _, err := Split(secret, 2, MaxShares+1); err != ErrTooManyShares {
+		t.Fatalf("expected ErrTooManyShares, got %v", err)
+	}
+}
@@ -47,6 +47,10 @@ var (
 		errors.New("minconf must be positive"),
 	}
 
+	ErrNeedPositiveConfTarget = InvalidParameterError{
+		errors.New("confirmation target must be positive"),
+	}
+
 	ErrAddressNotInWallet = btcjson.RPCError{
 		Code:    btcjson.ErrRPCWallet,
 		Message: "address not found in wallet",
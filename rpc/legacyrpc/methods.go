@@ -13,9 +13,14 @@ import (
 	"errors"
 	"fmt"
 	"github.com/classzz/classzz/czzec"
+	"math"
+	"os"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcutil/psbt"
+
 	"github.com/classzz/classzz/btcjson"
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
@@ -23,9 +28,15 @@ import (
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
 	"github.com/classzz/czzwallet/chain"
+	"github.com/classzz/czzwallet/internal/bip39"
+	"github.com/classzz/czzwallet/internal/zero"
+	"github.com/classzz/czzwallet/rpc/walletjson"
 	"github.com/classzz/czzwallet/waddrmgr"
 	"github.com/classzz/czzwallet/wallet"
+	"github.com/classzz/czzwallet/wallet/descriptor"
+	"github.com/classzz/czzwallet/wallet/txauthor"
 	"github.com/classzz/czzwallet/wallet/txrules"
 	"github.com/classzz/czzwallet/wtxmgr"
 )
@@ -33,6 +44,10 @@ import (
 const (
 	// defaultAccountName is the name of the wallet's default account.
 	defaultAccountName = "default"
+
+	// maxTxVersion is the highest transaction version relayed by the
+	// network.  Version 2 transactions enforce relative lock times.
+	maxTxVersion = 2
 )
 
 // confirms returns the number of confirmations for a transaction in a block at
@@ -72,9 +87,14 @@ var rpcHandlers = map[string]struct {
 	noHelp bool
 }{
 	// Reference implementation wallet methods (implemented)
+	"abandontransaction":     {handler: abandonTransaction},
 	"addmultisigaddress":     {handler: addMultiSigAddress},
+	"addtimelockaddress":     {handler: addTimeLockAddress},
+	"backupwallet":           {handler: backupWallet},
+	"bumpfee":                {handler: bumpFee},
 	"createmultisig":         {handler: createMultiSig},
 	"dumpprivkey":            {handler: dumpPrivKey},
+	"dumpwallet":             {handler: dumpWallet},
 	"getaccount":             {handler: getAccount},
 	"getaccountaddress":      {handler: getAccountAddress},
 	"getaddressesbyaccount":  {handler: getAddressesByAccount},
@@ -87,10 +107,13 @@ var rpcHandlers = map[string]struct {
 	"getreceivedbyaccount":   {handler: getReceivedByAccount},
 	"getreceivedbyaddress":   {handler: getReceivedByAddress},
 	"gettransaction":         {handler: getTransaction},
+	"getwalletinfo":          {handler: getWalletInfo},
 	"help":                   {handler: helpNoChainRPC, handlerWithChain: helpWithChainRPC},
 	"importprivkey":          {handler: importPrivKey},
+	"importwallet":           {handler: importWallet},
 	"keypoolrefill":          {handler: keypoolRefill},
 	"listaccounts":           {handler: listAccounts},
+	"listaddressgroupings":   {handler: listAddressGroupings},
 	"listlockunspent":        {handler: listLockUnspent},
 	"listreceivedbyaccount":  {handler: listReceivedByAccount},
 	"listreceivedbyaddress":  {handler: listReceivedByAddress},
@@ -110,13 +133,6 @@ var rpcHandlers = map[string]struct {
 	"walletpassphrase":       {handler: walletPassphrase},
 	"walletpassphrasechange": {handler: walletPassphraseChange},
 
-	// Reference implementation methods (still unimplemented)
-	"backupwallet":         {handler: unimplemented, noHelp: true},
-	"dumpwallet":           {handler: unimplemented, noHelp: true},
-	"getwalletinfo":        {handler: unimplemented, noHelp: true},
-	"importwallet":         {handler: unimplemented, noHelp: true},
-	"listaddressgroupings": {handler: unimplemented, noHelp: true},
-
 	// Reference methods which can't be implemented by btcwallet due to
 	// design decision differences
 	"encryptwallet": {handler: unsupported, noHelp: true},
@@ -124,17 +140,50 @@ var rpcHandlers = map[string]struct {
 	"setaccount":    {handler: unsupported, noHelp: true},
 
 	// Extensions to the reference client JSON-RPC API
-	"createnewaccount": {handler: createNewAccount},
-	"getbestblock":     {handler: getBestBlock},
+	"createmultisigaccount":  {handler: createMultiSigAccount},
+	"createnewaccount":       {handler: createNewAccount},
+	"getbestblock":           {handler: getBestBlock},
+	"getaccountxpub":         {handler: getAccountXpub},
+	"getconsolidationstatus": {handler: getConsolidationStatus},
 	// This was an extension but the reference implementation added it as
 	// well, but with a different API (no account parameter).  It's listed
 	// here because it hasn't been update to use the reference
 	// implemenation's API.
-	"getunconfirmedbalance":   {handler: getUnconfirmedBalance},
-	"listaddresstransactions": {handler: listAddressTransactions},
-	"listalltransactions":     {handler: listAllTransactions},
-	"renameaccount":           {handler: renameAccount},
-	"walletislocked":          {handler: walletIsLocked},
+	"getunconfirmedbalance":       {handler: getUnconfirmedBalance},
+	"listaccountaddressgroupings": {handler: listAccountAddressGroupings},
+	"listaddresstransactions":     {handler: listAddressTransactions},
+	"listalltransactions":         {handler: listAllTransactions},
+	"previewtransaction":          {handler: previewTransaction},
+	"renameaccount":               {handler: renameAccount},
+	"splitseed":                   {handler: splitSeed},
+	"walletislocked":              {handler: walletIsLocked},
+
+	// PSBT methods
+	"abandonpsbt":            {handler: abandonPsbt},
+	"finalizepsbt":           {handler: finalizePsbt},
+	"walletcreatefundedpsbt": {handler: walletCreateFundedPsbt},
+	"walletprocesspsbt":      {handler: walletProcessPsbt},
+
+	// Raw transaction methods
+	"combinerawtransaction": {handlerWithChain: combineRawTransaction},
+	"createrawtransaction":  {handler: createRawTransaction},
+	"decoderawtransaction":  {handler: decodeRawTransaction},
+	"fundrawtransaction":    {handler: fundRawTransaction},
+
+	// Output lease methods
+	"leaseoutput":   {handler: leaseOutput},
+	"listleases":    {handler: listLeases},
+	"releaseoutput": {handler: releaseOutput},
+
+	// Payout queue methods
+	"cancelpayout":  {handler: cancelPayout},
+	"enqueuepayout": {handler: enqueuePayout},
+	"getpayout":     {handler: getPayout},
+	"listpayouts":   {handler: listPayouts},
+
+	// Descriptor methods
+	"importdescriptors": {handler: importDescriptors},
+	"listdescriptors":   {handler: listDescriptors},
 }
 
 // unimplemented handles an unimplemented RPC request with the
@@ -160,6 +209,15 @@ func unsupported(interface{}, *wallet.Wallet) (interface{}, error) {
 // context.
 type lazyHandler func() (interface{}, *btcjson.RPCError)
 
+// unmarshalCmd unmarshals the command of a request.  Methods whose
+// parameters are extended by the walletjson package are unmarshalled into
+// their extended command.
+func unmarshalCmd(request *btcjson.Request) (interface{}, error) {
+	extended := *request
+	extended.Method = walletjson.ExtendedMethod(request.Method)
+	return btcjson.UnmarshalCmd(&extended)
+}
+
 // lazyApplyHandler looks up the best request handler func for the method,
 // returning a closure that will execute it with the (required) wallet and
 // (optional) consensus RPC server.  If no handlers are found and the
@@ -168,7 +226,7 @@ func lazyApplyHandler(request *btcjson.Request, w *wallet.Wallet, chainClient ch
 	handlerData, ok := rpcHandlers[request.Method]
 	if ok && handlerData.handlerWithChain != nil && w != nil && chainClient != nil {
 		return func() (interface{}, *btcjson.RPCError) {
-			cmd, err := btcjson.UnmarshalCmd(request)
+			cmd, err := unmarshalCmd(request)
 			if err != nil {
 				return nil, btcjson.ErrRPCInvalidRequest
 			}
@@ -190,7 +248,7 @@ func lazyApplyHandler(request *btcjson.Request, w *wallet.Wallet, chainClient ch
 	}
 	if ok && handlerData.handler != nil && w != nil {
 		return func() (interface{}, *btcjson.RPCError) {
-			cmd, err := btcjson.UnmarshalCmd(request)
+			cmd, err := unmarshalCmd(request)
 			if err != nil {
 				return nil, btcjson.ErrRPCInvalidRequest
 			}
@@ -350,6 +408,51 @@ func addMultiSigAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error)
 	return p2shAddr.EncodeAddress(), nil
 }
 
+// addTimeLockAddress handles an addtimelockaddress request by adding a P2SH
+// address paying to a key once a CLTV or CSV time lock has expired to the
+// wallet.  The key is either a public key or a P2PKH address of the wallet.
+func addTimeLockAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.AddTimeLockAddressCmd)
+
+	addr, err := decodeAddress(cmd.Key, w.ChainParams())
+	if err != nil {
+		return nil, ParseError{err}
+	}
+
+	var lockType wallet.TimeLockType
+	switch cmd.LockType {
+	case "cltv":
+		lockType = wallet.TimeLockAbsolute
+	case "csv":
+		lockType = wallet.TimeLockRelative
+	default:
+		return nil, InvalidParameterError{fmt.Errorf("unknown lock "+
+			"type %q", cmd.LockType)}
+	}
+	if cmd.Value <= 0 || cmd.Value > math.MaxUint32 {
+		return nil, InvalidParameterError{fmt.Errorf("lock value %d "+
+			"is out of range", cmd.Value)}
+	}
+
+	lock, err := w.NewTimeLock(addr, lockType, uint32(cmd.Value))
+	if err != nil {
+		if errors.Is(err, wallet.ErrInvalidTimeLock) {
+			return nil, InvalidParameterError{err}
+		}
+		return nil, err
+	}
+
+	p2shAddr, script, err := w.ImportTimeLockScript(lock)
+	if err != nil {
+		return nil, err
+	}
+
+	return walletjson.AddTimeLockAddressResult{
+		Address:      p2shAddr.EncodeAddress(),
+		RedeemScript: hex.EncodeToString(script),
+	}, nil
+}
+
 // createMultiSig handles an createmultisig request by returning a
 // multisig address for the given inputs.
 func createMultiSig(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
@@ -372,6 +475,15 @@ func createMultiSig(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	}, nil
 }
 
+// backupWallet handles a backupwallet request by writing a consistent copy of
+// the wallet database to the destination file.
+func backupWallet(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*btcjson.BackupWalletCmd)
+
+	err := w.BackupWallet(cmd.Destination)
+	return nil, err
+}
+
 // dumpPrivKey handles a dumpprivkey request with the private key
 // for a single address, or an appropriate error if the wallet
 // is locked.
@@ -492,7 +604,7 @@ func getInfo(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient) (
 	// to using the manager version.
 	info.WalletVersion = int32(waddrmgr.LatestMgrVersion)
 	info.Balance = bal.ToCZZ()
-	info.PaytxFee = float64(txrules.DefaultRelayFeePerKb)
+	info.PaytxFee = w.EstimateFeeRate(wallet.DefaultConfTarget).ToCZZ()
 	// We don't set the following since they don't make much sense in the
 	// wallet architecture:
 	//  - unlocked_until
@@ -501,6 +613,137 @@ func getInfo(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient) (
 	return info, nil
 }
 
+// getAccountXpub handles a getaccountxpub extension request by returning the
+// extended public key of an account together with the fingerprint of the master
+// key it is derived from and its derivation path.
+func getAccountXpub(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.GetAccountXpubCmd)
+
+	scope := waddrmgr.KeyScope{
+		Purpose: *cmd.Purpose,
+		Coin:    *cmd.CoinType,
+	}
+	account, err := w.AccountNumber(scope, cmd.Account)
+	switch {
+	case waddrmgr.IsError(err, waddrmgr.ErrAccountNotFound):
+		return nil, &ErrAccountNameNotFound
+	case err != nil:
+		return nil, err
+	}
+	xpub, err := w.AccountXpub(scope, account)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &walletjson.GetAccountXpubResult{
+		Xpub:          xpub.AccountPubKey.String(),
+		Path:          xpub.Origin.PathString(),
+		AccountNumber: account,
+	}
+	if xpub.Origin.Fingerprint != 0 {
+		result.MasterFingerprint = xpub.Origin.FingerprintString()
+	}
+	return result, nil
+}
+
+// getConsolidationStatus handles a getconsolidationstatus extension request by
+// returning the configuration and outcome of the background consolidation of
+// unspent outputs.
+func getConsolidationStatus(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	status := w.ConsolidationStatus()
+
+	accounts := make([]walletjson.ConsolidationAccountResult, 0,
+		len(status.Accounts))
+	for _, count := range status.Accounts {
+		name, err := w.AccountName(count.KeyScope, count.Account)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, walletjson.ConsolidationAccountResult{
+			KeyScope: count.KeyScope.String(),
+			Account:  name,
+			Outputs:  count.Outputs,
+		})
+	}
+
+	consolidations := make([]walletjson.ConsolidationResult, 0,
+		len(status.Consolidations))
+	for _, c := range status.Consolidations {
+		name, err := w.AccountName(c.KeyScope, c.Account)
+		if err != nil {
+			return nil, err
+		}
+		consolidations = append(consolidations, walletjson.ConsolidationResult{
+			TxID:     c.Hash.String(),
+			KeyScope: c.KeyScope.String(),
+			Account:  name,
+			Inputs:   c.Inputs,
+			Amount:   c.Amount.ToCZZ(),
+			Fee:      c.Fee.ToCZZ(),
+			Time:     c.Time.Unix(),
+		})
+	}
+
+	result := &walletjson.GetConsolidationStatusResult{
+		Enabled:        status.Enabled,
+		Threshold:      status.Config.Threshold,
+		MaxFeeRate:     status.Config.MaxFeeRate.ToCZZ(),
+		MaxInputs:      status.Config.MaxInputs,
+		Interval:       int64(status.Config.Interval / time.Second),
+		FeeRate:        status.FeeRate.ToCZZ(),
+		Accounts:       accounts,
+		Consolidations: consolidations,
+	}
+	if !status.LastRun.IsZero() {
+		result.LastRun = status.LastRun.Unix()
+	}
+	if status.LastError != nil {
+		result.LastError = status.LastError.Error()
+	}
+	return result, nil
+}
+
+// getWalletInfo handles a getwalletinfo request by returning the balances,
+// lock state and sync state of the wallet.
+func getWalletInfo(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	info, err := w.Info()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]walletjson.ScopeAccountsResult, 0, len(info.Accounts))
+	for _, scope := range info.Accounts {
+		accounts = append(accounts, walletjson.ScopeAccountsResult{
+			KeyScope: scope.Scope.String(),
+			Accounts: scope.NumAccounts,
+		})
+	}
+
+	var unlockedUntil int64
+	if !info.Locked && !info.UnlockedUntil.IsZero() {
+		unlockedUntil = info.UnlockedUntil.Unix()
+	}
+
+	return &walletjson.GetWalletInfoResult{
+		Balance:            info.ConfirmedBalance.ToCZZ(),
+		UnconfirmedBalance: info.UnconfirmedBalance.ToCZZ(),
+		ImmatureBalance:    info.ImmatureBalance.ToCZZ(),
+		FrozenBalance:      info.FrozenBalance.ToCZZ(),
+		TxCount:            info.TxCount,
+		Accounts:           accounts,
+		Locked:             info.Locked,
+		UnlockedUntil:      unlockedUntil,
+		WatchOnly:          info.WatchOnly,
+		Birthday:           info.Birthday.Unix(),
+		BirthdayHeight:     info.BirthdayBlock.Height,
+		BirthdayHash:       info.BirthdayBlock.Hash.String(),
+		SyncHeight:         info.SyncedTo.Height,
+		SyncHash:           info.SyncedTo.Hash.String(),
+		Rescanning:         info.Rescanning,
+		Recovering:         info.Recovering,
+	}, nil
+}
+
 func decodeAddress(s string, params *chaincfg.Params) (czzutil.Address, error) {
 	addr, err := czzutil.DecodeAddress(s, params)
 	if err != nil {
@@ -583,7 +826,58 @@ func getUnconfirmedBalance(icmd interface{}, w *wallet.Wallet) (interface{}, err
 		return nil, err
 	}
 
-	return (bals.Total - bals.Spendable).ToCZZ(), nil
+	// Frozen outputs are not spendable but may be confirmed.
+	return (bals.Total - bals.Spendable - bals.Frozen).ToCZZ(), nil
+}
+
+// dumpWallet handles a dumpwallet request by writing all wallet keys to a new
+// file, or an appropriate error if the wallet is locked.
+func dumpWallet(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*btcjson.DumpWalletCmd)
+
+	// Never overwrite an existing file, which might be an earlier dump or
+	// the wallet database itself.
+	f, err := os.OpenFile(cmd.Filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: err.Error(),
+		}
+	}
+
+	err = w.DumpWallet(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(cmd.Filename)
+		if waddrmgr.IsError(err, waddrmgr.ErrLocked) {
+			return nil, &ErrWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// importWallet handles an importwallet request by importing all keys and
+// scripts of a file written by dumpwallet and rescanning for them.
+func importWallet(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*btcjson.ImportWalletCmd)
+
+	f, err := os.Open(cmd.Filename)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: err.Error(),
+		}
+	}
+	defer f.Close()
+
+	_, err = w.ImportWallet(f, true)
+	if waddrmgr.IsError(err, waddrmgr.ErrLocked) {
+		return nil, &ErrWalletUnlockNeeded
+	}
+	return nil, err
 }
 
 // importPrivKey handles an importprivkey request by parsing
@@ -653,6 +947,39 @@ func createNewAccount(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return nil, err
 }
 
+// createMultiSigAccount handles a createmultisigaccount request by creating a
+// multisig account whose addresses require nrequired signatures of the keys
+// derived from the account extended public keys of its cosigners.  To be able
+// to sign, the keys include the account public key of an account of this
+// wallet, as returned by getaccountxpub.
+func createMultiSigAccount(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.CreateMultiSigAccountCmd)
+
+	// The wildcard * is reserved by the rpc server with the special meaning
+	// of "all accounts", so disallow naming accounts to this string.
+	if cmd.Account == "*" {
+		return nil, &ErrReservedAccountName
+	}
+
+	keys := make([]*hdkeychain.ExtendedKey, 0, len(cmd.Keys))
+	for _, keyStr := range cmd.Keys {
+		key, err := hdkeychain.NewKeyFromString(keyStr)
+		if err != nil {
+			return nil, InvalidParameterError{fmt.Errorf("invalid "+
+				"cosigner key %q: %v", keyStr, err)}
+		}
+		keys = append(keys, key)
+	}
+
+	_, err := w.NewMultiSigAccount(
+		waddrmgr.KeyScopeBIP0044, cmd.Account, cmd.NRequired, keys,
+	)
+	if waddrmgr.IsError(err, waddrmgr.ErrInvalidAccount) {
+		return nil, InvalidParameterError{err}
+	}
+	return nil, err
+}
+
 // renameAccount handles a renameaccount request by renaming an account.
 // If the account does not exist an appropiate error will be returned.
 func renameAccount(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
@@ -672,6 +999,54 @@ func renameAccount(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return nil, w.RenameAccount(waddrmgr.KeyScopeBIP0044, account, cmd.NewAccount)
 }
 
+// splitSeed handles a splitseed request by splitting the seed of the wallet
+// into Shamir shares, any threshold of which restore the wallet.  The seed is
+// provided by the caller, and is only split when the wallet is unlocked and it
+// is the seed the wallet was created from.
+//
+// The seed is sent in plaintext over the RPC connection, and the string of the
+// request cannot be cleared from memory, so it is exposed to anyone able to
+// observe the connection or the memory of the process.  Only the decoded copy
+// of the seed is zeroed once it is split.
+func splitSeed(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SplitSeedCmd)
+
+	// A seed of several words is a mnemonic.
+	var seed []byte
+	if len(strings.Fields(cmd.Seed)) > 1 {
+		var passphrase []byte
+		if cmd.MnemonicPassphrase != nil {
+			passphrase = []byte(*cmd.MnemonicPassphrase)
+		}
+		var err error
+		seed, err = bip39.NewSeed(cmd.Seed, passphrase)
+		if err != nil {
+			return nil, InvalidParameterError{err}
+		}
+	} else {
+		var err error
+		seed, err = decodeHexStr(cmd.Seed)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer zero.Bytes(seed)
+
+	shares, err := w.SplitSeed(seed, cmd.Threshold, cmd.Shares)
+	switch {
+	case waddrmgr.IsError(err, waddrmgr.ErrLocked):
+		return nil, &ErrWalletUnlockNeeded
+	case err != nil:
+		return nil, InvalidParameterError{err}
+	}
+
+	encoded := make([]string, len(shares))
+	for i, share := range shares {
+		encoded[i] = share.String()
+	}
+	return encoded, nil
+}
+
 // getNewAddress handles a getnewaddress request by returning a new
 // address for an account.  If the account does not exist an appropiate
 // error is returned.
@@ -799,7 +1174,7 @@ func getTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 
 	// TODO: Add a "generated" field to this result type.  "generated":true
 	// is only added if the transaction is a coinbase.
-	ret := btcjson.GetTransactionResult{
+	ret := walletjson.GetTransactionResult{
 		TxID:            cmd.Txid,
 		Hex:             hex.EncodeToString(txBuf.Bytes()),
 		Time:            details.Received.Unix(),
@@ -808,6 +1183,14 @@ func getTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 		//Generated:     blockchain.IsCoinBaseTx(&details.MsgTx),
 	}
 
+	// The data carried by null-data outputs is included as hexadecimal
+	// strings.
+	for _, output := range details.MsgTx.TxOut {
+		if data, ok := txrules.NullData(output.PkScript); ok {
+			ret.Data = append(ret.Data, hex.EncodeToString(data))
+		}
+	}
+
 	if details.Block.Height != -1 {
 		ret.BlockHash = details.Block.Hash.String()
 		ret.BlockTime = details.Block.Time.Unix()
@@ -1040,10 +1423,96 @@ func listAccounts(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return accountBalances, nil
 }
 
+// listAddressGroupings handles a listaddressgroupings request by returning
+// the groups of wallet addresses that are linked to each other by having been
+// spent together, along with their balances.
+func listAddressGroupings(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	groups, err := w.AddressGroupings()
+	if err != nil {
+		return nil, err
+	}
+	return addressGroupingResults(groups), nil
+}
+
+// listAccountAddressGroupings handles a listaccountaddressgroupings extension
+// request by returning the address groups, as returned by
+// listaddressgroupings, that contain an address of an account.
+func listAccountAddressGroupings(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.ListAccountAddressGroupingsCmd)
+
+	account, err := w.AccountNumber(waddrmgr.KeyScopeBIP0044, cmd.Account)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := w.AccountAddressGroupings(waddrmgr.KeyScopeBIP0044, account)
+	if err != nil {
+		return nil, err
+	}
+	return addressGroupingResults(groups), nil
+}
+
+// addressGroupingResults converts address groups to their JSON results.  As
+// with bitcoind, every group is an array of address entries, and each entry
+// is an array of the address, its balance and its label, which is the name
+// of the account of the address.
+func addressGroupingResults(groups []wallet.AddressGroup) [][][]interface{} {
+	results := make([][][]interface{}, 0, len(groups))
+	for i := range groups {
+		group := &groups[i]
+		result := make([][]interface{}, 0, len(group.Addresses))
+		for _, a := range group.Addresses {
+			result = append(result, []interface{}{
+				a.Address.EncodeAddress(),
+				a.Balance.ToCZZ(),
+				a.AccountName,
+			})
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
 // listLockUnspent handles a listlockunspent request by returning an slice of
-// all locked outpoints.
+// all outpoints locked for the wallet session and all frozen outputs.
 func listLockUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
-	return w.LockedOutpoints(), nil
+	frozen, err := w.ListFrozenOutputs()
+	if err != nil {
+		return nil, err
+	}
+
+	locked := w.LockedOutpoints()
+	results := make([]walletjson.LockedOutputResult, 0,
+		len(locked)+len(frozen))
+	isFrozen := make(map[btcjson.TransactionInput]struct{}, len(frozen))
+	for _, output := range frozen {
+		result := walletjson.LockedOutputResult{
+			Txid:       output.Outpoint.Hash.String(),
+			Vout:       output.Outpoint.Index,
+			Persistent: true,
+			Reason:     output.Reason,
+		}
+		if !output.Expiration.IsZero() {
+			result.Expiry = output.Expiration.Unix()
+		}
+		results = append(results, result)
+		isFrozen[btcjson.TransactionInput{
+			Txid: result.Txid,
+			Vout: result.Vout,
+		}] = struct{}{}
+	}
+
+	// Frozen outputs which are also locked for the session are only
+	// listed once.
+	for _, input := range locked {
+		if _, ok := isFrozen[input]; ok {
+			continue
+		}
+		results = append(results, walletjson.LockedOutputResult{
+			Txid: input.Txid,
+			Vout: input.Vout,
+		})
+	}
+	return results, nil
 }
 
 // listReceivedByAccount handles a listreceivedbyaccount request by returning
@@ -1214,8 +1683,8 @@ func listSinceBlock(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCCl
 		return nil, err
 	}
 
-	res := btcjson.ListSinceBlockResult{
-		Transactions: txInfoList,
+	res := walletjson.ListSinceBlockResult{
+		Transactions: listTransactionsResults(txInfoList),
 		LastBlock:    blockHash.String(),
 	}
 	return res, nil
@@ -1240,7 +1709,46 @@ func listTransactions(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 		}
 	}
 
-	return w.ListTransactions(*cmd.From, *cmd.Count)
+	txList, err := w.ListTransactions(*cmd.From, *cmd.Count)
+	if err != nil {
+		return nil, err
+	}
+	return listTransactionsResults(txList), nil
+}
+
+// listTransactionsResults converts transactions listed by the wallet to the
+// results of the listtransactions family of requests.
+func listTransactionsResults(
+	txList []wallet.ListTransactionsResult) []walletjson.ListTransactionsResult {
+
+	results := make([]walletjson.ListTransactionsResult, len(txList))
+	for i, tx := range txList {
+		results[i] = walletjson.ListTransactionsResult{
+			Abandoned:         tx.Abandoned,
+			Account:           tx.Account,
+			Address:           tx.Address,
+			Amount:            tx.Amount,
+			BIP125Replaceable: tx.BIP125Replaceable,
+			BlockHash:         tx.BlockHash,
+			BlockIndex:        tx.BlockIndex,
+			BlockTime:         tx.BlockTime,
+			Category:          tx.Category,
+			Confirmations:     tx.Confirmations,
+			Fee:               tx.Fee,
+			Generated:         tx.Generated,
+			InvolvesWatchOnly: tx.InvolvesWatchOnly,
+			Time:              tx.Time,
+			TimeReceived:      tx.TimeReceived,
+			Trusted:           tx.Trusted,
+			TxID:              tx.TxID,
+			Vout:              tx.Vout,
+			WalletConflicts:   tx.WalletConflicts,
+			Comment:           tx.Comment,
+			OtherAccount:      tx.OtherAccount,
+			Data:              tx.Data,
+		}
+	}
+	return results
 }
 
 // listAddressTransactions handles a listaddresstransactions request by
@@ -1268,7 +1776,11 @@ func listAddressTransactions(icmd interface{}, w *wallet.Wallet) (interface{}, e
 		hash160Map[string(addr.ScriptAddress())] = struct{}{}
 	}
 
-	return w.ListAddressTransactions(hash160Map)
+	txList, err := w.ListAddressTransactions(hash160Map)
+	if err != nil {
+		return nil, err
+	}
+	return listTransactionsResults(txList), nil
 }
 
 // listAllTransactions handles a listalltransactions request by returning
@@ -1285,7 +1797,11 @@ func listAllTransactions(icmd interface{}, w *wallet.Wallet) (interface{}, error
 		}
 	}
 
-	return w.ListAllTransactions()
+	txList, err := w.ListAllTransactions()
+	if err != nil {
+		return nil, err
+	}
+	return listTransactionsResults(txList), nil
 }
 
 // listUnspent handles the listunspent command.
@@ -1302,11 +1818,37 @@ func listUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return w.ListUnspent(int32(*cmd.MinConf), int32(*cmd.MaxConf), "")
 }
 
-// lockUnspent handles the lockunspent command.
+// lockUnspent handles the lockunspent command.  Outputs locked with the
+// persistent flag are frozen in the wallet database instead of being locked
+// for the wallet session.  Unlocking an output removes both its lock and its
+// freeze, while unlocking all outputs only removes the session locks, or the
+// freezes with the persistent flag.
 func lockUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
-	cmd := icmd.(*btcjson.LockUnspentCmd)
+	cmd := icmd.(*walletjson.LockUnspentCmd)
+
+	persistent := *cmd.Persistent
+	if !persistent && (cmd.Reason != nil || *cmd.Expiry != 0) {
+		return nil, InvalidParameterError{errors.New("reason and " +
+			"expiry are only allowed for persistent locks")}
+	}
+	var expiry time.Time
+	if *cmd.Expiry != 0 {
+		expiry = time.Unix(*cmd.Expiry, 0)
+		if !expiry.After(time.Now()) {
+			return nil, InvalidParameterError{errors.New("expiry " +
+				"must be in the future")}
+		}
+	}
+	var reason string
+	if cmd.Reason != nil {
+		reason = *cmd.Reason
+	}
 
 	switch {
+	case cmd.Unlock && len(cmd.Transactions) == 0 && persistent:
+		if err := w.ResetFrozenOutputs(); err != nil {
+			return nil, err
+		}
 	case cmd.Unlock && len(cmd.Transactions) == 0:
 		w.ResetLockedOutpoints()
 	default:
@@ -1316,9 +1858,23 @@ func lockUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 				return nil, ParseError{err}
 			}
 			op := wire.OutPoint{Hash: *txHash, Index: input.Vout}
-			if cmd.Unlock {
+			switch {
+			case cmd.Unlock:
 				w.UnlockOutpoint(op)
-			} else {
+				if err := w.UnfreezeOutput(op); err != nil {
+					return nil, err
+				}
+			case persistent:
+				err := w.FreezeOutput(op, reason, expiry)
+				if err == wtxmgr.ErrUnknownOutput {
+					return nil, InvalidParameterError{
+						fmt.Errorf("unknown output %v", op),
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+			default:
 				w.LockOutpoint(op)
 			}
 		}
@@ -1348,33 +1904,40 @@ func makeOutputs(pairs map[string]czzutil.Amount, chainParams *chaincfg.Params)
 	return outputs, nil
 }
 
-// sendPairs creates and sends payment transactions.
+// sendPairs creates and sends payment transactions as described by opts.
 // It returns the transaction hash in string format upon success
 // All errors are returned in btcjson.RPCError format
+// The fee is subtracted from the amounts paid to the subtractFeeFrom addresses,
+// or paid on top of the amounts if there are none.  Non-nil data is carried by
+// an additional zero-value null-data output.
 func sendPairs(w *wallet.Wallet, amounts map[string]czzutil.Amount,
-	keyScope waddrmgr.KeyScope, account uint32, minconf int32,
-	feeSatPerKb czzutil.Amount) (string, error) {
+	opts wallet.SendOptions, subtractFeeFrom []string,
+	data []byte) (string, error) {
 
 	outputs, err := makeOutputs(amounts, w.ChainParams())
 	if err != nil {
 		return "", err
 	}
-	tx, err := w.SendOutputs(outputs, &keyScope, account, minconf, feeSatPerKb, "")
-	if err != nil {
-		if err == txrules.ErrAmountNegative {
-			return "", ErrNeedPositiveAmount
-		}
-		if waddrmgr.IsError(err, waddrmgr.ErrLocked) {
-			return "", &ErrWalletUnlockNeeded
+	if data != nil {
+		output, err := txrules.NullDataOutput(data)
+		if err != nil {
+			return "", InvalidParameterError{err}
 		}
-		if _, ok := err.(btcjson.RPCError); ok {
+		outputs = append(outputs, output)
+	}
+
+	if len(subtractFeeFrom) != 0 {
+		opts.SubtractFeeFrom, err = outputIndexes(
+			outputs, subtractFeeFrom, w.ChainParams(),
+		)
+		if err != nil {
 			return "", err
 		}
+	}
 
-		return "", &btcjson.RPCError{
-			Code:    btcjson.ErrRPCInternal.Code,
-			Message: err.Error(),
-		}
+	tx, err := w.SendOutputsWithOptions(outputs, &opts, "")
+	if err != nil {
+		return "", sendError(err)
 	}
 
 	txHashStr := tx.TxHash().String()
@@ -1382,62 +1945,169 @@ func sendPairs(w *wallet.Wallet, amounts map[string]czzutil.Amount,
 	return txHashStr, nil
 }
 
-func isNilOrEmpty(s *string) bool {
-	return s == nil || *s == ""
-}
-
-// sendFrom handles a sendfrom RPC request by creating a new transaction
-// spending unspent transaction outputs for a wallet to another payment
-// address.  Leftover inputs not sent to the payment address or a fee for
-// the miner are sent back to a new address in the wallet.  Upon success,
-// the TxID for the created transaction is returned.
-func sendFrom(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient) (interface{}, error) {
-	cmd := icmd.(*btcjson.SendFromCmd)
+// sweepToAddress creates and sends a transaction spending all unspent outputs
+// of an account with at least minconf confirmations to an address, with the
+// fee subtracted from the swept amount.  It returns the transaction hash in
+// string format upon success.
+func sweepToAddress(w *wallet.Wallet, address string, account uint32,
+	minconf int32, feeSatPerKb czzutil.Amount) (string, error) {
 
-	// Transaction comments are not yet supported.  Error instead of
-	// pretending to save them.
-	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
-		return nil, &btcjson.RPCError{
-			Code:    btcjson.ErrRPCUnimplemented,
-			Message: "Transaction comments are not yet supported",
-		}
+	addr, err := decodeAddress(address, w.ChainParams())
+	if err != nil {
+		return "", err
 	}
-
-	account, err := w.AccountNumber(
-		waddrmgr.KeyScopeBIP0044, cmd.FromAccount,
-	)
+	pkScript, err := txscript.PayToAddrScript(addr)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	// Check that signed integer parameters are positive.
-	if cmd.Amount < 0 {
-		return nil, ErrNeedPositiveAmount
-	}
-	minConf := int32(*cmd.MinConf)
-	if minConf < 0 {
-		return nil, ErrNeedPositiveMinconf
+	policy := wallet.OutputSelectionPolicy{
+		Account:               account,
+		RequiredConfirmations: minconf,
 	}
-	// Create map of address and amount pairs.
-	amt, err := czzutil.NewAmount(cmd.Amount)
+	tx, err := w.SweepOutputs(
+		policy, &waddrmgr.KeyScopeBIP0044, pkScript, feeSatPerKb, "",
+	)
 	if err != nil {
-		return nil, err
-	}
-	pairs := map[string]czzutil.Amount{
-		cmd.ToAddress: amt,
+		return "", sendError(err)
 	}
 
-	return sendPairs(w, pairs, waddrmgr.KeyScopeBIP0044, account, minConf,
-		txrules.DefaultRelayFeePerKb)
+	txHashStr := tx.TxHash().String()
+	log.Infof("Successfully sent transaction %v", txHashStr)
+	return txHashStr, nil
 }
 
-// sendMany handles a sendmany RPC request by creating a new transaction
-// spending unspent transaction outputs for a wallet to any number of
-// payment addresses.  Leftover inputs not sent to the payment address
+// outputIndexes returns the indexes of the outputs paying to the passed
+// addresses.  Every address must be paid by one of the outputs.
+func outputIndexes(outputs []*wire.TxOut, addresses []string,
+	chainParams *chaincfg.Params) ([]int, error) {
+
+	indexes := make([]int, 0, len(addresses))
+	for _, address := range addresses {
+		addr, err := decodeAddress(address, chainParams)
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		index := -1
+		for i, output := range outputs {
+			if bytes.Equal(output.PkScript, pkScript) {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return nil, InvalidParameterError{fmt.Errorf("address "+
+				"%s is not paid by the transaction", address)}
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
+
+// sendError returns the RPC error of a failure to create and send a payment
+// transaction.
+func sendError(err error) error {
+	switch {
+	case err == txrules.ErrAmountNegative:
+		return ErrNeedPositiveAmount
+	case err == txauthor.ErrOutputsTooSmall:
+		return InvalidParameterError{err}
+	case waddrmgr.IsError(err, waddrmgr.ErrLocked):
+		return &ErrWalletUnlockNeeded
+	}
+	if _, ok := err.(btcjson.RPCError); ok {
+		return err
+	}
+
+	return &btcjson.RPCError{
+		Code:    btcjson.ErrRPCInternal.Code,
+		Message: err.Error(),
+	}
+}
+
+func isNilOrEmpty(s *string) bool {
+	return s == nil || *s == ""
+}
+
+// confTarget returns the confirmation target of a request, or the default
+// target when none is given.
+func confTarget(target *int) (uint32, error) {
+	if target == nil {
+		return wallet.DefaultConfTarget, nil
+	}
+	if *target <= 0 {
+		return 0, ErrNeedPositiveConfTarget
+	}
+	return uint32(*target), nil
+}
+
+// sendFrom handles a sendfrom RPC request by creating a new transaction
+// spending unspent transaction outputs for a wallet to another payment
+// address.  Leftover inputs not sent to the payment address or a fee for
+// the miner are sent back to a new address in the wallet.  Upon success,
+// the TxID for the created transaction is returned.
+func sendFrom(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient) (interface{}, error) {
+	cmd := icmd.(*btcjson.SendFromCmd)
+
+	// Transaction comments are not yet supported.  Error instead of
+	// pretending to save them.
+	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCUnimplemented,
+			Message: "Transaction comments are not yet supported",
+		}
+	}
+
+	account, err := w.AccountNumber(
+		waddrmgr.KeyScopeBIP0044, cmd.FromAccount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that signed integer parameters are positive.
+	if cmd.Amount < 0 {
+		return nil, ErrNeedPositiveAmount
+	}
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, ErrNeedPositiveMinconf
+	}
+	// Create map of address and amount pairs.
+	amt, err := czzutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+	pairs := map[string]czzutil.Amount{
+		cmd.ToAddress: amt,
+	}
+
+	feeSatPerKb := w.EstimateFeeRate(wallet.DefaultConfTarget)
+	return sendPairs(w, pairs, wallet.SendOptions{
+		KeyScope:              &waddrmgr.KeyScopeBIP0044,
+		Account:               account,
+		RequiredConfirmations: minConf,
+		FeeRate:               feeSatPerKb,
+	}, nil, nil)
+}
+
+// sendMany handles a sendmany RPC request by creating a new transaction
+// spending unspent transaction outputs for a wallet to any number of
+// payment addresses.  Leftover inputs not sent to the payment address
 // or a fee for the miner are sent back to a new address in the wallet.
 // Upon success, the TxID for the created transaction is returned.
+//
+// The inputs are selected with the wallet's default coin selection strategy,
+// unless another strategy is requested by name.  The fee rate is estimated for
+// the requested confirmation target.  The fee is subtracted from the amounts
+// paid to the requested addresses, or paid on top of the amounts otherwise.
 func sendMany(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
-	cmd := icmd.(*btcjson.SendManyCmd)
+	cmd := icmd.(*walletjson.SendManyCmd)
 
 	// Transaction comments are not yet supported.  Error instead of
 	// pretending to save them.
@@ -1448,68 +2118,713 @@ func sendMany(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 		}
 	}
 
-	account, err := w.AccountNumber(waddrmgr.KeyScopeBIP0044, cmd.FromAccount)
+	account, err := w.AccountNumber(waddrmgr.KeyScopeBIP0044, cmd.FromAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that minconf is positive.
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, ErrNeedPositiveMinconf
+	}
+
+	// Recreate address/amount pairs, using dcrutil.Amount.
+	pairs := make(map[string]czzutil.Amount, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := czzutil.NewAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		pairs[k] = amt
+	}
+
+	var coinSelector wallet.CoinSelector
+	if !isNilOrEmpty(cmd.CoinSelection) {
+		coinSelector, err = wallet.CoinSelectorByName(*cmd.CoinSelection)
+		if err != nil {
+			return nil, InvalidParameterError{err}
+		}
+	}
+
+	target, err := confTarget(cmd.ConfTarget)
+	if err != nil {
+		return nil, err
+	}
+	feeSatPerKb := w.EstimateFeeRate(target)
+
+	var subtractFeeFrom []string
+	if cmd.SubtractFeeFrom != nil {
+		subtractFeeFrom = *cmd.SubtractFeeFrom
+	}
+
+	lockTime, err := txLockTime(cmd.LockTime)
+	if err != nil {
+		return nil, err
+	}
+	data, err := nullData(cmd.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendPairs(w, pairs, wallet.SendOptions{
+		KeyScope:              &waddrmgr.KeyScopeBIP0044,
+		Account:               account,
+		RequiredConfirmations: minConf,
+		FeeRate:               feeSatPerKb,
+		CoinSelector:          coinSelector,
+		LockTime:              lockTime,
+	}, subtractFeeFrom, data)
+}
+
+// sendToAddress handles a sendtoaddress RPC request by creating a new
+// transaction spending unspent transaction outputs for a wallet to another
+// payment address.  Leftover inputs not sent to the payment address or a fee
+// for the miner are sent back to a new address in the wallet.  Upon success,
+// the TxID for the created transaction is returned.  The fee rate is estimated
+// for the requested confirmation target.  The fee is subtracted from the amount
+// if requested.  If all funds are to be sent, every spendable output of the
+// default account is spent to the address, with the fee subtracted from the
+// total and no change.
+func sendToAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SendToAddressCmd)
+
+	// Transaction comments are not yet supported.  Error instead of
+	// pretending to save them.
+	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCUnimplemented,
+			Message: "Transaction comments are not yet supported",
+		}
+	}
+
+	amt, err := czzutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that signed integer parameters are positive.
+	if amt < 0 {
+		return nil, ErrNeedPositiveAmount
+	}
+
+	target, err := confTarget(cmd.ConfTarget)
+	if err != nil {
+		return nil, err
+	}
+	feeSatPerKb := w.EstimateFeeRate(target)
+
+	lockTime, err := txLockTime(cmd.LockTime)
+	if err != nil {
+		return nil, err
+	}
+	data, err := nullData(cmd.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// sendtoaddress always spends from the default account, this matches bitcoind
+	if cmd.SendAll != nil && *cmd.SendAll {
+		if lockTime != 0 || data != nil {
+			return nil, InvalidParameterError{errors.New("a lock " +
+				"time or data cannot be set when sending all " +
+				"funds")}
+		}
+		return sweepToAddress(w, cmd.Address, waddrmgr.DefaultAccountNum,
+			1, feeSatPerKb)
+	}
+
+	// Mock up map of address and amount pairs.
+	pairs := map[string]czzutil.Amount{
+		cmd.Address: amt,
+	}
+
+	var subtractFeeFrom []string
+	if cmd.SubtractFeeFromAmount != nil && *cmd.SubtractFeeFromAmount {
+		subtractFeeFrom = []string{cmd.Address}
+	}
+
+	return sendPairs(w, pairs, wallet.SendOptions{
+		KeyScope:              &waddrmgr.KeyScopeBIP0044,
+		Account:               waddrmgr.DefaultAccountNum,
+		RequiredConfirmations: 1,
+		FeeRate:               feeSatPerKb,
+		LockTime:              lockTime,
+	}, subtractFeeFrom, data)
+}
+
+// nullData returns the data of an optional hex-encoded data parameter, or nil
+// if it is not set.
+func nullData(hexStr *string) ([]byte, error) {
+	if hexStr == nil {
+		return nil, nil
+	}
+	data, err := decodeHexStr(*hexStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > txrules.MaxNullDataSize {
+		return nil, InvalidParameterError{txrules.ErrNullDataTooLarge}
+	}
+	return data, nil
+}
+
+// txLockTime returns the transaction lock time of an optional locktime
+// parameter, or zero if it is not set.
+func txLockTime(lockTime *int64) (uint32, error) {
+	if lockTime == nil {
+		return 0, nil
+	}
+	if *lockTime < 0 || *lockTime > math.MaxUint32 {
+		return 0, InvalidParameterError{fmt.Errorf("lock time %d is "+
+			"out of range", *lockTime)}
+	}
+	return uint32(*lockTime), nil
+}
+
+// txVersion returns the transaction version of an optional version parameter,
+// or wire.TxVersion if it is not set.  Only the versions relayed by the
+// network are accepted.
+func txVersion(version *int32) (int32, error) {
+	if version == nil {
+		return wire.TxVersion, nil
+	}
+	if *version < 1 || *version > maxTxVersion {
+		return 0, InvalidParameterError{fmt.Errorf("transaction "+
+			"version %d is out of range", *version)}
+	}
+	return *version, nil
+}
+
+// txInSequence returns the sequence number of an input with an optional
+// sequence parameter.  Inputs are final by default, unless the transaction
+// has a lock time, which is only enforced when an input is not final.
+func txInSequence(sequence *uint32, lockTime uint32) uint32 {
+	switch {
+	case sequence != nil:
+		return *sequence
+	case lockTime != 0:
+		return wire.MaxTxInSequenceNum - 1
+	default:
+		return wire.MaxTxInSequenceNum
+	}
+}
+
+// previewTransaction handles a previewtransaction request by creating the
+// transaction sendmany would send for the same parameters as a dry run.  The
+// unsigned transaction, its inputs, estimated size, fee and change are
+// returned without persisting a change address, locking outputs or
+// broadcasting the transaction.  If all funds are to be sent, the transaction
+// sweeping every spendable output of the account to the single address given
+// is previewed instead.
+func previewTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.PreviewTransactionCmd)
+
+	account, err := w.AccountNumber(waddrmgr.KeyScopeBIP0044, *cmd.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, ErrNeedPositiveMinconf
+	}
+
+	target, err := confTarget(cmd.ConfTarget)
+	if err != nil {
+		return nil, err
+	}
+	feeSatPerKb := w.EstimateFeeRate(target)
+
+	var coinSelector wallet.CoinSelector
+	if !isNilOrEmpty(cmd.CoinSelection) {
+		coinSelector, err = wallet.CoinSelectorByName(*cmd.CoinSelection)
+		if err != nil {
+			return nil, InvalidParameterError{err}
+		}
+	}
+
+	pairs := make(map[string]czzutil.Amount, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := czzutil.NewAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		pairs[k] = amt
+	}
+	outputs, err := makeOutputs(pairs, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	keyScope := waddrmgr.KeyScopeBIP0044
+	var preview *wallet.TxPreview
+	if cmd.SendAll != nil && *cmd.SendAll {
+		if len(outputs) != 1 {
+			return nil, InvalidParameterError{errors.New("sending " +
+				"all funds requires exactly one address")}
+		}
+		policy := wallet.OutputSelectionPolicy{
+			Account:               account,
+			RequiredConfirmations: minConf,
+		}
+		preview, err = w.PreviewSweep(
+			policy, &keyScope, outputs[0].PkScript, feeSatPerKb,
+		)
+	} else {
+		var subtractFeeFrom []int
+		if cmd.SubtractFeeFrom != nil {
+			subtractFeeFrom, err = outputIndexes(
+				outputs, *cmd.SubtractFeeFrom, w.ChainParams(),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		preview, err = w.PreviewOutputs(outputs, &wallet.SendOptions{
+			KeyScope:              &keyScope,
+			Account:               account,
+			RequiredConfirmations: minConf,
+			FeeRate:               feeSatPerKb,
+			CoinSelector:          coinSelector,
+			SubtractFeeFrom:       subtractFeeFrom,
+		})
+	}
+	if err != nil {
+		return nil, sendError(err)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(preview.Tx.SerializeSize())
+	if err := preview.Tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	inputs := make([]walletjson.PreviewTransactionInput, len(preview.Tx.TxIn))
+	for i, input := range preview.Tx.TxIn {
+		inputs[i] = walletjson.PreviewTransactionInput{
+			TxID:         input.PreviousOutPoint.Hash.String(),
+			Vout:         input.PreviousOutPoint.Index,
+			Amount:       preview.PrevInputValues[i].ToCZZ(),
+			ScriptPubKey: hex.EncodeToString(preview.PrevScripts[i]),
+		}
+	}
+
+	return &walletjson.PreviewTransactionResult{
+		Hex:       hex.EncodeToString(buf.Bytes()),
+		Inputs:    inputs,
+		Size:      preview.EstimatedSize,
+		Fee:       preview.Fee.ToCZZ(),
+		Change:    preview.ChangeAmount.ToCZZ(),
+		ChangePos: preview.ChangeIndex,
+	}, nil
+}
+
+// abandonTransaction handles an abandontransaction request by removing an
+// unmined transaction and its unmined descendants from the wallet.
+func abandonTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.AbandonTransactionCmd)
+
+	txHash, err := chainhash.NewHashFromStr(cmd.TxID)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCDecodeHexString,
+			Message: "Transaction hash string decode failed: " + err.Error(),
+		}
+	}
+
+	_, err = w.AbandonTransaction(txHash)
+	switch err {
+	case wallet.ErrTxNotFound:
+		return nil, &ErrNoTransactionInfo
+	case wallet.ErrTxMined:
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Transaction has already been mined",
+		}
+	}
+	return nil, err
+}
+
+// payoutError returns the RPC error reported for an error of the payout queue.
+func payoutError(err error) error {
+	switch err {
+	case wallet.ErrInvalidPayoutKey, wallet.ErrPayoutKeyConflict:
+		return InvalidParameterError{err}
+	case wallet.ErrPayoutNotFound, wallet.ErrPayoutNotQueued,
+		wallet.ErrPayoutQueueStopped:
+
+		return &btcjson.RPCError{
+			Code:    btcjson.ErrRPCWallet,
+			Message: err.Error(),
+		}
+	}
+	return err
+}
+
+// marshalPayout returns the JSON result describing a payout.
+func marshalPayout(w *wallet.Wallet, payout *wallet.Payout) (
+	*walletjson.PayoutResult, error) {
+
+	account, err := w.AccountName(waddrmgr.KeyScopeBIP0044, payout.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Payouts are only enqueued for outputs paying to an address, so the
+	// address can always be extracted.
+	var address string
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		payout.PkScript, w.ChainParams(),
+	)
+	if err == nil && len(addrs) == 1 {
+		address = addrs[0].EncodeAddress()
+	}
+
+	result := &walletjson.PayoutResult{
+		Key:     payout.Key,
+		Address: address,
+		Amount:  payout.Amount.ToCZZ(),
+		Account: account,
+		State:   payout.State.String(),
+		Error:   payout.Error,
+		Time:    payout.Created.Unix(),
+	}
+	if payout.State == wallet.PayoutBroadcast ||
+		payout.State == wallet.PayoutConfirmed {
+
+		result.TxID = payout.TxHash.String()
+	}
+	return result, nil
+}
+
+// enqueuePayout handles an enqueuepayout request by queueing a payment to be
+// sent with other queued payments at the next flush of the payout queue.  The
+// key makes the request idempotent.
+func enqueuePayout(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.EnqueuePayoutCmd)
+
+	amt, err := czzutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if amt <= 0 {
+		return nil, ErrNeedPositiveAmount
+	}
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+	account, err := w.AccountNumber(
+		waddrmgr.KeyScopeBIP0044, *cmd.Account,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payout, err := w.EnqueuePayout(cmd.Key, account, pkScript, amt)
+	if err != nil {
+		if err == txrules.ErrOutputIsDust {
+			return nil, InvalidParameterError{err}
+		}
+		return nil, payoutError(err)
+	}
+	return marshalPayout(w, payout)
+}
+
+// cancelPayout handles a cancelpayout request by cancelling a queued payout.
+func cancelPayout(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.CancelPayoutCmd)
+
+	payout, err := w.CancelPayout(cmd.Key)
+	if err != nil {
+		return nil, payoutError(err)
+	}
+	return marshalPayout(w, payout)
+}
+
+// getPayout handles a getpayout request by returning the state of a payout.
+func getPayout(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.GetPayoutCmd)
+
+	payout, err := w.Payout(cmd.Key)
+	if err != nil {
+		return nil, payoutError(err)
+	}
+	return marshalPayout(w, payout)
+}
+
+// listPayouts handles a listpayouts request by returning all recorded payouts,
+// oldest first.
+func listPayouts(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	payouts, err := w.Payouts()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]walletjson.PayoutResult, 0, len(payouts))
+	for _, payout := range payouts {
+		result, err := marshalPayout(w, payout)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// parseLockID parses the lock ID of an output lease, which is 32 bytes encoded
+// in hexadecimal.
+func parseLockID(id string) (wtxmgr.LockID, error) {
+	var lockID wtxmgr.LockID
+	idBytes, err := decodeHexStr(id)
+	if err != nil {
+		return lockID, err
+	}
+	if len(idBytes) != len(lockID) {
+		return lockID, InvalidParameterError{
+			fmt.Errorf("lease id must be %d bytes", len(lockID)),
+		}
+	}
+	copy(lockID[:], idBytes)
+	return lockID, nil
+}
+
+// parseLease parses the lock ID and outpoint of an output lease.
+func parseLease(id, txid string, vout uint32) (wtxmgr.LockID, wire.OutPoint,
+	error) {
+
+	lockID, err := parseLockID(id)
+	if err != nil {
+		return lockID, wire.OutPoint{}, err
+	}
+
+	txHash, err := chainhash.NewHashFromStr(txid)
+	if err != nil {
+		return lockID, wire.OutPoint{}, ParseError{err}
+	}
+	return lockID, wire.OutPoint{Hash: *txHash, Index: vout}, nil
+}
+
+// leaseError returns the RPC error reported for an error leasing or releasing
+// an output.
+func leaseError(err error) error {
+	switch err {
+	case wtxmgr.ErrUnknownOutput:
+		return InvalidParameterError{err}
+	case wtxmgr.ErrOutputAlreadyLocked, wtxmgr.ErrOutputUnlockNotAllowed:
+		return &btcjson.RPCError{
+			Code:    btcjson.ErrRPCWallet,
+			Message: err.Error(),
+		}
+	}
+	return err
+}
+
+// leaseOutput handles a leaseoutput request by leasing an output to a lock ID
+// for a number of seconds.  Leasing an output again with the same ID extends
+// its lease.
+func leaseOutput(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.LeaseOutputCmd)
+
+	if *cmd.Duration <= 0 {
+		return nil, InvalidParameterError{errors.New("lease duration " +
+			"must be positive")}
+	}
+	lockID, op, err := parseLease(cmd.ID, cmd.Txid, cmd.Vout)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check that minconf is positive.
-	minConf := int32(*cmd.MinConf)
-	if minConf < 0 {
-		return nil, ErrNeedPositiveMinconf
+	duration := time.Duration(*cmd.Duration) * time.Second
+	expiration, err := w.LeaseOutput(lockID, op, duration)
+	if err != nil {
+		return nil, leaseError(err)
 	}
+	return &walletjson.LeaseResult{
+		ID:         cmd.ID,
+		Txid:       op.Hash.String(),
+		Vout:       op.Index,
+		Expiration: expiration.Unix(),
+	}, nil
+}
 
-	// Recreate address/amount pairs, using dcrutil.Amount.
-	pairs := make(map[string]czzutil.Amount, len(cmd.Amounts))
-	for k, v := range cmd.Amounts {
-		amt, err := czzutil.NewAmount(v)
-		if err != nil {
-			return nil, err
+// releaseOutput handles a releaseoutput request by releasing the lease of an
+// output.  The lock ID must match the one the output was leased with.
+func releaseOutput(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.ReleaseOutputCmd)
+
+	lockID, op, err := parseLease(cmd.ID, cmd.Txid, cmd.Vout)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.ReleaseOutput(lockID, op); err != nil {
+		return nil, leaseError(err)
+	}
+	return true, nil
+}
+
+// listLeases handles a listleases request by returning all unexpired output
+// leases.
+func listLeases(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	leases, err := w.ListLeasedOutputs()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]walletjson.LeaseResult, 0, len(leases))
+	for _, lease := range leases {
+		results = append(results, walletjson.LeaseResult{
+			ID:         hex.EncodeToString(lease.LockID[:]),
+			Txid:       lease.Outpoint.Hash.String(),
+			Vout:       lease.Outpoint.Index,
+			Expiration: lease.Expiration.Unix(),
+		})
+	}
+	return results, nil
+}
+
+// importDescriptors handles an importdescriptors request by importing the
+// outputs of each output script descriptor.  Ranged descriptors create an
+// account named by the label of the request.  Each descriptor is imported
+// independently, and the result of each import is returned in the order of the
+// requests.
+func importDescriptors(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.ImportDescriptorsCmd)
+
+	results := make([]walletjson.ImportDescriptorResult, 0, len(cmd.Requests))
+	for _, req := range cmd.Requests {
+		var result walletjson.ImportDescriptorResult
+		props, err := importDescriptor(w, &req)
+		switch {
+		case err != nil:
+			result.Error = err.Error()
+		case props != nil:
+			result.Success = true
+			result.Account = props.AccountName
+			result.KeyScope = props.KeyScope.String()
+		default:
+			result.Success = true
 		}
-		pairs[k] = amt
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// importDescriptor imports the descriptor of a single importdescriptors
+// request.
+func importDescriptor(w *wallet.Wallet,
+	req *walletjson.ImportDescriptorRequest) (*waddrmgr.AccountProperties,
+	error) {
+
+	desc, err := descriptor.Parse(req.Desc)
+	if err != nil {
+		return nil, err
+	}
+
+	var label string
+	if req.Label != nil {
+		label = *req.Label
+	}
+	if desc.Ranged() && label == "" {
+		return nil, errors.New("ranged descriptors require a label " +
+			"naming their account")
 	}
 
-	return sendPairs(w, pairs, waddrmgr.KeyScopeBIP0044, account, minConf, txrules.DefaultRelayFeePerKb)
+	// Without a timestamp, the descriptor is assumed to have no previous
+	// outputs and the wallet is not rescanned.
+	var birthday time.Time
+	if req.Timestamp != nil {
+		birthday = time.Unix(*req.Timestamp, 0)
+	}
+	return w.ImportDescriptor(desc, label, birthday)
 }
 
-// sendToAddress handles a sendtoaddress RPC request by creating a new
-// transaction spending unspent transaction outputs for a wallet to another
-// payment address.  Leftover inputs not sent to the payment address or a fee
-// for the miner are sent back to a new address in the wallet.  Upon success,
-// the TxID for the created transaction is returned.
-func sendToAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
-	cmd := icmd.(*btcjson.SendToAddressCmd)
+// listDescriptors handles a listdescriptors request by returning the output
+// script descriptors of the accounts of every key scope.
+func listDescriptors(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	descs, err := w.AccountDescriptors()
+	if err != nil {
+		return nil, err
+	}
 
-	// Transaction comments are not yet supported.  Error instead of
-	// pretending to save them.
-	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
+	results := make([]walletjson.DescriptorResult, 0, len(descs))
+	for _, desc := range descs {
+		results = append(results, walletjson.DescriptorResult{
+			Desc:          desc.Descriptor.String(),
+			KeyScope:      desc.KeyScope.String(),
+			Account:       desc.AccountName,
+			AccountNumber: desc.AccountNumber,
+		})
+	}
+	return results, nil
+}
+
+// bumpFee handles a bumpfee request.  There is no transaction replacement on
+// the network, so the fee of an unmined wallet transaction is bumped by
+// publishing a child transaction that spends one of its wallet outputs and
+// pays for the parent and the child at the requested fee rate, or the fee rate
+// estimated for the requested confirmation target.
+func bumpFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.BumpFeeCmd)
+
+	txHash, err := chainhash.NewHashFromStr(cmd.TxID)
+	if err != nil {
 		return nil, &btcjson.RPCError{
-			Code:    btcjson.ErrRPCUnimplemented,
-			Message: "Transaction comments are not yet supported",
+			Code:    btcjson.ErrRPCDecodeHexString,
+			Message: "Transaction hash string decode failed: " + err.Error(),
 		}
 	}
 
-	amt, err := czzutil.NewAmount(cmd.Amount)
-	if err != nil {
-		return nil, err
+	var opts walletjson.BumpFeeOptions
+	if cmd.Options != nil {
+		opts = *cmd.Options
 	}
 
-	// Check that signed integer parameters are positive.
-	if amt < 0 {
-		return nil, ErrNeedPositiveAmount
+	// The fee rate is estimated for the confirmation target, unless it is
+	// given explicitly.
+	var feeSatPerKb czzutil.Amount
+	if opts.FeeRate != nil {
+		feeSatPerKb, err = czzutil.NewAmount(*opts.FeeRate)
+		if err != nil {
+			return nil, err
+		}
+		if feeSatPerKb <= 0 {
+			return nil, ErrNeedPositiveAmount
+		}
+	} else {
+		target, err := confTarget(opts.ConfTarget)
+		if err != nil {
+			return nil, err
+		}
+		feeSatPerKb = w.EstimateFeeRate(target)
 	}
 
-	// Mock up map of address and amount pairs.
-	pairs := map[string]czzutil.Amount{
-		cmd.Address: amt,
+	child, err := w.BumpFeeCPFP(txHash, feeSatPerKb)
+	switch {
+	case err == wallet.ErrTxNotFound:
+		return nil, &ErrNoTransactionInfo
+	case waddrmgr.IsError(err, waddrmgr.ErrLocked):
+		return nil, &ErrWalletUnlockNeeded
+	case err != nil:
+		return nil, err
 	}
 
-	// sendtoaddress always spends from the default account, this matches bitcoind
-	return sendPairs(w, pairs, waddrmgr.KeyScopeBIP0044, waddrmgr.DefaultAccountNum, 1,
-		txrules.DefaultRelayFeePerKb)
+	return &walletjson.BumpFeeResult{
+		TxID:           child.Tx.TxHash().String(),
+		ParentTxID:     txHash.String(),
+		Fee:            child.Fee.ToCZZ(),
+		ParentFee:      child.ParentFee.ToCZZ(),
+		PackageFeeRate: child.PackageFeeRate.ToCZZ(),
+	}, nil
 }
 
-// setTxFee sets the transaction fee per kilobyte added to transactions.
+// setTxFee sets the transaction fee per kilobyte paid by transactions instead
+// of an estimated fee rate.  A zero fee estimates the fee rate again.
 func setTxFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	cmd := icmd.(*btcjson.SetTxFeeCmd)
 
@@ -1518,6 +2833,12 @@ func setTxFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 		return nil, ErrNeedPositiveAmount
 	}
 
+	feeSatPerKb, err := czzutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+	w.SetTxFeeRate(feeSatPerKb)
+
 	// A boolean true result is returned upon success.
 	return true, nil
 }
@@ -1592,7 +2913,7 @@ func signRawTransaction(icmd interface{}, w *wallet.Wallet, chainClient *chain.R
 	if cmd.Inputs != nil {
 		cmdInputs = *cmd.Inputs
 	}
-	var inputValues []int64
+	values := make(map[wire.OutPoint]int64)
 	for _, rti := range cmdInputs {
 		inputHash, err := chainhash.NewHashFromStr(rti.Txid)
 		if err != nil {
@@ -1623,111 +2944,426 @@ func signRawTransaction(icmd interface{}, w *wallet.Wallet, chainClient *chain.R
 			}
 			scripts[addr.String()] = redeemScript
 		}
-		inputs[wire.OutPoint{
+		outPoint := wire.OutPoint{
 			Hash:  *inputHash,
 			Index: rti.Vout,
-		}] = script
+		}
+		inputs[outPoint] = script
 		amt, err := czzutil.NewAmount(rti.Amount)
 		if err != nil {
 			return nil, DeserializationError{err}
 		}
-		inputValues = append(inputValues, int64(amt.ToUnit(czzutil.AmountSatoshi)))
+		values[outPoint] = int64(amt)
+	}
+
+	// Now we go and look for any inputs that we were not provided by
+	// querying bchd with getrawtransaction. We queue up a bunch of async
+	// requests and will wait for replies after we have checked the rest of
+	// the arguments.
+	requested := make(map[wire.OutPoint]rpcclient.FutureGetTxOutResult)
+	for _, txIn := range tx.TxIn {
+		// Did we get this outpoint from the arguments?
+		if _, ok := inputs[txIn.PreviousOutPoint]; ok {
+			continue
+		}
+
+		// Asynchronously request the output script.
+		requested[txIn.PreviousOutPoint] = chainClient.GetTxOutAsync(
+			&txIn.PreviousOutPoint.Hash, txIn.PreviousOutPoint.Index,
+			true)
+	}
+
+	// Parse list of private keys, if present. If there are any keys here
+	// they are the keys that we may use for signing. If empty we will
+	// use any keys known to us already.
+	var keys map[string]*czzutil.WIF
+	if cmd.PrivKeys != nil {
+		keys = make(map[string]*czzutil.WIF)
+
+		for _, key := range *cmd.PrivKeys {
+			wif, err := czzutil.DecodeWIF(key)
+			if err != nil {
+				return nil, DeserializationError{err}
+			}
+
+			if !wif.IsForNet(w.ChainParams()) {
+				s := "key network doesn't match wallet's"
+				return nil, DeserializationError{errors.New(s)}
+			}
+
+			address, err := czzutil.NewAddressPubKeyHash(
+				czzutil.Hash160(wif.SerializePubKey()), w.ChainParams())
+
+			if err != nil {
+				return nil, DeserializationError{err}
+			}
+			keys[address.String()] = wif
+		}
+	}
+
+	// We have checked the rest of the args. now we can collect the async
+	// txs. TODO: If we don't mind the possibility of wasting work we could
+	// move waiting to the following loop and be slightly more asynchronous.
+	for outPoint, resp := range requested {
+		result, err := resp.Receive()
+		if err != nil {
+			return nil, err
+		}
+		script, err := hex.DecodeString(result.ScriptPubKey.Hex)
+		if err != nil {
+			return nil, err
+		}
+		inputs[outPoint] = script
+		amt, err := czzutil.NewAmount(result.Value)
+		if err != nil {
+			return nil, err
+		}
+		values[outPoint] = int64(amt)
+	}
+
+	// Signatures commit to the amounts spent, so every input is signed
+	// with the amount of the output it spends.  This allows signatures of
+	// other cosigners to be verified and merged with the new signatures.
+	inputValues := make([]int64, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		inputValues[i] = values[txIn.PreviousOutPoint]
+	}
+
+	// All args collected. Now we can sign all the inputs that we can.
+	// `complete' denotes that we successfully signed all outputs and that
+	// all scripts will run to completion. This is returned as part of the
+	// reply.
+	signErrs, err := w.SignTransaction(&tx, inputValues, hashType, inputs, keys, scripts)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+
+	// All returned errors (not OOM, which panics) encountered during
+	// bytes.Buffer writes are unexpected.
+	if err = tx.Serialize(&buf); err != nil {
+		panic(err)
+	}
+
+	signErrors := make([]btcjson.SignRawTransactionError, 0, len(signErrs))
+	for _, e := range signErrs {
+		input := tx.TxIn[e.InputIndex]
+		signErrors = append(signErrors, btcjson.SignRawTransactionError{
+			TxID:      input.PreviousOutPoint.Hash.String(),
+			Vout:      input.PreviousOutPoint.Index,
+			ScriptSig: hex.EncodeToString(input.SignatureScript),
+			Sequence:  input.Sequence,
+			Error:     e.Error.Error(),
+		})
+	}
+
+	return btcjson.SignRawTransactionResult{
+		Hex:      hex.EncodeToString(buf.Bytes()),
+		Complete: len(signErrors) == 0,
+		Errors:   signErrors,
+	}, nil
+}
+
+// combineRawTransaction handles the combinerawtransaction command by merging
+// the signatures of copies of a transaction partially signed by different
+// cosigners.
+func combineRawTransaction(icmd interface{}, w *wallet.Wallet,
+	chainClient *chain.RPCClient) (interface{}, error) {
+
+	cmd := icmd.(*walletjson.CombineRawTransactionCmd)
+
+	if len(cmd.HexTxs) == 0 {
+		return nil, InvalidParameterError{
+			errors.New("no transactions to combine"),
+		}
+	}
+	txs := make([]*wire.MsgTx, 0, len(cmd.HexTxs))
+	for _, hexTx := range cmd.HexTxs {
+		serializedTx, err := decodeHexStr(hexTx)
+		if err != nil {
+			return nil, err
+		}
+		var tx wire.MsgTx
+		err = tx.Deserialize(bytes.NewReader(serializedTx))
+		if err != nil {
+			e := errors.New("TX decode failed")
+			return nil, DeserializationError{e}
+		}
+		txs = append(txs, &tx)
+	}
+
+	// The outputs spent by the transaction are looked up with the
+	// consensus server, as the signatures commit to their amounts.
+	// Outputs it does not know of are looked up in the wallet.
+	requested := make(map[wire.OutPoint]rpcclient.FutureGetTxOutResult)
+	for _, txIn := range txs[0].TxIn {
+		requested[txIn.PreviousOutPoint] = chainClient.GetTxOutAsync(
+			&txIn.PreviousOutPoint.Hash, txIn.PreviousOutPoint.Index,
+			true)
+	}
+	prevOutputs := make(map[wire.OutPoint]*wire.TxOut)
+	for outPoint, resp := range requested {
+		result, err := resp.Receive()
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			continue
+		}
+		script, err := hex.DecodeString(result.ScriptPubKey.Hex)
+		if err != nil {
+			return nil, err
+		}
+		amt, err := czzutil.NewAmount(result.Value)
+		if err != nil {
+			return nil, err
+		}
+		prevOutputs[outPoint] = wire.NewTxOut(int64(amt), script)
+	}
+
+	combined, err := w.CombineTransactions(txs, prevOutputs)
+	if errors.Is(err, wallet.ErrCombineMismatch) {
+		return nil, InvalidParameterError{err}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(combined.SerializeSize())
+	if err := combined.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+// createRawTransaction handles the createrawtransaction command by creating an
+// unsigned transaction spending the given inputs to the given amounts.  Unlike
+// fundrawtransaction, the inputs are not required to be controlled by the
+// wallet.
+func createRawTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.CreateRawTransactionCmd)
+
+	lockTime, err := txLockTime(cmd.LockTime)
+	if err != nil {
+		return nil, err
+	}
+	version, err := txVersion(cmd.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(version)
+	for _, input := range cmd.Inputs {
+		txHash, err := chainhash.NewHashFromStr(input.Txid)
+		if err != nil {
+			return nil, DeserializationError{err}
+		}
+		txIn := wire.NewTxIn(wire.NewOutPoint(txHash, input.Vout), nil)
+		txIn.Sequence = txInSequence(input.Sequence, lockTime)
+		tx.AddTxIn(txIn)
 	}
 
-	// Now we go and look for any inputs that we were not provided by
-	// querying bchd with getrawtransaction. We queue up a bunch of async
-	// requests and will wait for replies after we have checked the rest of
-	// the arguments.
-	requested := make(map[wire.OutPoint]rpcclient.FutureGetTxOutResult)
-	for _, txIn := range tx.TxIn {
-		// Did we get this outpoint from the arguments?
-		if _, ok := inputs[txIn.PreviousOutPoint]; ok {
-			continue
+	pairs := make(map[string]czzutil.Amount, len(cmd.Amounts))
+	for addr, v := range cmd.Amounts {
+		amt, err := czzutil.NewAmount(v)
+		if err != nil {
+			return nil, err
 		}
-
-		// Asynchronously request the output script.
-		requested[txIn.PreviousOutPoint] = chainClient.GetTxOutAsync(
-			&txIn.PreviousOutPoint.Hash, txIn.PreviousOutPoint.Index,
-			true)
+		if amt <= 0 {
+			return nil, ErrNeedPositiveAmount
+		}
+		pairs[addr] = amt
+	}
+	outputs, err := makeOutputs(pairs, w.ChainParams())
+	if err != nil {
+		return nil, InvalidParameterError{err}
 	}
+	tx.TxOut = outputs
+	tx.LockTime = lockTime
 
-	// Parse list of private keys, if present. If there are any keys here
-	// they are the keys that we may use for signing. If empty we will
-	// use any keys known to us already.
-	var keys map[string]*czzutil.WIF
-	if cmd.PrivKeys != nil {
-		keys = make(map[string]*czzutil.WIF)
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return hex.EncodeToString(buf.Bytes()), nil
+}
 
-		for _, key := range *cmd.PrivKeys {
-			wif, err := czzutil.DecodeWIF(key)
-			if err != nil {
-				return nil, DeserializationError{err}
-			}
+// decodeRawTransaction handles the decoderawtransaction command.
+func decodeRawTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*btcjson.DecodeRawTransactionCmd)
 
-			if !wif.IsForNet(w.ChainParams()) {
-				s := "key network doesn't match wallet's"
-				return nil, DeserializationError{errors.New(s)}
-			}
+	serializedTx, err := decodeHexStr(cmd.HexTx)
+	if err != nil {
+		return nil, err
+	}
+	var tx wire.MsgTx
+	err = tx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		e := errors.New("TX decode failed")
+		return nil, DeserializationError{e}
+	}
 
-			address, err := czzutil.NewAddressPubKeyHash(
-				czzutil.Hash160(wif.SerializePubKey()), w.ChainParams())
+	return btcjson.TxRawDecodeResult{
+		Txid:     tx.TxHash().String(),
+		Version:  tx.Version,
+		Locktime: tx.LockTime,
+		Vin:      rawTxVin(&tx),
+		Vout:     rawTxVout(&tx, w.ChainParams()),
+	}, nil
+}
 
-			if err != nil {
-				return nil, DeserializationError{err}
+// rawTxVin describes the inputs of a transaction for the decoderawtransaction
+// result.
+func rawTxVin(tx *wire.MsgTx) []btcjson.Vin {
+	vin := make([]btcjson.Vin, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		op := &txIn.PreviousOutPoint
+		if len(tx.TxIn) == 1 && op.Index == wire.MaxPrevOutIndex &&
+			op.Hash == (chainhash.Hash{}) {
+
+			vin[i] = btcjson.Vin{
+				Coinbase: hex.EncodeToString(txIn.SignatureScript),
+				Sequence: txIn.Sequence,
 			}
-			keys[address.String()] = wif
+			continue
+		}
+
+		// The disassembly of scripts which fail to parse is cut short
+		// rather than failing the request.
+		disbuf, _ := txscript.DisasmString(txIn.SignatureScript)
+		vin[i] = btcjson.Vin{
+			Txid: op.Hash.String(),
+			Vout: op.Index,
+			ScriptSig: &btcjson.ScriptSig{
+				Asm: disbuf,
+				Hex: hex.EncodeToString(txIn.SignatureScript),
+			},
+			Sequence: txIn.Sequence,
 		}
 	}
+	return vin
+}
 
-	// We have checked the rest of the args. now we can collect the async
-	// txs. TODO: If we don't mind the possibility of wasting work we could
-	// move waiting to the following loop and be slightly more asynchronous.
-	for outPoint, resp := range requested {
-		result, err := resp.Receive()
-		if err != nil {
-			return nil, err
+// rawTxVout describes the outputs of a transaction for the
+// decoderawtransaction result.
+func rawTxVout(tx *wire.MsgTx, chainParams *chaincfg.Params) []btcjson.Vout {
+	vout := make([]btcjson.Vout, len(tx.TxOut))
+	for i, txOut := range tx.TxOut {
+		disbuf, _ := txscript.DisasmString(txOut.PkScript)
+
+		// Non-standard scripts have no addresses, so the error is
+		// ignored.
+		class, addrs, reqSigs, _ := txscript.ExtractPkScriptAddrs(
+			txOut.PkScript, chainParams,
+		)
+		var encodedAddrs []string
+		for _, addr := range addrs {
+			encodedAddrs = append(encodedAddrs, addr.EncodeAddress())
 		}
-		script, err := hex.DecodeString(result.ScriptPubKey.Hex)
-		if err != nil {
-			return nil, err
+
+		vout[i] = btcjson.Vout{
+			Value: czzutil.Amount(txOut.Value).ToCZZ(),
+			N:     uint32(i),
+			ScriptPubKey: btcjson.ScriptPubKeyResult{
+				Asm:       disbuf,
+				Hex:       hex.EncodeToString(txOut.PkScript),
+				ReqSigs:   int32(reqSigs),
+				Type:      class.String(),
+				Addresses: encodedAddrs,
+			},
 		}
-		inputs[outPoint] = script
 	}
+	return vout
+}
 
-	// All args collected. Now we can sign all the inputs that we can.
-	// `complete' denotes that we successfully signed all outputs and that
-	// all scripts will run to completion. This is returned as part of the
-	// reply.
-	signErrs, err := w.SignTransaction(&tx, inputValues, hashType, inputs, keys, scripts)
+// fundRawTransaction handles the fundrawtransaction command by adding inputs
+// and change to a raw transaction so that it pays for its outputs.  The inputs
+// of the raw transaction are kept and must be controlled by the wallet.
+func fundRawTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.FundRawTransactionCmd)
+
+	serializedTx, err := decodeHexStr(cmd.HexTx)
 	if err != nil {
 		return nil, err
 	}
+	var tx wire.MsgTx
+	err = tx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		e := errors.New("TX decode failed")
+		return nil, DeserializationError{e}
+	}
 
-	var buf bytes.Buffer
-	buf.Grow(tx.SerializeSize())
+	accountName := defaultAccountName
+	fundOpts := &wallet.FundRawTxOptions{
+		KeyScope:              &waddrmgr.KeyScopeBIP0044,
+		RequiredConfirmations: 1,
+		FeeRate:               w.EstimateFeeRate(wallet.DefaultConfTarget),
+	}
+	if opts := cmd.Options; opts != nil {
+		if opts.Account != nil {
+			accountName = *opts.Account
+		}
+		if opts.MinConf != nil {
+			if *opts.MinConf < 0 {
+				return nil, ErrNeedPositiveMinconf
+			}
+			fundOpts.RequiredConfirmations = *opts.MinConf
+		}
+		if opts.ChangeAccount != nil {
+			changeAccount, err := w.AccountNumber(
+				waddrmgr.KeyScopeBIP0044, *opts.ChangeAccount,
+			)
+			if err != nil {
+				return nil, err
+			}
+			fundOpts.ChangeAccount = &changeAccount
+		}
+		fundOpts.ChangePosition = opts.ChangePosition
+		if opts.FeeRate != nil {
+			feeSatPerKb, err := czzutil.NewAmount(*opts.FeeRate)
+			if err != nil {
+				return nil, err
+			}
+			if feeSatPerKb < 0 {
+				return nil, ErrNeedPositiveAmount
+			}
+			fundOpts.FeeRate = feeSatPerKb
+		}
+	}
 
-	// All returned errors (not OOM, which panics) encountered during
-	// bytes.Buffer writes are unexpected.
-	if err = tx.Serialize(&buf); err != nil {
-		panic(err)
+	fundOpts.Account, err = w.AccountNumber(
+		waddrmgr.KeyScopeBIP0044, accountName,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	signErrors := make([]btcjson.SignRawTransactionError, 0, len(signErrs))
-	for _, e := range signErrs {
-		input := tx.TxIn[e.InputIndex]
-		signErrors = append(signErrors, btcjson.SignRawTransactionError{
-			TxID:      input.PreviousOutPoint.Hash.String(),
-			Vout:      input.PreviousOutPoint.Index,
-			ScriptSig: hex.EncodeToString(input.SignatureScript),
-			Sequence:  input.Sequence,
-			Error:     e.Error.Error(),
-		})
+	funded, err := w.FundRawTransaction(&tx, fundOpts)
+	switch err {
+	case nil:
+	case wallet.ErrChangePosition:
+		return nil, InvalidParameterError{err}
+	case txrules.ErrAmountNegative:
+		return nil, ErrNeedPositiveAmount
+	default:
+		return nil, err
 	}
 
-	return btcjson.SignRawTransactionResult{
-		Hex:      hex.EncodeToString(buf.Bytes()),
-		Complete: len(signErrors) == 0,
-		Errors:   signErrors,
+	var buf bytes.Buffer
+	buf.Grow(funded.Tx.SerializeSize())
+	if err := funded.Tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	fee := funded.TotalInput - txauthor.SumOutputValues(funded.Tx.TxOut)
+
+	return &walletjson.FundRawTransactionResult{
+		Hex:       hex.EncodeToString(buf.Bytes()),
+		Fee:       fee.ToCZZ(),
+		ChangePos: int64(funded.ChangeIndex),
 	}, nil
 }
 
@@ -1858,6 +3494,253 @@ func verifyMessage(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	}
 }
 
+// decodePsbt decodes a base64-encoded PSBT passed as an RPC parameter.
+func decodePsbt(b64 string) (*psbt.Packet, error) {
+	packet, err := psbt.NewFromRawBytes(strings.NewReader(b64), true)
+	if err != nil {
+		return nil, DeserializationError{err}
+	}
+	return packet, nil
+}
+
+// walletCreateFundedPsbt handles a walletcreatefundedpsbt request by creating
+// a PSBT for the passed inputs and outputs and funding it from the wallet.
+// Inputs are selected automatically when none are given.  All inputs of the
+// funded PSBT are leased under the requested lease ID, or wallet.PsbtLockID,
+// until the PSBT is published or the lease expires or is released.
+func walletCreateFundedPsbt(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.WalletCreateFundedPsbtCmd)
+
+	accountName := defaultAccountName
+	minConf := int32(1)
+	feeSatPerKb := w.EstimateFeeRate(wallet.DefaultConfTarget)
+	lockID := wallet.PsbtLockID
+	leaseDuration := wallet.DefaultPsbtLeaseDuration
+	version := int32(wire.TxVersion)
+	if opts := cmd.Options; opts != nil {
+		if opts.Account != nil {
+			accountName = *opts.Account
+		}
+		if opts.MinConf != nil {
+			minConf = *opts.MinConf
+			if minConf < 0 {
+				return nil, ErrNeedPositiveMinconf
+			}
+		}
+		if opts.FeeRate != nil {
+			var err error
+			feeSatPerKb, err = czzutil.NewAmount(*opts.FeeRate)
+			if err != nil {
+				return nil, err
+			}
+			if feeSatPerKb < 0 {
+				return nil, ErrNeedPositiveAmount
+			}
+		}
+		if opts.LeaseID != nil {
+			var err error
+			lockID, err = parseLockID(*opts.LeaseID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if opts.LeaseDuration != nil {
+			if *opts.LeaseDuration <= 0 {
+				return nil, InvalidParameterError{
+					errors.New("lease duration must be positive"),
+				}
+			}
+			leaseDuration = time.Duration(*opts.LeaseDuration) *
+				time.Second
+		}
+		if opts.Version != nil {
+			var err error
+			version, err = txVersion(opts.Version)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	account, err := w.AccountNumber(waddrmgr.KeyScopeBIP0044, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	var lockTime uint32
+	if cmd.Locktime != nil {
+		lockTime = *cmd.Locktime
+	}
+	tx := wire.NewMsgTx(version)
+	for _, input := range cmd.Inputs {
+		txHash, err := chainhash.NewHashFromStr(input.Txid)
+		if err != nil {
+			return nil, DeserializationError{err}
+		}
+		txIn := wire.NewTxIn(wire.NewOutPoint(txHash, input.Vout), nil)
+		txIn.Sequence = txInSequence(input.Sequence, lockTime)
+		tx.AddTxIn(txIn)
+	}
+
+	pairs := make(map[string]czzutil.Amount, len(cmd.Outputs))
+	for addr, v := range cmd.Outputs {
+		amt, err := czzutil.NewAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		if amt < 0 {
+			return nil, ErrNeedPositiveAmount
+		}
+		pairs[addr] = amt
+	}
+	outputs, err := makeOutputs(pairs, w.ChainParams())
+	if err != nil {
+		return nil, InvalidParameterError{err}
+	}
+	tx.TxOut = outputs
+	tx.LockTime = lockTime
+
+	packet, err := wallet.NewPsbt(tx)
+	if err != nil {
+		return nil, err
+	}
+	changeIndex, err := w.FundPsbt(
+		packet, &waddrmgr.KeyScopeBIP0044, account, minConf, feeSatPerKb,
+		lockID, leaseDuration,
+	)
+	if err != nil {
+		if err == txrules.ErrAmountNegative {
+			return nil, ErrNeedPositiveAmount
+		}
+		return nil, err
+	}
+
+	fee, err := wallet.PsbtFee(packet)
+	if err != nil {
+		return nil, err
+	}
+	b64, err := packet.B64Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &walletjson.WalletCreateFundedPsbtResult{
+		Psbt:      b64,
+		Fee:       fee.ToCZZ(),
+		ChangePos: int64(changeIndex),
+	}, nil
+}
+
+// walletProcessPsbt handles a walletprocesspsbt request by signing all inputs
+// of a PSBT the wallet is able to sign and finalizing those inputs that are
+// complete.
+func walletProcessPsbt(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.WalletProcessPsbtCmd)
+
+	packet, err := decodePsbt(cmd.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.Sign == nil || *cmd.Sign {
+		if _, err := w.SignPsbt(packet); err != nil {
+			if waddrmgr.IsError(err, waddrmgr.ErrLocked) {
+				return nil, &ErrWalletUnlockNeeded
+			}
+			return nil, err
+		}
+	}
+
+	var complete bool
+	if cmd.Finalize == nil || *cmd.Finalize {
+		complete = wallet.FinalizePsbtInputs(packet)
+	} else {
+		complete = packet.IsComplete()
+	}
+
+	b64, err := packet.B64Encode()
+	if err != nil {
+		return nil, err
+	}
+	return &walletjson.WalletProcessPsbtResult{
+		Psbt:     b64,
+		Complete: complete,
+	}, nil
+}
+
+// finalizePsbt handles a finalizepsbt request by signing and finalizing all
+// inputs of a PSBT.  When the PSBT is complete, the final transaction is
+// returned instead of the PSBT unless extraction is disabled.
+func finalizePsbt(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.FinalizePsbtCmd)
+
+	packet, err := decodePsbt(cmd.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.FinalizePsbt(packet)
+	switch {
+	case err == wallet.ErrPsbtIncomplete:
+		b64, err := packet.B64Encode()
+		if err != nil {
+			return nil, err
+		}
+		return &walletjson.FinalizePsbtResult{Psbt: b64}, nil
+
+	case waddrmgr.IsError(err, waddrmgr.ErrLocked):
+		return nil, &ErrWalletUnlockNeeded
+
+	case err != nil:
+		return nil, err
+	}
+
+	if cmd.Extract != nil && !*cmd.Extract {
+		b64, err := packet.B64Encode()
+		if err != nil {
+			return nil, err
+		}
+		return &walletjson.FinalizePsbtResult{
+			Psbt:     b64,
+			Complete: true,
+		}, nil
+	}
+
+	tx, err := wallet.ExtractPsbtTx(packet)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return &walletjson.FinalizePsbtResult{
+		Hex:      hex.EncodeToString(buf.Bytes()),
+		Complete: true,
+	}, nil
+}
+
+// abandonPsbt handles an abandonpsbt request by releasing the leases held on
+// the inputs of a PSBT funded by walletcreatefundedpsbt that will not be
+// published.
+func abandonPsbt(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.AbandonPsbtCmd)
+
+	packet, err := decodePsbt(cmd.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	lockID := wallet.PsbtLockID
+	if cmd.LeaseID != nil {
+		lockID, err = parseLockID(*cmd.LeaseID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, w.AbandonPsbt(packet, lockID)
+}
+
 // walletIsLocked handles the walletislocked extension request by
 // returning the current lock state (false for unlocked, true for locked)
 // of an account.
@@ -1880,11 +3763,7 @@ func walletPassphrase(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	cmd := icmd.(*btcjson.WalletPassphraseCmd)
 
 	timeout := time.Second * time.Duration(cmd.Timeout)
-	var unlockAfter <-chan time.Time
-	if timeout != 0 {
-		unlockAfter = time.After(timeout)
-	}
-	err := w.Unlock([]byte(cmd.Passphrase), unlockAfter)
+	err := w.UnlockWithTimeout([]byte(cmd.Passphrase), timeout)
 	return nil, err
 }
 
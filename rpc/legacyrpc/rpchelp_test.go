@@ -18,8 +18,8 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/classzz/classzz/btcjson"
 	"github.com/classzz/czzwallet/internal/rpchelp"
+	"github.com/classzz/czzwallet/rpc/walletjson"
 )
 
 func serverMethods() map[string]struct{} {
@@ -54,7 +54,7 @@ func TestRPCMethodHelpGeneration(t *testing.T) {
 		for _, m := range rpchelp.Methods {
 			delete(svrMethods, m.Method)
 
-			helpText, err := btcjson.GenerateHelp(m.Method, rpchelp.HelpDescs[i].Descs, m.ResultTypes...)
+			helpText, err := walletjson.GenerateHelp(m.Method, rpchelp.HelpDescs[i].Descs, m.ResultTypes...)
 			if err != nil {
 				t.Errorf("Cannot generate '%s' help for method '%s': missing description for '%s'",
 					locale, m.Method, err)
@@ -91,7 +91,7 @@ func TestRPCMethodUsageGeneration(t *testing.T) {
 	for _, m := range rpchelp.Methods {
 		delete(svrMethods, m.Method)
 
-		usage, err := btcjson.MethodUsageText(m.Method)
+		usage, err := walletjson.MethodUsageText(m.Method)
 		if err != nil {
 			t.Errorf("Cannot generate single line usage for method '%s': %v",
 				m.Method, err)
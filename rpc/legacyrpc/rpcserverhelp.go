@@ -4,51 +4,82 @@ package legacyrpc
 
 func helpDescsEnUS() map[string]string {
 	return map[string]string{
-		"addmultisigaddress":      "addmultisigaddress nrequired [\"key\",...] (\"account\")\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n3. account   (string, optional)          DEPRECATED -- Unused (all imported addresses belong to the imported account)\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
-		"createmultisig":          "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
-		"dumpprivkey":             "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
-		"getaccount":              "getaccount \"address\"\n\nDEPRECATED -- Lookup the account name that some wallet address belongs to.\n\nArguments:\n1. address (string, required) The address to query the account for\n\nResult:\n\"value\" (string) The name of the account that 'address' belongs to\n",
-		"getaccountaddress":       "getaccountaddress \"account\"\n\nDEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\n\nArguments:\n1. account (string, required) The account of the returned address\n\nResult:\n\"value\" (string) The unused address for 'account'\n",
-		"getaddressesbyaccount":   "getaddressesbyaccount \"account\"\n\nDEPRECATED -- Returns all addresses strings controlled by a single account.\n\nArguments:\n1. account (string, required) Account name to fetch addresses for\n\nResult:\n[\"value\",...] (array of string) All addresses controlled by 'account'\n",
-		"getbalance":              "getbalance (\"account\" minconf=1)\n\nCalculates and returns the balance of one or all accounts.\n\nArguments:\n1. account (string, optional)             DEPRECATED -- The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult (account != \"*\"):\nn.nnn (numeric) The balance of 'account' valued in bitcoin\n\nResult (account = \"*\"):\nn.nnn (numeric) The balance of all accounts valued in bitcoin\n",
-		"getbestblockhash":        "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
-		"getblockcount":           "getblockcount\n\nReturns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The blockchain height of the most recent synced-to block\n",
-		"getinfo":                 "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The increment used each time more fee is required for an authored transaction\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in BTC/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
-		"getnewaddress":           "getnewaddress (\"account\")\n\nGenerates and returns a new payment address.\n\nArguments:\n1. account (string, optional) DEPRECATED -- Account name the new address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The payment address\n",
-		"getrawchangeaddress":     "getrawchangeaddress (\"account\")\n\nGenerates and returns a new internal payment address for use as a change address in raw transactions.\n\nArguments:\n1. account (string, optional) Account name the new internal address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The internal payment address\n",
-		"getreceivedbyaccount":    "getreceivedbyaccount \"account\" (minconf=1)\n\nDEPRECATED -- Returns the total amount received by addresses of some account, including spent outputs.\n\nArguments:\n1. account (string, required)             Account name to query total received amount for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in bitcoin\n",
-		"getreceivedbyaddress":    "getreceivedbyaddress \"address\" (minconf=1)\n\nReturns the total amount received by a single address, including spent outputs.\n\nArguments:\n1. address (string, required)             Payment address which received outputs to include in total\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in bitcoin\n",
-		"gettransaction":          "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in bitcoin\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n}                                  \n",
-		"help":                    "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
-		"importprivkey":           "importprivkey \"privkey\" (\"label\" rescan=true)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey (string, required)                The WIF-encoded private key\n2. label   (string, optional)                Unused (must be unset or 'imported')\n3. rescan  (boolean, optional, default=true) Rescan the blockchain (since the genesis block) for outputs controlled by the imported key\n\nResult:\nNothing\n",
-		"keypoolrefill":           "keypoolrefill (newsize=100)\n\nDEPRECATED -- This request does nothing since no keypool is maintained.\n\nArguments:\n1. newsize (numeric, optional, default=100) Unused\n\nResult:\nNothing\n",
-		"listaccounts":            "listaccounts (minconf=1)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"The account name\": The account balance valued in bitcoin, (object) JSON object with account names as keys and bitcoin amounts as values\n ...\n}\n",
-		"listlockunspent":         "listlockunspent\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\n\nArguments:\nNone\n\nResult:\n[{\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n},...]\n",
-		"listreceivedbyaccount":   "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nDEPRECATED -- Returns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in bitcoin\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
-		"listreceivedbyaddress":   "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in bitcoin\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
-		"listsinceblock":          "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"abandoned\": true|false,          (boolean)         Unset\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n  \"bip125-replaceable\": \"value\",    (string)          Unset\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"trusted\": true|false,            (boolean)         Unset\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
-		"listtransactions":        "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Unset\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listunspent":             "listunspent (minconf=1 maxconf=9999999 [\"address\",...])\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  Unset\n \"amount\": n.nnn,         (numeric) The amount of the output valued in bitcoin\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n}                         \n",
-		"lockunspent":             "lockunspent unlock [{\"txid\":\"value\",\"vout\":n},...]\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts.\nIf unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n},...]\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
-		"sendfrom":                "sendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\n\nDEPRECATED -- Authors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Account to pick unspent outputs from\n2. toaddress   (string, required)             Address to pay\n3. amount      (numeric, required)            Amount to send to the payment address valued in bitcoin\n4. minconf     (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment     (string, optional)             Unused\n6. commentto   (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendmany":                "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) DEPRECATED -- Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in bitcoin, (object) JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address\n ...\n}\n3. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtoaddress":           "sendtoaddress \"address\" amount (\"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)  Address to pay\n2. amount    (numeric, required) Amount to send to the payment address valued in bitcoin\n3. comment   (string, optional)  Unused\n4. commentto (string, optional)  Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"settxfee":                "settxfee amount\n\nModify the increment used each time more fee is required for an authored transaction.\n\nArguments:\n1. amount (numeric, required) The new fee increment valued in bitcoin\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
-		"signmessage":             "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
-		"signrawtransaction":      "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
-		"validateaddress":         "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): isscript, pubkey, iscompressed, account, addresses, hex, script, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n}                            \n",
-		"verifymessage":           "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
-		"walletlock":              "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
-		"walletpassphrase":        "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks\n\nResult:\nNothing\n",
-		"walletpassphrasechange":  "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
-		"createnewaccount":        "createnewaccount \"account\"\n\nCreates a new account.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account (string, required) Name of the new account\n\nResult:\nNothing\n",
-		"exportwatchingwallet":    "exportwatchingwallet (\"account\" download=false)\n\nCreates and returns a duplicate of the wallet database without any private keys to be used as a watching-only wallet.\n\nArguments:\n1. account  (string, optional)                 Unused (must be unset or \"*\")\n2. download (boolean, optional, default=false) Unused\n\nResult:\n\"value\" (string) The watching-only database encoded as a base64 string\n",
-		"getbestblock":            "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
-		"getunconfirmedbalance":   "getunconfirmedbalance (\"account\")\n\nCalculates the unspent output value of all unmined transaction outputs for an account.\n\nArguments:\n1. account (string, optional) The account to query the unconfirmed balance for (default=\"default\")\n\nResult:\nn.nnn (numeric) Total amount of all unmined unspent outputs of the account valued in bitcoin.\n",
-		"listaddresstransactions": "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Unset\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listalltransactions":     "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Unset\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"renameaccount":           "renameaccount \"oldaccount\" \"newaccount\"\n\nRenames an account.\n\nArguments:\n1. oldaccount (string, required) The old account name to rename\n2. newaccount (string, required) The new name for the account\n\nResult:\nNothing\n",
-		"walletislocked":          "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
+		"abandontransaction":          "abandontransaction \"txid\"\n\nRemoves an unmined wallet transaction, and all unmined transactions spending its outputs, from the wallet.\nThe outputs spent by the removed transactions become spendable again, and the transactions are listed as abandoned by listtransactions.\nTransactions should only be abandoned if they are not in the mempool of the consensus server.\n\nArguments:\n1. txid (string, required) The hash of the unmined transaction\n\nResult:\nNothing\n",
+		"addmultisigaddress":          "addmultisigaddress nrequired [\"key\",...] (\"account\")\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n3. account   (string, optional)          DEPRECATED -- Unused (all imported addresses belong to the imported account)\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
+		"addtimelockaddress":          "addtimelockaddress \"key\" \"cltv|csv\" value\n\nGenerates and imports a pay-to-script-hash address paying to a key once a CLTV or CSV time lock has expired to the 'imported' account.\nOutputs paid to the address are never selected to fund wallet transactions, and can only be spent by signing a transaction satisfying the lock.\n\nArguments:\n1. key      (string, required)  Pubkey or pay-to-pubkey-hash address of the wallet controlling the address\n2. locktype (string, required)  The type of time lock: cltv locks until an absolute block height or time, csv locks for a relative number of blocks or 512-second intervals\n3. value    (numeric, required) The lock time (cltv) or encoded sequence lock (csv) of the time lock\n\nResult:\n{\n \"address\": \"value\",      (string) The imported pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the address\n}                         \n",
+		"backupwallet":                "backupwallet \"destination\"\n\nWrites a consistent copy of the wallet database to a file while the wallet is running, replacing any existing file.\n\nArguments:\n1. destination (string, required) Path of the backup file to write\n\nResult:\nNothing\n",
+		"bumpfee":                     "bumpfee \"txid\" ({\"feerate\":feerate,\"conftarget\":conftarget})\n\nBumps the fee of an unmined wallet transaction by publishing a child transaction (child-pays-for-parent).\nThe child spends a wallet output of the transaction and pays enough fee for both transactions together to reach the fee rate.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. txid    (string, required) The hash of the unmined transaction\n2. options (object, optional) Options for the fee bump\n{\n \"feeRate\": n.nnn, (numeric) Fee rate in bitcoin per kilobyte the parent and child transaction together should pay, defaults to the fee rate estimated for confTarget\n \"confTarget\": n,  (numeric) Number of blocks the parent and child transaction should be mined within, used to estimate the fee rate when feeRate is not set (default=6)\n}                  \n\nResult:\n{\n \"txid\": \"value\",         (string)  The hash of the child transaction\n \"parenttxid\": \"value\",   (string)  The hash of the transaction whose fee was bumped\n \"fee\": n.nnn,            (numeric) The fee paid by the child transaction valued in bitcoin\n \"parentfee\": n.nnn,      (numeric) The fee paid by the parent transaction valued in bitcoin, or zero if it spends outputs not controlled by the wallet\n \"packagefeerate\": n.nnn, (numeric) The fee rate of the parent, its unmined ancestors and the child transaction together in bitcoin per kilobyte\n}                         \n",
+		"createmultisig":              "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
+		"dumpprivkey":                 "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
+		"dumpwallet":                  "dumpwallet \"filename\"\n\nWrites a text export of all wallet keys to a new file, including the private key of every address in WIF, or its public key if the wallet has no private key for it, the derivation path and account of HD keys, imported scripts, and the wallet birthday.\nThe HD seed itself is not exported.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. filename (string, required) Path of the file to write, which must not already exist\n\nResult:\nNothing\n",
+		"getaccount":                  "getaccount \"address\"\n\nDEPRECATED -- Lookup the account name that some wallet address belongs to.\n\nArguments:\n1. address (string, required) The address to query the account for\n\nResult:\n\"value\" (string) The name of the account that 'address' belongs to\n",
+		"getaccountaddress":           "getaccountaddress \"account\"\n\nDEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\n\nArguments:\n1. account (string, required) The account of the returned address\n\nResult:\n\"value\" (string) The unused address for 'account'\n",
+		"getaddressesbyaccount":       "getaddressesbyaccount \"account\"\n\nDEPRECATED -- Returns all addresses strings controlled by a single account.\n\nArguments:\n1. account (string, required) Account name to fetch addresses for\n\nResult:\n[\"value\",...] (array of string) All addresses controlled by 'account'\n",
+		"getbalance":                  "getbalance (\"account\" minconf=1)\n\nCalculates and returns the balance of one or all accounts.\n\nArguments:\n1. account (string, optional)             DEPRECATED -- The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult (account != \"*\"):\nn.nnn (numeric) The balance of 'account' valued in bitcoin\n\nResult (account = \"*\"):\nn.nnn (numeric) The balance of all accounts valued in bitcoin\n",
+		"getbestblockhash":            "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
+		"getblockcount":               "getblockcount\n\nReturns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The blockchain height of the most recent synced-to block\n",
+		"getinfo":                     "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The fee rate per kilobyte currently paid by authored transactions\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in BTC/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
+		"getnewaddress":               "getnewaddress (\"account\")\n\nGenerates and returns a new payment address.\n\nArguments:\n1. account (string, optional) DEPRECATED -- Account name the new address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The payment address\n",
+		"getrawchangeaddress":         "getrawchangeaddress (\"account\")\n\nGenerates and returns a new internal payment address for use as a change address in raw transactions.\n\nArguments:\n1. account (string, optional) Account name the new internal address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The internal payment address\n",
+		"getreceivedbyaccount":        "getreceivedbyaccount \"account\" (minconf=1)\n\nDEPRECATED -- Returns the total amount received by addresses of some account, including spent outputs.\n\nArguments:\n1. account (string, required)             Account name to query total received amount for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in bitcoin\n",
+		"getreceivedbyaddress":        "getreceivedbyaddress \"address\" (minconf=1)\n\nReturns the total amount received by a single address, including spent outputs.\n\nArguments:\n1. address (string, required)             Payment address which received outputs to include in total\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in bitcoin\n",
+		"gettransaction":              "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in bitcoin\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n \"data\": [\"value\",...],            (array of string) The hex-encoded data carried by each null-data (OP_RETURN) output of the transaction\n}                                  \n",
+		"getwalletinfo":               "getwalletinfo\n\nReturns a JSON object with the balances, lock state and sync state of the wallet.\n\nArguments:\nNone\n\nResult:\n{\n \"balance\": n.nnn,             (numeric)         The balance of all mature outputs with at least one confirmation valued in bitcoin\n \"unconfirmed_balance\": n.nnn, (numeric)         The balance of all outputs of unmined transactions valued in bitcoin\n \"immature_balance\": n.nnn,    (numeric)         The balance of all coinbase outputs that have not matured yet valued in bitcoin\n \"frozen_balance\": n.nnn,      (numeric)         The balance of all frozen outputs, not included in the other balances, valued in bitcoin\n \"txcount\": n,                 (numeric)         The number of transactions recorded by the wallet\n \"accounts\": [{                (array of object) The number of accounts of every key scope of the wallet\n  \"keyscope\": \"value\",         (string)          The key scope, as purpose/coin\n  \"accounts\": n,               (numeric)         The number of accounts of the key scope, not counting the imported account\n },...],                                         \n \"locked\": true|false,         (boolean)         Whether the wallet is locked\n \"unlocked_until\": n,          (numeric)         The Unix time the wallet will be relocked, or 0 if the wallet is locked or unlocked without a time limit\n \"watchonly\": true|false,      (boolean)         Whether the wallet is watching-only\n \"birthday\": n,                (numeric)         The Unix time of the wallet birthday\n \"birthdayheight\": n,          (numeric)         The height of the block matching the wallet birthday, or 0 if not known yet\n \"birthdayhash\": \"value\",      (string)          The hash of the block matching the wallet birthday, or all zeros if not known yet\n \"syncheight\": n,              (numeric)         The height of the block the wallet is synced to\n \"synchash\": \"value\",          (string)          The hash of the block the wallet is synced to\n \"rescanning\": true|false,     (boolean)         Whether a rescan is running\n \"recovering\": true|false,     (boolean)         Whether the wallet is recovering addresses from its seed\n}                              \n",
+		"help":                        "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
+		"importprivkey":               "importprivkey \"privkey\" (\"label\" rescan=true)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey (string, required)                The WIF-encoded private key\n2. label   (string, optional)                Unused (must be unset or 'imported')\n3. rescan  (boolean, optional, default=true) Rescan the blockchain (since the genesis block) for outputs controlled by the imported key\n\nResult:\nNothing\n",
+		"importwallet":                "importwallet \"filename\"\n\nImports all keys and scripts of a file written by dumpwallet to the 'imported' account, followed by a single rescan from the earliest key timestamp.\nKeys derived from the seed of this wallet are instead restored into their accounts, which are created with the names recorded by the dump if missing.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. filename (string, required) Path of the wallet dump to import\n\nResult:\nNothing\n",
+		"keypoolrefill":               "keypoolrefill (newsize=100)\n\nDEPRECATED -- This request does nothing since no keypool is maintained.\n\nArguments:\n1. newsize (numeric, optional, default=100) Unused\n\nResult:\nNothing\n",
+		"listaccounts":                "listaccounts (minconf=1)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"The account name\": The account balance valued in bitcoin, (object) JSON object with account names as keys and bitcoin amounts as values\n ...\n}\n",
+		"listaddressgroupings":        "listaddressgroupings\n\nReturns groups of wallet addresses that the public can link to each other because they were spent together as inputs of a transaction or received its change.\n\nArguments:\nNone\n\nResult:\n[[[unknown,...],...],...] (array of array of array of value) An array of address groups, each an array of [address, amount, label] entries with the balance of the address valued in bitcoin and the account of the address as its label\n",
+		"listlockunspent":             "listlockunspent\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session, and of persistently locked outputs.\n\nArguments:\nNone\n\nResult:\n[{\n \"txid\": \"value\",          (string)  The transaction hash of the locked output\n \"vout\": n,                (numeric) The output index of the locked output\n \"persistent\": true|false, (boolean) Whether the lock is saved across wallet restarts\n \"reason\": \"value\",        (string)  The reason of a persistent lock\n \"expiry\": n,              (numeric) The unix time a persistent lock expires at, omitted if it does not expire\n},...]\n",
+		"listreceivedbyaccount":       "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nDEPRECATED -- Returns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in bitcoin\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
+		"listreceivedbyaddress":       "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in bitcoin\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
+		"listsinceblock":              "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"abandoned\": true|false,          (boolean)         Whether the transaction was abandoned with abandontransaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n  \"bip125-replaceable\": \"value\",    (string)          Unset\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"conflicted\" for transactions removed because they conflict with a mined transaction, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction, or minus the number of block confirmations of the conflicting transaction for conflicted transactions\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"trusted\": true|false,            (boolean)         Unset\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) The hashes of the mined transactions a conflicted transaction conflicts with\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n  \"data\": \"value\",                  (string)          The hex-encoded data carried by a null-data (OP_RETURN) output\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
+		"listtransactions":            "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Whether the transaction was abandoned with abandontransaction\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"conflicted\" for transactions removed because they conflict with a mined transaction, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction, or minus the number of block confirmations of the conflicting transaction for conflicted transactions\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) The hashes of the mined transactions a conflicted transaction conflicts with\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"data\": \"value\",                  (string)          The hex-encoded data carried by a null-data (OP_RETURN) output\n},...]\n",
+		"listunspent":                 "listunspent (minconf=1 maxconf=9999999 [\"address\",...])\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  Unset\n \"amount\": n.nnn,         (numeric) The amount of the output valued in bitcoin\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n}                         \n",
+		"lockunspent":                 "lockunspent unlock [{\"txid\":\"value\",\"vout\":n},...] (persistent=false \"reason\" expiry=0)\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts, unless they are locked with persistent set to true.\nIf unlock is true and no transaction outputs are specified, all outputs locked for the wallet session are marked unlocked, or all persistently locked outputs if persistent is also true.\nUnlocking specified transaction outputs removes both their session and persistent locks.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n},...]\n3. persistent (boolean, optional, default=false) Save the locks in the wallet database so they survive wallet restarts, or when unlocking all outputs, unlock the persistently locked outputs instead of the session locks\n4. reason     (string, optional)                 The reason of the persistent locks\n5. expiry     (numeric, optional, default=0)     The unix time the persistent locks expire at, or 0 for locks which do not expire\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"sendfrom":                    "sendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\n\nDEPRECATED -- Authors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Account to pick unspent outputs from\n2. toaddress   (string, required)             Address to pay\n3. amount      (numeric, required)            Amount to send to the payment address valued in bitcoin\n4. minconf     (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment     (string, optional)             Unused\n6. commentto   (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendmany":                    "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\" \"coinselection\" conftarget=6 [\"subtractfeefrom\",...] locktime \"data\")\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) DEPRECATED -- Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in bitcoin, (object) JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address\n ...\n}\n3. minconf         (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment         (string, optional)             Unused\n5. coinselection   (string, optional)             Coin selection strategy used to pick the unspent outputs (largest, smallest, bnb or random), defaults to the wallet's strategy\n6. conftarget      (numeric, optional, default=6) Number of blocks the transaction should be mined within, used to estimate its fee rate\n7. subtractfeefrom (array of string, optional)    Addresses whose amounts the fee is subtracted from, split in proportion to the amounts, instead of adding the fee on top of the amounts\n8. locktime        (numeric, optional)            The lock time of the transaction, a block height below 500000000 or a unix time otherwise, defaulting to 0; the transaction is published immediately, so a lock time that has not yet matured is rejected as non-final\n9. data            (string, optional)             Hex-encoded data of up to 220 bytes carried by an additional zero-value null-data (OP_RETURN) output\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendtoaddress":               "sendtoaddress \"address\" amount (\"comment\" \"commentto\" conftarget=6 subtractfeefromamount=false sendall=false locktime \"data\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address               (string, required)                 Address to pay\n2. amount                (numeric, required)                Amount to send to the payment address valued in bitcoin\n3. comment               (string, optional)                 Unused\n4. commentto             (string, optional)                 Unused\n5. conftarget            (numeric, optional, default=6)     Number of blocks the transaction should be mined within, used to estimate its fee rate\n6. subtractfeefromamount (boolean, optional, default=false) Subtract the fee from the amount instead of adding it on top of the amount\n7. sendall               (boolean, optional, default=false) Send all spendable funds of the default account to the address, with the fee subtracted and no change, ignoring the amount\n8. locktime              (numeric, optional)                The lock time of the transaction, a block height below 500000000 or a unix time otherwise, defaulting to 0; the transaction is published immediately, so a lock time that has not yet matured is rejected as non-final; cannot be combined with sendall\n9. data                  (string, optional)                 Hex-encoded data of up to 220 bytes carried by an additional zero-value null-data (OP_RETURN) output; cannot be combined with sendall\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"settxfee":                    "settxfee amount\n\nSet the fee rate per kilobyte paid by authored transactions instead of an estimated fee rate.  An amount of zero estimates the fee rate again.\n\nArguments:\n1. amount (numeric, required) The new fee rate per kilobyte valued in bitcoin\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"signmessage":                 "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
+		"signrawtransaction":          "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"validateaddress":             "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): isscript, pubkey, iscompressed, account, addresses, hex, script, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n}                            \n",
+		"verifymessage":               "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
+		"walletlock":                  "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"walletpassphrase":            "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks\n\nResult:\nNothing\n",
+		"walletpassphrasechange":      "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
+		"createmultisigaccount":       "createmultisigaccount \"account\" nrequired [\"key\",...]\n\nCreates a new multisig account whose addresses are pay-to-script-hash multisig addresses of the keys derived from the account extended public keys of its cosigners.\nEvery cosigner creates the account with the same keys, in any order, to derive the same addresses.\nThe wallet signs for the keys of its own accounts, whose extended public keys are returned by getaccountxpub.\n\nArguments:\n1. account   (string, required)          Name of the new account\n2. nrequired (numeric, required)         The number of signatures required to spend from the addresses of the account\n3. keys      (array of string, required) The account extended public keys of the cosigners\n\nResult:\nNothing\n",
+		"createnewaccount":            "createnewaccount \"account\"\n\nCreates a new account.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account (string, required) Name of the new account\n\nResult:\nNothing\n",
+		"exportwatchingwallet":        "exportwatchingwallet (\"account\" download=false)\n\nCreates and returns a duplicate of the wallet database without any private keys to be used as a watching-only wallet.\n\nArguments:\n1. account  (string, optional)                 Unused (must be unset or \"*\")\n2. download (boolean, optional, default=false) Unused\n\nResult:\n\"value\" (string) The watching-only database encoded as a base64 string\n",
+		"getbestblock":                "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
+		"getaccountxpub":              "getaccountxpub \"account\" (purpose=44 cointype=0)\n\nReturns the extended public key of an account together with the fingerprint of the master key it is derived from and its derivation path, as needed to create watch-only wallets and to configure external signers.\n\nArguments:\n1. account  (string, required)              The name of the account\n2. purpose  (numeric, optional, default=44) The purpose of the key scope of the account\n3. cointype (numeric, optional, default=0)  The coin type of the key scope of the account\n\nResult:\n{\n \"xpub\": \"value\",              (string)  The extended public key of the account\n \"masterfingerprint\": \"value\", (string)  The fingerprint of the master key the account key is derived from, encoded in hexadecimal, or omitted when unknown\n \"path\": \"value\",              (string)  The derivation path of the account key, m/purpose'/coin_type'/account'\n \"accountnumber\": n,           (numeric) The number of the account\n}                              \n",
+		"getconsolidationstatus":      "getconsolidationstatus\n\nReturns the configuration of the background consolidation of unspent outputs, the outcome of its last check and its most recent consolidation transactions.\n\nArguments:\nNone\n\nResult:\n{\n \"enabled\": true|false, (boolean)         Whether unspent outputs are consolidated in the background\n \"threshold\": n,        (numeric)         The number of spendable outputs an account must hold above which they are consolidated\n \"maxfeerate\": n.nnn,   (numeric)         The highest estimated fee rate in bitcoin per kilobyte at which outputs are consolidated, or 0 for no limit\n \"maxinputs\": n,        (numeric)         The largest number of outputs merged by a single consolidation transaction\n \"interval\": n,         (numeric)         The number of seconds between two checks of the number of unspent outputs\n \"lastrun\": n,          (numeric)         The Unix time of the last check, or 0 if outputs were never checked\n \"feerate\": n.nnn,      (numeric)         The fee rate in bitcoin per kilobyte estimated at the last check\n \"lasterror\": \"value\",  (string)          The error the last check failed with, if any\n \"accounts\": [{         (array of object) The number of spendable outputs held by each account at the last check\n  \"keyscope\": \"value\",  (string)          The key scope of the account, as purpose/coin\n  \"account\": \"value\",   (string)          The name of the account\n  \"outputs\": n,         (numeric)         The number of spendable outputs held by the account\n },...],                                  \n \"consolidations\": [{   (array of object) The most recent consolidation transactions, oldest first\n  \"txid\": \"value\",      (string)          The hash of the consolidation transaction\n  \"keyscope\": \"value\",  (string)          The key scope of the consolidated account, as purpose/coin\n  \"account\": \"value\",   (string)          The name of the consolidated account\n  \"inputs\": n,          (numeric)         The number of outputs merged by the transaction\n  \"amount\": n.nnn,      (numeric)         The value of the merged output valued in bitcoin\n  \"fee\": n.nnn,         (numeric)         The fee paid by the transaction valued in bitcoin\n  \"time\": n,            (numeric)         The Unix time the transaction was created\n },...],                                  \n}                       \n",
+		"getunconfirmedbalance":       "getunconfirmedbalance (\"account\")\n\nCalculates the unspent output value of all unmined transaction outputs for an account.\n\nArguments:\n1. account (string, optional) The account to query the unconfirmed balance for (default=\"default\")\n\nResult:\nn.nnn (numeric) Total amount of all unmined unspent outputs of the account valued in bitcoin.\n",
+		"listaccountaddressgroupings": "listaccountaddressgroupings \"account\"\n\nReturns the address groups, as returned by listaddressgroupings, that contain an address of an account, including the addresses of other accounts linked to it.\n\nArguments:\n1. account (string, required) The account whose address groups are returned\n\nResult:\n[[[unknown,...],...],...] (array of array of array of value) An array of address groups in the format of listaddressgroupings\n",
+		"listaddresstransactions":     "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Whether the transaction was abandoned with abandontransaction\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"conflicted\" for transactions removed because they conflict with a mined transaction, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction, or minus the number of block confirmations of the conflicting transaction for conflicted transactions\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) The hashes of the mined transactions a conflicted transaction conflicts with\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"data\": \"value\",                  (string)          The hex-encoded data carried by a null-data (OP_RETURN) output\n},...]\n",
+		"listalltransactions":         "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Whether the transaction was abandoned with abandontransaction\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"conflicted\" for transactions removed because they conflict with a mined transaction, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction, or minus the number of block confirmations of the conflicting transaction for conflicted transactions\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) The hashes of the mined transactions a conflicted transaction conflicts with\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"data\": \"value\",                  (string)          The hex-encoded data carried by a null-data (OP_RETURN) output\n},...]\n",
+		"previewtransaction":          "previewtransaction {\"address\":amount,...} (account=\"default\" minconf=1 \"coinselection\" conftarget=6 [\"subtractfeefrom\",...] sendall=false)\n\nPreviews the transaction sendmany would send, without signing or broadcasting it.\nReturns the unsigned transaction, the outputs it spends, its estimated size, fee and change.\nThe change address is not persisted and no outputs are locked.\n\nArguments:\n1. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in bitcoin, (object) JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address\n ...\n}\n2. account         (string, optional, default=\"default\") Account to pick unspent outputs from\n3. minconf         (numeric, optional, default=1)        Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. coinselection   (string, optional)                    Coin selection strategy used to pick the unspent outputs (largest, smallest, bnb or random), defaults to the wallet's strategy\n5. conftarget      (numeric, optional, default=6)        Number of blocks the transaction should be mined within, used to estimate its fee rate\n6. subtractfeefrom (array of string, optional)           Addresses whose amounts the fee is subtracted from, split in proportion to the amounts, instead of adding the fee on top of the amounts\n7. sendall         (boolean, optional, default=false)    Send all spendable funds of the account to the single address, with the fee subtracted and no change, ignoring the amount\n\nResult:\n{\n \"hex\": \"value\",           (string)          The serialized unsigned transaction in hexadecimal\n \"inputs\": [{              (array of object) The outputs spent by the transaction, in input order\n  \"txid\": \"value\",         (string)          The hash of the transaction of the spent output\n  \"vout\": n,               (numeric)         The index of the spent output\n  \"amount\": n.nnn,         (numeric)         The value of the spent output valued in bitcoin\n  \"scriptpubkey\": \"value\", (string)          The output script of the spent output in hexadecimal\n },...],                                     \n \"size\": n,                (numeric)         The estimated size of the signed transaction in bytes\n \"fee\": n.nnn,             (numeric)         The fee paid by the transaction valued in bitcoin\n \"change\": n.nnn,          (numeric)         The amount returned to the wallet as change valued in bitcoin\n \"changepos\": n,           (numeric)         The index of the change output, or -1 if there is no change\n}                          \n",
+		"renameaccount":               "renameaccount \"oldaccount\" \"newaccount\"\n\nRenames an account.\n\nArguments:\n1. oldaccount (string, required) The old account name to rename\n2. newaccount (string, required) The new name for the account\n\nResult:\nNothing\n",
+		"splitseed":                   "splitseed \"seed\" threshold shares (\"mnemonicpassphrase\")\n\nSplits the seed of the wallet into Shamir secret shares, any threshold of which restore the wallet when entered during wallet creation.\nThe wallet does not store its seed, so it must be provided, and it is only split when the wallet is unlocked and it is the seed the wallet was created from.\nThe seed is sent in plaintext, so this should only be called over a secure connection to a trusted server.\n\nArguments:\n1. seed               (string, required)  The hex-encoded seed or the BIP0039 mnemonic of the wallet\n2. threshold          (numeric, required) The number of shares required to restore the wallet, at least 2\n3. shares             (numeric, required) The number of shares to split the seed into\n4. mnemonicpassphrase (string, optional)  The passphrase used together with the mnemonic\n\nResult:\n[\"value\",...] (array of string) The encoded seed shares\n",
+		"walletislocked":              "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
+		"walletcreatefundedpsbt":      "walletcreatefundedpsbt [{\"txid\":\"value\",\"vout\":n,\"sequence\":sequence},...] {\"address\":amount,...} (locktime {\"account\":account,\"minconf\":minconf,\"feerate\":feerate,\"leaseid\":leaseid,\"leaseduration\":leaseduration,\"version\":version})\n\nCreates and funds a transaction in the Partially Signed Transaction (PSBT) format.\nInputs are selected from the account when none are specified, a change output is added when necessary, and all wallet inputs are leased to prevent their reuse until the PSBT is published or the leases are released.\n\nArguments:\n1. inputs (array of object, required) Inputs to spend (may be empty to let the wallet select inputs)\n[{\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"sequence\": n,   (numeric) The sequence number of the input (default=final, or non-final when a lock time is set)\n},...]\n2. outputs (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in bitcoin, (object) JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address\n ...\n}\n3. locktime (numeric, optional) Transaction lock time (default=0); inputs without a sequence number are made non-final so that it is enforced\n4. options  (object, optional)  Options for funding the transaction\n{\n \"account\": \"value\", (string)  The account to fund the transaction from (default=\"default\")\n \"minconf\": n,       (numeric) Minimum number of block confirmations required before a transaction output is eligible to be spent (default=1)\n \"feeRate\": n.nnn,   (numeric) Fee rate in bitcoin per kilobyte (default=estimated fee rate)\n \"leaseId\": \"value\", (string)  Lease id of 32 bytes encoded in hexadecimal to lease the inputs with, which is needed to release them with abandonpsbt or releaseoutput if the PSBT is not published (default=the wallet's PSBT lease id)\n \"leaseDuration\": n, (numeric) The number of seconds the inputs are leased for unless the PSBT is published first (default=600)\n \"version\": n,       (numeric) Transaction version, 1 or 2 (default=1); relative lock times are only enforced for version 2\n}                    \n\nResult:\n{\n \"psbt\": \"value\", (string)  The base64-encoded funded PSBT\n \"fee\": n.nnn,    (numeric) The fee paid by the transaction valued in bitcoin\n \"changepos\": n,  (numeric) The index of the change output, or -1 if no change output was added\n}                 \n",
+		"walletprocesspsbt":           "walletprocesspsbt \"psbt\" (sign=true finalize=true)\n\nSigns the inputs of a PSBT that belong to the wallet.\nThe wallet must be unlocked for this request to succeed when signing.\n\nArguments:\n1. psbt     (string, required)                The base64-encoded PSBT\n2. sign     (boolean, optional, default=true) Sign the inputs of the PSBT that the wallet can sign\n3. finalize (boolean, optional, default=true) Finalize the inputs of the PSBT when possible\n\nResult:\n{\n \"psbt\": \"value\",        (string)  The base64-encoded processed PSBT\n \"complete\": true|false, (boolean) Whether all inputs of the PSBT have been finalized\n}                        \n",
+		"finalizepsbt":                "finalizepsbt \"psbt\" (extract=true)\n\nSigns and finalizes all wallet inputs of a PSBT, and optionally extracts the network serialized transaction.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. psbt    (string, required)                The base64-encoded PSBT\n2. extract (boolean, optional, default=true) Extract the final transaction when the PSBT is complete\n\nResult:\n{\n \"psbt\": \"value\",        (string)  The base64-encoded PSBT, when it was not extracted\n \"hex\": \"value\",         (string)  The hex-encoded final transaction, when it was extracted\n \"complete\": true|false, (boolean) Whether all inputs of the PSBT have been finalized\n}                        \n",
+		"abandonpsbt":                 "abandonpsbt \"psbt\" (\"leaseid\")\n\nReleases the leases taken by walletcreatefundedpsbt on the inputs of a PSBT that will not be published, making them available for coin selection again.\nInputs leased under other lease ids are left untouched.\n\nArguments:\n1. psbt    (string, required) The base64-encoded PSBT\n2. leaseid (string, optional) Lease id of 32 bytes encoded in hexadecimal the inputs were leased with (default=the wallet's PSBT lease id)\n\nResult:\nNothing\n",
+		"combinerawtransaction":       "combinerawtransaction [\"hextx\",...]\n\nCombines copies of a transaction signed by different cosigners into a single transaction.\nThe signatures of inputs spending multisig scripts are merged in the order of the keys of the script.\n\nArguments:\n1. hextxs (array of string, required) The hex-encoded copies of the transaction\n\nResult:\n\"value\" (string) The hex-encoded combined transaction\n",
+		"createrawtransaction":        "createrawtransaction [{\"txid\":\"value\",\"vout\":n,\"sequence\":sequence},...] {\"address\":amount,...} (locktime version)\n\nReturns a new unsigned transaction spending the given inputs to the given amounts.\nThe inputs are not required to be controlled by the wallet, and no inputs or change are added.\n\nArguments:\n1. inputs (array of object, required) Inputs to spend\n[{\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"sequence\": n,   (numeric) The sequence number of the input (default=final, or non-final when a lock time is set)\n},...]\n2. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in bitcoin, (object) JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address\n ...\n}\n3. locktime (numeric, optional) Transaction lock time (default=0); inputs without a sequence number are made non-final so that it is enforced\n4. version  (numeric, optional) Transaction version, 1 or 2 (default=1); relative lock times are only enforced for version 2\n\nResult:\n\"value\" (string) The hex-encoded unsigned transaction\n",
+		"decoderawtransaction":        "decoderawtransaction \"hextx\"\n\nReturns a JSON object describing the given hex-encoded transaction.\n\nArguments:\n1. hextx (string, required) The hex-encoded transaction\n\nResult:\n{\n \"txid\": \"value\",              (string)          The hash of the transaction\n \"version\": n,                 (numeric)         The transaction version\n \"locktime\": n,                (numeric)         The transaction lock time\n \"vin\": [{                     (array of object) The transaction inputs\n  \"coinbase\": \"value\",         (string)          The hex-encoded signature script of a coinbase input\n  \"txid\": \"value\",             (string)          The hash of the transaction of the spent output\n  \"vout\": n,                   (numeric)         The index of the spent output\n  \"scriptSig\": {               (object)          The signature script of the input\n   \"asm\": \"value\",             (string)          The disassembly of the script\n   \"hex\": \"value\",             (string)          The hex-encoded script\n  },                                             \n  \"sequence\": n,               (numeric)         The sequence number of the input\n },...],                                         \n \"vout\": [{                    (array of object) The transaction outputs\n  \"value\": n.nnn,              (numeric)         The value of the output valued in bitcoin\n  \"n\": n,                      (numeric)         The index of the output\n  \"scriptPubKey\": {            (object)          The output script\n   \"asm\": \"value\",             (string)          The disassembly of the script\n   \"hex\": \"value\",             (string)          The hex-encoded script\n   \"reqSigs\": n,               (numeric)         The number of signatures required to spend the output\n   \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n   \"addresses\": [\"value\",...], (array of string) The addresses paid by the script\n  },                                             \n },...],                                         \n}                              \n",
+		"fundrawtransaction":          "fundrawtransaction \"hextx\" ({\"account\":account,\"minconf\":minconf,\"changeaccount\":changeaccount,\"changeposition\":changeposition,\"feerate\":feerate})\n\nAdds inputs and a change output to a raw transaction so that it pays for its outputs and the fee.\nThe inputs of the transaction are kept and must be controlled by the wallet, more inputs are selected from the account when needed, and the transaction is returned unsigned.\n\nArguments:\n1. hextx   (string, required) The hex-encoded transaction to fund\n2. options (object, optional) Options for funding the transaction\n{\n \"account\": \"value\",       (string)  The account to select inputs from (default=\"default\")\n \"minconf\": n,             (numeric) Minimum number of block confirmations required before a transaction output is eligible to be spent (default=1)\n \"changeAccount\": \"value\", (string)  The account to return change to (default=the account inputs are selected from)\n \"changePosition\": n,      (numeric) The index of the change output (default=random)\n \"feeRate\": n.nnn,         (numeric) Fee rate in bitcoin per kilobyte (default=estimated fee rate)\n}                          \n\nResult:\n{\n \"hex\": \"value\", (string)  The hex-encoded funded transaction\n \"fee\": n.nnn,   (numeric) The fee paid by the transaction valued in bitcoin\n \"changepos\": n, (numeric) The index of the change output, or -1 if no change output was added\n}                \n",
+		"enqueuepayout":               "enqueuepayout \"key\" \"address\" amount (account=\"default\")\n\nQueues a payment to be sent with the other queued payments of the account by a single transaction at the next flush of the payout queue.\nEnqueueing a payment with the key of an identical payment returns the recorded payment instead of queueing it again.\nPayments are pruned once the configured retention has elapsed since they were mined or cancelled, after which their key may be reused.\n\nArguments:\n1. key     (string, required)                    Idempotency key identifying the payment\n2. address (string, required)                    Address to pay\n3. amount  (numeric, required)                   Amount to send to the payment address valued in bitcoin\n4. account (string, optional, default=\"default\") Account funding the payment\n\nResult:\n{\n \"key\": \"value\",     (string)  Idempotency key of the payment\n \"address\": \"value\", (string)  Address paid\n \"amount\": n.nnn,    (numeric) Amount paid valued in bitcoin\n \"account\": \"value\", (string)  Account funding the payment\n \"state\": \"value\",   (string)  State of the payment (queued, broadcast, confirmed, cancelled or failed, in which case it is sent again at the next flush)\n \"txid\": \"value\",    (string)  Hash of the transaction sending the payment, once broadcast\n \"error\": \"value\",   (string)  Reason the last attempt to send a failed payment did not succeed\n \"time\": n,          (numeric) Unix time the payment was enqueued\n}                    \n",
+		"cancelpayout":                "cancelpayout \"key\"\n\nCancels a queued or failed payment that has not been sent yet.\n\nArguments:\n1. key (string, required) Idempotency key of the payment\n\nResult:\n{\n \"key\": \"value\",     (string)  Idempotency key of the payment\n \"address\": \"value\", (string)  Address paid\n \"amount\": n.nnn,    (numeric) Amount paid valued in bitcoin\n \"account\": \"value\", (string)  Account funding the payment\n \"state\": \"value\",   (string)  State of the payment (queued, broadcast, confirmed, cancelled or failed, in which case it is sent again at the next flush)\n \"txid\": \"value\",    (string)  Hash of the transaction sending the payment, once broadcast\n \"error\": \"value\",   (string)  Reason the last attempt to send a failed payment did not succeed\n \"time\": n,          (numeric) Unix time the payment was enqueued\n}                    \n",
+		"getpayout":                   "getpayout \"key\"\n\nReturns the state of a payment enqueued in the payout queue.\n\nArguments:\n1. key (string, required) Idempotency key of the payment\n\nResult:\n{\n \"key\": \"value\",     (string)  Idempotency key of the payment\n \"address\": \"value\", (string)  Address paid\n \"amount\": n.nnn,    (numeric) Amount paid valued in bitcoin\n \"account\": \"value\", (string)  Account funding the payment\n \"state\": \"value\",   (string)  State of the payment (queued, broadcast, confirmed, cancelled or failed, in which case it is sent again at the next flush)\n \"txid\": \"value\",    (string)  Hash of the transaction sending the payment, once broadcast\n \"error\": \"value\",   (string)  Reason the last attempt to send a failed payment did not succeed\n \"time\": n,          (numeric) Unix time the payment was enqueued\n}                    \n",
+		"listpayouts":                 "listpayouts\n\nReturns all payments enqueued in the payout queue, oldest first.\n\nArguments:\nNone\n\nResult:\n[{\n \"key\": \"value\",     (string)  Idempotency key of the payment\n \"address\": \"value\", (string)  Address paid\n \"amount\": n.nnn,    (numeric) Amount paid valued in bitcoin\n \"account\": \"value\", (string)  Account funding the payment\n \"state\": \"value\",   (string)  State of the payment (queued, broadcast, confirmed, cancelled or failed, in which case it is sent again at the next flush)\n \"txid\": \"value\",    (string)  Hash of the transaction sending the payment, once broadcast\n \"error\": \"value\",   (string)  Reason the last attempt to send a failed payment did not succeed\n \"time\": n,          (numeric) Unix time the payment was enqueued\n},...]\n",
+		"leaseoutput":                 "leaseoutput \"id\" \"txid\" vout (duration=600)\n\nLeases an unspent output to a lease id, preventing it from being chosen for transaction inputs of authored transactions until the lease expires or is released.\nLeases are saved across wallet restarts, and leasing an output again with the same id extends its lease.\n\nArguments:\n1. id       (string, required)               Lease id of 32 bytes encoded in hexadecimal\n2. txid     (string, required)               The transaction hash of the leased output\n3. vout     (numeric, required)              The output index of the leased output\n4. duration (numeric, optional, default=600) The number of seconds the output is leased for\n\nResult:\n{\n \"id\": \"value\",   (string)  Lease id of the output\n \"txid\": \"value\", (string)  The transaction hash of the leased output\n \"vout\": n,       (numeric) The output index of the leased output\n \"expiration\": n, (numeric) Unix time the lease expires at\n}                 \n",
+		"releaseoutput":               "releaseoutput \"id\" \"txid\" vout\n\nReleases the lease of an output, making it available for coin selection again if it remains unspent.\n\nArguments:\n1. id   (string, required)  Lease id the output was leased with\n2. txid (string, required)  The transaction hash of the leased output\n3. vout (numeric, required) The output index of the leased output\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"listleases":                  "listleases\n\nReturns all output leases which have not expired.\n\nArguments:\nNone\n\nResult:\n[{\n \"id\": \"value\",   (string)  Lease id of the output\n \"txid\": \"value\", (string)  The transaction hash of the leased output\n \"vout\": n,       (numeric) The output index of the leased output\n \"expiration\": n, (numeric) Unix time the lease expires at\n},...]\n",
+		"importdescriptors":           "importdescriptors [{\"desc\":\"value\",\"label\":label,\"timestamp\":timestamp},...]\n\nImports the outputs described by output script descriptors as watch-only outputs.\nRanged pkh() descriptors of an account public key create a watch-only account, and ranged sh(sortedmulti()) descriptors create a multisig account, named by the label of the request.\nOther descriptors import their public key or redeem script into the imported account.\nThe first 20 addresses of both branches of the accounts of ranged descriptors are derived, and the imported addresses are watched for new transactions.\nDescriptors with a timestamp also start a rescan from the block of that time for previous outputs paying to them.\n\nArguments:\n1. requests (array of object, required) The descriptors to import\n[{\n \"desc\": \"value\",  (string)  The output script descriptor, with an optional checksum\n \"label\": \"value\", (string)  The name of the account created for ranged descriptors\n \"timestamp\": n,   (numeric) The Unix time of the earliest output paying to the descriptor, from which the wallet is rescanned, or omitted if the descriptor has no previous outputs\n},...]\n\nResult:\n[{\n \"success\": true|false, (boolean) Whether the descriptor was imported\n \"account\": \"value\",    (string)  The name of the account created for the descriptor\n \"keyscope\": \"value\",   (string)  The key scope of the account created for the descriptor\n \"error\": \"value\",      (string)  The reason the descriptor could not be imported\n},...]\n",
+		"listdescriptors":             "listdescriptors\n\nReturns the output script descriptors of the accounts of every key scope, including the fingerprints of the master keys the account keys originate from when known.\n\nArguments:\nNone\n\nResult:\n[{\n \"desc\": \"value\",     (string)  The output script descriptor of the account, with its checksum\n \"keyscope\": \"value\", (string)  The key scope of the account\n \"account\": \"value\",  (string)  The name of the account\n \"accountnumber\": n,  (numeric) The number of the account\n},...]\n",
 	}
 }
 
@@ -56,4 +87,4 @@ var localeHelpDescs = map[string]func() map[string]string{
 	"en_US": helpDescsEnUS,
 }
 
-var requestUsages = "addmultisigaddress nrequired [\"key\",...] (\"account\")\ncreatemultisig nrequired [\"key\",...]\ndumpprivkey \"address\"\ngetaccount \"address\"\ngetaccountaddress \"account\"\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1)\ngetbestblockhash\ngetblockcount\ngetinfo\ngetnewaddress (\"account\")\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngettransaction \"txid\" (includewatchonly=false)\nhelp (\"command\")\nimportprivkey \"privkey\" (\"label\" rescan=true)\nkeypoolrefill (newsize=100)\nlistaccounts (minconf=1)\nlistlockunspent\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...])\nlockunspent unlock [{\"txid\":\"value\",\"vout\":n},...]\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\nsendtoaddress \"address\" amount (\"comment\" \"commentto\")\nsettxfee amount\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nvalidateaddress \"address\"\nverifymessage \"address\" \"signature\" \"message\"\nwalletlock\nwalletpassphrase \"passphrase\" timeout\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\ncreatenewaccount \"account\"\nexportwatchingwallet (\"account\" download=false)\ngetbestblock\ngetunconfirmedbalance (\"account\")\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nrenameaccount \"oldaccount\" \"newaccount\"\nwalletislocked"
+var requestUsages = "abandontransaction \"txid\"\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddtimelockaddress \"key\" \"cltv|csv\" value\nbackupwallet \"destination\"\nbumpfee \"txid\" ({\"feerate\":feerate,\"conftarget\":conftarget})\ncreatemultisig nrequired [\"key\",...]\ndumpprivkey \"address\"\ndumpwallet \"filename\"\ngetaccount \"address\"\ngetaccountaddress \"account\"\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1)\ngetbestblockhash\ngetblockcount\ngetinfo\ngetnewaddress (\"account\")\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngettransaction \"txid\" (includewatchonly=false)\ngetwalletinfo\nhelp (\"command\")\nimportprivkey \"privkey\" (\"label\" rescan=true)\nimportwallet \"filename\"\nkeypoolrefill (newsize=100)\nlistaccounts (minconf=1)\nlistaddressgroupings\nlistlockunspent\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...])\nlockunspent unlock [{\"txid\":\"value\",\"vout\":n},...] (persistent=false \"reason\" expiry=0)\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\" \"coinselection\" conftarget=6 [\"subtractfeefrom\",...] locktime \"data\")\nsendtoaddress \"address\" amount (\"comment\" \"commentto\" conftarget=6 subtractfeefromamount=false sendall=false locktime \"data\")\nsettxfee amount\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nvalidateaddress \"address\"\nverifymessage \"address\" \"signature\" \"message\"\nwalletlock\nwalletpassphrase \"passphrase\" timeout\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\ncreatemultisigaccount \"account\" nrequired [\"key\",...]\ncreatenewaccount \"account\"\nexportwatchingwallet (\"account\" download=false)\ngetbestblock\ngetaccountxpub \"account\" (purpose=44 cointype=0)\ngetconsolidationstatus\ngetunconfirmedbalance (\"account\")\nlistaccountaddressgroupings \"account\"\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\npreviewtransaction {\"address\":amount,...} (account=\"default\" minconf=1 \"coinselection\" conftarget=6 [\"subtractfeefrom\",...] sendall=false)\nrenameaccount \"oldaccount\" \"newaccount\"\nsplitseed \"seed\" threshold shares (\"mnemonicpassphrase\")\nwalletislocked\nwalletcreatefundedpsbt [{\"txid\":\"value\",\"vout\":n,\"sequence\":sequence},...] {\"address\":amount,...} (locktime {\"account\":account,\"minconf\":minconf,\"feerate\":feerate,\"leaseid\":leaseid,\"leaseduration\":leaseduration,\"version\":version})\nwalletprocesspsbt \"psbt\" (sign=true finalize=true)\nfinalizepsbt \"psbt\" (extract=true)\nabandonpsbt \"psbt\" (\"leaseid\")\ncombinerawtransaction [\"hextx\",...]\ncreaterawtransaction [{\"txid\":\"value\",\"vout\":n,\"sequence\":sequence},...] {\"address\":amount,...} (locktime version)\ndecoderawtransaction \"hextx\"\nfundrawtransaction \"hextx\" ({\"account\":account,\"minconf\":minconf,\"changeaccount\":changeaccount,\"changeposition\":changeposition,\"feerate\":feerate})\nenqueuepayout \"key\" \"address\" amount (account=\"default\")\ncancelpayout \"key\"\ngetpayout \"key\"\nlistpayouts\nleaseoutput \"id\" \"txid\" vout (duration=600)\nreleaseoutput \"id\" \"txid\" vout\nlistleases\nimportdescriptors [{\"desc\":\"value\",\"label\":label,\"timestamp\":timestamp},...]\nlistdescriptors"
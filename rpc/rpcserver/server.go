@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcutil/psbt"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -33,21 +34,25 @@ import (
 	"github.com/classzz/czzutil"
 	"github.com/classzz/czzutil/hdkeychain"
 	"github.com/classzz/czzwallet/chain"
+	"github.com/classzz/czzwallet/internal/bip39"
 	"github.com/classzz/czzwallet/internal/cfgutil"
 	"github.com/classzz/czzwallet/internal/zero"
 	"github.com/classzz/czzwallet/netparams"
 	pb "github.com/classzz/czzwallet/rpc/walletrpc"
 	"github.com/classzz/czzwallet/waddrmgr"
 	"github.com/classzz/czzwallet/wallet"
+	"github.com/classzz/czzwallet/wallet/txauthor"
+	"github.com/classzz/czzwallet/wallet/txrules"
 	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
 )
 
 // Public API version constants
 const (
-	semverString = "2.0.1"
+	semverString = "2.21.0"
 	semverMajor  = 2
-	semverMinor  = 0
-	semverPatch  = 1
+	semverMinor  = 21
+	semverPatch  = 0
 )
 
 // translateError creates a new gRPC error with an appropriate error code for
@@ -77,6 +82,8 @@ func errorCode(err error) codes.Code {
 			return codes.InvalidArgument
 		case waddrmgr.ErrDuplicateAccount:
 			return codes.AlreadyExists
+		case waddrmgr.ErrScopeNotFound:
+			return codes.NotFound
 		}
 
 		err = e.Err
@@ -93,6 +100,26 @@ func errorCode(err error) codes.Code {
 		return codes.NotFound
 	case hdkeychain.ErrInvalidSeedLen:
 		return codes.InvalidArgument
+	case wallet.ErrTxNotFound:
+		return codes.NotFound
+	case wallet.ErrTxMined, wallet.ErrNoCPFPOutput, wallet.ErrFeeRateReached,
+		wallet.ErrCPFPOutputTooSmall:
+		return codes.FailedPrecondition
+	case txauthor.ErrOutputsTooSmall, txrules.ErrOutputIsDust,
+		txrules.ErrAmountExceedsMax, wallet.ErrInvalidPayoutKey,
+		wallet.ErrChangePosition:
+		return codes.InvalidArgument
+	case wallet.ErrPayoutNotFound:
+		return codes.NotFound
+	case wallet.ErrPayoutKeyConflict:
+		return codes.AlreadyExists
+	case wallet.ErrPayoutNotQueued, wallet.ErrPayoutQueueStopped,
+		wallet.ErrNoAccountPubKey:
+		return codes.FailedPrecondition
+	case wtxmgr.ErrUnknownOutput:
+		return codes.NotFound
+	case wtxmgr.ErrOutputAlreadyLocked, wtxmgr.ErrOutputUnlockNotAllowed:
+		return codes.FailedPrecondition
 	default:
 		return codes.Unknown
 	}
@@ -186,6 +213,26 @@ func (s *walletServer) Accounts(ctx context.Context, req *pb.AccountsRequest) (
 	}, nil
 }
 
+func (s *walletServer) AccountXpub(ctx context.Context, req *pb.AccountXpubRequest) (
+	*pb.AccountXpubResponse, error) {
+
+	// The BIP0044 key scope is used when no purpose is specified.
+	scope := waddrmgr.KeyScopeBIP0044
+	if req.Purpose != 0 {
+		scope = waddrmgr.KeyScope{Purpose: req.Purpose, Coin: req.Coin}
+	}
+
+	xpub, err := s.wallet.AccountXpub(scope, req.AccountNumber)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.AccountXpubResponse{
+		ExtendedPublicKey:    xpub.AccountPubKey.String(),
+		MasterKeyFingerprint: xpub.Origin.Fingerprint,
+		DerivationPath:       xpub.Origin.Path,
+	}, nil
+}
+
 func (s *walletServer) RenameAccount(ctx context.Context, req *pb.RenameAccountRequest) (
 	*pb.RenameAccountResponse, error) {
 
@@ -296,6 +343,50 @@ func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 		Total:          int64(bals.Total),
 		Spendable:      int64(bals.Spendable),
 		ImmatureReward: int64(bals.ImmatureReward),
+		Frozen:         int64(bals.Frozen),
+	}
+	return resp, nil
+}
+
+func (s *walletServer) WalletInfo(ctx context.Context, req *pb.WalletInfoRequest) (
+	*pb.WalletInfoResponse, error) {
+
+	info, err := s.wallet.Info()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	accounts := make([]*pb.WalletInfoResponse_ScopeAccounts, 0, len(info.Accounts))
+	for _, scope := range info.Accounts {
+		accounts = append(accounts, &pb.WalletInfoResponse_ScopeAccounts{
+			Purpose:  scope.Scope.Purpose,
+			Coin:     scope.Scope.Coin,
+			Accounts: scope.NumAccounts,
+		})
+	}
+
+	var unlockedUntil int64
+	if !info.Locked && !info.UnlockedUntil.IsZero() {
+		unlockedUntil = info.UnlockedUntil.Unix()
+	}
+
+	resp := &pb.WalletInfoResponse{
+		ConfirmedBalance:   int64(info.ConfirmedBalance),
+		UnconfirmedBalance: int64(info.UnconfirmedBalance),
+		ImmatureBalance:    int64(info.ImmatureBalance),
+		FrozenBalance:      int64(info.FrozenBalance),
+		TxCount:            int64(info.TxCount),
+		Accounts:           accounts,
+		Locked:             info.Locked,
+		UnlockedUntil:      unlockedUntil,
+		WatchOnly:          info.WatchOnly,
+		Birthday:           info.Birthday.Unix(),
+		BirthdayHeight:     info.BirthdayBlock.Height,
+		BirthdayHash:       info.BirthdayBlock.Hash[:],
+		SyncedHeight:       info.SyncedTo.Height,
+		SyncedHash:         info.SyncedTo.Hash[:],
+		Rescanning:         info.Rescanning,
+		Recovering:         info.Recovering,
 	}
 	return resp, nil
 }
@@ -303,6 +394,14 @@ func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 func (s *walletServer) FundTransaction(ctx context.Context, req *pb.FundTransactionRequest) (
 	*pb.FundTransactionResponse, error) {
 
+	if len(req.Transaction) != 0 || len(req.Data) != 0 {
+		return s.fundRawTransaction(req)
+	}
+	if req.UseChangePosition {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Change position requires a transaction to fund")
+	}
+
 	policy := wallet.OutputSelectionPolicy{
 		Account:               req.Account,
 		RequiredConfirmations: req.RequiredConfirmations,
@@ -312,6 +411,69 @@ func (s *walletServer) FundTransaction(ctx context.Context, req *pb.FundTransact
 		return nil, translateError(err)
 	}
 
+	// Required outputs are selected regardless of their confirmations,
+	// and are not candidates of the coin selection.
+	var required []*wallet.TransactionOutput
+	if len(req.RequiredInputs) != 0 {
+		required, err = s.requiredOutputs(req.Account, req.RequiredInputs)
+		if err != nil {
+			return nil, err
+		}
+		candidates := unspentOutputs[:0]
+		for _, output := range unspentOutputs {
+			if !containsOutput(required, output.OutPoint) {
+				candidates = append(candidates, output)
+			}
+		}
+		unspentOutputs = candidates
+	}
+
+	// With a confirmation target, the selected outputs also pay for their
+	// own inputs at the fee rate estimated for the target, unless the fee
+	// is subtracted from the outputs of the transaction.
+	var feeRate, inputFeeRate czzutil.Amount
+	if req.ConfTarget != 0 {
+		feeRate = s.wallet.EstimateFeeRate(req.ConfTarget)
+		if !req.SubtractFee {
+			inputFeeRate = feeRate
+		}
+	}
+
+	// Select the outputs reaching the target amount.  When the target can
+	// not be reached, all outputs are returned so the caller can tell
+	// the available amount.  All outputs are spent when sending all
+	// funds.
+	if req.TargetAmount != 0 && !req.SendAll {
+		selector, err := coinSelector(req.CoinSelection)
+		if err != nil {
+			return nil, err
+		}
+		params := wallet.CoinSelectionParams{
+			Target:  czzutil.Amount(req.TargetAmount),
+			FeeRate: inputFeeRate,
+		}
+
+		// The required outputs contribute their effective value to the
+		// target.
+		for _, output := range required {
+			params.Target -= czzutil.Amount(output.Output.Value) -
+				params.InputFee(output.Output.PkScript)
+		}
+		if params.Target <= 0 {
+			unspentOutputs = nil
+		} else {
+			selected, err := s.wallet.SelectOutputs(unspentOutputs, selector, params)
+			switch err.(type) {
+			case nil:
+				unspentOutputs = selected
+			case wallet.InsufficientFundsError:
+			default:
+				return nil, translateError(err)
+			}
+		}
+	}
+	unspentOutputs = append(required, unspentOutputs...)
+
 	selectedOutputs := make([]*pb.FundTransactionResponse_PreviousOutput, 0, len(unspentOutputs))
 	var totalAmount czzutil.Amount
 	for _, output := range unspentOutputs {
@@ -324,15 +486,18 @@ func (s *walletServer) FundTransaction(ctx context.Context, req *pb.FundTransact
 			FromCoinbase:    output.OutputKind == wallet.OutputKindCoinbase,
 		})
 		totalAmount += czzutil.Amount(output.Output.Value)
-
-		if req.TargetAmount != 0 && totalAmount > czzutil.Amount(req.TargetAmount) {
-			break
-		}
 	}
 
+	// A transaction sending all funds has no change.
 	var changeScript []byte
-	if req.IncludeChangeScript && totalAmount > czzutil.Amount(req.TargetAmount) {
-		changeAddr, err := s.wallet.NewChangeAddress(req.Account, waddrmgr.KeyScopeBIP0044)
+	if req.IncludeChangeScript && !req.SendAll &&
+		totalAmount > czzutil.Amount(req.TargetAmount) {
+
+		changeAccount := req.Account
+		if req.UseChangeAccount {
+			changeAccount = req.ChangeAccount
+		}
+		changeAddr, err := s.wallet.NewChangeAddress(changeAccount, waddrmgr.KeyScopeBIP0044)
 		if err != nil {
 			return nil, translateError(err)
 		}
@@ -346,6 +511,291 @@ func (s *walletServer) FundTransaction(ctx context.Context, req *pb.FundTransact
 		SelectedOutputs: selectedOutputs,
 		TotalAmount:     int64(totalAmount),
 		ChangePkScript:  changeScript,
+		FeeRate:         int64(feeRate),
+	}, nil
+}
+
+// fundRawTransaction handles a FundTransaction request funding the unsigned
+// transaction of the request, or a new transaction if the request only carries
+// data.  The inputs of the transaction and the required inputs are kept, and
+// more inputs and change are added by the wallet.
+func (s *walletServer) fundRawTransaction(req *pb.FundTransactionRequest) (
+	*pb.FundTransactionResponse, error) {
+
+	if req.TargetAmount != 0 || req.SubtractFee || req.SendAll {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Target amount, subtract fee and send all do not apply "+
+				"when funding a transaction")
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if len(req.Transaction) != 0 {
+		err := tx.Deserialize(bytes.NewReader(req.Transaction))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Bytes do not represent a valid raw "+
+					"transaction: %v", err)
+		}
+	}
+	if len(req.Data) != 0 {
+		output, err := txrules.NullDataOutput(req.Data)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Invalid data: %v", err)
+		}
+		tx.AddTxOut(output)
+	}
+	for _, input := range req.RequiredInputs {
+		op, err := parseOutPoint(input)
+		if err != nil {
+			return nil, err
+		}
+		spent := false
+		for _, txIn := range tx.TxIn {
+			spent = spent || txIn.PreviousOutPoint == op
+		}
+		if !spent {
+			tx.AddTxIn(wire.NewTxIn(&op, nil))
+		}
+	}
+
+	selector, err := coinSelector(req.CoinSelection)
+	if err != nil {
+		return nil, err
+	}
+	confTarget := req.ConfTarget
+	if confTarget == 0 {
+		confTarget = wallet.DefaultConfTarget
+	}
+	feeRate := s.wallet.EstimateFeeRate(confTarget)
+	opts := &wallet.FundRawTxOptions{
+		KeyScope:              &waddrmgr.KeyScopeBIP0044,
+		Account:               req.Account,
+		RequiredConfirmations: req.RequiredConfirmations,
+		FeeRate:               feeRate,
+		CoinSelector:          selector,
+	}
+	if req.UseChangeAccount {
+		opts.ChangeAccount = &req.ChangeAccount
+	}
+	if req.UseChangePosition {
+		changePosition := int(req.ChangePosition)
+		opts.ChangePosition = &changePosition
+	}
+
+	funded, err := s.wallet.FundRawTransaction(tx, opts)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	selectedOutputs := make([]*pb.FundTransactionResponse_PreviousOutput,
+		len(funded.Tx.TxIn))
+	for i, txIn := range funded.Tx.TxIn {
+		op := &txIn.PreviousOutPoint
+		selectedOutputs[i] = &pb.FundTransactionResponse_PreviousOutput{
+			TransactionHash: op.Hash[:],
+			OutputIndex:     op.Index,
+			Amount:          int64(funded.PrevInputValues[i]),
+			PkScript:        funded.PrevScripts[i],
+		}
+	}
+	var changeScript []byte
+	if funded.ChangeIndex >= 0 {
+		changeScript = funded.Tx.TxOut[funded.ChangeIndex].PkScript
+	}
+	var fundedTx bytes.Buffer
+	fundedTx.Grow(funded.Tx.SerializeSize())
+	if err := funded.Tx.Serialize(&fundedTx); err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.FundTransactionResponse{
+		SelectedOutputs:   selectedOutputs,
+		TotalAmount:       int64(funded.TotalInput),
+		ChangePkScript:    changeScript,
+		FeeRate:           int64(feeRate),
+		FundedTransaction: fundedTx.Bytes(),
+		ChangeOutputIndex: int32(funded.ChangeIndex),
+	}, nil
+}
+
+// requiredOutputs returns the unspent outputs of an account spent by the
+// required inputs of a FundTransaction request, whatever their number of
+// confirmations.
+func (s *walletServer) requiredOutputs(account uint32,
+	inputs []*pb.FundTransactionRequest_OutPoint) (
+	[]*wallet.TransactionOutput, error) {
+
+	policy := wallet.OutputSelectionPolicy{Account: account}
+	unspentOutputs, err := s.wallet.UnspentOutputs(policy)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	required := make([]*wallet.TransactionOutput, 0, len(inputs))
+	for _, input := range inputs {
+		op, err := parseOutPoint(input)
+		if err != nil {
+			return nil, err
+		}
+		if containsOutput(required, op) {
+			continue
+		}
+		var found bool
+		for _, output := range unspentOutputs {
+			if output.OutPoint == op {
+				required = append(required, output)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Required input %v is not an unspent output of "+
+					"the account", op)
+		}
+	}
+	return required, nil
+}
+
+// parseOutPoint parses an outpoint from a request.
+func parseOutPoint(op *pb.FundTransactionRequest_OutPoint) (wire.OutPoint, error) {
+	hash, err := chainhash.NewHash(op.TransactionHash)
+	if err != nil {
+		return wire.OutPoint{}, status.Errorf(codes.InvalidArgument,
+			"Invalid transaction hash: %v", err)
+	}
+	return wire.OutPoint{Hash: *hash, Index: op.OutputIndex}, nil
+}
+
+// containsOutput returns whether outputs contains the output at an outpoint.
+func containsOutput(outputs []*wallet.TransactionOutput, op wire.OutPoint) bool {
+	for _, output := range outputs {
+		if output.OutPoint == op {
+			return true
+		}
+	}
+	return false
+}
+
+// coinSelector returns the wallet coin selector of a coin selection strategy.
+// The default strategy returns a nil selector, which selects with the wallet's
+// coin selector.
+func coinSelector(strategy pb.FundTransactionRequest_CoinSelection) (
+	wallet.CoinSelector, error) {
+
+	switch strategy {
+	case pb.FundTransactionRequest_DEFAULT:
+		return nil, nil
+	case pb.FundTransactionRequest_LARGEST_FIRST:
+		return wallet.LargestFirst{}, nil
+	case pb.FundTransactionRequest_SMALLEST_FIRST:
+		return wallet.SmallestFirst{}, nil
+	case pb.FundTransactionRequest_BRANCH_AND_BOUND:
+		return wallet.BranchAndBound{}, nil
+	case pb.FundTransactionRequest_RANDOM_IMPROVE:
+		return wallet.RandomImprove{}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"unknown coin selection strategy %v", strategy)
+	}
+}
+
+func (s *walletServer) PreviewTransaction(ctx context.Context, req *pb.PreviewTransactionRequest) (
+	*pb.PreviewTransactionResponse, error) {
+
+	if req.RequiredConfirmations < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"required_confirmations may not be negative")
+	}
+	if req.FeeRate < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"fee_rate may not be negative")
+	}
+	if len(req.Outputs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"no outputs")
+	}
+	selector, err := coinSelector(req.CoinSelection)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate := czzutil.Amount(req.FeeRate)
+	if feeRate == 0 {
+		confTarget := req.ConfTarget
+		if confTarget == 0 {
+			confTarget = wallet.DefaultConfTarget
+		}
+		feeRate = s.wallet.EstimateFeeRate(confTarget)
+	}
+
+	outputs := make([]*wire.TxOut, len(req.Outputs))
+	for i, output := range req.Outputs {
+		outputs[i] = wire.NewTxOut(output.Amount, output.PkScript)
+	}
+
+	var preview *wallet.TxPreview
+	if req.SendAll {
+		if len(outputs) != 1 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"send_all requires exactly one output")
+		}
+		policy := wallet.OutputSelectionPolicy{
+			Account:               req.Account,
+			RequiredConfirmations: req.RequiredConfirmations,
+		}
+		preview, err = s.wallet.PreviewSweep(
+			policy, &waddrmgr.KeyScopeBIP0044, outputs[0].PkScript,
+			feeRate,
+		)
+	} else {
+		subtractFeeFrom := make([]int, len(req.SubtractFeeFrom))
+		for i, index := range req.SubtractFeeFrom {
+			if int(index) >= len(outputs) {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"subtract_fee_from index %d out of range",
+					index)
+			}
+			subtractFeeFrom[i] = int(index)
+		}
+		preview, err = s.wallet.PreviewOutputs(outputs, &wallet.SendOptions{
+			KeyScope:              &waddrmgr.KeyScopeBIP0044,
+			Account:               req.Account,
+			RequiredConfirmations: req.RequiredConfirmations,
+			FeeRate:               feeRate,
+			CoinSelector:          selector,
+			SubtractFeeFrom:       subtractFeeFrom,
+		})
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(preview.Tx.SerializeSize())
+	if err := preview.Tx.Serialize(&buf); err != nil {
+		return nil, translateError(err)
+	}
+
+	inputs := make([]*pb.PreviewTransactionResponse_Input, len(preview.Tx.TxIn))
+	for i, input := range preview.Tx.TxIn {
+		inputs[i] = &pb.PreviewTransactionResponse_Input{
+			TransactionHash: input.PreviousOutPoint.Hash[:],
+			OutputIndex:     input.PreviousOutPoint.Index,
+			Amount:          int64(preview.PrevInputValues[i]),
+			PkScript:        preview.PrevScripts[i],
+		}
+	}
+
+	return &pb.PreviewTransactionResponse{
+		UnsignedTransaction: buf.Bytes(),
+		Inputs:              inputs,
+		EstimatedSize:       int64(preview.EstimatedSize),
+		Fee:                 int64(preview.Fee),
+		FeeRate:             int64(feeRate),
+		ChangeAmount:        int64(preview.ChangeAmount),
+		ChangeOutputIndex:   int32(preview.ChangeIndex),
 	}, nil
 }
 
@@ -353,8 +803,9 @@ func marshalGetTransactionsResult(wresp *wallet.GetTransactionsResult) (
 	*pb.GetTransactionsResponse, error) {
 
 	resp := &pb.GetTransactionsResponse{
-		MinedTransactions:   marshalBlocks(wresp.MinedTransactions),
-		UnminedTransactions: marshalTransactionDetails(wresp.UnminedTransactions),
+		MinedTransactions:      marshalBlocks(wresp.MinedTransactions),
+		UnminedTransactions:    marshalTransactionDetails(wresp.UnminedTransactions),
+		ConflictedTransactions: marshalConflictedTransactions(wresp.ConflictedTransactions),
 	}
 	return resp, nil
 }
@@ -510,6 +961,457 @@ func (s *walletServer) PublishTransaction(ctx context.Context, req *pb.PublishTr
 	return &pb.PublishTransactionResponse{}, nil
 }
 
+func (s *walletServer) BumpFee(ctx context.Context, req *pb.BumpFeeRequest) (
+	*pb.BumpFeeResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Invalid transaction hash: %v", err)
+	}
+	if req.FeeRate <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Fee rate must be positive")
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	child, err := s.wallet.BumpFeeCPFP(txHash, czzutil.Amount(req.FeeRate))
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var serializedTransaction bytes.Buffer
+	serializedTransaction.Grow(child.Tx.SerializeSize())
+	err = child.Tx.Serialize(&serializedTransaction)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	childHash := child.Tx.TxHash()
+	return &pb.BumpFeeResponse{
+		Transaction:     serializedTransaction.Bytes(),
+		TransactionHash: childHash[:],
+		Fee:             int64(child.Fee),
+		ParentFee:       int64(child.ParentFee),
+		PackageFeeRate:  int64(child.PackageFeeRate),
+	}, nil
+}
+
+func (s *walletServer) AbandonTransaction(ctx context.Context,
+	req *pb.AbandonTransactionRequest) (*pb.AbandonTransactionResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Invalid transaction hash: %v", err)
+	}
+
+	abandoned, err := s.wallet.AbandonTransaction(txHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	hashes := make([][]byte, len(abandoned))
+	for i := range abandoned {
+		hashes[i] = abandoned[i][:]
+	}
+	return &pb.AbandonTransactionResponse{
+		AbandonedTransactionHashes: hashes,
+	}, nil
+}
+
+// parsePsbt parses a binary serialized PSBT from a request.
+func parsePsbt(b []byte) (*psbt.Packet, error) {
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(b), false)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bytes do not represent a valid PSBT: %v", err)
+	}
+	return packet, nil
+}
+
+// serializePsbt returns the binary serialization of a PSBT.
+func serializePsbt(packet *psbt.Packet) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, translateError(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *walletServer) FundPsbt(ctx context.Context, req *pb.FundPsbtRequest) (
+	*pb.FundPsbtResponse, error) {
+
+	packet, err := parsePsbt(req.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.RequiredConfirmations < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"required_confirmations may not be negative")
+	}
+	if req.FeeRate < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"fee_rate may not be negative")
+	}
+	feeRate := s.wallet.EstimateFeeRate(wallet.DefaultConfTarget)
+	if req.FeeRate != 0 {
+		feeRate = czzutil.Amount(req.FeeRate)
+	}
+	lockID := wallet.PsbtLockID
+	if len(req.LeaseId) != 0 {
+		if len(req.LeaseId) != len(lockID) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Lease ID must be %d bytes", len(lockID))
+		}
+		copy(lockID[:], req.LeaseId)
+	}
+	leaseDuration := wallet.DefaultPsbtLeaseDuration
+	if req.LeaseDurationSeconds != 0 {
+		leaseDuration = time.Duration(req.LeaseDurationSeconds) *
+			time.Second
+	}
+
+	changeIndex, err := s.wallet.FundPsbt(packet, &waddrmgr.KeyScopeBIP0044,
+		req.Account, req.RequiredConfirmations, feeRate, lockID,
+		leaseDuration)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	fundedPsbt, err := serializePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FundPsbtResponse{
+		FundedPsbt:        fundedPsbt,
+		ChangeOutputIndex: changeIndex,
+	}, nil
+}
+
+func (s *walletServer) SignPsbt(ctx context.Context, req *pb.SignPsbtRequest) (
+	*pb.SignPsbtResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	packet, err := parsePsbt(req.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	signedInputs, err := s.wallet.SignPsbt(packet)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	signedPsbt, err := serializePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignPsbtResponse{
+		SignedPsbt:         signedPsbt,
+		SignedInputIndexes: signedInputs,
+	}, nil
+}
+
+func (s *walletServer) FinalizePsbt(ctx context.Context, req *pb.FinalizePsbtRequest) (
+	*pb.FinalizePsbtResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	packet, err := parsePsbt(req.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	err = s.wallet.FinalizePsbt(packet)
+	if err == wallet.ErrPsbtIncomplete {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	tx, err := wallet.ExtractPsbtTx(packet)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	var rawTx bytes.Buffer
+	rawTx.Grow(tx.SerializeSize())
+	if err := tx.Serialize(&rawTx); err != nil {
+		return nil, translateError(err)
+	}
+
+	signedPsbt, err := serializePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FinalizePsbtResponse{
+		SignedPsbt: signedPsbt,
+		RawFinalTx: rawTx.Bytes(),
+	}, nil
+}
+
+func (s *walletServer) AbandonPsbt(ctx context.Context, req *pb.AbandonPsbtRequest) (
+	*pb.AbandonPsbtResponse, error) {
+
+	packet, err := parsePsbt(req.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	lockID := wallet.PsbtLockID
+	if len(req.LeaseId) != 0 {
+		if len(req.LeaseId) != len(lockID) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Lease ID must be %d bytes", len(lockID))
+		}
+		copy(lockID[:], req.LeaseId)
+	}
+
+	if err := s.wallet.AbandonPsbt(packet, lockID); err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.AbandonPsbtResponse{}, nil
+}
+
+func (s *walletServer) EnqueuePayout(ctx context.Context, req *pb.EnqueuePayoutRequest) (
+	*pb.EnqueuePayoutResponse, error) {
+
+	if req.Amount <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Payout amount must be positive")
+	}
+	if len(req.PkScript) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Output script must not be empty")
+	}
+
+	payout, err := s.wallet.EnqueuePayout(req.Key, req.Account,
+		req.PkScript, czzutil.Amount(req.Amount))
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.EnqueuePayoutResponse{Payout: marshalPayout(payout)}, nil
+}
+
+func (s *walletServer) CancelPayout(ctx context.Context, req *pb.CancelPayoutRequest) (
+	*pb.CancelPayoutResponse, error) {
+
+	payout, err := s.wallet.CancelPayout(req.Key)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.CancelPayoutResponse{Payout: marshalPayout(payout)}, nil
+}
+
+func (s *walletServer) Payouts(ctx context.Context, req *pb.PayoutsRequest) (
+	*pb.PayoutsResponse, error) {
+
+	payouts, err := s.wallet.Payouts()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.PayoutsResponse{
+		Payouts: make([]*pb.Payout, len(payouts)),
+	}
+	for i, payout := range payouts {
+		resp.Payouts[i] = marshalPayout(payout)
+	}
+	return resp, nil
+}
+
+func (s *walletServer) FreezeOutputs(ctx context.Context, req *pb.FreezeOutputsRequest) (
+	*pb.FreezeOutputsResponse, error) {
+
+	ops := make([]wire.OutPoint, len(req.Outputs))
+	for i, output := range req.Outputs {
+		op, err := parseOutPoint(&pb.FundTransactionRequest_OutPoint{
+			TransactionHash: output.TransactionHash,
+			OutputIndex:     output.OutputIndex,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if output.ExpiryTime < 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Negative expiry time")
+		}
+		ops[i] = op
+	}
+
+	for i, output := range req.Outputs {
+		var expiry time.Time
+		if output.ExpiryTime != 0 {
+			expiry = time.Unix(output.ExpiryTime, 0)
+		}
+		err := s.wallet.FreezeOutput(ops[i], output.Reason, expiry)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+	return &pb.FreezeOutputsResponse{}, nil
+}
+
+func (s *walletServer) UnfreezeOutputs(ctx context.Context, req *pb.UnfreezeOutputsRequest) (
+	*pb.UnfreezeOutputsResponse, error) {
+
+	if req.All {
+		if len(req.Outputs) != 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Outputs may not be set when unfreezing all outputs")
+		}
+		if err := s.wallet.ResetFrozenOutputs(); err != nil {
+			return nil, translateError(err)
+		}
+		return &pb.UnfreezeOutputsResponse{}, nil
+	}
+
+	ops := make([]wire.OutPoint, len(req.Outputs))
+	for i, output := range req.Outputs {
+		op, err := parseOutPoint(output)
+		if err != nil {
+			return nil, err
+		}
+		ops[i] = op
+	}
+	for _, op := range ops {
+		if err := s.wallet.UnfreezeOutput(op); err != nil {
+			return nil, translateError(err)
+		}
+	}
+	return &pb.UnfreezeOutputsResponse{}, nil
+}
+
+func (s *walletServer) FrozenOutputs(ctx context.Context, req *pb.FrozenOutputsRequest) (
+	*pb.FrozenOutputsResponse, error) {
+
+	frozen, err := s.wallet.ListFrozenOutputs()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.FrozenOutputsResponse{
+		Outputs: make([]*pb.FrozenOutput, len(frozen)),
+	}
+	for i, output := range frozen {
+		resp.Outputs[i] = &pb.FrozenOutput{
+			TransactionHash: output.Outpoint.Hash[:],
+			OutputIndex:     output.Outpoint.Index,
+			Reason:          output.Reason,
+		}
+		if !output.Expiration.IsZero() {
+			resp.Outputs[i].ExpiryTime = output.Expiration.Unix()
+		}
+	}
+	return resp, nil
+}
+
+// parseLease parses the lock ID and outpoint of an output lease.
+func parseLease(id []byte, outPoint *pb.FundTransactionRequest_OutPoint) (
+	wtxmgr.LockID, wire.OutPoint, error) {
+
+	var lockID wtxmgr.LockID
+	if len(id) != len(lockID) {
+		return lockID, wire.OutPoint{}, status.Errorf(
+			codes.InvalidArgument, "Lease ID must be %d bytes",
+			len(lockID))
+	}
+	copy(lockID[:], id)
+	if outPoint == nil {
+		return lockID, wire.OutPoint{}, status.Errorf(
+			codes.InvalidArgument, "Outpoint is required")
+	}
+	op, err := parseOutPoint(outPoint)
+	if err != nil {
+		return lockID, wire.OutPoint{}, err
+	}
+	return lockID, op, nil
+}
+
+func (s *walletServer) LeaseOutput(ctx context.Context, req *pb.LeaseOutputRequest) (
+	*pb.LeaseOutputResponse, error) {
+
+	lockID, op, err := parseLease(req.Id, req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+	if req.DurationSeconds == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Lease duration must be positive")
+	}
+
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	expiration, err := s.wallet.LeaseOutput(lockID, op, duration)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.LeaseOutputResponse{Expiration: expiration.Unix()}, nil
+}
+
+func (s *walletServer) ReleaseOutput(ctx context.Context, req *pb.ReleaseOutputRequest) (
+	*pb.ReleaseOutputResponse, error) {
+
+	lockID, op, err := parseLease(req.Id, req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.wallet.ReleaseOutput(lockID, op); err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.ReleaseOutputResponse{}, nil
+}
+
+func (s *walletServer) Leases(ctx context.Context, req *pb.LeasesRequest) (
+	*pb.LeasesResponse, error) {
+
+	leases, err := s.wallet.ListLeasedOutputs()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.LeasesResponse{
+		Leases: make([]*pb.OutputLease, len(leases)),
+	}
+	for i, lease := range leases {
+		resp.Leases[i] = &pb.OutputLease{
+			Id: lease.LockID[:],
+			Outpoint: &pb.FundTransactionRequest_OutPoint{
+				TransactionHash: lease.Outpoint.Hash[:],
+				OutputIndex:     lease.Outpoint.Index,
+			},
+			Expiration: lease.Expiration.Unix(),
+		}
+	}
+	return resp, nil
+}
+
 func marshalTransactionInputs(v []wallet.TransactionSummaryInput) []*pb.TransactionDetails_Input {
 	inputs := make([]*pb.TransactionDetails_Input, len(v))
 	for i := range v {
@@ -552,6 +1454,40 @@ func marshalTransactionDetails(v []wallet.TransactionSummary) []*pb.TransactionD
 	return txs
 }
 
+func marshalPayout(p *wallet.Payout) *pb.Payout {
+	payout := &pb.Payout{
+		Key:         p.Key,
+		Account:     p.Account,
+		PkScript:    p.PkScript,
+		Amount:      int64(p.Amount),
+		State:       pb.Payout_State(p.State),
+		CreatedTime: p.Created.Unix(),
+		Error:       p.Error,
+	}
+	if p.State == wallet.PayoutBroadcast || p.State == wallet.PayoutConfirmed {
+		payout.TransactionHash = p.TxHash[:]
+	}
+	return payout
+}
+
+func marshalConflictedTransactions(v []wallet.ConflictedTransaction) []*pb.ConflictedTransactionDetails {
+	summaries := make([]wallet.TransactionSummary, len(v))
+	for i := range v {
+		summaries[i] = v[i].TransactionSummary
+	}
+	details := marshalTransactionDetails(summaries)
+
+	txs := make([]*pb.ConflictedTransactionDetails, len(v))
+	for i := range v {
+		txs[i] = &pb.ConflictedTransactionDetails{
+			Transaction:       details[i],
+			ConflictingHash:   v[i].ConflictingHash[:],
+			ConflictingHeight: v[i].ConflictingHeight,
+		}
+	}
+	return txs
+}
+
 func marshalBlocks(v []wallet.Block) []*pb.BlockDetails {
 	blocks := make([]*pb.BlockDetails, len(v))
 	for i := range v {
@@ -685,6 +1621,7 @@ func (s *loaderServer) CreateWallet(ctx context.Context, req *pb.CreateWalletReq
 	defer func() {
 		zero.Bytes(req.PrivatePassphrase)
 		zero.Bytes(req.Seed)
+		zero.Bytes(req.MnemonicPassphrase)
 	}()
 
 	// Use an insecure public passphrase when the request's is empty.
@@ -693,8 +1630,24 @@ func (s *loaderServer) CreateWallet(ctx context.Context, req *pb.CreateWalletReq
 		pubPassphrase = []byte(wallet.InsecurePubPassphrase)
 	}
 
+	// The seed is derived from the mnemonic when one is provided instead.
+	seed := req.Seed
+	if req.Mnemonic != "" {
+		if len(req.Seed) != 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"seed and mnemonic are mutually exclusive")
+		}
+		var err error
+		seed, err = bip39.NewSeed(req.Mnemonic, req.MnemonicPassphrase)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid mnemonic: %v", err)
+		}
+		defer zero.Bytes(seed)
+	}
+
 	wallet, err := s.loader.CreateNewWallet(
-		pubPassphrase, req.PrivatePassphrase, req.Seed, time.Now(),
+		pubPassphrase, req.PrivatePassphrase, seed, time.Now(),
 	)
 	if err != nil {
 		return nil, translateError(err)
@@ -709,6 +1662,74 @@ func (s *loaderServer) CreateWallet(ctx context.Context, req *pb.CreateWalletReq
 	return &pb.CreateWalletResponse{}, nil
 }
 
+func (s *loaderServer) CreateWatchingOnlyWallet(ctx context.Context,
+	req *pb.CreateWatchingOnlyWalletRequest) (
+	*pb.CreateWatchingOnlyWalletResponse, error) {
+
+	// Use an insecure public passphrase when the request's is empty.
+	pubPassphrase := req.PublicPassphrase
+	if len(pubPassphrase) == 0 {
+		pubPassphrase = []byte(wallet.InsecurePubPassphrase)
+	}
+
+	if len(req.Accounts) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"no accounts to import")
+	}
+
+	// A missing birthday is rejected rather than read as the Unix epoch, so
+	// that callers choose between a full rescan, by passing the time of the
+	// genesis block, and a recent birthday.
+	if req.Birthday <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"birthday must be a positive Unix time")
+	}
+	accounts := make([]*wallet.WatchingOnlyAccount, 0, len(req.Accounts))
+	for _, account := range req.Accounts {
+		accountPubKey, err := hdkeychain.NewKeyFromString(
+			account.ExtendedPublicKey,
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid extended public key: %v", err)
+		}
+		if accountPubKey.IsPrivate() {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"watching-only wallets may not hold private keys")
+		}
+
+		// The BIP0044 key scope is used when no purpose is specified.
+		scope := waddrmgr.KeyScopeBIP0044
+		if account.Purpose != 0 {
+			scope = waddrmgr.KeyScope{
+				Purpose: account.Purpose,
+				Coin:    account.Coin,
+			}
+		}
+		accounts = append(accounts, &wallet.WatchingOnlyAccount{
+			Name:                 account.Name,
+			KeyScope:             scope,
+			AccountPubKey:        accountPubKey,
+			MasterKeyFingerprint: account.MasterKeyFingerprint,
+		})
+	}
+
+	wallet, err := s.loader.CreateWatchingOnlyWalletFromAccounts(
+		pubPassphrase, accounts, time.Unix(req.Birthday, 0),
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	s.mu.Lock()
+	if s.rpcClient != nil {
+		wallet.SynchronizeRPC(s.rpcClient)
+	}
+	s.mu.Unlock()
+
+	return &pb.CreateWatchingOnlyWalletResponse{}, nil
+}
+
 func (s *loaderServer) OpenWallet(ctx context.Context, req *pb.OpenWalletRequest) (
 	*pb.OpenWalletResponse, error) {
 
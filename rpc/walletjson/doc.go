@@ -0,0 +1,15 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package walletjson provides the JSON-RPC commands and results of methods that
+are implemented by the wallet's legacy RPC server but are not known to the
+btcjson package.
+
+Every command defined here is registered with btcjson when the package is
+initialized, so the commands can be marshalled, unmarshalled and documented
+through the usual btcjson functions, exactly like the commands btcjson defines
+itself.
+*/
+package walletjson
@@ -0,0 +1,58 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletjson
+
+import (
+	"strings"
+
+	"github.com/classzz/classzz/btcjson"
+)
+
+// extendedMethods maps the names of methods already defined by btcjson, whose
+// parameters are extended by this package, to the name the extended command
+// is registered with.  btcjson does not allow a method to be registered twice,
+// so the extended commands are registered under these aliases instead.
+var extendedMethods = map[string]string{
+	"createrawtransaction": "createrawtransactionext",
+	"fundrawtransaction":   "fundrawtransactionext",
+	"lockunspent":          "lockunspentext",
+	"sendmany":             "sendmanyext",
+	"sendtoaddress":        "sendtoaddressext",
+}
+
+// ExtendedMethod returns the name the command of a method is registered with.
+// This is the method itself, unless its parameters are extended by this
+// package.
+func ExtendedMethod(method string) string {
+	if alias, ok := extendedMethods[method]; ok {
+		return alias
+	}
+	return method
+}
+
+// GenerateHelp is a wrapper around btcjson.GenerateHelp that generates the
+// help of extended methods from their extended command.  The descriptions of
+// an extended method are keyed by the name of its alias.
+func GenerateHelp(method string, descs map[string]string,
+	resultTypes ...interface{}) (string, error) {
+
+	alias := ExtendedMethod(method)
+	help, err := btcjson.GenerateHelp(alias, descs, resultTypes...)
+	if err != nil || alias == method {
+		return help, err
+	}
+	return strings.Replace(help, alias, method, 1), nil
+}
+
+// MethodUsageText is a wrapper around btcjson.MethodUsageText that returns
+// the usage of extended methods from their extended command.
+func MethodUsageText(method string) (string, error) {
+	alias := ExtendedMethod(method)
+	usage, err := btcjson.MethodUsageText(alias)
+	if err != nil || alias == method {
+		return usage, err
+	}
+	return strings.Replace(usage, alias, method, 1), nil
+}
@@ -0,0 +1,643 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// NOTE: This file is intended to house the RPC commands that are supported by
+// a wallet server.
+
+package walletjson
+
+import (
+	"github.com/classzz/classzz/btcjson"
+)
+
+// AbandonPsbtCmd defines the abandonpsbt JSON-RPC command.
+type AbandonPsbtCmd struct {
+	Psbt    string
+	LeaseID *string
+}
+
+// NewAbandonPsbtCmd returns a new instance which can be used to issue an
+// abandonpsbt JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewAbandonPsbtCmd(psbt string, leaseID *string) *AbandonPsbtCmd {
+	return &AbandonPsbtCmd{
+		Psbt:    psbt,
+		LeaseID: leaseID,
+	}
+}
+
+// AbandonTransactionCmd defines the abandontransaction JSON-RPC command.
+type AbandonTransactionCmd struct {
+	TxID string
+}
+
+// NewAbandonTransactionCmd returns a new instance which can be used to issue
+// an abandontransaction JSON-RPC command.
+func NewAbandonTransactionCmd(txID string) *AbandonTransactionCmd {
+	return &AbandonTransactionCmd{
+		TxID: txID,
+	}
+}
+
+// AddTimeLockAddressCmd defines the addtimelockaddress JSON-RPC command.
+type AddTimeLockAddressCmd struct {
+	Key      string
+	LockType string `jsonrpcusage:"\"cltv|csv\""`
+	Value    int64
+}
+
+// NewAddTimeLockAddressCmd returns a new instance which can be used to issue
+// an addtimelockaddress JSON-RPC command.
+func NewAddTimeLockAddressCmd(key, lockType string,
+	value int64) *AddTimeLockAddressCmd {
+
+	return &AddTimeLockAddressCmd{
+		Key:      key,
+		LockType: lockType,
+		Value:    value,
+	}
+}
+
+// PsbtInput represents an input to include in the PSBT created by the
+// WalletCreateFundedPsbtCmd command.
+type PsbtInput struct {
+	Txid     string  `json:"txid"`
+	Vout     uint32  `json:"vout"`
+	Sequence *uint32 `json:"sequence,omitempty"`
+}
+
+// WalletCreateFundedPsbtOpts represents the optional options struct provided
+// with a WalletCreateFundedPsbtCmd command.
+type WalletCreateFundedPsbtOpts struct {
+	Account       *string  `json:"account,omitempty"`
+	MinConf       *int32   `json:"minconf,omitempty"`
+	FeeRate       *float64 `json:"feeRate,omitempty"`
+	LeaseID       *string  `json:"leaseId,omitempty"`
+	LeaseDuration *int64   `json:"leaseDuration,omitempty"`
+	Version       *int32   `json:"version,omitempty"`
+}
+
+// WalletCreateFundedPsbtCmd defines the walletcreatefundedpsbt JSON-RPC
+// command.
+type WalletCreateFundedPsbtCmd struct {
+	Inputs   []PsbtInput
+	Outputs  map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In BTC
+	Locktime *uint32
+	Options  *WalletCreateFundedPsbtOpts
+}
+
+// NewWalletCreateFundedPsbtCmd returns a new instance which can be used to
+// issue a walletcreatefundedpsbt JSON-RPC command.
+func NewWalletCreateFundedPsbtCmd(inputs []PsbtInput,
+	outputs map[string]float64, locktime *uint32,
+	options *WalletCreateFundedPsbtOpts) *WalletCreateFundedPsbtCmd {
+
+	return &WalletCreateFundedPsbtCmd{
+		Inputs:   inputs,
+		Outputs:  outputs,
+		Locktime: locktime,
+		Options:  options,
+	}
+}
+
+// WalletProcessPsbtCmd defines the walletprocesspsbt JSON-RPC command.
+type WalletProcessPsbtCmd struct {
+	Psbt     string
+	Sign     *bool `jsonrpcdefault:"true"`
+	Finalize *bool `jsonrpcdefault:"true"`
+}
+
+// NewWalletProcessPsbtCmd returns a new instance which can be used to issue a
+// walletprocesspsbt JSON-RPC command.
+func NewWalletProcessPsbtCmd(psbt string, sign, finalize *bool) *WalletProcessPsbtCmd {
+	return &WalletProcessPsbtCmd{
+		Psbt:     psbt,
+		Sign:     sign,
+		Finalize: finalize,
+	}
+}
+
+// BumpFeeOptions represents the optional options struct provided with a
+// BumpFeeCmd command.
+type BumpFeeOptions struct {
+	FeeRate    *float64 `json:"feeRate,omitempty"`
+	ConfTarget *int     `json:"confTarget,omitempty"`
+}
+
+// BumpFeeCmd defines the bumpfee JSON-RPC command.
+type BumpFeeCmd struct {
+	TxID    string
+	Options *BumpFeeOptions
+}
+
+// NewBumpFeeCmd returns a new instance which can be used to issue a bumpfee
+// JSON-RPC command.
+func NewBumpFeeCmd(txID string, options *BumpFeeOptions) *BumpFeeCmd {
+	return &BumpFeeCmd{
+		TxID:    txID,
+		Options: options,
+	}
+}
+
+// FinalizePsbtCmd defines the finalizepsbt JSON-RPC command.
+type FinalizePsbtCmd struct {
+	Psbt    string
+	Extract *bool `jsonrpcdefault:"true"`
+}
+
+// NewFinalizePsbtCmd returns a new instance which can be used to issue a
+// finalizepsbt JSON-RPC command.
+func NewFinalizePsbtCmd(psbt string, extract *bool) *FinalizePsbtCmd {
+	return &FinalizePsbtCmd{
+		Psbt:    psbt,
+		Extract: extract,
+	}
+}
+
+// FundRawTransactionOpts represents the optional options struct provided with
+// a FundRawTransactionCmd command.
+type FundRawTransactionOpts struct {
+	Account        *string  `json:"account,omitempty"`
+	MinConf        *int32   `json:"minconf,omitempty"`
+	ChangeAccount  *string  `json:"changeAccount,omitempty"`
+	ChangePosition *int     `json:"changePosition,omitempty"`
+	FeeRate        *float64 `json:"feeRate,omitempty"`
+}
+
+// FundRawTransactionCmd defines the fundrawtransaction JSON-RPC command.  It
+// replaces the command defined by btcjson, whose options and witness flag are
+// those of segwit wallets, with options selecting the accounts the inputs and
+// change come from, and is registered under the fundrawtransactionext alias.
+type FundRawTransactionCmd struct {
+	HexTx   string
+	Options *FundRawTransactionOpts
+}
+
+// NewFundRawTransactionCmd returns a new instance which can be used to issue
+// a fundrawtransaction JSON-RPC command.
+func NewFundRawTransactionCmd(hexTx string,
+	options *FundRawTransactionOpts) *FundRawTransactionCmd {
+
+	return &FundRawTransactionCmd{
+		HexTx:   hexTx,
+		Options: options,
+	}
+}
+
+// CreateMultiSigAccountCmd defines the createmultisigaccount JSON-RPC command.
+type CreateMultiSigAccountCmd struct {
+	Account   string
+	NRequired int
+	Keys      []string
+}
+
+// NewCreateMultiSigAccountCmd returns a new instance which can be used to
+// issue a createmultisigaccount JSON-RPC command.
+func NewCreateMultiSigAccountCmd(account string, nRequired int,
+	keys []string) *CreateMultiSigAccountCmd {
+
+	return &CreateMultiSigAccountCmd{
+		Account:   account,
+		NRequired: nRequired,
+		Keys:      keys,
+	}
+}
+
+// RawTxInput represents an input of the transaction created by the
+// CreateRawTransactionCmd command.
+type RawTxInput struct {
+	Txid     string  `json:"txid"`
+	Vout     uint32  `json:"vout"`
+	Sequence *uint32 `json:"sequence,omitempty"`
+}
+
+// CreateRawTransactionCmd defines the createrawtransaction JSON-RPC command.
+// It extends the command defined by btcjson with the sequence numbers of the
+// inputs and the version of the transaction, and is registered under the
+// createrawtransactionext alias.
+type CreateRawTransactionCmd struct {
+	Inputs   []RawTxInput
+	Amounts  map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In BTC
+	LockTime *int64
+	Version  *int32
+}
+
+// NewCreateRawTransactionCmd returns a new instance which can be used to issue
+// a createrawtransaction JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateRawTransactionCmd(inputs []RawTxInput,
+	amounts map[string]float64, lockTime *int64,
+	version *int32) *CreateRawTransactionCmd {
+
+	return &CreateRawTransactionCmd{
+		Inputs:   inputs,
+		Amounts:  amounts,
+		LockTime: lockTime,
+		Version:  version,
+	}
+}
+
+// CombineRawTransactionCmd defines the combinerawtransaction JSON-RPC command.
+type CombineRawTransactionCmd struct {
+	HexTxs []string
+}
+
+// NewCombineRawTransactionCmd returns a new instance which can be used to
+// issue a combinerawtransaction JSON-RPC command.
+func NewCombineRawTransactionCmd(hexTxs []string) *CombineRawTransactionCmd {
+	return &CombineRawTransactionCmd{
+		HexTxs: hexTxs,
+	}
+}
+
+// CancelPayoutCmd defines the cancelpayout JSON-RPC command.
+type CancelPayoutCmd struct {
+	Key string
+}
+
+// NewCancelPayoutCmd returns a new instance which can be used to issue a
+// cancelpayout JSON-RPC command.
+func NewCancelPayoutCmd(key string) *CancelPayoutCmd {
+	return &CancelPayoutCmd{
+		Key: key,
+	}
+}
+
+// EnqueuePayoutCmd defines the enqueuepayout JSON-RPC command.
+type EnqueuePayoutCmd struct {
+	Key     string
+	Address string
+	Amount  float64 // In CZZ
+	Account *string `jsonrpcdefault:"\"default\""`
+}
+
+// NewEnqueuePayoutCmd returns a new instance which can be used to issue an
+// enqueuepayout JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewEnqueuePayoutCmd(key, address string, amount float64,
+	account *string) *EnqueuePayoutCmd {
+
+	return &EnqueuePayoutCmd{
+		Key:     key,
+		Address: address,
+		Amount:  amount,
+		Account: account,
+	}
+}
+
+// GetAccountXpubCmd defines the getaccountxpub JSON-RPC command.
+type GetAccountXpubCmd struct {
+	Account  string
+	Purpose  *uint32 `jsonrpcdefault:"44"`
+	CoinType *uint32 `jsonrpcdefault:"0"`
+}
+
+// NewGetAccountXpubCmd returns a new instance which can be used to issue a
+// getaccountxpub JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetAccountXpubCmd(account string, purpose,
+	coinType *uint32) *GetAccountXpubCmd {
+
+	return &GetAccountXpubCmd{
+		Account:  account,
+		Purpose:  purpose,
+		CoinType: coinType,
+	}
+}
+
+// GetConsolidationStatusCmd defines the getconsolidationstatus JSON-RPC
+// command.
+type GetConsolidationStatusCmd struct{}
+
+// NewGetConsolidationStatusCmd returns a new instance which can be used to
+// issue a getconsolidationstatus JSON-RPC command.
+func NewGetConsolidationStatusCmd() *GetConsolidationStatusCmd {
+	return &GetConsolidationStatusCmd{}
+}
+
+// GetPayoutCmd defines the getpayout JSON-RPC command.
+type GetPayoutCmd struct {
+	Key string
+}
+
+// NewGetPayoutCmd returns a new instance which can be used to issue a
+// getpayout JSON-RPC command.
+func NewGetPayoutCmd(key string) *GetPayoutCmd {
+	return &GetPayoutCmd{
+		Key: key,
+	}
+}
+
+// ListAccountAddressGroupingsCmd defines the listaccountaddressgroupings
+// JSON-RPC command.
+type ListAccountAddressGroupingsCmd struct {
+	Account string
+}
+
+// NewListAccountAddressGroupingsCmd returns a new instance which can be used
+// to issue a listaccountaddressgroupings JSON-RPC command.
+func NewListAccountAddressGroupingsCmd(account string) *ListAccountAddressGroupingsCmd {
+	return &ListAccountAddressGroupingsCmd{
+		Account: account,
+	}
+}
+
+// ImportDescriptorRequest represents a descriptor imported by an
+// ImportDescriptorsCmd command.  The label names the account created for a
+// ranged descriptor, and the timestamp is the Unix time of the earliest output
+// paying to the descriptor, from which the wallet is rescanned.
+type ImportDescriptorRequest struct {
+	Desc      string  `json:"desc"`
+	Label     *string `json:"label,omitempty"`
+	Timestamp *int64  `json:"timestamp,omitempty"`
+}
+
+// ImportDescriptorsCmd defines the importdescriptors JSON-RPC command.
+type ImportDescriptorsCmd struct {
+	Requests []ImportDescriptorRequest
+}
+
+// NewImportDescriptorsCmd returns a new instance which can be used to issue an
+// importdescriptors JSON-RPC command.
+func NewImportDescriptorsCmd(requests []ImportDescriptorRequest) *ImportDescriptorsCmd {
+	return &ImportDescriptorsCmd{
+		Requests: requests,
+	}
+}
+
+// LeaseOutputCmd defines the leaseoutput JSON-RPC command.
+type LeaseOutputCmd struct {
+	ID       string
+	Txid     string
+	Vout     uint32
+	Duration *int64 `jsonrpcdefault:"600"`
+}
+
+// NewLeaseOutputCmd returns a new instance which can be used to issue a
+// leaseoutput JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewLeaseOutputCmd(id, txid string, vout uint32,
+	duration *int64) *LeaseOutputCmd {
+
+	return &LeaseOutputCmd{
+		ID:       id,
+		Txid:     txid,
+		Vout:     vout,
+		Duration: duration,
+	}
+}
+
+// ListDescriptorsCmd defines the listdescriptors JSON-RPC command.
+type ListDescriptorsCmd struct{}
+
+// NewListDescriptorsCmd returns a new instance which can be used to issue a
+// listdescriptors JSON-RPC command.
+func NewListDescriptorsCmd() *ListDescriptorsCmd {
+	return &ListDescriptorsCmd{}
+}
+
+// ListLeasesCmd defines the listleases JSON-RPC command.
+type ListLeasesCmd struct{}
+
+// NewListLeasesCmd returns a new instance which can be used to issue a
+// listleases JSON-RPC command.
+func NewListLeasesCmd() *ListLeasesCmd {
+	return &ListLeasesCmd{}
+}
+
+// ListPayoutsCmd defines the listpayouts JSON-RPC command.
+type ListPayoutsCmd struct{}
+
+// NewListPayoutsCmd returns a new instance which can be used to issue a
+// listpayouts JSON-RPC command.
+func NewListPayoutsCmd() *ListPayoutsCmd {
+	return &ListPayoutsCmd{}
+}
+
+// LockUnspentCmd defines the lockunspent JSON-RPC command.  It extends the
+// command defined by btcjson with whether the locks are persisted as output
+// freezes, and the reason and expiry of the freezes, and is registered under
+// the lockunspentext alias.
+type LockUnspentCmd struct {
+	Unlock       bool
+	Transactions []btcjson.TransactionInput
+	Persistent   *bool `jsonrpcdefault:"false"`
+	Reason       *string
+	Expiry       *int64 `jsonrpcdefault:"0"`
+}
+
+// NewLockUnspentCmd returns a new instance which can be used to issue a
+// lockunspent JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewLockUnspentCmd(unlock bool, transactions []btcjson.TransactionInput,
+	persistent *bool, reason *string, expiry *int64) *LockUnspentCmd {
+
+	return &LockUnspentCmd{
+		Unlock:       unlock,
+		Transactions: transactions,
+		Persistent:   persistent,
+		Reason:       reason,
+		Expiry:       expiry,
+	}
+}
+
+// PreviewTransactionCmd defines the previewtransaction JSON-RPC command.
+type PreviewTransactionCmd struct {
+	Amounts         map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In CZZ
+	Account         *string            `jsonrpcdefault:"\"default\""`
+	MinConf         *int               `jsonrpcdefault:"1"`
+	CoinSelection   *string
+	ConfTarget      *int `jsonrpcdefault:"6"`
+	SubtractFeeFrom *[]string
+	SendAll         *bool `jsonrpcdefault:"false"`
+}
+
+// NewPreviewTransactionCmd returns a new instance which can be used to issue a
+// previewtransaction JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewPreviewTransactionCmd(amounts map[string]float64, account *string,
+	minConf *int, coinSelection *string, confTarget *int,
+	subtractFeeFrom *[]string, sendAll *bool) *PreviewTransactionCmd {
+
+	return &PreviewTransactionCmd{
+		Amounts:         amounts,
+		Account:         account,
+		MinConf:         minConf,
+		CoinSelection:   coinSelection,
+		ConfTarget:      confTarget,
+		SubtractFeeFrom: subtractFeeFrom,
+		SendAll:         sendAll,
+	}
+}
+
+// ReleaseOutputCmd defines the releaseoutput JSON-RPC command.
+type ReleaseOutputCmd struct {
+	ID   string
+	Txid string
+	Vout uint32
+}
+
+// NewReleaseOutputCmd returns a new instance which can be used to issue a
+// releaseoutput JSON-RPC command.
+func NewReleaseOutputCmd(id, txid string, vout uint32) *ReleaseOutputCmd {
+	return &ReleaseOutputCmd{
+		ID:   id,
+		Txid: txid,
+		Vout: vout,
+	}
+}
+
+// SendManyCmd defines the sendmany JSON-RPC command.  It extends the command
+// defined by btcjson with the name of the coin selection strategy used to
+// fund the transaction, the confirmation target its fee rate is estimated for,
+// the addresses whose amounts the fee is subtracted from, the lock time of the
+// transaction and the hex-encoded data of a null-data output, and is
+// registered under the sendmanyext alias.
+type SendManyCmd struct {
+	FromAccount     string
+	Amounts         map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In CZZ
+	MinConf         *int               `jsonrpcdefault:"1"`
+	Comment         *string
+	CoinSelection   *string
+	ConfTarget      *int `jsonrpcdefault:"6"`
+	SubtractFeeFrom *[]string
+	LockTime        *int64
+	Data            *string
+}
+
+// NewSendManyCmd returns a new instance which can be used to issue a sendmany
+// JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSendManyCmd(fromAccount string, amounts map[string]float64,
+	minConf *int, comment, coinSelection *string, confTarget *int,
+	subtractFeeFrom *[]string, lockTime *int64, data *string) *SendManyCmd {
+
+	return &SendManyCmd{
+		FromAccount:     fromAccount,
+		Amounts:         amounts,
+		MinConf:         minConf,
+		Comment:         comment,
+		CoinSelection:   coinSelection,
+		ConfTarget:      confTarget,
+		SubtractFeeFrom: subtractFeeFrom,
+		LockTime:        lockTime,
+		Data:            data,
+	}
+}
+
+// SendToAddressCmd defines the sendtoaddress JSON-RPC command.  It extends the
+// command defined by btcjson with the confirmation target the fee rate of the
+// transaction is estimated for, whether the fee is subtracted from the amount,
+// whether all funds are sent, the lock time of the transaction and the
+// hex-encoded data of a null-data output, and is registered under the
+// sendtoaddressext alias.
+type SendToAddressCmd struct {
+	Address               string
+	Amount                float64
+	Comment               *string
+	CommentTo             *string
+	ConfTarget            *int  `jsonrpcdefault:"6"`
+	SubtractFeeFromAmount *bool `jsonrpcdefault:"false"`
+	SendAll               *bool `jsonrpcdefault:"false"`
+	LockTime              *int64
+	Data                  *string
+}
+
+// NewSendToAddressCmd returns a new instance which can be used to issue a
+// sendtoaddress JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSendToAddressCmd(address string, amount float64, comment,
+	commentTo *string, confTarget *int, subtractFeeFromAmount,
+	sendAll *bool, lockTime *int64, data *string) *SendToAddressCmd {
+
+	return &SendToAddressCmd{
+		Address:               address,
+		Amount:                amount,
+		Comment:               comment,
+		CommentTo:             commentTo,
+		ConfTarget:            confTarget,
+		SubtractFeeFromAmount: subtractFeeFromAmount,
+		SendAll:               sendAll,
+		LockTime:              lockTime,
+		Data:                  data,
+	}
+}
+
+// SplitSeedCmd defines the splitseed JSON-RPC command.  The seed is either
+// hex-encoded or a BIP0039 mnemonic, which is used with the optional mnemonic
+// passphrase.
+type SplitSeedCmd struct {
+	Seed               string
+	Threshold          int
+	Shares             int
+	MnemonicPassphrase *string
+}
+
+// NewSplitSeedCmd returns a new instance which can be used to issue a
+// splitseed JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSplitSeedCmd(seed string, threshold, shares int,
+	mnemonicPassphrase *string) *SplitSeedCmd {
+
+	return &SplitSeedCmd{
+		Seed:               seed,
+		Threshold:          threshold,
+		Shares:             shares,
+		MnemonicPassphrase: mnemonicPassphrase,
+	}
+}
+
+func init() {
+	// The commands in this file are only usable with a wallet server.
+	flags := btcjson.UFWalletOnly
+
+	btcjson.MustRegisterCmd("abandonpsbt", (*AbandonPsbtCmd)(nil), flags)
+	btcjson.MustRegisterCmd("abandontransaction", (*AbandonTransactionCmd)(nil), flags)
+	btcjson.MustRegisterCmd("addtimelockaddress", (*AddTimeLockAddressCmd)(nil), flags)
+	btcjson.MustRegisterCmd("bumpfee", (*BumpFeeCmd)(nil), flags)
+	btcjson.MustRegisterCmd("cancelpayout", (*CancelPayoutCmd)(nil), flags)
+	btcjson.MustRegisterCmd("combinerawtransaction", (*CombineRawTransactionCmd)(nil), flags)
+	btcjson.MustRegisterCmd("createmultisigaccount", (*CreateMultiSigAccountCmd)(nil), flags)
+	btcjson.MustRegisterCmd(ExtendedMethod("createrawtransaction"), (*CreateRawTransactionCmd)(nil), flags)
+	btcjson.MustRegisterCmd("enqueuepayout", (*EnqueuePayoutCmd)(nil), flags)
+	btcjson.MustRegisterCmd("finalizepsbt", (*FinalizePsbtCmd)(nil), flags)
+	btcjson.MustRegisterCmd(ExtendedMethod("fundrawtransaction"), (*FundRawTransactionCmd)(nil), flags)
+	btcjson.MustRegisterCmd("getaccountxpub", (*GetAccountXpubCmd)(nil), flags)
+	btcjson.MustRegisterCmd("getconsolidationstatus", (*GetConsolidationStatusCmd)(nil), flags)
+	btcjson.MustRegisterCmd("getpayout", (*GetPayoutCmd)(nil), flags)
+	btcjson.MustRegisterCmd("importdescriptors", (*ImportDescriptorsCmd)(nil), flags)
+	btcjson.MustRegisterCmd("leaseoutput", (*LeaseOutputCmd)(nil), flags)
+	btcjson.MustRegisterCmd("listaccountaddressgroupings", (*ListAccountAddressGroupingsCmd)(nil), flags)
+	btcjson.MustRegisterCmd("listdescriptors", (*ListDescriptorsCmd)(nil), flags)
+	btcjson.MustRegisterCmd("listleases", (*ListLeasesCmd)(nil), flags)
+	btcjson.MustRegisterCmd("listpayouts", (*ListPayoutsCmd)(nil), flags)
+	btcjson.MustRegisterCmd(ExtendedMethod("lockunspent"), (*LockUnspentCmd)(nil), flags)
+	btcjson.MustRegisterCmd("previewtransaction", (*PreviewTransactionCmd)(nil), flags)
+	btcjson.MustRegisterCmd("releaseoutput", (*ReleaseOutputCmd)(nil), flags)
+	btcjson.MustRegisterCmd(ExtendedMethod("sendmany"), (*SendManyCmd)(nil), flags)
+	btcjson.MustRegisterCmd(ExtendedMethod("sendtoaddress"), (*SendToAddressCmd)(nil), flags)
+	btcjson.MustRegisterCmd("splitseed", (*SplitSeedCmd)(nil), flags)
+	btcjson.MustRegisterCmd("walletcreatefundedpsbt", (*WalletCreateFundedPsbtCmd)(nil), flags)
+	btcjson.MustRegisterCmd("walletprocesspsbt", (*WalletProcessPsbtCmd)(nil), flags)
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletjson
+
+import (
+	"github.com/classzz/classzz/btcjson"
+)
+
+// WalletCreateFundedPsbtResult models the data returned from the
+// walletcreatefundedpsbt command.
+type WalletCreateFundedPsbtResult struct {
+	Psbt      string  `json:"psbt"`
+	Fee       float64 `json:"fee"`
+	ChangePos int64   `json:"changepos"`
+}
+
+// AddTimeLockAddressResult models the data returned from the
+// addtimelockaddress command.
+type AddTimeLockAddressResult struct {
+	Address      string `json:"address"`
+	RedeemScript string `json:"redeemScript"`
+}
+
+// FundRawTransactionResult models the data returned from the
+// fundrawtransaction command.
+type FundRawTransactionResult struct {
+	Hex       string  `json:"hex"`
+	Fee       float64 `json:"fee"`
+	ChangePos int64   `json:"changepos"`
+}
+
+// WalletProcessPsbtResult models the data returned from the walletprocesspsbt
+// command.
+type WalletProcessPsbtResult struct {
+	Psbt     string `json:"psbt"`
+	Complete bool   `json:"complete"`
+}
+
+// FinalizePsbtResult models the data returned from the finalizepsbt command.
+type FinalizePsbtResult struct {
+	Psbt     string `json:"psbt,omitempty"`
+	Hex      string `json:"hex,omitempty"`
+	Complete bool   `json:"complete"`
+}
+
+// ScopeAccountsResult models the number of accounts of a key scope returned
+// as part of the getwalletinfo command.
+type ScopeAccountsResult struct {
+	KeyScope string `json:"keyscope"`
+	Accounts uint32 `json:"accounts"`
+}
+
+// GetWalletInfoResult models the data returned from the getwalletinfo command.
+type GetWalletInfoResult struct {
+	Balance            float64               `json:"balance"`
+	UnconfirmedBalance float64               `json:"unconfirmed_balance"`
+	ImmatureBalance    float64               `json:"immature_balance"`
+	FrozenBalance      float64               `json:"frozen_balance"`
+	TxCount            int                   `json:"txcount"`
+	Accounts           []ScopeAccountsResult `json:"accounts"`
+	Locked             bool                  `json:"locked"`
+	UnlockedUntil      int64                 `json:"unlocked_until"`
+	WatchOnly          bool                  `json:"watchonly"`
+	Birthday           int64                 `json:"birthday"`
+	BirthdayHeight     int32                 `json:"birthdayheight"`
+	BirthdayHash       string                `json:"birthdayhash"`
+	SyncHeight         int32                 `json:"syncheight"`
+	SyncHash           string                `json:"synchash"`
+	Rescanning         bool                  `json:"rescanning"`
+	Recovering         bool                  `json:"recovering"`
+}
+
+// GetTransactionResult models the data from the gettransaction command.  It
+// extends the result defined by btcjson with the data carried by the
+// null-data outputs of the transaction.
+type GetTransactionResult struct {
+	Amount          float64                               `json:"amount"`
+	Fee             float64                               `json:"fee,omitempty"`
+	Confirmations   int64                                 `json:"confirmations"`
+	BlockHash       string                                `json:"blockhash"`
+	BlockIndex      int64                                 `json:"blockindex"`
+	BlockTime       int64                                 `json:"blocktime"`
+	TxID            string                                `json:"txid"`
+	WalletConflicts []string                              `json:"walletconflicts"`
+	Time            int64                                 `json:"time"`
+	TimeReceived    int64                                 `json:"timereceived"`
+	Details         []btcjson.GetTransactionDetailsResult `json:"details"`
+	Hex             string                                `json:"hex"`
+	Data            []string                              `json:"data,omitempty"`
+}
+
+// ListTransactionsResult models the data from the listtransactions command.
+// It extends the result defined by btcjson with the data carried by null-data
+// outputs.
+type ListTransactionsResult struct {
+	Abandoned         bool     `json:"abandoned"`
+	Account           string   `json:"account"`
+	Address           string   `json:"address,omitempty"`
+	Amount            float64  `json:"amount"`
+	BIP125Replaceable string   `json:"bip125-replaceable,omitempty"`
+	BlockHash         string   `json:"blockhash,omitempty"`
+	BlockIndex        *int64   `json:"blockindex,omitempty"`
+	BlockTime         int64    `json:"blocktime,omitempty"`
+	Category          string   `json:"category"`
+	Confirmations     int64    `json:"confirmations"`
+	Fee               *float64 `json:"fee,omitempty"`
+	Generated         bool     `json:"generated,omitempty"`
+	InvolvesWatchOnly bool     `json:"involveswatchonly,omitempty"`
+	Time              int64    `json:"time"`
+	TimeReceived      int64    `json:"timereceived"`
+	Trusted           bool     `json:"trusted"`
+	TxID              string   `json:"txid"`
+	Vout              uint32   `json:"vout"`
+	WalletConflicts   []string `json:"walletconflicts"`
+	Comment           string   `json:"comment,omitempty"`
+	OtherAccount      string   `json:"otheraccount,omitempty"`
+	Data              string   `json:"data,omitempty"`
+}
+
+// ListSinceBlockResult models the data from the listsinceblock command.
+type ListSinceBlockResult struct {
+	Transactions []ListTransactionsResult `json:"transactions"`
+	LastBlock    string                   `json:"lastblock"`
+}
+
+// BumpFeeResult models the data returned from the bumpfee command.
+type BumpFeeResult struct {
+	TxID           string  `json:"txid"`
+	ParentTxID     string  `json:"parenttxid"`
+	Fee            float64 `json:"fee"`
+	ParentFee      float64 `json:"parentfee"`
+	PackageFeeRate float64 `json:"packagefeerate"`
+}
+
+// ConsolidationAccountResult models the number of spendable outputs of an
+// account returned as part of the getconsolidationstatus command.
+type ConsolidationAccountResult struct {
+	KeyScope string `json:"keyscope"`
+	Account  string `json:"account"`
+	Outputs  int    `json:"outputs"`
+}
+
+// ConsolidationResult models a consolidation transaction returned as part of
+// the getconsolidationstatus command.
+type ConsolidationResult struct {
+	TxID     string  `json:"txid"`
+	KeyScope string  `json:"keyscope"`
+	Account  string  `json:"account"`
+	Inputs   int     `json:"inputs"`
+	Amount   float64 `json:"amount"`
+	Fee      float64 `json:"fee"`
+	Time     int64   `json:"time"`
+}
+
+// GetConsolidationStatusResult models the data returned from the
+// getconsolidationstatus command.
+type GetConsolidationStatusResult struct {
+	Enabled        bool                         `json:"enabled"`
+	Threshold      int                          `json:"threshold"`
+	MaxFeeRate     float64                      `json:"maxfeerate"`
+	MaxInputs      int                          `json:"maxinputs"`
+	Interval       int64                        `json:"interval"`
+	LastRun        int64                        `json:"lastrun"`
+	FeeRate        float64                      `json:"feerate"`
+	LastError      string                       `json:"lasterror,omitempty"`
+	Accounts       []ConsolidationAccountResult `json:"accounts"`
+	Consolidations []ConsolidationResult        `json:"consolidations"`
+}
+
+// LeaseResult models an output lease returned by the leaseoutput and
+// listleases commands.
+type LeaseResult struct {
+	ID         string `json:"id"`
+	Txid       string `json:"txid"`
+	Vout       uint32 `json:"vout"`
+	Expiration int64  `json:"expiration"`
+}
+
+// LockedOutputResult models a locked output returned by the listlockunspent
+// command.
+type LockedOutputResult struct {
+	Txid       string `json:"txid"`
+	Vout       uint32 `json:"vout"`
+	Persistent bool   `json:"persistent"`
+	Reason     string `json:"reason,omitempty"`
+	Expiry     int64  `json:"expiry,omitempty"`
+}
+
+// PayoutResult models a payout returned by the enqueuepayout, cancelpayout,
+// getpayout and listpayouts commands.
+type PayoutResult struct {
+	Key     string  `json:"key"`
+	Address string  `json:"address"`
+	Amount  float64 `json:"amount"`
+	Account string  `json:"account"`
+	State   string  `json:"state"`
+	TxID    string  `json:"txid,omitempty"`
+	Error   string  `json:"error,omitempty"`
+	Time    int64   `json:"time"`
+}
+
+// PreviewTransactionInput models an input of the transaction returned by the
+// previewtransaction command.
+type PreviewTransactionInput struct {
+	TxID         string  `json:"txid"`
+	Vout         uint32  `json:"vout"`
+	Amount       float64 `json:"amount"`
+	ScriptPubKey string  `json:"scriptpubkey"`
+}
+
+// PreviewTransactionResult models the data returned from the
+// previewtransaction command.
+type PreviewTransactionResult struct {
+	Hex       string                    `json:"hex"`
+	Inputs    []PreviewTransactionInput `json:"inputs"`
+	Size      int                       `json:"size"`
+	Fee       float64                   `json:"fee"`
+	Change    float64                   `json:"change"`
+	ChangePos int                       `json:"changepos"`
+}
+
+// ImportDescriptorResult models the result of importing a descriptor returned
+// by the importdescriptors command.  The account and key scope are those of the
+// account created for a ranged descriptor.
+type ImportDescriptorResult struct {
+	Success  bool   `json:"success"`
+	Account  string `json:"account,omitempty"`
+	KeyScope string `json:"keyscope,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// DescriptorResult models an account descriptor returned by the
+// listdescriptors command.
+type DescriptorResult struct {
+	Desc          string `json:"desc"`
+	KeyScope      string `json:"keyscope"`
+	Account       string `json:"account"`
+	AccountNumber uint32 `json:"accountnumber"`
+}
+
+// GetAccountXpubResult models the data returned by the getaccountxpub command.
+// The master key fingerprint is omitted when the master key the account key is
+// derived from is unknown.
+type GetAccountXpubResult struct {
+	Xpub              string `json:"xpub"`
+	MasterFingerprint string `json:"masterfingerprint,omitempty"`
+	Path              string `json:"path"`
+	AccountNumber     uint32 `json:"accountnumber"`
+}
@@ -1,75 +1,16 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // source: api.proto
 
-/*
-Package walletrpc is a generated protocol buffer package.
-
-It is generated from these files:
-	api.proto
-
-It has these top-level messages:
-	VersionRequest
-	VersionResponse
-	TransactionDetails
-	BlockDetails
-	AccountBalance
-	PingRequest
-	PingResponse
-	NetworkRequest
-	NetworkResponse
-	AccountNumberRequest
-	AccountNumberResponse
-	AccountsRequest
-	AccountsResponse
-	RenameAccountRequest
-	RenameAccountResponse
-	NextAccountRequest
-	NextAccountResponse
-	NextAddressRequest
-	NextAddressResponse
-	ImportPrivateKeyRequest
-	ImportPrivateKeyResponse
-	BalanceRequest
-	BalanceResponse
-	GetTransactionsRequest
-	GetTransactionsResponse
-	ChangePassphraseRequest
-	ChangePassphraseResponse
-	FundTransactionRequest
-	FundTransactionResponse
-	SignTransactionRequest
-	SignTransactionResponse
-	PublishTransactionRequest
-	PublishTransactionResponse
-	TransactionNotificationsRequest
-	TransactionNotificationsResponse
-	SpentnessNotificationsRequest
-	SpentnessNotificationsResponse
-	AccountNotificationsRequest
-	AccountNotificationsResponse
-	CreateWalletRequest
-	CreateWalletResponse
-	OpenWalletRequest
-	OpenWalletResponse
-	CloseWalletRequest
-	CloseWalletResponse
-	WalletExistsRequest
-	WalletExistsResponse
-	StartConsensusRpcRequest
-	StartConsensusRpcResponse
-*/
 package walletrpc
 
 import (
+	context "context"
 	fmt "fmt"
-
 	proto "github.com/golang/protobuf/proto"
-
-	math "math"
-
-	context "golang.org/x/net/context"
-
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -81,7 +22,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type NextAddressRequest_Kind int32
 
@@ -94,6 +35,7 @@ var NextAddressRequest_Kind_name = map[int32]string{
 	0: "BIP0044_EXTERNAL",
 	1: "BIP0044_INTERNAL",
 }
+
 var NextAddressRequest_Kind_value = map[string]int32{
 	"BIP0044_EXTERNAL": 0,
 	"BIP0044_INTERNAL": 1,
@@ -102,7 +44,10 @@ var NextAddressRequest_Kind_value = map[string]int32{
 func (x NextAddressRequest_Kind) String() string {
 	return proto.EnumName(NextAddressRequest_Kind_name, int32(x))
 }
-func (NextAddressRequest_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{17, 0} }
+
+func (NextAddressRequest_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20, 0}
+}
 
 type ChangePassphraseRequest_Key int32
 
@@ -115,6 +60,7 @@ var ChangePassphraseRequest_Key_name = map[int32]string{
 	0: "PRIVATE",
 	1: "PUBLIC",
 }
+
 var ChangePassphraseRequest_Key_value = map[string]int32{
 	"PRIVATE": 0,
 	"PUBLIC":  1,
@@ -123,31 +69,146 @@ var ChangePassphraseRequest_Key_value = map[string]int32{
 func (x ChangePassphraseRequest_Key) String() string {
 	return proto.EnumName(ChangePassphraseRequest_Key_name, int32(x))
 }
+
 func (ChangePassphraseRequest_Key) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{25, 0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30, 0}
+}
+
+type FundTransactionRequest_CoinSelection int32
+
+const (
+	FundTransactionRequest_DEFAULT          FundTransactionRequest_CoinSelection = 0
+	FundTransactionRequest_LARGEST_FIRST    FundTransactionRequest_CoinSelection = 1
+	FundTransactionRequest_SMALLEST_FIRST   FundTransactionRequest_CoinSelection = 2
+	FundTransactionRequest_BRANCH_AND_BOUND FundTransactionRequest_CoinSelection = 3
+	FundTransactionRequest_RANDOM_IMPROVE   FundTransactionRequest_CoinSelection = 4
+)
+
+var FundTransactionRequest_CoinSelection_name = map[int32]string{
+	0: "DEFAULT",
+	1: "LARGEST_FIRST",
+	2: "SMALLEST_FIRST",
+	3: "BRANCH_AND_BOUND",
+	4: "RANDOM_IMPROVE",
+}
+
+var FundTransactionRequest_CoinSelection_value = map[string]int32{
+	"DEFAULT":          0,
+	"LARGEST_FIRST":    1,
+	"SMALLEST_FIRST":   2,
+	"BRANCH_AND_BOUND": 3,
+	"RANDOM_IMPROVE":   4,
+}
+
+func (x FundTransactionRequest_CoinSelection) String() string {
+	return proto.EnumName(FundTransactionRequest_CoinSelection_name, int32(x))
+}
+
+func (FundTransactionRequest_CoinSelection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32, 0}
+}
+
+type Payout_State int32
+
+const (
+	Payout_QUEUED    Payout_State = 0
+	Payout_BROADCAST Payout_State = 1
+	Payout_CONFIRMED Payout_State = 2
+	Payout_CANCELLED Payout_State = 3
+	Payout_FAILED    Payout_State = 4
+)
+
+var Payout_State_name = map[int32]string{
+	0: "QUEUED",
+	1: "BROADCAST",
+	2: "CONFIRMED",
+	3: "CANCELLED",
+	4: "FAILED",
+}
+
+var Payout_State_value = map[string]int32{
+	"QUEUED":    0,
+	"BROADCAST": 1,
+	"CONFIRMED": 2,
+	"CANCELLED": 3,
+	"FAILED":    4,
+}
+
+func (x Payout_State) String() string {
+	return proto.EnumName(Payout_State_name, int32(x))
+}
+
+func (Payout_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52, 0}
 }
 
 type VersionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
+func (m *VersionRequest) String() string { return proto.CompactTextString(m) }
+func (*VersionRequest) ProtoMessage()    {}
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionRequest.Unmarshal(m, b)
+}
+func (m *VersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionRequest.Marshal(b, m, deterministic)
+}
+func (m *VersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionRequest.Merge(m, src)
+}
+func (m *VersionRequest) XXX_Size() int {
+	return xxx_messageInfo_VersionRequest.Size(m)
+}
+func (m *VersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionRequest.DiscardUnknown(m)
 }
 
-func (m *VersionRequest) Reset()                    { *m = VersionRequest{} }
-func (m *VersionRequest) String() string            { return proto.CompactTextString(m) }
-func (*VersionRequest) ProtoMessage()               {}
-func (*VersionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+var xxx_messageInfo_VersionRequest proto.InternalMessageInfo
 
 type VersionResponse struct {
-	VersionString string `protobuf:"bytes,1,opt,name=version_string,json=versionString" json:"version_string,omitempty"`
-	Major         uint32 `protobuf:"varint,2,opt,name=major" json:"major,omitempty"`
-	Minor         uint32 `protobuf:"varint,3,opt,name=minor" json:"minor,omitempty"`
-	Patch         uint32 `protobuf:"varint,4,opt,name=patch" json:"patch,omitempty"`
-	Prerelease    string `protobuf:"bytes,5,opt,name=prerelease" json:"prerelease,omitempty"`
-	BuildMetadata string `protobuf:"bytes,6,opt,name=build_metadata,json=buildMetadata" json:"build_metadata,omitempty"`
+	VersionString        string   `protobuf:"bytes,1,opt,name=version_string,json=versionString,proto3" json:"version_string,omitempty"`
+	Major                uint32   `protobuf:"varint,2,opt,name=major,proto3" json:"major,omitempty"`
+	Minor                uint32   `protobuf:"varint,3,opt,name=minor,proto3" json:"minor,omitempty"`
+	Patch                uint32   `protobuf:"varint,4,opt,name=patch,proto3" json:"patch,omitempty"`
+	Prerelease           string   `protobuf:"bytes,5,opt,name=prerelease,proto3" json:"prerelease,omitempty"`
+	BuildMetadata        string   `protobuf:"bytes,6,opt,name=build_metadata,json=buildMetadata,proto3" json:"build_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
+func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
+func (*VersionResponse) ProtoMessage()    {}
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionResponse.Unmarshal(m, b)
+}
+func (m *VersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionResponse.Marshal(b, m, deterministic)
+}
+func (m *VersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionResponse.Merge(m, src)
+}
+func (m *VersionResponse) XXX_Size() int {
+	return xxx_messageInfo_VersionResponse.Size(m)
+}
+func (m *VersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionResponse.DiscardUnknown(m)
 }
 
-func (m *VersionResponse) Reset()                    { *m = VersionResponse{} }
-func (m *VersionResponse) String() string            { return proto.CompactTextString(m) }
-func (*VersionResponse) ProtoMessage()               {}
-func (*VersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+var xxx_messageInfo_VersionResponse proto.InternalMessageInfo
 
 func (m *VersionResponse) GetVersionString() string {
 	if m != nil {
@@ -192,18 +253,41 @@ func (m *VersionResponse) GetBuildMetadata() string {
 }
 
 type TransactionDetails struct {
-	Hash        []byte                       `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Transaction []byte                       `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	Debits      []*TransactionDetails_Input  `protobuf:"bytes,3,rep,name=debits" json:"debits,omitempty"`
-	Credits     []*TransactionDetails_Output `protobuf:"bytes,4,rep,name=credits" json:"credits,omitempty"`
-	Fee         int64                        `protobuf:"varint,5,opt,name=fee" json:"fee,omitempty"`
-	Timestamp   int64                        `protobuf:"varint,6,opt,name=timestamp" json:"timestamp,omitempty"`
+	Hash                 []byte                       `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Transaction          []byte                       `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Debits               []*TransactionDetails_Input  `protobuf:"bytes,3,rep,name=debits,proto3" json:"debits,omitempty"`
+	Credits              []*TransactionDetails_Output `protobuf:"bytes,4,rep,name=credits,proto3" json:"credits,omitempty"`
+	Fee                  int64                        `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Timestamp            int64                        `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
+func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
+func (*TransactionDetails) ProtoMessage()    {}
+func (*TransactionDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionDetails.Unmarshal(m, b)
+}
+func (m *TransactionDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionDetails.Marshal(b, m, deterministic)
+}
+func (m *TransactionDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionDetails.Merge(m, src)
+}
+func (m *TransactionDetails) XXX_Size() int {
+	return xxx_messageInfo_TransactionDetails.Size(m)
+}
+func (m *TransactionDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionDetails.DiscardUnknown(m)
 }
 
-func (m *TransactionDetails) Reset()                    { *m = TransactionDetails{} }
-func (m *TransactionDetails) String() string            { return proto.CompactTextString(m) }
-func (*TransactionDetails) ProtoMessage()               {}
-func (*TransactionDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+var xxx_messageInfo_TransactionDetails proto.InternalMessageInfo
 
 func (m *TransactionDetails) GetHash() []byte {
 	if m != nil {
@@ -248,15 +332,38 @@ func (m *TransactionDetails) GetTimestamp() int64 {
 }
 
 type TransactionDetails_Input struct {
-	Index           uint32 `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
-	PreviousAccount uint32 `protobuf:"varint,2,opt,name=previous_account,json=previousAccount" json:"previous_account,omitempty"`
-	PreviousAmount  int64  `protobuf:"varint,3,opt,name=previous_amount,json=previousAmount" json:"previous_amount,omitempty"`
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PreviousAccount      uint32   `protobuf:"varint,2,opt,name=previous_account,json=previousAccount,proto3" json:"previous_account,omitempty"`
+	PreviousAmount       int64    `protobuf:"varint,3,opt,name=previous_amount,json=previousAmount,proto3" json:"previous_amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionDetails_Input) Reset()         { *m = TransactionDetails_Input{} }
+func (m *TransactionDetails_Input) String() string { return proto.CompactTextString(m) }
+func (*TransactionDetails_Input) ProtoMessage()    {}
+func (*TransactionDetails_Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2, 0}
+}
+
+func (m *TransactionDetails_Input) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionDetails_Input.Unmarshal(m, b)
+}
+func (m *TransactionDetails_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionDetails_Input.Marshal(b, m, deterministic)
+}
+func (m *TransactionDetails_Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionDetails_Input.Merge(m, src)
+}
+func (m *TransactionDetails_Input) XXX_Size() int {
+	return xxx_messageInfo_TransactionDetails_Input.Size(m)
+}
+func (m *TransactionDetails_Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionDetails_Input.DiscardUnknown(m)
 }
 
-func (m *TransactionDetails_Input) Reset()                    { *m = TransactionDetails_Input{} }
-func (m *TransactionDetails_Input) String() string            { return proto.CompactTextString(m) }
-func (*TransactionDetails_Input) ProtoMessage()               {}
-func (*TransactionDetails_Input) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
+var xxx_messageInfo_TransactionDetails_Input proto.InternalMessageInfo
 
 func (m *TransactionDetails_Input) GetIndex() uint32 {
 	if m != nil {
@@ -280,15 +387,38 @@ func (m *TransactionDetails_Input) GetPreviousAmount() int64 {
 }
 
 type TransactionDetails_Output struct {
-	Index    uint32 `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
-	Account  uint32 `protobuf:"varint,2,opt,name=account" json:"account,omitempty"`
-	Internal bool   `protobuf:"varint,3,opt,name=internal" json:"internal,omitempty"`
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Account              uint32   `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
+	Internal             bool     `protobuf:"varint,3,opt,name=internal,proto3" json:"internal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionDetails_Output) Reset()         { *m = TransactionDetails_Output{} }
+func (m *TransactionDetails_Output) String() string { return proto.CompactTextString(m) }
+func (*TransactionDetails_Output) ProtoMessage()    {}
+func (*TransactionDetails_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2, 1}
+}
+
+func (m *TransactionDetails_Output) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionDetails_Output.Unmarshal(m, b)
+}
+func (m *TransactionDetails_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionDetails_Output.Marshal(b, m, deterministic)
+}
+func (m *TransactionDetails_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionDetails_Output.Merge(m, src)
+}
+func (m *TransactionDetails_Output) XXX_Size() int {
+	return xxx_messageInfo_TransactionDetails_Output.Size(m)
+}
+func (m *TransactionDetails_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionDetails_Output.DiscardUnknown(m)
 }
 
-func (m *TransactionDetails_Output) Reset()                    { *m = TransactionDetails_Output{} }
-func (m *TransactionDetails_Output) String() string            { return proto.CompactTextString(m) }
-func (*TransactionDetails_Output) ProtoMessage()               {}
-func (*TransactionDetails_Output) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 1} }
+var xxx_messageInfo_TransactionDetails_Output proto.InternalMessageInfo
 
 func (m *TransactionDetails_Output) GetIndex() uint32 {
 	if m != nil {
@@ -311,17 +441,95 @@ func (m *TransactionDetails_Output) GetInternal() bool {
 	return false
 }
 
+type ConflictedTransactionDetails struct {
+	Transaction          *TransactionDetails `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	ConflictingHash      []byte              `protobuf:"bytes,2,opt,name=conflicting_hash,json=conflictingHash,proto3" json:"conflicting_hash,omitempty"`
+	ConflictingHeight    int32               `protobuf:"varint,3,opt,name=conflicting_height,json=conflictingHeight,proto3" json:"conflicting_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ConflictedTransactionDetails) Reset()         { *m = ConflictedTransactionDetails{} }
+func (m *ConflictedTransactionDetails) String() string { return proto.CompactTextString(m) }
+func (*ConflictedTransactionDetails) ProtoMessage()    {}
+func (*ConflictedTransactionDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+func (m *ConflictedTransactionDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConflictedTransactionDetails.Unmarshal(m, b)
+}
+func (m *ConflictedTransactionDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConflictedTransactionDetails.Marshal(b, m, deterministic)
+}
+func (m *ConflictedTransactionDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictedTransactionDetails.Merge(m, src)
+}
+func (m *ConflictedTransactionDetails) XXX_Size() int {
+	return xxx_messageInfo_ConflictedTransactionDetails.Size(m)
+}
+func (m *ConflictedTransactionDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictedTransactionDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictedTransactionDetails proto.InternalMessageInfo
+
+func (m *ConflictedTransactionDetails) GetTransaction() *TransactionDetails {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *ConflictedTransactionDetails) GetConflictingHash() []byte {
+	if m != nil {
+		return m.ConflictingHash
+	}
+	return nil
+}
+
+func (m *ConflictedTransactionDetails) GetConflictingHeight() int32 {
+	if m != nil {
+		return m.ConflictingHeight
+	}
+	return 0
+}
+
 type BlockDetails struct {
-	Hash         []byte                `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Height       int32                 `protobuf:"varint,2,opt,name=height" json:"height,omitempty"`
-	Timestamp    int64                 `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
-	Transactions []*TransactionDetails `protobuf:"bytes,4,rep,name=transactions" json:"transactions,omitempty"`
+	Hash                 []byte                `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height               int32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp            int64                 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Transactions         []*TransactionDetails `protobuf:"bytes,4,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BlockDetails) Reset()         { *m = BlockDetails{} }
+func (m *BlockDetails) String() string { return proto.CompactTextString(m) }
+func (*BlockDetails) ProtoMessage()    {}
+func (*BlockDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 
-func (m *BlockDetails) Reset()                    { *m = BlockDetails{} }
-func (m *BlockDetails) String() string            { return proto.CompactTextString(m) }
-func (*BlockDetails) ProtoMessage()               {}
-func (*BlockDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (m *BlockDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockDetails.Unmarshal(m, b)
+}
+func (m *BlockDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockDetails.Marshal(b, m, deterministic)
+}
+func (m *BlockDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDetails.Merge(m, src)
+}
+func (m *BlockDetails) XXX_Size() int {
+	return xxx_messageInfo_BlockDetails.Size(m)
+}
+func (m *BlockDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockDetails proto.InternalMessageInfo
 
 func (m *BlockDetails) GetHash() []byte {
 	if m != nil {
@@ -352,14 +560,37 @@ func (m *BlockDetails) GetTransactions() []*TransactionDetails {
 }
 
 type AccountBalance struct {
-	Account      uint32 `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
-	TotalBalance int64  `protobuf:"varint,2,opt,name=total_balance,json=totalBalance" json:"total_balance,omitempty"`
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	TotalBalance         int64    `protobuf:"varint,2,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountBalance) Reset()         { *m = AccountBalance{} }
+func (m *AccountBalance) String() string { return proto.CompactTextString(m) }
+func (*AccountBalance) ProtoMessage()    {}
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
-func (m *AccountBalance) Reset()                    { *m = AccountBalance{} }
-func (m *AccountBalance) String() string            { return proto.CompactTextString(m) }
-func (*AccountBalance) ProtoMessage()               {}
-func (*AccountBalance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (m *AccountBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountBalance.Unmarshal(m, b)
+}
+func (m *AccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountBalance.Marshal(b, m, deterministic)
+}
+func (m *AccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBalance.Merge(m, src)
+}
+func (m *AccountBalance) XXX_Size() int {
+	return xxx_messageInfo_AccountBalance.Size(m)
+}
+func (m *AccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountBalance proto.InternalMessageInfo
 
 func (m *AccountBalance) GetAccount() uint32 {
 	if m != nil {
@@ -376,37 +607,129 @@ func (m *AccountBalance) GetTotalBalance() int64 {
 }
 
 type PingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingRequest) Reset()         { *m = PingRequest{} }
+func (m *PingRequest) String() string { return proto.CompactTextString(m) }
+func (*PingRequest) ProtoMessage()    {}
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
-func (m *PingRequest) Reset()                    { *m = PingRequest{} }
-func (m *PingRequest) String() string            { return proto.CompactTextString(m) }
-func (*PingRequest) ProtoMessage()               {}
-func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (m *PingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+}
+func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+}
+func (m *PingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingRequest.Merge(m, src)
+}
+func (m *PingRequest) XXX_Size() int {
+	return xxx_messageInfo_PingRequest.Size(m)
+}
+func (m *PingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingRequest proto.InternalMessageInfo
 
 type PingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingResponse) Reset()         { *m = PingResponse{} }
+func (m *PingResponse) String() string { return proto.CompactTextString(m) }
+func (*PingResponse) ProtoMessage()    {}
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
-func (m *PingResponse) Reset()                    { *m = PingResponse{} }
-func (m *PingResponse) String() string            { return proto.CompactTextString(m) }
-func (*PingResponse) ProtoMessage()               {}
-func (*PingResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (m *PingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+}
+func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+}
+func (m *PingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingResponse.Merge(m, src)
+}
+func (m *PingResponse) XXX_Size() int {
+	return xxx_messageInfo_PingResponse.Size(m)
+}
+func (m *PingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingResponse proto.InternalMessageInfo
 
 type NetworkRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkRequest) Reset()         { *m = NetworkRequest{} }
+func (m *NetworkRequest) String() string { return proto.CompactTextString(m) }
+func (*NetworkRequest) ProtoMessage()    {}
+func (*NetworkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
-func (m *NetworkRequest) Reset()                    { *m = NetworkRequest{} }
-func (m *NetworkRequest) String() string            { return proto.CompactTextString(m) }
-func (*NetworkRequest) ProtoMessage()               {}
-func (*NetworkRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (m *NetworkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkRequest.Unmarshal(m, b)
+}
+func (m *NetworkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkRequest.Marshal(b, m, deterministic)
+}
+func (m *NetworkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkRequest.Merge(m, src)
+}
+func (m *NetworkRequest) XXX_Size() int {
+	return xxx_messageInfo_NetworkRequest.Size(m)
+}
+func (m *NetworkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkRequest proto.InternalMessageInfo
 
 type NetworkResponse struct {
-	ActiveNetwork uint32 `protobuf:"varint,1,opt,name=active_network,json=activeNetwork" json:"active_network,omitempty"`
+	ActiveNetwork        uint32   `protobuf:"varint,1,opt,name=active_network,json=activeNetwork,proto3" json:"active_network,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkResponse) Reset()         { *m = NetworkResponse{} }
+func (m *NetworkResponse) String() string { return proto.CompactTextString(m) }
+func (*NetworkResponse) ProtoMessage()    {}
+func (*NetworkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 
-func (m *NetworkResponse) Reset()                    { *m = NetworkResponse{} }
-func (m *NetworkResponse) String() string            { return proto.CompactTextString(m) }
-func (*NetworkResponse) ProtoMessage()               {}
-func (*NetworkResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (m *NetworkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkResponse.Unmarshal(m, b)
+}
+func (m *NetworkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkResponse.Marshal(b, m, deterministic)
+}
+func (m *NetworkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkResponse.Merge(m, src)
+}
+func (m *NetworkResponse) XXX_Size() int {
+	return xxx_messageInfo_NetworkResponse.Size(m)
+}
+func (m *NetworkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkResponse proto.InternalMessageInfo
 
 func (m *NetworkResponse) GetActiveNetwork() uint32 {
 	if m != nil {
@@ -416,13 +739,36 @@ func (m *NetworkResponse) GetActiveNetwork() uint32 {
 }
 
 type AccountNumberRequest struct {
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	AccountName          string   `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountNumberRequest) Reset()         { *m = AccountNumberRequest{} }
+func (m *AccountNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountNumberRequest) ProtoMessage()    {}
+func (*AccountNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 
-func (m *AccountNumberRequest) Reset()                    { *m = AccountNumberRequest{} }
-func (m *AccountNumberRequest) String() string            { return proto.CompactTextString(m) }
-func (*AccountNumberRequest) ProtoMessage()               {}
-func (*AccountNumberRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (m *AccountNumberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountNumberRequest.Unmarshal(m, b)
+}
+func (m *AccountNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountNumberRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountNumberRequest.Merge(m, src)
+}
+func (m *AccountNumberRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountNumberRequest.Size(m)
+}
+func (m *AccountNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountNumberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountNumberRequest proto.InternalMessageInfo
 
 func (m *AccountNumberRequest) GetAccountName() string {
 	if m != nil {
@@ -432,13 +778,36 @@ func (m *AccountNumberRequest) GetAccountName() string {
 }
 
 type AccountNumberResponse struct {
-	AccountNumber uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber" json:"account_number,omitempty"`
+	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountNumberResponse) Reset()         { *m = AccountNumberResponse{} }
+func (m *AccountNumberResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountNumberResponse) ProtoMessage()    {}
+func (*AccountNumberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
 }
 
-func (m *AccountNumberResponse) Reset()                    { *m = AccountNumberResponse{} }
-func (m *AccountNumberResponse) String() string            { return proto.CompactTextString(m) }
-func (*AccountNumberResponse) ProtoMessage()               {}
-func (*AccountNumberResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (m *AccountNumberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountNumberResponse.Unmarshal(m, b)
+}
+func (m *AccountNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountNumberResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountNumberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountNumberResponse.Merge(m, src)
+}
+func (m *AccountNumberResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountNumberResponse.Size(m)
+}
+func (m *AccountNumberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountNumberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountNumberResponse proto.InternalMessageInfo
 
 func (m *AccountNumberResponse) GetAccountNumber() uint32 {
 	if m != nil {
@@ -448,23 +817,69 @@ func (m *AccountNumberResponse) GetAccountNumber() uint32 {
 }
 
 type AccountsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountsRequest) Reset()         { *m = AccountsRequest{} }
+func (m *AccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountsRequest) ProtoMessage()    {}
+func (*AccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
 }
 
-func (m *AccountsRequest) Reset()                    { *m = AccountsRequest{} }
-func (m *AccountsRequest) String() string            { return proto.CompactTextString(m) }
-func (*AccountsRequest) ProtoMessage()               {}
-func (*AccountsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (m *AccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsRequest.Unmarshal(m, b)
+}
+func (m *AccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsRequest.Merge(m, src)
+}
+func (m *AccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountsRequest.Size(m)
+}
+func (m *AccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsRequest proto.InternalMessageInfo
 
 type AccountsResponse struct {
-	Accounts           []*AccountsResponse_Account `protobuf:"bytes,1,rep,name=accounts" json:"accounts,omitempty"`
-	CurrentBlockHash   []byte                      `protobuf:"bytes,2,opt,name=current_block_hash,json=currentBlockHash,proto3" json:"current_block_hash,omitempty"`
-	CurrentBlockHeight int32                       `protobuf:"varint,3,opt,name=current_block_height,json=currentBlockHeight" json:"current_block_height,omitempty"`
+	Accounts             []*AccountsResponse_Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	CurrentBlockHash     []byte                      `protobuf:"bytes,2,opt,name=current_block_hash,json=currentBlockHash,proto3" json:"current_block_hash,omitempty"`
+	CurrentBlockHeight   int32                       `protobuf:"varint,3,opt,name=current_block_height,json=currentBlockHeight,proto3" json:"current_block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AccountsResponse) Reset()         { *m = AccountsResponse{} }
+func (m *AccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountsResponse) ProtoMessage()    {}
+func (*AccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
 
-func (m *AccountsResponse) Reset()                    { *m = AccountsResponse{} }
-func (m *AccountsResponse) String() string            { return proto.CompactTextString(m) }
-func (*AccountsResponse) ProtoMessage()               {}
-func (*AccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (m *AccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsResponse.Unmarshal(m, b)
+}
+func (m *AccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsResponse.Merge(m, src)
+}
+func (m *AccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountsResponse.Size(m)
+}
+func (m *AccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsResponse proto.InternalMessageInfo
 
 func (m *AccountsResponse) GetAccounts() []*AccountsResponse_Account {
 	if m != nil {
@@ -488,18 +903,41 @@ func (m *AccountsResponse) GetCurrentBlockHeight() int32 {
 }
 
 type AccountsResponse_Account struct {
-	AccountNumber    uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber" json:"account_number,omitempty"`
-	AccountName      string `protobuf:"bytes,2,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
-	TotalBalance     int64  `protobuf:"varint,3,opt,name=total_balance,json=totalBalance" json:"total_balance,omitempty"`
-	ExternalKeyCount uint32 `protobuf:"varint,4,opt,name=external_key_count,json=externalKeyCount" json:"external_key_count,omitempty"`
-	InternalKeyCount uint32 `protobuf:"varint,5,opt,name=internal_key_count,json=internalKeyCount" json:"internal_key_count,omitempty"`
-	ImportedKeyCount uint32 `protobuf:"varint,6,opt,name=imported_key_count,json=importedKeyCount" json:"imported_key_count,omitempty"`
+	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountName          string   `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	TotalBalance         int64    `protobuf:"varint,3,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	ExternalKeyCount     uint32   `protobuf:"varint,4,opt,name=external_key_count,json=externalKeyCount,proto3" json:"external_key_count,omitempty"`
+	InternalKeyCount     uint32   `protobuf:"varint,5,opt,name=internal_key_count,json=internalKeyCount,proto3" json:"internal_key_count,omitempty"`
+	ImportedKeyCount     uint32   `protobuf:"varint,6,opt,name=imported_key_count,json=importedKeyCount,proto3" json:"imported_key_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountsResponse_Account) Reset()         { *m = AccountsResponse_Account{} }
+func (m *AccountsResponse_Account) String() string { return proto.CompactTextString(m) }
+func (*AccountsResponse_Account) ProtoMessage()    {}
+func (*AccountsResponse_Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13, 0}
 }
 
-func (m *AccountsResponse_Account) Reset()                    { *m = AccountsResponse_Account{} }
-func (m *AccountsResponse_Account) String() string            { return proto.CompactTextString(m) }
-func (*AccountsResponse_Account) ProtoMessage()               {}
-func (*AccountsResponse_Account) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12, 0} }
+func (m *AccountsResponse_Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsResponse_Account.Unmarshal(m, b)
+}
+func (m *AccountsResponse_Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsResponse_Account.Marshal(b, m, deterministic)
+}
+func (m *AccountsResponse_Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsResponse_Account.Merge(m, src)
+}
+func (m *AccountsResponse_Account) XXX_Size() int {
+	return xxx_messageInfo_AccountsResponse_Account.Size(m)
+}
+func (m *AccountsResponse_Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsResponse_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsResponse_Account proto.InternalMessageInfo
 
 func (m *AccountsResponse_Account) GetAccountNumber() uint32 {
 	if m != nil {
@@ -543,129 +981,400 @@ func (m *AccountsResponse_Account) GetImportedKeyCount() uint32 {
 	return 0
 }
 
-type RenameAccountRequest struct {
-	AccountNumber uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber" json:"account_number,omitempty"`
-	NewName       string `protobuf:"bytes,2,opt,name=new_name,json=newName" json:"new_name,omitempty"`
+type AccountXpubRequest struct {
+	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Purpose              uint32   `protobuf:"varint,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Coin                 uint32   `protobuf:"varint,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountXpubRequest) Reset()         { *m = AccountXpubRequest{} }
+func (m *AccountXpubRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountXpubRequest) ProtoMessage()    {}
+func (*AccountXpubRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+}
+
+func (m *AccountXpubRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountXpubRequest.Unmarshal(m, b)
+}
+func (m *AccountXpubRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountXpubRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountXpubRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountXpubRequest.Merge(m, src)
+}
+func (m *AccountXpubRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountXpubRequest.Size(m)
+}
+func (m *AccountXpubRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountXpubRequest.DiscardUnknown(m)
 }
 
-func (m *RenameAccountRequest) Reset()                    { *m = RenameAccountRequest{} }
-func (m *RenameAccountRequest) String() string            { return proto.CompactTextString(m) }
-func (*RenameAccountRequest) ProtoMessage()               {}
-func (*RenameAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+var xxx_messageInfo_AccountXpubRequest proto.InternalMessageInfo
 
-func (m *RenameAccountRequest) GetAccountNumber() uint32 {
+func (m *AccountXpubRequest) GetAccountNumber() uint32 {
 	if m != nil {
 		return m.AccountNumber
 	}
 	return 0
 }
 
-func (m *RenameAccountRequest) GetNewName() string {
+func (m *AccountXpubRequest) GetPurpose() uint32 {
 	if m != nil {
-		return m.NewName
+		return m.Purpose
 	}
-	return ""
+	return 0
 }
 
-type RenameAccountResponse struct {
+func (m *AccountXpubRequest) GetCoin() uint32 {
+	if m != nil {
+		return m.Coin
+	}
+	return 0
 }
 
-func (m *RenameAccountResponse) Reset()                    { *m = RenameAccountResponse{} }
-func (m *RenameAccountResponse) String() string            { return proto.CompactTextString(m) }
-func (*RenameAccountResponse) ProtoMessage()               {}
-func (*RenameAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+type AccountXpubResponse struct {
+	ExtendedPublicKey    string   `protobuf:"bytes,1,opt,name=extended_public_key,json=extendedPublicKey,proto3" json:"extended_public_key,omitempty"`
+	MasterKeyFingerprint uint32   `protobuf:"varint,2,opt,name=master_key_fingerprint,json=masterKeyFingerprint,proto3" json:"master_key_fingerprint,omitempty"`
+	DerivationPath       []uint32 `protobuf:"varint,3,rep,packed,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-type NextAccountRequest struct {
-	Passphrase  []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+func (m *AccountXpubResponse) Reset()         { *m = AccountXpubResponse{} }
+func (m *AccountXpubResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountXpubResponse) ProtoMessage()    {}
+func (*AccountXpubResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 
-func (m *NextAccountRequest) Reset()                    { *m = NextAccountRequest{} }
-func (m *NextAccountRequest) String() string            { return proto.CompactTextString(m) }
-func (*NextAccountRequest) ProtoMessage()               {}
-func (*NextAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (m *AccountXpubResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountXpubResponse.Unmarshal(m, b)
+}
+func (m *AccountXpubResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountXpubResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountXpubResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountXpubResponse.Merge(m, src)
+}
+func (m *AccountXpubResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountXpubResponse.Size(m)
+}
+func (m *AccountXpubResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountXpubResponse.DiscardUnknown(m)
+}
 
-func (m *NextAccountRequest) GetPassphrase() []byte {
+var xxx_messageInfo_AccountXpubResponse proto.InternalMessageInfo
+
+func (m *AccountXpubResponse) GetExtendedPublicKey() string {
 	if m != nil {
-		return m.Passphrase
+		return m.ExtendedPublicKey
 	}
-	return nil
+	return ""
 }
 
-func (m *NextAccountRequest) GetAccountName() string {
+func (m *AccountXpubResponse) GetMasterKeyFingerprint() uint32 {
 	if m != nil {
-		return m.AccountName
+		return m.MasterKeyFingerprint
 	}
-	return ""
+	return 0
 }
 
-type NextAccountResponse struct {
-	AccountNumber uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber" json:"account_number,omitempty"`
+func (m *AccountXpubResponse) GetDerivationPath() []uint32 {
+	if m != nil {
+		return m.DerivationPath
+	}
+	return nil
 }
 
-func (m *NextAccountResponse) Reset()                    { *m = NextAccountResponse{} }
-func (m *NextAccountResponse) String() string            { return proto.CompactTextString(m) }
-func (*NextAccountResponse) ProtoMessage()               {}
-func (*NextAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+type RenameAccountRequest struct {
+	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-func (m *NextAccountResponse) GetAccountNumber() uint32 {
-	if m != nil {
-		return m.AccountNumber
-	}
-	return 0
+func (m *RenameAccountRequest) Reset()         { *m = RenameAccountRequest{} }
+func (m *RenameAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameAccountRequest) ProtoMessage()    {}
+func (*RenameAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 
-type NextAddressRequest struct {
-	Account uint32                  `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
-	Kind    NextAddressRequest_Kind `protobuf:"varint,2,opt,name=kind,enum=walletrpc.NextAddressRequest_Kind" json:"kind,omitempty"`
+func (m *RenameAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameAccountRequest.Unmarshal(m, b)
+}
+func (m *RenameAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *RenameAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameAccountRequest.Merge(m, src)
+}
+func (m *RenameAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameAccountRequest.Size(m)
+}
+func (m *RenameAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameAccountRequest.DiscardUnknown(m)
 }
 
-func (m *NextAddressRequest) Reset()                    { *m = NextAddressRequest{} }
-func (m *NextAddressRequest) String() string            { return proto.CompactTextString(m) }
-func (*NextAddressRequest) ProtoMessage()               {}
-func (*NextAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+var xxx_messageInfo_RenameAccountRequest proto.InternalMessageInfo
 
-func (m *NextAddressRequest) GetAccount() uint32 {
+func (m *RenameAccountRequest) GetAccountNumber() uint32 {
 	if m != nil {
-		return m.Account
+		return m.AccountNumber
 	}
 	return 0
 }
 
-func (m *NextAddressRequest) GetKind() NextAddressRequest_Kind {
+func (m *RenameAccountRequest) GetNewName() string {
 	if m != nil {
-		return m.Kind
+		return m.NewName
 	}
-	return NextAddressRequest_BIP0044_EXTERNAL
+	return ""
 }
 
-type NextAddressResponse struct {
-	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+type RenameAccountResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NextAddressResponse) Reset()                    { *m = NextAddressResponse{} }
-func (m *NextAddressResponse) String() string            { return proto.CompactTextString(m) }
-func (*NextAddressResponse) ProtoMessage()               {}
-func (*NextAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (m *RenameAccountResponse) Reset()         { *m = RenameAccountResponse{} }
+func (m *RenameAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*RenameAccountResponse) ProtoMessage()    {}
+func (*RenameAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+}
 
-func (m *NextAddressResponse) GetAddress() string {
-	if m != nil {
-		return m.Address
+func (m *RenameAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameAccountResponse.Unmarshal(m, b)
+}
+func (m *RenameAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *RenameAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameAccountResponse.Merge(m, src)
+}
+func (m *RenameAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_RenameAccountResponse.Size(m)
+}
+func (m *RenameAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameAccountResponse proto.InternalMessageInfo
+
+type NextAccountRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	AccountName          string   `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NextAccountRequest) Reset()         { *m = NextAccountRequest{} }
+func (m *NextAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*NextAccountRequest) ProtoMessage()    {}
+func (*NextAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+}
+
+func (m *NextAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NextAccountRequest.Unmarshal(m, b)
+}
+func (m *NextAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NextAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *NextAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextAccountRequest.Merge(m, src)
+}
+func (m *NextAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_NextAccountRequest.Size(m)
+}
+func (m *NextAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextAccountRequest proto.InternalMessageInfo
+
+func (m *NextAccountRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *NextAccountRequest) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
+}
+
+type NextAccountResponse struct {
+	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NextAccountResponse) Reset()         { *m = NextAccountResponse{} }
+func (m *NextAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*NextAccountResponse) ProtoMessage()    {}
+func (*NextAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+}
+
+func (m *NextAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NextAccountResponse.Unmarshal(m, b)
+}
+func (m *NextAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NextAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *NextAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextAccountResponse.Merge(m, src)
+}
+func (m *NextAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_NextAccountResponse.Size(m)
+}
+func (m *NextAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextAccountResponse proto.InternalMessageInfo
+
+func (m *NextAccountResponse) GetAccountNumber() uint32 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+type NextAddressRequest struct {
+	Account              uint32                  `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	Kind                 NextAddressRequest_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=walletrpc.NextAddressRequest_Kind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *NextAddressRequest) Reset()         { *m = NextAddressRequest{} }
+func (m *NextAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*NextAddressRequest) ProtoMessage()    {}
+func (*NextAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+}
+
+func (m *NextAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NextAddressRequest.Unmarshal(m, b)
+}
+func (m *NextAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NextAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *NextAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextAddressRequest.Merge(m, src)
+}
+func (m *NextAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_NextAddressRequest.Size(m)
+}
+func (m *NextAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextAddressRequest proto.InternalMessageInfo
+
+func (m *NextAddressRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *NextAddressRequest) GetKind() NextAddressRequest_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return NextAddressRequest_BIP0044_EXTERNAL
+}
+
+type NextAddressResponse struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NextAddressResponse) Reset()         { *m = NextAddressResponse{} }
+func (m *NextAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*NextAddressResponse) ProtoMessage()    {}
+func (*NextAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+}
+
+func (m *NextAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NextAddressResponse.Unmarshal(m, b)
+}
+func (m *NextAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NextAddressResponse.Marshal(b, m, deterministic)
+}
+func (m *NextAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextAddressResponse.Merge(m, src)
+}
+func (m *NextAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_NextAddressResponse.Size(m)
+}
+func (m *NextAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextAddressResponse proto.InternalMessageInfo
+
+func (m *NextAddressResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
 	return ""
 }
 
 type ImportPrivateKeyRequest struct {
-	Passphrase    []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	Account       uint32 `protobuf:"varint,2,opt,name=account" json:"account,omitempty"`
-	PrivateKeyWif string `protobuf:"bytes,3,opt,name=private_key_wif,json=privateKeyWif" json:"private_key_wif,omitempty"`
-	Rescan        bool   `protobuf:"varint,4,opt,name=rescan" json:"rescan,omitempty"`
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Account              uint32   `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
+	PrivateKeyWif        string   `protobuf:"bytes,3,opt,name=private_key_wif,json=privateKeyWif,proto3" json:"private_key_wif,omitempty"`
+	Rescan               bool     `protobuf:"varint,4,opt,name=rescan,proto3" json:"rescan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportPrivateKeyRequest) Reset()         { *m = ImportPrivateKeyRequest{} }
+func (m *ImportPrivateKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportPrivateKeyRequest) ProtoMessage()    {}
+func (*ImportPrivateKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+}
+
+func (m *ImportPrivateKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPrivateKeyRequest.Unmarshal(m, b)
+}
+func (m *ImportPrivateKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPrivateKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportPrivateKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPrivateKeyRequest.Merge(m, src)
+}
+func (m *ImportPrivateKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportPrivateKeyRequest.Size(m)
+}
+func (m *ImportPrivateKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPrivateKeyRequest.DiscardUnknown(m)
 }
 
-func (m *ImportPrivateKeyRequest) Reset()                    { *m = ImportPrivateKeyRequest{} }
-func (m *ImportPrivateKeyRequest) String() string            { return proto.CompactTextString(m) }
-func (*ImportPrivateKeyRequest) ProtoMessage()               {}
-func (*ImportPrivateKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+var xxx_messageInfo_ImportPrivateKeyRequest proto.InternalMessageInfo
 
 func (m *ImportPrivateKeyRequest) GetPassphrase() []byte {
 	if m != nil {
@@ -696,22 +1405,68 @@ func (m *ImportPrivateKeyRequest) GetRescan() bool {
 }
 
 type ImportPrivateKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportPrivateKeyResponse) Reset()         { *m = ImportPrivateKeyResponse{} }
+func (m *ImportPrivateKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportPrivateKeyResponse) ProtoMessage()    {}
+func (*ImportPrivateKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+}
+
+func (m *ImportPrivateKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPrivateKeyResponse.Unmarshal(m, b)
+}
+func (m *ImportPrivateKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPrivateKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportPrivateKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPrivateKeyResponse.Merge(m, src)
+}
+func (m *ImportPrivateKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportPrivateKeyResponse.Size(m)
+}
+func (m *ImportPrivateKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPrivateKeyResponse.DiscardUnknown(m)
 }
 
-func (m *ImportPrivateKeyResponse) Reset()                    { *m = ImportPrivateKeyResponse{} }
-func (m *ImportPrivateKeyResponse) String() string            { return proto.CompactTextString(m) }
-func (*ImportPrivateKeyResponse) ProtoMessage()               {}
-func (*ImportPrivateKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+var xxx_messageInfo_ImportPrivateKeyResponse proto.InternalMessageInfo
 
 type BalanceRequest struct {
-	AccountNumber         uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber" json:"account_number,omitempty"`
-	RequiredConfirmations int32  `protobuf:"varint,2,opt,name=required_confirmations,json=requiredConfirmations" json:"required_confirmations,omitempty"`
+	AccountNumber         uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	RequiredConfirmations int32    `protobuf:"varint,2,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
+func (m *BalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*BalanceRequest) ProtoMessage()    {}
+func (*BalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+}
+
+func (m *BalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceRequest.Unmarshal(m, b)
+}
+func (m *BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *BalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceRequest.Merge(m, src)
+}
+func (m *BalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_BalanceRequest.Size(m)
+}
+func (m *BalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceRequest.DiscardUnknown(m)
 }
 
-func (m *BalanceRequest) Reset()                    { *m = BalanceRequest{} }
-func (m *BalanceRequest) String() string            { return proto.CompactTextString(m) }
-func (*BalanceRequest) ProtoMessage()               {}
-func (*BalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+var xxx_messageInfo_BalanceRequest proto.InternalMessageInfo
 
 func (m *BalanceRequest) GetAccountNumber() uint32 {
 	if m != nil {
@@ -728,15 +1483,39 @@ func (m *BalanceRequest) GetRequiredConfirmations() int32 {
 }
 
 type BalanceResponse struct {
-	Total          int64 `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
-	Spendable      int64 `protobuf:"varint,2,opt,name=spendable" json:"spendable,omitempty"`
-	ImmatureReward int64 `protobuf:"varint,3,opt,name=immature_reward,json=immatureReward" json:"immature_reward,omitempty"`
+	Total                int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Spendable            int64    `protobuf:"varint,2,opt,name=spendable,proto3" json:"spendable,omitempty"`
+	ImmatureReward       int64    `protobuf:"varint,3,opt,name=immature_reward,json=immatureReward,proto3" json:"immature_reward,omitempty"`
+	Frozen               int64    `protobuf:"varint,4,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
+func (m *BalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*BalanceResponse) ProtoMessage()    {}
+func (*BalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+}
+
+func (m *BalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceResponse.Unmarshal(m, b)
+}
+func (m *BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *BalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceResponse.Merge(m, src)
+}
+func (m *BalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_BalanceResponse.Size(m)
+}
+func (m *BalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceResponse.DiscardUnknown(m)
 }
 
-func (m *BalanceResponse) Reset()                    { *m = BalanceResponse{} }
-func (m *BalanceResponse) String() string            { return proto.CompactTextString(m) }
-func (*BalanceResponse) ProtoMessage()               {}
-func (*BalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+var xxx_messageInfo_BalanceResponse proto.InternalMessageInfo
 
 func (m *BalanceResponse) GetTotal() int64 {
 	if m != nil {
@@ -759,1121 +1538,4730 @@ func (m *BalanceResponse) GetImmatureReward() int64 {
 	return 0
 }
 
-type GetTransactionsRequest struct {
-	// Optionally specify the starting block from which to begin including all transactions.
-	// Either the starting block hash or height may be specified, but not both.
-	// If a block height is specified and is negative, the absolute value becomes the number of
-	// last blocks to include.  That is, given a current chain height of 1000 and a starting block
-	// height of -3, transaction notifications will be created for blocks 998, 999, and 1000.
-	// If both options are excluded, transaction results are created for transactions since the
-	// genesis block.
-	StartingBlockHash   []byte `protobuf:"bytes,1,opt,name=starting_block_hash,json=startingBlockHash,proto3" json:"starting_block_hash,omitempty"`
-	StartingBlockHeight int32  `protobuf:"zigzag32,2,opt,name=starting_block_height,json=startingBlockHeight" json:"starting_block_height,omitempty"`
-	// Optionally specify the last block that transaction results may appear in.
-	// Either the ending block hash or height may be specified, but not both.
-	// If both are excluded, transaction results are created for all transactions
-	// through the best block, and include all unmined transactions.
-	EndingBlockHash   []byte `protobuf:"bytes,3,opt,name=ending_block_hash,json=endingBlockHash,proto3" json:"ending_block_hash,omitempty"`
-	EndingBlockHeight int32  `protobuf:"varint,4,opt,name=ending_block_height,json=endingBlockHeight" json:"ending_block_height,omitempty"`
-	// Include at least this many of the newest transactions if they exist.
-	// Cannot be used when the ending block hash is specified.
-	//
-	// TODO: remove until spec adds it back in some way.
-	MinimumRecentTransactions int32 `protobuf:"varint,5,opt,name=minimum_recent_transactions,json=minimumRecentTransactions" json:"minimum_recent_transactions,omitempty"`
+func (m *BalanceResponse) GetFrozen() int64 {
+	if m != nil {
+		return m.Frozen
+	}
+	return 0
 }
 
-func (m *GetTransactionsRequest) Reset()                    { *m = GetTransactionsRequest{} }
-func (m *GetTransactionsRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetTransactionsRequest) ProtoMessage()               {}
-func (*GetTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+type WalletInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-func (m *GetTransactionsRequest) GetStartingBlockHash() []byte {
-	if m != nil {
-		return m.StartingBlockHash
-	}
-	return nil
+func (m *WalletInfoRequest) Reset()         { *m = WalletInfoRequest{} }
+func (m *WalletInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletInfoRequest) ProtoMessage()    {}
+func (*WalletInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 
-func (m *GetTransactionsRequest) GetStartingBlockHeight() int32 {
+func (m *WalletInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletInfoRequest.Unmarshal(m, b)
+}
+func (m *WalletInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *WalletInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletInfoRequest.Merge(m, src)
+}
+func (m *WalletInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletInfoRequest.Size(m)
+}
+func (m *WalletInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletInfoRequest proto.InternalMessageInfo
+
+type WalletInfoResponse struct {
+	ConfirmedBalance     int64                               `protobuf:"varint,1,opt,name=confirmed_balance,json=confirmedBalance,proto3" json:"confirmed_balance,omitempty"`
+	UnconfirmedBalance   int64                               `protobuf:"varint,2,opt,name=unconfirmed_balance,json=unconfirmedBalance,proto3" json:"unconfirmed_balance,omitempty"`
+	ImmatureBalance      int64                               `protobuf:"varint,3,opt,name=immature_balance,json=immatureBalance,proto3" json:"immature_balance,omitempty"`
+	TxCount              int64                               `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	Accounts             []*WalletInfoResponse_ScopeAccounts `protobuf:"bytes,5,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Locked               bool                                `protobuf:"varint,6,opt,name=locked,proto3" json:"locked,omitempty"`
+	UnlockedUntil        int64                               `protobuf:"varint,7,opt,name=unlocked_until,json=unlockedUntil,proto3" json:"unlocked_until,omitempty"`
+	WatchOnly            bool                                `protobuf:"varint,8,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
+	Birthday             int64                               `protobuf:"varint,9,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	BirthdayHeight       int32                               `protobuf:"varint,10,opt,name=birthday_height,json=birthdayHeight,proto3" json:"birthday_height,omitempty"`
+	BirthdayHash         []byte                              `protobuf:"bytes,11,opt,name=birthday_hash,json=birthdayHash,proto3" json:"birthday_hash,omitempty"`
+	SyncedHeight         int32                               `protobuf:"varint,12,opt,name=synced_height,json=syncedHeight,proto3" json:"synced_height,omitempty"`
+	SyncedHash           []byte                              `protobuf:"bytes,13,opt,name=synced_hash,json=syncedHash,proto3" json:"synced_hash,omitempty"`
+	Rescanning           bool                                `protobuf:"varint,14,opt,name=rescanning,proto3" json:"rescanning,omitempty"`
+	Recovering           bool                                `protobuf:"varint,15,opt,name=recovering,proto3" json:"recovering,omitempty"`
+	FrozenBalance        int64                               `protobuf:"varint,16,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *WalletInfoResponse) Reset()         { *m = WalletInfoResponse{} }
+func (m *WalletInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*WalletInfoResponse) ProtoMessage()    {}
+func (*WalletInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+}
+
+func (m *WalletInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletInfoResponse.Unmarshal(m, b)
+}
+func (m *WalletInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *WalletInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletInfoResponse.Merge(m, src)
+}
+func (m *WalletInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_WalletInfoResponse.Size(m)
+}
+func (m *WalletInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletInfoResponse proto.InternalMessageInfo
+
+func (m *WalletInfoResponse) GetConfirmedBalance() int64 {
 	if m != nil {
-		return m.StartingBlockHeight
+		return m.ConfirmedBalance
 	}
 	return 0
 }
 
-func (m *GetTransactionsRequest) GetEndingBlockHash() []byte {
+func (m *WalletInfoResponse) GetUnconfirmedBalance() int64 {
 	if m != nil {
-		return m.EndingBlockHash
+		return m.UnconfirmedBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *GetTransactionsRequest) GetEndingBlockHeight() int32 {
+func (m *WalletInfoResponse) GetImmatureBalance() int64 {
 	if m != nil {
-		return m.EndingBlockHeight
+		return m.ImmatureBalance
 	}
 	return 0
 }
 
-func (m *GetTransactionsRequest) GetMinimumRecentTransactions() int32 {
+func (m *WalletInfoResponse) GetTxCount() int64 {
 	if m != nil {
-		return m.MinimumRecentTransactions
+		return m.TxCount
 	}
 	return 0
 }
 
-type GetTransactionsResponse struct {
-	MinedTransactions   []*BlockDetails       `protobuf:"bytes,1,rep,name=mined_transactions,json=minedTransactions" json:"mined_transactions,omitempty"`
-	UnminedTransactions []*TransactionDetails `protobuf:"bytes,2,rep,name=unmined_transactions,json=unminedTransactions" json:"unmined_transactions,omitempty"`
-}
-
-func (m *GetTransactionsResponse) Reset()                    { *m = GetTransactionsResponse{} }
-func (m *GetTransactionsResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetTransactionsResponse) ProtoMessage()               {}
-func (*GetTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
-
-func (m *GetTransactionsResponse) GetMinedTransactions() []*BlockDetails {
+func (m *WalletInfoResponse) GetAccounts() []*WalletInfoResponse_ScopeAccounts {
 	if m != nil {
-		return m.MinedTransactions
+		return m.Accounts
 	}
 	return nil
 }
 
-func (m *GetTransactionsResponse) GetUnminedTransactions() []*TransactionDetails {
+func (m *WalletInfoResponse) GetLocked() bool {
 	if m != nil {
-		return m.UnminedTransactions
+		return m.Locked
 	}
-	return nil
-}
-
-type ChangePassphraseRequest struct {
-	Key           ChangePassphraseRequest_Key `protobuf:"varint,1,opt,name=key,enum=walletrpc.ChangePassphraseRequest_Key" json:"key,omitempty"`
-	OldPassphrase []byte                      `protobuf:"bytes,2,opt,name=old_passphrase,json=oldPassphrase,proto3" json:"old_passphrase,omitempty"`
-	NewPassphrase []byte                      `protobuf:"bytes,3,opt,name=new_passphrase,json=newPassphrase,proto3" json:"new_passphrase,omitempty"`
+	return false
 }
 
-func (m *ChangePassphraseRequest) Reset()                    { *m = ChangePassphraseRequest{} }
-func (m *ChangePassphraseRequest) String() string            { return proto.CompactTextString(m) }
-func (*ChangePassphraseRequest) ProtoMessage()               {}
-func (*ChangePassphraseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
-
-func (m *ChangePassphraseRequest) GetKey() ChangePassphraseRequest_Key {
+func (m *WalletInfoResponse) GetUnlockedUntil() int64 {
 	if m != nil {
-		return m.Key
+		return m.UnlockedUntil
 	}
-	return ChangePassphraseRequest_PRIVATE
+	return 0
 }
 
-func (m *ChangePassphraseRequest) GetOldPassphrase() []byte {
+func (m *WalletInfoResponse) GetWatchOnly() bool {
 	if m != nil {
-		return m.OldPassphrase
+		return m.WatchOnly
 	}
-	return nil
+	return false
 }
 
-func (m *ChangePassphraseRequest) GetNewPassphrase() []byte {
+func (m *WalletInfoResponse) GetBirthday() int64 {
 	if m != nil {
-		return m.NewPassphrase
+		return m.Birthday
 	}
-	return nil
+	return 0
 }
 
-type ChangePassphraseResponse struct {
+func (m *WalletInfoResponse) GetBirthdayHeight() int32 {
+	if m != nil {
+		return m.BirthdayHeight
+	}
+	return 0
 }
 
-func (m *ChangePassphraseResponse) Reset()                    { *m = ChangePassphraseResponse{} }
-func (m *ChangePassphraseResponse) String() string            { return proto.CompactTextString(m) }
-func (*ChangePassphraseResponse) ProtoMessage()               {}
-func (*ChangePassphraseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
-
-type FundTransactionRequest struct {
-	Account                  uint32 `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
-	TargetAmount             int64  `protobuf:"varint,2,opt,name=target_amount,json=targetAmount" json:"target_amount,omitempty"`
-	RequiredConfirmations    int32  `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations" json:"required_confirmations,omitempty"`
-	IncludeImmatureCoinbases bool   `protobuf:"varint,4,opt,name=include_immature_coinbases,json=includeImmatureCoinbases" json:"include_immature_coinbases,omitempty"`
-	IncludeChangeScript      bool   `protobuf:"varint,5,opt,name=include_change_script,json=includeChangeScript" json:"include_change_script,omitempty"`
+func (m *WalletInfoResponse) GetBirthdayHash() []byte {
+	if m != nil {
+		return m.BirthdayHash
+	}
+	return nil
 }
 
-func (m *FundTransactionRequest) Reset()                    { *m = FundTransactionRequest{} }
-func (m *FundTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*FundTransactionRequest) ProtoMessage()               {}
-func (*FundTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
-
-func (m *FundTransactionRequest) GetAccount() uint32 {
+func (m *WalletInfoResponse) GetSyncedHeight() int32 {
 	if m != nil {
-		return m.Account
+		return m.SyncedHeight
 	}
 	return 0
 }
 
-func (m *FundTransactionRequest) GetTargetAmount() int64 {
+func (m *WalletInfoResponse) GetSyncedHash() []byte {
 	if m != nil {
-		return m.TargetAmount
+		return m.SyncedHash
 	}
-	return 0
+	return nil
 }
 
-func (m *FundTransactionRequest) GetRequiredConfirmations() int32 {
+func (m *WalletInfoResponse) GetRescanning() bool {
 	if m != nil {
-		return m.RequiredConfirmations
+		return m.Rescanning
 	}
-	return 0
+	return false
 }
 
-func (m *FundTransactionRequest) GetIncludeImmatureCoinbases() bool {
+func (m *WalletInfoResponse) GetRecovering() bool {
 	if m != nil {
-		return m.IncludeImmatureCoinbases
+		return m.Recovering
 	}
 	return false
 }
 
-func (m *FundTransactionRequest) GetIncludeChangeScript() bool {
+func (m *WalletInfoResponse) GetFrozenBalance() int64 {
 	if m != nil {
-		return m.IncludeChangeScript
+		return m.FrozenBalance
 	}
-	return false
+	return 0
 }
 
-type FundTransactionResponse struct {
-	SelectedOutputs []*FundTransactionResponse_PreviousOutput `protobuf:"bytes,1,rep,name=selected_outputs,json=selectedOutputs" json:"selected_outputs,omitempty"`
-	TotalAmount     int64                                     `protobuf:"varint,2,opt,name=total_amount,json=totalAmount" json:"total_amount,omitempty"`
-	ChangePkScript  []byte                                    `protobuf:"bytes,3,opt,name=change_pk_script,json=changePkScript,proto3" json:"change_pk_script,omitempty"`
+type WalletInfoResponse_ScopeAccounts struct {
+	Purpose              uint32   `protobuf:"varint,1,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Coin                 uint32   `protobuf:"varint,2,opt,name=coin,proto3" json:"coin,omitempty"`
+	Accounts             uint32   `protobuf:"varint,3,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FundTransactionResponse) Reset()                    { *m = FundTransactionResponse{} }
-func (m *FundTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*FundTransactionResponse) ProtoMessage()               {}
-func (*FundTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (m *WalletInfoResponse_ScopeAccounts) Reset()         { *m = WalletInfoResponse_ScopeAccounts{} }
+func (m *WalletInfoResponse_ScopeAccounts) String() string { return proto.CompactTextString(m) }
+func (*WalletInfoResponse_ScopeAccounts) ProtoMessage()    {}
+func (*WalletInfoResponse_ScopeAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27, 0}
+}
 
-func (m *FundTransactionResponse) GetSelectedOutputs() []*FundTransactionResponse_PreviousOutput {
+func (m *WalletInfoResponse_ScopeAccounts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletInfoResponse_ScopeAccounts.Unmarshal(m, b)
+}
+func (m *WalletInfoResponse_ScopeAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletInfoResponse_ScopeAccounts.Marshal(b, m, deterministic)
+}
+func (m *WalletInfoResponse_ScopeAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletInfoResponse_ScopeAccounts.Merge(m, src)
+}
+func (m *WalletInfoResponse_ScopeAccounts) XXX_Size() int {
+	return xxx_messageInfo_WalletInfoResponse_ScopeAccounts.Size(m)
+}
+func (m *WalletInfoResponse_ScopeAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletInfoResponse_ScopeAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletInfoResponse_ScopeAccounts proto.InternalMessageInfo
+
+func (m *WalletInfoResponse_ScopeAccounts) GetPurpose() uint32 {
 	if m != nil {
-		return m.SelectedOutputs
+		return m.Purpose
 	}
-	return nil
+	return 0
 }
 
-func (m *FundTransactionResponse) GetTotalAmount() int64 {
+func (m *WalletInfoResponse_ScopeAccounts) GetCoin() uint32 {
 	if m != nil {
-		return m.TotalAmount
+		return m.Coin
 	}
 	return 0
 }
 
-func (m *FundTransactionResponse) GetChangePkScript() []byte {
+func (m *WalletInfoResponse_ScopeAccounts) GetAccounts() uint32 {
 	if m != nil {
-		return m.ChangePkScript
+		return m.Accounts
 	}
-	return nil
+	return 0
 }
 
-type FundTransactionResponse_PreviousOutput struct {
-	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
-	OutputIndex     uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
-	Amount          int64  `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
-	PkScript        []byte `protobuf:"bytes,4,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
-	ReceiveTime     int64  `protobuf:"varint,5,opt,name=receive_time,json=receiveTime" json:"receive_time,omitempty"`
-	FromCoinbase    bool   `protobuf:"varint,6,opt,name=from_coinbase,json=fromCoinbase" json:"from_coinbase,omitempty"`
+type GetTransactionsRequest struct {
+	// Optionally specify the starting block from which to begin including all transactions.
+	// Either the starting block hash or height may be specified, but not both.
+	// If a block height is specified and is negative, the absolute value becomes the number of
+	// last blocks to include.  That is, given a current chain height of 1000 and a starting block
+	// height of -3, transaction notifications will be created for blocks 998, 999, and 1000.
+	// If both options are excluded, transaction results are created for transactions since the
+	// genesis block.
+	StartingBlockHash   []byte `protobuf:"bytes,1,opt,name=starting_block_hash,json=startingBlockHash,proto3" json:"starting_block_hash,omitempty"`
+	StartingBlockHeight int32  `protobuf:"zigzag32,2,opt,name=starting_block_height,json=startingBlockHeight,proto3" json:"starting_block_height,omitempty"`
+	// Optionally specify the last block that transaction results may appear in.
+	// Either the ending block hash or height may be specified, but not both.
+	// If both are excluded, transaction results are created for all transactions
+	// through the best block, and include all unmined transactions.
+	EndingBlockHash   []byte `protobuf:"bytes,3,opt,name=ending_block_hash,json=endingBlockHash,proto3" json:"ending_block_hash,omitempty"`
+	EndingBlockHeight int32  `protobuf:"varint,4,opt,name=ending_block_height,json=endingBlockHeight,proto3" json:"ending_block_height,omitempty"`
+	// Include at least this many of the newest transactions if they exist.
+	// Cannot be used when the ending block hash is specified.
+	//
+	// TODO: remove until spec adds it back in some way.
+	MinimumRecentTransactions int32    `protobuf:"varint,5,opt,name=minimum_recent_transactions,json=minimumRecentTransactions,proto3" json:"minimum_recent_transactions,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
-func (m *FundTransactionResponse_PreviousOutput) Reset() {
-	*m = FundTransactionResponse_PreviousOutput{}
+func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{} }
+func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionsRequest) ProtoMessage()    {}
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
-func (m *FundTransactionResponse_PreviousOutput) String() string { return proto.CompactTextString(m) }
-func (*FundTransactionResponse_PreviousOutput) ProtoMessage()    {}
-func (*FundTransactionResponse_PreviousOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{28, 0}
+
+func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
+}
+func (m *GetTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionsRequest.Merge(m, src)
+}
+func (m *GetTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionsRequest.Size(m)
+}
+func (m *GetTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionsRequest.DiscardUnknown(m)
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetTransactionHash() []byte {
+var xxx_messageInfo_GetTransactionsRequest proto.InternalMessageInfo
+
+func (m *GetTransactionsRequest) GetStartingBlockHash() []byte {
 	if m != nil {
-		return m.TransactionHash
+		return m.StartingBlockHash
 	}
 	return nil
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetOutputIndex() uint32 {
+func (m *GetTransactionsRequest) GetStartingBlockHeight() int32 {
 	if m != nil {
-		return m.OutputIndex
+		return m.StartingBlockHeight
 	}
 	return 0
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetAmount() int64 {
+func (m *GetTransactionsRequest) GetEndingBlockHash() []byte {
 	if m != nil {
-		return m.Amount
+		return m.EndingBlockHash
 	}
-	return 0
+	return nil
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetPkScript() []byte {
+func (m *GetTransactionsRequest) GetEndingBlockHeight() int32 {
 	if m != nil {
-		return m.PkScript
+		return m.EndingBlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetReceiveTime() int64 {
+func (m *GetTransactionsRequest) GetMinimumRecentTransactions() int32 {
 	if m != nil {
-		return m.ReceiveTime
+		return m.MinimumRecentTransactions
 	}
 	return 0
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetFromCoinbase() bool {
-	if m != nil {
-		return m.FromCoinbase
-	}
-	return false
+type GetTransactionsResponse struct {
+	MinedTransactions      []*BlockDetails                 `protobuf:"bytes,1,rep,name=mined_transactions,json=minedTransactions,proto3" json:"mined_transactions,omitempty"`
+	UnminedTransactions    []*TransactionDetails           `protobuf:"bytes,2,rep,name=unmined_transactions,json=unminedTransactions,proto3" json:"unmined_transactions,omitempty"`
+	ConflictedTransactions []*ConflictedTransactionDetails `protobuf:"bytes,3,rep,name=conflicted_transactions,json=conflictedTransactions,proto3" json:"conflicted_transactions,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                        `json:"-"`
+	XXX_unrecognized       []byte                          `json:"-"`
+	XXX_sizecache          int32                           `json:"-"`
 }
 
-type SignTransactionRequest struct {
-	Passphrase            []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	SerializedTransaction []byte `protobuf:"bytes,2,opt,name=serialized_transaction,json=serializedTransaction,proto3" json:"serialized_transaction,omitempty"`
-	// If no indexes are specified, signatures scripts will be added for
-	// every input. If any input indexes are specified, only those inputs
-	// will be signed.  Rather than returning an incompletely signed
-	// transaction if any of the inputs to be signed can not be, the RPC
-	// immediately errors.
-	InputIndexes []uint32 `protobuf:"varint,3,rep,packed,name=input_indexes,json=inputIndexes" json:"input_indexes,omitempty"`
+func (m *GetTransactionsResponse) Reset()         { *m = GetTransactionsResponse{} }
+func (m *GetTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionsResponse) ProtoMessage()    {}
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+}
 
-	InputValues          []int64  `protobuf:"varint,4,rep,packed,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
+func (m *GetTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionsResponse.Unmarshal(m, b)
+}
+func (m *GetTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionsResponse.Merge(m, src)
+}
+func (m *GetTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionsResponse.Size(m)
+}
+func (m *GetTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionsResponse.DiscardUnknown(m)
 }
 
-func (m *SignTransactionRequest) Reset()                    { *m = SignTransactionRequest{} }
-func (m *SignTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*SignTransactionRequest) ProtoMessage()               {}
-func (*SignTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+var xxx_messageInfo_GetTransactionsResponse proto.InternalMessageInfo
 
-func (m *SignTransactionRequest) GetPassphrase() []byte {
+func (m *GetTransactionsResponse) GetMinedTransactions() []*BlockDetails {
 	if m != nil {
-		return m.Passphrase
+		return m.MinedTransactions
 	}
 	return nil
 }
 
-func (m *SignTransactionRequest) GetSerializedTransaction() []byte {
+func (m *GetTransactionsResponse) GetUnminedTransactions() []*TransactionDetails {
 	if m != nil {
-		return m.SerializedTransaction
+		return m.UnminedTransactions
 	}
 	return nil
 }
 
-func (m *SignTransactionRequest) GetInputIndexes() []uint32 {
+func (m *GetTransactionsResponse) GetConflictedTransactions() []*ConflictedTransactionDetails {
 	if m != nil {
-		return m.InputIndexes
+		return m.ConflictedTransactions
 	}
 	return nil
 }
 
-type SignTransactionResponse struct {
-	Transaction          []byte   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	UnsignedInputIndexes []uint32 `protobuf:"varint,2,rep,packed,name=unsigned_input_indexes,json=unsignedInputIndexes" json:"unsigned_input_indexes,omitempty"`
+type ChangePassphraseRequest struct {
+	Key                  ChangePassphraseRequest_Key `protobuf:"varint,1,opt,name=key,proto3,enum=walletrpc.ChangePassphraseRequest_Key" json:"key,omitempty"`
+	OldPassphrase        []byte                      `protobuf:"bytes,2,opt,name=old_passphrase,json=oldPassphrase,proto3" json:"old_passphrase,omitempty"`
+	NewPassphrase        []byte                      `protobuf:"bytes,3,opt,name=new_passphrase,json=newPassphrase,proto3" json:"new_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func (m *SignTransactionResponse) Reset()                    { *m = SignTransactionResponse{} }
-func (m *SignTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*SignTransactionResponse) ProtoMessage()               {}
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (m *ChangePassphraseRequest) Reset()         { *m = ChangePassphraseRequest{} }
+func (m *ChangePassphraseRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePassphraseRequest) ProtoMessage()    {}
+func (*ChangePassphraseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+}
 
-func (m *SignTransactionResponse) GetTransaction() []byte {
+func (m *ChangePassphraseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePassphraseRequest.Unmarshal(m, b)
+}
+func (m *ChangePassphraseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePassphraseRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangePassphraseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePassphraseRequest.Merge(m, src)
+}
+func (m *ChangePassphraseRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePassphraseRequest.Size(m)
+}
+func (m *ChangePassphraseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePassphraseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePassphraseRequest proto.InternalMessageInfo
+
+func (m *ChangePassphraseRequest) GetKey() ChangePassphraseRequest_Key {
 	if m != nil {
-		return m.Transaction
+		return m.Key
 	}
-	return nil
+	return ChangePassphraseRequest_PRIVATE
 }
 
-func (m *SignTransactionResponse) GetUnsignedInputIndexes() []uint32 {
+func (m *ChangePassphraseRequest) GetOldPassphrase() []byte {
 	if m != nil {
-		return m.UnsignedInputIndexes
+		return m.OldPassphrase
 	}
 	return nil
 }
 
-type PublishTransactionRequest struct {
-	SignedTransaction []byte `protobuf:"bytes,1,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
-}
-
-func (m *PublishTransactionRequest) Reset()                    { *m = PublishTransactionRequest{} }
-func (m *PublishTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*PublishTransactionRequest) ProtoMessage()               {}
-func (*PublishTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
-
-func (m *PublishTransactionRequest) GetSignedTransaction() []byte {
+func (m *ChangePassphraseRequest) GetNewPassphrase() []byte {
 	if m != nil {
-		return m.SignedTransaction
+		return m.NewPassphrase
 	}
 	return nil
 }
 
-type PublishTransactionResponse struct {
+type ChangePassphraseResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PublishTransactionResponse) Reset()                    { *m = PublishTransactionResponse{} }
-func (m *PublishTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*PublishTransactionResponse) ProtoMessage()               {}
-func (*PublishTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
-
-type TransactionNotificationsRequest struct {
+func (m *ChangePassphraseResponse) Reset()         { *m = ChangePassphraseResponse{} }
+func (m *ChangePassphraseResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangePassphraseResponse) ProtoMessage()    {}
+func (*ChangePassphraseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 
-func (m *TransactionNotificationsRequest) Reset()         { *m = TransactionNotificationsRequest{} }
-func (m *TransactionNotificationsRequest) String() string { return proto.CompactTextString(m) }
-func (*TransactionNotificationsRequest) ProtoMessage()    {}
-func (*TransactionNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{33}
+func (m *ChangePassphraseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePassphraseResponse.Unmarshal(m, b)
 }
-
-type TransactionNotificationsResponse struct {
-	// Sorted by increasing height.  This is a repeated field so many new blocks
-	// in a new best chain can be notified at once during a reorganize.
-	AttachedBlocks []*BlockDetails `protobuf:"bytes,1,rep,name=attached_blocks,json=attachedBlocks" json:"attached_blocks,omitempty"`
-	// If there was a chain reorganize, there may have been blocks with wallet
-	// transactions that are no longer in the best chain.  These are those
-	// block's hashes.
-	DetachedBlocks [][]byte `protobuf:"bytes,2,rep,name=detached_blocks,json=detachedBlocks,proto3" json:"detached_blocks,omitempty"`
-	// Any new unmined transactions are included here.  These unmined transactions
-	// refer to the current best chain, so transactions from detached blocks may
-	// be moved to mempool and included here if they are not mined or double spent
-	// in the new chain.  Additonally, if no new blocks were attached but a relevant
-	// unmined transaction is seen by the wallet, it will be reported here.
-	UnminedTransactions []*TransactionDetails `protobuf:"bytes,3,rep,name=unmined_transactions,json=unminedTransactions" json:"unmined_transactions,omitempty"`
-	// Instead of notifying all of the removed unmined transactions,
-	// just send all of the current hashes.
-	UnminedTransactionHashes [][]byte `protobuf:"bytes,4,rep,name=unmined_transaction_hashes,json=unminedTransactionHashes,proto3" json:"unmined_transaction_hashes,omitempty"`
+func (m *ChangePassphraseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePassphraseResponse.Marshal(b, m, deterministic)
 }
-
-func (m *TransactionNotificationsResponse) Reset()         { *m = TransactionNotificationsResponse{} }
-func (m *TransactionNotificationsResponse) String() string { return proto.CompactTextString(m) }
-func (*TransactionNotificationsResponse) ProtoMessage()    {}
-func (*TransactionNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34}
+func (m *ChangePassphraseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePassphraseResponse.Merge(m, src)
+}
+func (m *ChangePassphraseResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangePassphraseResponse.Size(m)
+}
+func (m *ChangePassphraseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePassphraseResponse.DiscardUnknown(m)
 }
 
-func (m *TransactionNotificationsResponse) GetAttachedBlocks() []*BlockDetails {
+var xxx_messageInfo_ChangePassphraseResponse proto.InternalMessageInfo
+
+type FundTransactionRequest struct {
+	Account                  uint32                               `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	TargetAmount             int64                                `protobuf:"varint,2,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	RequiredConfirmations    int32                                `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	IncludeImmatureCoinbases bool                                 `protobuf:"varint,4,opt,name=include_immature_coinbases,json=includeImmatureCoinbases,proto3" json:"include_immature_coinbases,omitempty"`
+	IncludeChangeScript      bool                                 `protobuf:"varint,5,opt,name=include_change_script,json=includeChangeScript,proto3" json:"include_change_script,omitempty"`
+	CoinSelection            FundTransactionRequest_CoinSelection `protobuf:"varint,6,opt,name=coin_selection,json=coinSelection,proto3,enum=walletrpc.FundTransactionRequest_CoinSelection" json:"coin_selection,omitempty"`
+	ConfTarget               uint32                               `protobuf:"varint,7,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	SubtractFee              bool                                 `protobuf:"varint,8,opt,name=subtract_fee,json=subtractFee,proto3" json:"subtract_fee,omitempty"`
+	SendAll                  bool                                 `protobuf:"varint,9,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	// Outputs of the account that are always selected, whether or not
+	// they are needed to reach the target amount.
+	RequiredInputs []*FundTransactionRequest_OutPoint `protobuf:"bytes,10,rep,name=required_inputs,json=requiredInputs,proto3" json:"required_inputs,omitempty"`
+	// The account of the change script when use_change_account is set.
+	// Change is returned to account otherwise.
+	UseChangeAccount bool   `protobuf:"varint,11,opt,name=use_change_account,json=useChangeAccount,proto3" json:"use_change_account,omitempty"`
+	ChangeAccount    uint32 `protobuf:"varint,12,opt,name=change_account,json=changeAccount,proto3" json:"change_account,omitempty"`
+	// A serialized unsigned transaction to fund.  When set, the target
+	// amount is the value of its outputs, its inputs are always selected,
+	// and the funded transaction is included in the response.
+	Transaction []byte `protobuf:"bytes,13,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The index of the change output of the funded transaction when
+	// use_change_position is set.  The change output is placed at a
+	// random index otherwise.
+	UseChangePosition bool   `protobuf:"varint,14,opt,name=use_change_position,json=useChangePosition,proto3" json:"use_change_position,omitempty"`
+	ChangePosition    uint32 `protobuf:"varint,15,opt,name=change_position,json=changePosition,proto3" json:"change_position,omitempty"`
+	// Data carried by a zero-value null-data output added to the funded
+	// transaction.  A new transaction is funded when no transaction is
+	// set.
+	Data                 []byte   `protobuf:"bytes,16,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundTransactionRequest) Reset()         { *m = FundTransactionRequest{} }
+func (m *FundTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*FundTransactionRequest) ProtoMessage()    {}
+func (*FundTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+}
+
+func (m *FundTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundTransactionRequest.Unmarshal(m, b)
+}
+func (m *FundTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *FundTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTransactionRequest.Merge(m, src)
+}
+func (m *FundTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_FundTransactionRequest.Size(m)
+}
+func (m *FundTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundTransactionRequest proto.InternalMessageInfo
+
+func (m *FundTransactionRequest) GetAccount() uint32 {
 	if m != nil {
-		return m.AttachedBlocks
+		return m.Account
 	}
-	return nil
+	return 0
 }
 
-func (m *TransactionNotificationsResponse) GetDetachedBlocks() [][]byte {
+func (m *FundTransactionRequest) GetTargetAmount() int64 {
 	if m != nil {
-		return m.DetachedBlocks
+		return m.TargetAmount
 	}
-	return nil
+	return 0
 }
 
-func (m *TransactionNotificationsResponse) GetUnminedTransactions() []*TransactionDetails {
+func (m *FundTransactionRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
-		return m.UnminedTransactions
+		return m.RequiredConfirmations
 	}
-	return nil
+	return 0
 }
 
-func (m *TransactionNotificationsResponse) GetUnminedTransactionHashes() [][]byte {
+func (m *FundTransactionRequest) GetIncludeImmatureCoinbases() bool {
 	if m != nil {
-		return m.UnminedTransactionHashes
+		return m.IncludeImmatureCoinbases
 	}
-	return nil
+	return false
 }
 
-type SpentnessNotificationsRequest struct {
-	Account         uint32 `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
-	NoNotifyUnspent bool   `protobuf:"varint,2,opt,name=no_notify_unspent,json=noNotifyUnspent" json:"no_notify_unspent,omitempty"`
-	NoNotifySpent   bool   `protobuf:"varint,3,opt,name=no_notify_spent,json=noNotifySpent" json:"no_notify_spent,omitempty"`
+func (m *FundTransactionRequest) GetIncludeChangeScript() bool {
+	if m != nil {
+		return m.IncludeChangeScript
+	}
+	return false
 }
 
-func (m *SpentnessNotificationsRequest) Reset()                    { *m = SpentnessNotificationsRequest{} }
-func (m *SpentnessNotificationsRequest) String() string            { return proto.CompactTextString(m) }
-func (*SpentnessNotificationsRequest) ProtoMessage()               {}
-func (*SpentnessNotificationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (m *FundTransactionRequest) GetCoinSelection() FundTransactionRequest_CoinSelection {
+	if m != nil {
+		return m.CoinSelection
+	}
+	return FundTransactionRequest_DEFAULT
+}
 
-func (m *SpentnessNotificationsRequest) GetAccount() uint32 {
+func (m *FundTransactionRequest) GetConfTarget() uint32 {
 	if m != nil {
-		return m.Account
+		return m.ConfTarget
 	}
 	return 0
 }
 
-func (m *SpentnessNotificationsRequest) GetNoNotifyUnspent() bool {
+func (m *FundTransactionRequest) GetSubtractFee() bool {
 	if m != nil {
-		return m.NoNotifyUnspent
+		return m.SubtractFee
 	}
 	return false
 }
 
-func (m *SpentnessNotificationsRequest) GetNoNotifySpent() bool {
+func (m *FundTransactionRequest) GetSendAll() bool {
 	if m != nil {
-		return m.NoNotifySpent
+		return m.SendAll
 	}
 	return false
 }
 
-type SpentnessNotificationsResponse struct {
-	TransactionHash []byte                                  `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
-	OutputIndex     uint32                                  `protobuf:"varint,2,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
-	Spender         *SpentnessNotificationsResponse_Spender `protobuf:"bytes,3,opt,name=spender" json:"spender,omitempty"`
+func (m *FundTransactionRequest) GetRequiredInputs() []*FundTransactionRequest_OutPoint {
+	if m != nil {
+		return m.RequiredInputs
+	}
+	return nil
 }
 
-func (m *SpentnessNotificationsResponse) Reset()         { *m = SpentnessNotificationsResponse{} }
-func (m *SpentnessNotificationsResponse) String() string { return proto.CompactTextString(m) }
-func (*SpentnessNotificationsResponse) ProtoMessage()    {}
-func (*SpentnessNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{36}
+func (m *FundTransactionRequest) GetUseChangeAccount() bool {
+	if m != nil {
+		return m.UseChangeAccount
+	}
+	return false
 }
 
-func (m *SpentnessNotificationsResponse) GetTransactionHash() []byte {
+func (m *FundTransactionRequest) GetChangeAccount() uint32 {
 	if m != nil {
-		return m.TransactionHash
+		return m.ChangeAccount
+	}
+	return 0
+}
+
+func (m *FundTransactionRequest) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
 	}
 	return nil
 }
 
-func (m *SpentnessNotificationsResponse) GetOutputIndex() uint32 {
+func (m *FundTransactionRequest) GetUseChangePosition() bool {
 	if m != nil {
-		return m.OutputIndex
+		return m.UseChangePosition
+	}
+	return false
+}
+
+func (m *FundTransactionRequest) GetChangePosition() uint32 {
+	if m != nil {
+		return m.ChangePosition
 	}
 	return 0
 }
 
-func (m *SpentnessNotificationsResponse) GetSpender() *SpentnessNotificationsResponse_Spender {
+func (m *FundTransactionRequest) GetData() []byte {
 	if m != nil {
-		return m.Spender
+		return m.Data
 	}
 	return nil
 }
 
-type SpentnessNotificationsResponse_Spender struct {
-	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
-	InputIndex      uint32 `protobuf:"varint,2,opt,name=input_index,json=inputIndex" json:"input_index,omitempty"`
+type FundTransactionRequest_OutPoint struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SpentnessNotificationsResponse_Spender) Reset() {
-	*m = SpentnessNotificationsResponse_Spender{}
+func (m *FundTransactionRequest_OutPoint) Reset()         { *m = FundTransactionRequest_OutPoint{} }
+func (m *FundTransactionRequest_OutPoint) String() string { return proto.CompactTextString(m) }
+func (*FundTransactionRequest_OutPoint) ProtoMessage()    {}
+func (*FundTransactionRequest_OutPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32, 0}
 }
-func (m *SpentnessNotificationsResponse_Spender) String() string { return proto.CompactTextString(m) }
-func (*SpentnessNotificationsResponse_Spender) ProtoMessage()    {}
-func (*SpentnessNotificationsResponse_Spender) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{36, 0}
+
+func (m *FundTransactionRequest_OutPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundTransactionRequest_OutPoint.Unmarshal(m, b)
+}
+func (m *FundTransactionRequest_OutPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundTransactionRequest_OutPoint.Marshal(b, m, deterministic)
+}
+func (m *FundTransactionRequest_OutPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTransactionRequest_OutPoint.Merge(m, src)
+}
+func (m *FundTransactionRequest_OutPoint) XXX_Size() int {
+	return xxx_messageInfo_FundTransactionRequest_OutPoint.Size(m)
+}
+func (m *FundTransactionRequest_OutPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTransactionRequest_OutPoint.DiscardUnknown(m)
 }
 
-func (m *SpentnessNotificationsResponse_Spender) GetTransactionHash() []byte {
+var xxx_messageInfo_FundTransactionRequest_OutPoint proto.InternalMessageInfo
+
+func (m *FundTransactionRequest_OutPoint) GetTransactionHash() []byte {
 	if m != nil {
 		return m.TransactionHash
 	}
 	return nil
 }
 
-func (m *SpentnessNotificationsResponse_Spender) GetInputIndex() uint32 {
+func (m *FundTransactionRequest_OutPoint) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.InputIndex
+		return m.OutputIndex
 	}
 	return 0
 }
 
-type AccountNotificationsRequest struct {
+type FundTransactionResponse struct {
+	SelectedOutputs []*FundTransactionResponse_PreviousOutput `protobuf:"bytes,1,rep,name=selected_outputs,json=selectedOutputs,proto3" json:"selected_outputs,omitempty"`
+	TotalAmount     int64                                     `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	ChangePkScript  []byte                                    `protobuf:"bytes,3,opt,name=change_pk_script,json=changePkScript,proto3" json:"change_pk_script,omitempty"`
+	FeeRate         int64                                     `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// The unsigned funded transaction, and the index of its change output
+	// or -1 if it has none, when a transaction was funded.
+	FundedTransaction    []byte   `protobuf:"bytes,5,opt,name=funded_transaction,json=fundedTransaction,proto3" json:"funded_transaction,omitempty"`
+	ChangeOutputIndex    int32    `protobuf:"varint,6,opt,name=change_output_index,json=changeOutputIndex,proto3" json:"change_output_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountNotificationsRequest) Reset()                    { *m = AccountNotificationsRequest{} }
-func (m *AccountNotificationsRequest) String() string            { return proto.CompactTextString(m) }
-func (*AccountNotificationsRequest) ProtoMessage()               {}
-func (*AccountNotificationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (m *FundTransactionResponse) Reset()         { *m = FundTransactionResponse{} }
+func (m *FundTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*FundTransactionResponse) ProtoMessage()    {}
+func (*FundTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
+}
 
-type AccountNotificationsResponse struct {
-	AccountNumber    uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber" json:"account_number,omitempty"`
-	AccountName      string `protobuf:"bytes,2,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
-	ExternalKeyCount uint32 `protobuf:"varint,3,opt,name=external_key_count,json=externalKeyCount" json:"external_key_count,omitempty"`
-	InternalKeyCount uint32 `protobuf:"varint,4,opt,name=internal_key_count,json=internalKeyCount" json:"internal_key_count,omitempty"`
-	ImportedKeyCount uint32 `protobuf:"varint,5,opt,name=imported_key_count,json=importedKeyCount" json:"imported_key_count,omitempty"`
+func (m *FundTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundTransactionResponse.Unmarshal(m, b)
+}
+func (m *FundTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *FundTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTransactionResponse.Merge(m, src)
+}
+func (m *FundTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_FundTransactionResponse.Size(m)
+}
+func (m *FundTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTransactionResponse.DiscardUnknown(m)
 }
 
-func (m *AccountNotificationsResponse) Reset()                    { *m = AccountNotificationsResponse{} }
-func (m *AccountNotificationsResponse) String() string            { return proto.CompactTextString(m) }
-func (*AccountNotificationsResponse) ProtoMessage()               {}
-func (*AccountNotificationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+var xxx_messageInfo_FundTransactionResponse proto.InternalMessageInfo
 
-func (m *AccountNotificationsResponse) GetAccountNumber() uint32 {
+func (m *FundTransactionResponse) GetSelectedOutputs() []*FundTransactionResponse_PreviousOutput {
 	if m != nil {
-		return m.AccountNumber
+		return m.SelectedOutputs
 	}
-	return 0
+	return nil
 }
 
-func (m *AccountNotificationsResponse) GetAccountName() string {
+func (m *FundTransactionResponse) GetTotalAmount() int64 {
 	if m != nil {
-		return m.AccountName
+		return m.TotalAmount
 	}
-	return ""
+	return 0
 }
 
-func (m *AccountNotificationsResponse) GetExternalKeyCount() uint32 {
+func (m *FundTransactionResponse) GetChangePkScript() []byte {
 	if m != nil {
-		return m.ExternalKeyCount
+		return m.ChangePkScript
 	}
-	return 0
+	return nil
 }
 
-func (m *AccountNotificationsResponse) GetInternalKeyCount() uint32 {
+func (m *FundTransactionResponse) GetFeeRate() int64 {
 	if m != nil {
-		return m.InternalKeyCount
+		return m.FeeRate
 	}
 	return 0
 }
 
-func (m *AccountNotificationsResponse) GetImportedKeyCount() uint32 {
+func (m *FundTransactionResponse) GetFundedTransaction() []byte {
 	if m != nil {
-		return m.ImportedKeyCount
+		return m.FundedTransaction
+	}
+	return nil
+}
+
+func (m *FundTransactionResponse) GetChangeOutputIndex() int32 {
+	if m != nil {
+		return m.ChangeOutputIndex
 	}
 	return 0
 }
 
-type CreateWalletRequest struct {
-	PublicPassphrase  []byte `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
-	PrivatePassphrase []byte `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
-	Seed              []byte `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+type FundTransactionResponse_PreviousOutput struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	PkScript             []byte   `protobuf:"bytes,4,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	ReceiveTime          int64    `protobuf:"varint,5,opt,name=receive_time,json=receiveTime,proto3" json:"receive_time,omitempty"`
+	FromCoinbase         bool     `protobuf:"varint,6,opt,name=from_coinbase,json=fromCoinbase,proto3" json:"from_coinbase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundTransactionResponse_PreviousOutput) Reset() {
+	*m = FundTransactionResponse_PreviousOutput{}
+}
+func (m *FundTransactionResponse_PreviousOutput) String() string { return proto.CompactTextString(m) }
+func (*FundTransactionResponse_PreviousOutput) ProtoMessage()    {}
+func (*FundTransactionResponse_PreviousOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33, 0}
+}
+
+func (m *FundTransactionResponse_PreviousOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Unmarshal(m, b)
+}
+func (m *FundTransactionResponse_PreviousOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Marshal(b, m, deterministic)
+}
+func (m *FundTransactionResponse_PreviousOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTransactionResponse_PreviousOutput.Merge(m, src)
+}
+func (m *FundTransactionResponse_PreviousOutput) XXX_Size() int {
+	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Size(m)
+}
+func (m *FundTransactionResponse_PreviousOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTransactionResponse_PreviousOutput.DiscardUnknown(m)
 }
 
-func (m *CreateWalletRequest) Reset()                    { *m = CreateWalletRequest{} }
-func (m *CreateWalletRequest) String() string            { return proto.CompactTextString(m) }
-func (*CreateWalletRequest) ProtoMessage()               {}
-func (*CreateWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+var xxx_messageInfo_FundTransactionResponse_PreviousOutput proto.InternalMessageInfo
 
-func (m *CreateWalletRequest) GetPublicPassphrase() []byte {
+func (m *FundTransactionResponse_PreviousOutput) GetTransactionHash() []byte {
 	if m != nil {
-		return m.PublicPassphrase
+		return m.TransactionHash
 	}
 	return nil
 }
 
-func (m *CreateWalletRequest) GetPrivatePassphrase() []byte {
+func (m *FundTransactionResponse_PreviousOutput) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.PrivatePassphrase
+		return m.OutputIndex
 	}
-	return nil
+	return 0
 }
 
-func (m *CreateWalletRequest) GetSeed() []byte {
+func (m *FundTransactionResponse_PreviousOutput) GetAmount() int64 {
 	if m != nil {
-		return m.Seed
+		return m.Amount
 	}
-	return nil
+	return 0
 }
 
-type CreateWalletResponse struct {
+func (m *FundTransactionResponse_PreviousOutput) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
+	}
+	return nil
 }
 
-func (m *CreateWalletResponse) Reset()                    { *m = CreateWalletResponse{} }
-func (m *CreateWalletResponse) String() string            { return proto.CompactTextString(m) }
-func (*CreateWalletResponse) ProtoMessage()               {}
-func (*CreateWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
-
-type OpenWalletRequest struct {
-	PublicPassphrase []byte `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+func (m *FundTransactionResponse_PreviousOutput) GetReceiveTime() int64 {
+	if m != nil {
+		return m.ReceiveTime
+	}
+	return 0
 }
 
-func (m *OpenWalletRequest) Reset()                    { *m = OpenWalletRequest{} }
-func (m *OpenWalletRequest) String() string            { return proto.CompactTextString(m) }
-func (*OpenWalletRequest) ProtoMessage()               {}
-func (*OpenWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
-
-func (m *OpenWalletRequest) GetPublicPassphrase() []byte {
+func (m *FundTransactionResponse_PreviousOutput) GetFromCoinbase() bool {
 	if m != nil {
-		return m.PublicPassphrase
+		return m.FromCoinbase
 	}
-	return nil
+	return false
 }
 
-type OpenWalletResponse struct {
+type PreviewTransactionRequest struct {
+	Account               uint32                              `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	Outputs               []*PreviewTransactionRequest_Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	RequiredConfirmations int32                               `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	// Fee rate in satoshis per kilobyte.  The fee rate estimated for
+	// conf_target is used when unset.
+	FeeRate              int64                                `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	ConfTarget           uint32                               `protobuf:"varint,5,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	CoinSelection        FundTransactionRequest_CoinSelection `protobuf:"varint,6,opt,name=coin_selection,json=coinSelection,proto3,enum=walletrpc.FundTransactionRequest_CoinSelection" json:"coin_selection,omitempty"`
+	SubtractFeeFrom      []uint32                             `protobuf:"varint,7,rep,packed,name=subtract_fee_from,json=subtractFeeFrom,proto3" json:"subtract_fee_from,omitempty"`
+	SendAll              bool                                 `protobuf:"varint,8,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
 }
 
-func (m *OpenWalletResponse) Reset()                    { *m = OpenWalletResponse{} }
-func (m *OpenWalletResponse) String() string            { return proto.CompactTextString(m) }
-func (*OpenWalletResponse) ProtoMessage()               {}
-func (*OpenWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
-
-type CloseWalletRequest struct {
+func (m *PreviewTransactionRequest) Reset()         { *m = PreviewTransactionRequest{} }
+func (m *PreviewTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*PreviewTransactionRequest) ProtoMessage()    {}
+func (*PreviewTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 
-func (m *CloseWalletRequest) Reset()                    { *m = CloseWalletRequest{} }
-func (m *CloseWalletRequest) String() string            { return proto.CompactTextString(m) }
-func (*CloseWalletRequest) ProtoMessage()               {}
-func (*CloseWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
-
-type CloseWalletResponse struct {
+func (m *PreviewTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewTransactionRequest.Unmarshal(m, b)
 }
-
-func (m *CloseWalletResponse) Reset()                    { *m = CloseWalletResponse{} }
-func (m *CloseWalletResponse) String() string            { return proto.CompactTextString(m) }
-func (*CloseWalletResponse) ProtoMessage()               {}
-func (*CloseWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
-
-type WalletExistsRequest struct {
+func (m *PreviewTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *PreviewTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewTransactionRequest.Merge(m, src)
+}
+func (m *PreviewTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_PreviewTransactionRequest.Size(m)
+}
+func (m *PreviewTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewTransactionRequest.DiscardUnknown(m)
 }
 
-func (m *WalletExistsRequest) Reset()                    { *m = WalletExistsRequest{} }
-func (m *WalletExistsRequest) String() string            { return proto.CompactTextString(m) }
-func (*WalletExistsRequest) ProtoMessage()               {}
-func (*WalletExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+var xxx_messageInfo_PreviewTransactionRequest proto.InternalMessageInfo
 
-type WalletExistsResponse struct {
-	Exists bool `protobuf:"varint,1,opt,name=exists" json:"exists,omitempty"`
+func (m *PreviewTransactionRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
 }
 
-func (m *WalletExistsResponse) Reset()                    { *m = WalletExistsResponse{} }
-func (m *WalletExistsResponse) String() string            { return proto.CompactTextString(m) }
-func (*WalletExistsResponse) ProtoMessage()               {}
-func (*WalletExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
-
-func (m *WalletExistsResponse) GetExists() bool {
+func (m *PreviewTransactionRequest) GetOutputs() []*PreviewTransactionRequest_Output {
 	if m != nil {
-		return m.Exists
+		return m.Outputs
 	}
-	return false
+	return nil
 }
 
-type StartConsensusRpcRequest struct {
-	NetworkAddress string `protobuf:"bytes,1,opt,name=network_address,json=networkAddress" json:"network_address,omitempty"`
-	Username       string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
-	Password       []byte `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Certificate    []byte `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+func (m *PreviewTransactionRequest) GetRequiredConfirmations() int32 {
+	if m != nil {
+		return m.RequiredConfirmations
+	}
+	return 0
 }
 
-func (m *StartConsensusRpcRequest) Reset()                    { *m = StartConsensusRpcRequest{} }
-func (m *StartConsensusRpcRequest) String() string            { return proto.CompactTextString(m) }
-func (*StartConsensusRpcRequest) ProtoMessage()               {}
-func (*StartConsensusRpcRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (m *PreviewTransactionRequest) GetFeeRate() int64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
 
-func (m *StartConsensusRpcRequest) GetNetworkAddress() string {
+func (m *PreviewTransactionRequest) GetConfTarget() uint32 {
 	if m != nil {
-		return m.NetworkAddress
+		return m.ConfTarget
 	}
-	return ""
+	return 0
 }
 
-func (m *StartConsensusRpcRequest) GetUsername() string {
+func (m *PreviewTransactionRequest) GetCoinSelection() FundTransactionRequest_CoinSelection {
 	if m != nil {
-		return m.Username
+		return m.CoinSelection
 	}
-	return ""
+	return FundTransactionRequest_DEFAULT
 }
 
-func (m *StartConsensusRpcRequest) GetPassword() []byte {
+func (m *PreviewTransactionRequest) GetSubtractFeeFrom() []uint32 {
 	if m != nil {
-		return m.Password
+		return m.SubtractFeeFrom
 	}
 	return nil
 }
 
-func (m *StartConsensusRpcRequest) GetCertificate() []byte {
+func (m *PreviewTransactionRequest) GetSendAll() bool {
 	if m != nil {
-		return m.Certificate
+		return m.SendAll
 	}
-	return nil
+	return false
 }
 
-type StartConsensusRpcResponse struct {
+type PreviewTransactionRequest_Output struct {
+	PkScript             []byte   `protobuf:"bytes,1,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StartConsensusRpcResponse) Reset()                    { *m = StartConsensusRpcResponse{} }
-func (m *StartConsensusRpcResponse) String() string            { return proto.CompactTextString(m) }
-func (*StartConsensusRpcResponse) ProtoMessage()               {}
-func (*StartConsensusRpcResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (m *PreviewTransactionRequest_Output) Reset()         { *m = PreviewTransactionRequest_Output{} }
+func (m *PreviewTransactionRequest_Output) String() string { return proto.CompactTextString(m) }
+func (*PreviewTransactionRequest_Output) ProtoMessage()    {}
+func (*PreviewTransactionRequest_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34, 0}
+}
 
-func init() {
-	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
-	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
-	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
-	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
-	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
-	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
-	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
-	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
-	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
-	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
-	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
-	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
-	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
-	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
-	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
-	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
-	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
-	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
-	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
-	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
-	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
-	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
-	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
-	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
-	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
-	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
-	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
-	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
-	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
-	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
-	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
-	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
-	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
-	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
-	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
-	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
-	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
-	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
-	proto.RegisterType((*SpentnessNotificationsRequest)(nil), "walletrpc.SpentnessNotificationsRequest")
-	proto.RegisterType((*SpentnessNotificationsResponse)(nil), "walletrpc.SpentnessNotificationsResponse")
-	proto.RegisterType((*SpentnessNotificationsResponse_Spender)(nil), "walletrpc.SpentnessNotificationsResponse.Spender")
-	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
-	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
-	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
-	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
-	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
-	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
-	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
-	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
-	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
-	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
-	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
-	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
-	proto.RegisterEnum("walletrpc.NextAddressRequest_Kind", NextAddressRequest_Kind_name, NextAddressRequest_Kind_value)
-	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
+func (m *PreviewTransactionRequest_Output) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewTransactionRequest_Output.Unmarshal(m, b)
+}
+func (m *PreviewTransactionRequest_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewTransactionRequest_Output.Marshal(b, m, deterministic)
+}
+func (m *PreviewTransactionRequest_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewTransactionRequest_Output.Merge(m, src)
+}
+func (m *PreviewTransactionRequest_Output) XXX_Size() int {
+	return xxx_messageInfo_PreviewTransactionRequest_Output.Size(m)
+}
+func (m *PreviewTransactionRequest_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewTransactionRequest_Output.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_PreviewTransactionRequest_Output proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *PreviewTransactionRequest_Output) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
+	}
+	return nil
+}
 
-// Client API for VersionService service
+func (m *PreviewTransactionRequest_Output) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
 
-type VersionServiceClient interface {
-	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+type PreviewTransactionResponse struct {
+	UnsignedTransaction []byte                              `protobuf:"bytes,1,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
+	Inputs              []*PreviewTransactionResponse_Input `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	EstimatedSize       int64                               `protobuf:"varint,3,opt,name=estimated_size,json=estimatedSize,proto3" json:"estimated_size,omitempty"`
+	Fee                 int64                               `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeRate             int64                               `protobuf:"varint,5,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	ChangeAmount        int64                               `protobuf:"varint,6,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
+	// The index of the change output, or -1 if there is no change.
+	ChangeOutputIndex    int32    `protobuf:"varint,7,opt,name=change_output_index,json=changeOutputIndex,proto3" json:"change_output_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type versionServiceClient struct {
-	cc *grpc.ClientConn
+func (m *PreviewTransactionResponse) Reset()         { *m = PreviewTransactionResponse{} }
+func (m *PreviewTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*PreviewTransactionResponse) ProtoMessage()    {}
+func (*PreviewTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 
-func NewVersionServiceClient(cc *grpc.ClientConn) VersionServiceClient {
-	return &versionServiceClient{cc}
+func (m *PreviewTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewTransactionResponse.Unmarshal(m, b)
+}
+func (m *PreviewTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *PreviewTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewTransactionResponse.Merge(m, src)
+}
+func (m *PreviewTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_PreviewTransactionResponse.Size(m)
+}
+func (m *PreviewTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewTransactionResponse.DiscardUnknown(m)
 }
 
-func (c *versionServiceClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.VersionService/Version", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PreviewTransactionResponse proto.InternalMessageInfo
+
+func (m *PreviewTransactionResponse) GetUnsignedTransaction() []byte {
+	if m != nil {
+		return m.UnsignedTransaction
 	}
-	return out, nil
+	return nil
 }
 
-// Server API for VersionService service
-
-type VersionServiceServer interface {
-	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+func (m *PreviewTransactionResponse) GetInputs() []*PreviewTransactionResponse_Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
 }
 
-func RegisterVersionServiceServer(s *grpc.Server, srv VersionServiceServer) {
-	s.RegisterService(&_VersionService_serviceDesc, srv)
+func (m *PreviewTransactionResponse) GetEstimatedSize() int64 {
+	if m != nil {
+		return m.EstimatedSize
+	}
+	return 0
 }
 
-func _VersionService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PreviewTransactionResponse) GetFee() int64 {
+	if m != nil {
+		return m.Fee
 	}
-	if interceptor == nil {
-		return srv.(VersionServiceServer).Version(ctx, in)
+	return 0
+}
+
+func (m *PreviewTransactionResponse) GetFeeRate() int64 {
+	if m != nil {
+		return m.FeeRate
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/walletrpc.VersionService/Version",
+	return 0
+}
+
+func (m *PreviewTransactionResponse) GetChangeAmount() int64 {
+	if m != nil {
+		return m.ChangeAmount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VersionServiceServer).Version(ctx, req.(*VersionRequest))
+	return 0
+}
+
+func (m *PreviewTransactionResponse) GetChangeOutputIndex() int32 {
+	if m != nil {
+		return m.ChangeOutputIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _VersionService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "walletrpc.VersionService",
-	HandlerType: (*VersionServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Version",
-			Handler:    _VersionService_Version_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "api.proto",
+type PreviewTransactionResponse_Input struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	PkScript             []byte   `protobuf:"bytes,4,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Client API for WalletService service
+func (m *PreviewTransactionResponse_Input) Reset()         { *m = PreviewTransactionResponse_Input{} }
+func (m *PreviewTransactionResponse_Input) String() string { return proto.CompactTextString(m) }
+func (*PreviewTransactionResponse_Input) ProtoMessage()    {}
+func (*PreviewTransactionResponse_Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35, 0}
+}
 
-type WalletServiceClient interface {
-	// Queries
-	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
-	Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error)
-	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
-	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
-	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
-	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
-	// Notifications
-	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error)
-	SpentnessNotifications(ctx context.Context, in *SpentnessNotificationsRequest, opts ...grpc.CallOption) (WalletService_SpentnessNotificationsClient, error)
-	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error)
-	// Control
-	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
-	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
-	NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error)
-	NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error)
-	ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error)
-	FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error)
-	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
-	PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error)
+func (m *PreviewTransactionResponse_Input) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewTransactionResponse_Input.Unmarshal(m, b)
 }
-
-type walletServiceClient struct {
-	cc *grpc.ClientConn
+func (m *PreviewTransactionResponse_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewTransactionResponse_Input.Marshal(b, m, deterministic)
 }
-
-func NewWalletServiceClient(cc *grpc.ClientConn) WalletServiceClient {
-	return &walletServiceClient{cc}
+func (m *PreviewTransactionResponse_Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewTransactionResponse_Input.Merge(m, src)
+}
+func (m *PreviewTransactionResponse_Input) XXX_Size() int {
+	return xxx_messageInfo_PreviewTransactionResponse_Input.Size(m)
+}
+func (m *PreviewTransactionResponse_Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewTransactionResponse_Input.DiscardUnknown(m)
 }
 
-func (c *walletServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
-	out := new(PingResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Ping", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PreviewTransactionResponse_Input proto.InternalMessageInfo
+
+func (m *PreviewTransactionResponse_Input) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletServiceClient) Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error) {
-	out := new(NetworkResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Network", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PreviewTransactionResponse_Input) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletServiceClient) AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error) {
-	out := new(AccountNumberResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/AccountNumber", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PreviewTransactionResponse_Input) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletServiceClient) Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
-	out := new(AccountsResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Accounts", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PreviewTransactionResponse_Input) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
-	out := new(BalanceResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Balance", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SignTransactionRequest struct {
+	Passphrase            []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	SerializedTransaction []byte `protobuf:"bytes,2,opt,name=serialized_transaction,json=serializedTransaction,proto3" json:"serialized_transaction,omitempty"`
+	// If no indexes are specified, signatures scripts will be added for
+	// every input. If any input indexes are specified, only those inputs
+	// will be signed.  Rather than returning an incompletely signed
+	// transaction if any of the inputs to be signed can not be, the RPC
+	// immediately errors.
+	InputIndexes []uint32 `protobuf:"varint,3,rep,packed,name=input_indexes,json=inputIndexes,proto3" json:"input_indexes,omitempty"`
+	// The values of the outputs spent by each input, in input order.  These
+	// are required to compute the signature hashes.
+	InputValues          []int64  `protobuf:"varint,4,rep,packed,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *walletServiceClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
-	out := new(GetTransactionsResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/GetTransactions", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *SignTransactionRequest) Reset()         { *m = SignTransactionRequest{} }
+func (m *SignTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SignTransactionRequest) ProtoMessage()    {}
+func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+}
+
+func (m *SignTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTransactionRequest.Unmarshal(m, b)
+}
+func (m *SignTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *SignTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTransactionRequest.Merge(m, src)
+}
+func (m *SignTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_SignTransactionRequest.Size(m)
+}
+func (m *SignTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTransactionRequest proto.InternalMessageInfo
+
+func (m *SignTransactionRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletServiceClient) TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[0], c.cc, "/walletrpc.WalletService/TransactionNotifications", opts...)
-	if err != nil {
-		return nil, err
+func (m *SignTransactionRequest) GetSerializedTransaction() []byte {
+	if m != nil {
+		return m.SerializedTransaction
 	}
-	x := &walletServiceTransactionNotificationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *SignTransactionRequest) GetInputIndexes() []uint32 {
+	if m != nil {
+		return m.InputIndexes
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *SignTransactionRequest) GetInputValues() []int64 {
+	if m != nil {
+		return m.InputValues
 	}
-	return x, nil
+	return nil
 }
 
-type WalletService_TransactionNotificationsClient interface {
-	Recv() (*TransactionNotificationsResponse, error)
-	grpc.ClientStream
+type SignTransactionResponse struct {
+	Transaction          []byte   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	UnsignedInputIndexes []uint32 `protobuf:"varint,2,rep,packed,name=unsigned_input_indexes,json=unsignedInputIndexes,proto3" json:"unsigned_input_indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type walletServiceTransactionNotificationsClient struct {
-	grpc.ClientStream
+func (m *SignTransactionResponse) Reset()         { *m = SignTransactionResponse{} }
+func (m *SignTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SignTransactionResponse) ProtoMessage()    {}
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
 
-func (x *walletServiceTransactionNotificationsClient) Recv() (*TransactionNotificationsResponse, error) {
-	m := new(TransactionNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *SignTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTransactionResponse.Unmarshal(m, b)
+}
+func (m *SignTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *SignTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTransactionResponse.Merge(m, src)
+}
+func (m *SignTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_SignTransactionResponse.Size(m)
+}
+func (m *SignTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTransactionResponse.DiscardUnknown(m)
 }
 
-func (c *walletServiceClient) SpentnessNotifications(ctx context.Context, in *SpentnessNotificationsRequest, opts ...grpc.CallOption) (WalletService_SpentnessNotificationsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[1], c.cc, "/walletrpc.WalletService/SpentnessNotifications", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &walletServiceSpentnessNotificationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+var xxx_messageInfo_SignTransactionResponse proto.InternalMessageInfo
+
+func (m *SignTransactionResponse) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *SignTransactionResponse) GetUnsignedInputIndexes() []uint32 {
+	if m != nil {
+		return m.UnsignedInputIndexes
 	}
-	return x, nil
+	return nil
 }
 
-type WalletService_SpentnessNotificationsClient interface {
-	Recv() (*SpentnessNotificationsResponse, error)
-	grpc.ClientStream
+type PublishTransactionRequest struct {
+	SignedTransaction    []byte   `protobuf:"bytes,1,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type walletServiceSpentnessNotificationsClient struct {
-	grpc.ClientStream
+func (m *PublishTransactionRequest) Reset()         { *m = PublishTransactionRequest{} }
+func (m *PublishTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishTransactionRequest) ProtoMessage()    {}
+func (*PublishTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
 
-func (x *walletServiceSpentnessNotificationsClient) Recv() (*SpentnessNotificationsResponse, error) {
-	m := new(SpentnessNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *PublishTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishTransactionRequest.Unmarshal(m, b)
+}
+func (m *PublishTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishTransactionRequest.Merge(m, src)
+}
+func (m *PublishTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishTransactionRequest.Size(m)
+}
+func (m *PublishTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishTransactionRequest.DiscardUnknown(m)
 }
 
-func (c *walletServiceClient) AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[2], c.cc, "/walletrpc.WalletService/AccountNotifications", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &walletServiceAccountNotificationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_PublishTransactionRequest proto.InternalMessageInfo
+
+func (m *PublishTransactionRequest) GetSignedTransaction() []byte {
+	if m != nil {
+		return m.SignedTransaction
 	}
-	return x, nil
+	return nil
 }
 
-type WalletService_AccountNotificationsClient interface {
-	Recv() (*AccountNotificationsResponse, error)
-	grpc.ClientStream
+type PublishTransactionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type walletServiceAccountNotificationsClient struct {
-	grpc.ClientStream
+func (m *PublishTransactionResponse) Reset()         { *m = PublishTransactionResponse{} }
+func (m *PublishTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*PublishTransactionResponse) ProtoMessage()    {}
+func (*PublishTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
 
-func (x *walletServiceAccountNotificationsClient) Recv() (*AccountNotificationsResponse, error) {
-	m := new(AccountNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *PublishTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishTransactionResponse.Unmarshal(m, b)
 }
-
-func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
-	out := new(ChangePassphraseResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ChangePassphrase", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PublishTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *PublishTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishTransactionResponse.Merge(m, src)
+}
+func (m *PublishTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_PublishTransactionResponse.Size(m)
+}
+func (m *PublishTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishTransactionResponse.DiscardUnknown(m)
 }
 
-func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error) {
-	out := new(RenameAccountResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/RenameAccount", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_PublishTransactionResponse proto.InternalMessageInfo
+
+type BumpFeeRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	TransactionHash      []byte   `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	FeeRate              int64    `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *walletServiceClient) NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error) {
-	out := new(NextAccountResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/NextAccount", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BumpFeeRequest) Reset()         { *m = BumpFeeRequest{} }
+func (m *BumpFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*BumpFeeRequest) ProtoMessage()    {}
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 
-func (c *walletServiceClient) NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error) {
-	out := new(NextAddressResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/NextAddress", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BumpFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpFeeRequest.Unmarshal(m, b)
+}
+func (m *BumpFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpFeeRequest.Marshal(b, m, deterministic)
+}
+func (m *BumpFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpFeeRequest.Merge(m, src)
+}
+func (m *BumpFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_BumpFeeRequest.Size(m)
+}
+func (m *BumpFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpFeeRequest.DiscardUnknown(m)
 }
 
-func (c *walletServiceClient) ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error) {
-	out := new(ImportPrivateKeyResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ImportPrivateKey", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BumpFeeRequest proto.InternalMessageInfo
+
+func (m *BumpFeeRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error) {
-	out := new(FundTransactionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/FundTransaction", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *BumpFeeRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletServiceClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
-	out := new(SignTransactionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SignTransaction", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *BumpFeeRequest) GetFeeRate() int64 {
+	if m != nil {
+		return m.FeeRate
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletServiceClient) PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error) {
-	out := new(PublishTransactionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/PublishTransaction", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BumpFeeResponse struct {
+	Transaction          []byte   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	TransactionHash      []byte   `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Fee                  int64    `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	ParentFee            int64    `protobuf:"varint,4,opt,name=parent_fee,json=parentFee,proto3" json:"parent_fee,omitempty"`
+	PackageFeeRate       int64    `protobuf:"varint,5,opt,name=package_fee_rate,json=packageFeeRate,proto3" json:"package_fee_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Server API for WalletService service
+func (m *BumpFeeResponse) Reset()         { *m = BumpFeeResponse{} }
+func (m *BumpFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*BumpFeeResponse) ProtoMessage()    {}
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+}
 
-type WalletServiceServer interface {
+func (m *BumpFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpFeeResponse.Unmarshal(m, b)
+}
+func (m *BumpFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpFeeResponse.Marshal(b, m, deterministic)
+}
+func (m *BumpFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpFeeResponse.Merge(m, src)
+}
+func (m *BumpFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_BumpFeeResponse.Size(m)
+}
+func (m *BumpFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BumpFeeResponse proto.InternalMessageInfo
+
+func (m *BumpFeeResponse) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *BumpFeeResponse) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *BumpFeeResponse) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *BumpFeeResponse) GetParentFee() int64 {
+	if m != nil {
+		return m.ParentFee
+	}
+	return 0
+}
+
+func (m *BumpFeeResponse) GetPackageFeeRate() int64 {
+	if m != nil {
+		return m.PackageFeeRate
+	}
+	return 0
+}
+
+type AbandonTransactionRequest struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbandonTransactionRequest) Reset()         { *m = AbandonTransactionRequest{} }
+func (m *AbandonTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*AbandonTransactionRequest) ProtoMessage()    {}
+func (*AbandonTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+}
+
+func (m *AbandonTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonTransactionRequest.Unmarshal(m, b)
+}
+func (m *AbandonTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *AbandonTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonTransactionRequest.Merge(m, src)
+}
+func (m *AbandonTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_AbandonTransactionRequest.Size(m)
+}
+func (m *AbandonTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbandonTransactionRequest proto.InternalMessageInfo
+
+func (m *AbandonTransactionRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+type AbandonTransactionResponse struct {
+	AbandonedTransactionHashes [][]byte `protobuf:"bytes,1,rep,name=abandoned_transaction_hashes,json=abandonedTransactionHashes,proto3" json:"abandoned_transaction_hashes,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *AbandonTransactionResponse) Reset()         { *m = AbandonTransactionResponse{} }
+func (m *AbandonTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*AbandonTransactionResponse) ProtoMessage()    {}
+func (*AbandonTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+}
+
+func (m *AbandonTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonTransactionResponse.Unmarshal(m, b)
+}
+func (m *AbandonTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *AbandonTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonTransactionResponse.Merge(m, src)
+}
+func (m *AbandonTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_AbandonTransactionResponse.Size(m)
+}
+func (m *AbandonTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbandonTransactionResponse proto.InternalMessageInfo
+
+func (m *AbandonTransactionResponse) GetAbandonedTransactionHashes() [][]byte {
+	if m != nil {
+		return m.AbandonedTransactionHashes
+	}
+	return nil
+}
+
+type FundPsbtRequest struct {
+	// A serialized PSBT.  If it contains no inputs, inputs are selected
+	// from the account.
+	Psbt                  []byte `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Account               uint32 `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
+	RequiredConfirmations int32  `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	// Fee rate in satoshis per kilobyte.  The fee rate estimated by the
+	// wallet is used when unset.
+	FeeRate int64 `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// The 32 byte ID and the duration of the leases of the inputs.  A
+	// default ID and a duration of ten minutes are used when unset.
+	LeaseId              []byte   `protobuf:"bytes,5,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	LeaseDurationSeconds uint64   `protobuf:"varint,6,opt,name=lease_duration_seconds,json=leaseDurationSeconds,proto3" json:"lease_duration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundPsbtRequest) Reset()         { *m = FundPsbtRequest{} }
+func (m *FundPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*FundPsbtRequest) ProtoMessage()    {}
+func (*FundPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+}
+
+func (m *FundPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundPsbtRequest.Unmarshal(m, b)
+}
+func (m *FundPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundPsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *FundPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundPsbtRequest.Merge(m, src)
+}
+func (m *FundPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_FundPsbtRequest.Size(m)
+}
+func (m *FundPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundPsbtRequest proto.InternalMessageInfo
+
+func (m *FundPsbtRequest) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+func (m *FundPsbtRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *FundPsbtRequest) GetRequiredConfirmations() int32 {
+	if m != nil {
+		return m.RequiredConfirmations
+	}
+	return 0
+}
+
+func (m *FundPsbtRequest) GetFeeRate() int64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *FundPsbtRequest) GetLeaseId() []byte {
+	if m != nil {
+		return m.LeaseId
+	}
+	return nil
+}
+
+func (m *FundPsbtRequest) GetLeaseDurationSeconds() uint64 {
+	if m != nil {
+		return m.LeaseDurationSeconds
+	}
+	return 0
+}
+
+type FundPsbtResponse struct {
+	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
+	// The index of the added change output, or -1 if none was added.
+	ChangeOutputIndex    int32    `protobuf:"varint,2,opt,name=change_output_index,json=changeOutputIndex,proto3" json:"change_output_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundPsbtResponse) Reset()         { *m = FundPsbtResponse{} }
+func (m *FundPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*FundPsbtResponse) ProtoMessage()    {}
+func (*FundPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+}
+
+func (m *FundPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundPsbtResponse.Unmarshal(m, b)
+}
+func (m *FundPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundPsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *FundPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundPsbtResponse.Merge(m, src)
+}
+func (m *FundPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_FundPsbtResponse.Size(m)
+}
+func (m *FundPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundPsbtResponse proto.InternalMessageInfo
+
+func (m *FundPsbtResponse) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+func (m *FundPsbtResponse) GetChangeOutputIndex() int32 {
+	if m != nil {
+		return m.ChangeOutputIndex
+	}
+	return 0
+}
+
+type SignPsbtRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Psbt                 []byte   `protobuf:"bytes,2,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignPsbtRequest) Reset()         { *m = SignPsbtRequest{} }
+func (m *SignPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*SignPsbtRequest) ProtoMessage()    {}
+func (*SignPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+}
+
+func (m *SignPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignPsbtRequest.Unmarshal(m, b)
+}
+func (m *SignPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignPsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *SignPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPsbtRequest.Merge(m, src)
+}
+func (m *SignPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_SignPsbtRequest.Size(m)
+}
+func (m *SignPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPsbtRequest proto.InternalMessageInfo
+
+func (m *SignPsbtRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *SignPsbtRequest) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+type SignPsbtResponse struct {
+	SignedPsbt           []byte   `protobuf:"bytes,1,opt,name=signed_psbt,json=signedPsbt,proto3" json:"signed_psbt,omitempty"`
+	SignedInputIndexes   []uint32 `protobuf:"varint,2,rep,packed,name=signed_input_indexes,json=signedInputIndexes,proto3" json:"signed_input_indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignPsbtResponse) Reset()         { *m = SignPsbtResponse{} }
+func (m *SignPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*SignPsbtResponse) ProtoMessage()    {}
+func (*SignPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+}
+
+func (m *SignPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignPsbtResponse.Unmarshal(m, b)
+}
+func (m *SignPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignPsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *SignPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPsbtResponse.Merge(m, src)
+}
+func (m *SignPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_SignPsbtResponse.Size(m)
+}
+func (m *SignPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPsbtResponse proto.InternalMessageInfo
+
+func (m *SignPsbtResponse) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+func (m *SignPsbtResponse) GetSignedInputIndexes() []uint32 {
+	if m != nil {
+		return m.SignedInputIndexes
+	}
+	return nil
+}
+
+type FinalizePsbtRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Psbt                 []byte   `protobuf:"bytes,2,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtRequest) Reset()         { *m = FinalizePsbtRequest{} }
+func (m *FinalizePsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtRequest) ProtoMessage()    {}
+func (*FinalizePsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+
+func (m *FinalizePsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtRequest.Unmarshal(m, b)
+}
+func (m *FinalizePsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *FinalizePsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtRequest.Merge(m, src)
+}
+func (m *FinalizePsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtRequest.Size(m)
+}
+func (m *FinalizePsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtRequest proto.InternalMessageInfo
+
+func (m *FinalizePsbtRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *FinalizePsbtRequest) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+type FinalizePsbtResponse struct {
+	SignedPsbt           []byte   `protobuf:"bytes,1,opt,name=signed_psbt,json=signedPsbt,proto3" json:"signed_psbt,omitempty"`
+	RawFinalTx           []byte   `protobuf:"bytes,2,opt,name=raw_final_tx,json=rawFinalTx,proto3" json:"raw_final_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtResponse) Reset()         { *m = FinalizePsbtResponse{} }
+func (m *FinalizePsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtResponse) ProtoMessage()    {}
+func (*FinalizePsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+}
+
+func (m *FinalizePsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtResponse.Unmarshal(m, b)
+}
+func (m *FinalizePsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *FinalizePsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtResponse.Merge(m, src)
+}
+func (m *FinalizePsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtResponse.Size(m)
+}
+func (m *FinalizePsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtResponse proto.InternalMessageInfo
+
+func (m *FinalizePsbtResponse) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+func (m *FinalizePsbtResponse) GetRawFinalTx() []byte {
+	if m != nil {
+		return m.RawFinalTx
+	}
+	return nil
+}
+
+type AbandonPsbtRequest struct {
+	Psbt []byte `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// The 32 byte ID the inputs were leased with.  The default ID of
+	// FundPsbt is used when unset.
+	LeaseId              []byte   `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbandonPsbtRequest) Reset()         { *m = AbandonPsbtRequest{} }
+func (m *AbandonPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*AbandonPsbtRequest) ProtoMessage()    {}
+func (*AbandonPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+}
+
+func (m *AbandonPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonPsbtRequest.Unmarshal(m, b)
+}
+func (m *AbandonPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonPsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *AbandonPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonPsbtRequest.Merge(m, src)
+}
+func (m *AbandonPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_AbandonPsbtRequest.Size(m)
+}
+func (m *AbandonPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbandonPsbtRequest proto.InternalMessageInfo
+
+func (m *AbandonPsbtRequest) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+func (m *AbandonPsbtRequest) GetLeaseId() []byte {
+	if m != nil {
+		return m.LeaseId
+	}
+	return nil
+}
+
+type AbandonPsbtResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbandonPsbtResponse) Reset()         { *m = AbandonPsbtResponse{} }
+func (m *AbandonPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*AbandonPsbtResponse) ProtoMessage()    {}
+func (*AbandonPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+}
+
+func (m *AbandonPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonPsbtResponse.Unmarshal(m, b)
+}
+func (m *AbandonPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonPsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *AbandonPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonPsbtResponse.Merge(m, src)
+}
+func (m *AbandonPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_AbandonPsbtResponse.Size(m)
+}
+func (m *AbandonPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbandonPsbtResponse proto.InternalMessageInfo
+
+type Payout struct {
+	Key      string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Account  uint32       `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
+	PkScript []byte       `protobuf:"bytes,3,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	Amount   int64        `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	State    Payout_State `protobuf:"varint,5,opt,name=state,proto3,enum=walletrpc.Payout_State" json:"state,omitempty"`
+	// Set once the payout has been sent.
+	TransactionHash []byte `protobuf:"bytes,6,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	CreatedTime     int64  `protobuf:"varint,7,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// Set when the last attempt to send the payout failed.
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Payout) Reset()         { *m = Payout{} }
+func (m *Payout) String() string { return proto.CompactTextString(m) }
+func (*Payout) ProtoMessage()    {}
+func (*Payout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+}
+
+func (m *Payout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Payout.Unmarshal(m, b)
+}
+func (m *Payout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Payout.Marshal(b, m, deterministic)
+}
+func (m *Payout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payout.Merge(m, src)
+}
+func (m *Payout) XXX_Size() int {
+	return xxx_messageInfo_Payout.Size(m)
+}
+func (m *Payout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Payout proto.InternalMessageInfo
+
+func (m *Payout) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Payout) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *Payout) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
+	}
+	return nil
+}
+
+func (m *Payout) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Payout) GetState() Payout_State {
+	if m != nil {
+		return m.State
+	}
+	return Payout_QUEUED
+}
+
+func (m *Payout) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *Payout) GetCreatedTime() int64 {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return 0
+}
+
+func (m *Payout) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type EnqueuePayoutRequest struct {
+	// Enqueueing a payout again with the same key and destination returns
+	// the recorded payout rather than paying it twice.
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Account              uint32   `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
+	PkScript             []byte   `protobuf:"bytes,3,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnqueuePayoutRequest) Reset()         { *m = EnqueuePayoutRequest{} }
+func (m *EnqueuePayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*EnqueuePayoutRequest) ProtoMessage()    {}
+func (*EnqueuePayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+}
+
+func (m *EnqueuePayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnqueuePayoutRequest.Unmarshal(m, b)
+}
+func (m *EnqueuePayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnqueuePayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *EnqueuePayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnqueuePayoutRequest.Merge(m, src)
+}
+func (m *EnqueuePayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_EnqueuePayoutRequest.Size(m)
+}
+func (m *EnqueuePayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnqueuePayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnqueuePayoutRequest proto.InternalMessageInfo
+
+func (m *EnqueuePayoutRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *EnqueuePayoutRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *EnqueuePayoutRequest) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
+	}
+	return nil
+}
+
+func (m *EnqueuePayoutRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type EnqueuePayoutResponse struct {
+	Payout               *Payout  `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnqueuePayoutResponse) Reset()         { *m = EnqueuePayoutResponse{} }
+func (m *EnqueuePayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*EnqueuePayoutResponse) ProtoMessage()    {}
+func (*EnqueuePayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+}
+
+func (m *EnqueuePayoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnqueuePayoutResponse.Unmarshal(m, b)
+}
+func (m *EnqueuePayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnqueuePayoutResponse.Marshal(b, m, deterministic)
+}
+func (m *EnqueuePayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnqueuePayoutResponse.Merge(m, src)
+}
+func (m *EnqueuePayoutResponse) XXX_Size() int {
+	return xxx_messageInfo_EnqueuePayoutResponse.Size(m)
+}
+func (m *EnqueuePayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnqueuePayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnqueuePayoutResponse proto.InternalMessageInfo
+
+func (m *EnqueuePayoutResponse) GetPayout() *Payout {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+type CancelPayoutRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelPayoutRequest) Reset()         { *m = CancelPayoutRequest{} }
+func (m *CancelPayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelPayoutRequest) ProtoMessage()    {}
+func (*CancelPayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+}
+
+func (m *CancelPayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelPayoutRequest.Unmarshal(m, b)
+}
+func (m *CancelPayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelPayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelPayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPayoutRequest.Merge(m, src)
+}
+func (m *CancelPayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelPayoutRequest.Size(m)
+}
+func (m *CancelPayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelPayoutRequest proto.InternalMessageInfo
+
+func (m *CancelPayoutRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type CancelPayoutResponse struct {
+	Payout               *Payout  `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelPayoutResponse) Reset()         { *m = CancelPayoutResponse{} }
+func (m *CancelPayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelPayoutResponse) ProtoMessage()    {}
+func (*CancelPayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+}
+
+func (m *CancelPayoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelPayoutResponse.Unmarshal(m, b)
+}
+func (m *CancelPayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelPayoutResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelPayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPayoutResponse.Merge(m, src)
+}
+func (m *CancelPayoutResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelPayoutResponse.Size(m)
+}
+func (m *CancelPayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelPayoutResponse proto.InternalMessageInfo
+
+func (m *CancelPayoutResponse) GetPayout() *Payout {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+type PayoutsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayoutsRequest) Reset()         { *m = PayoutsRequest{} }
+func (m *PayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*PayoutsRequest) ProtoMessage()    {}
+func (*PayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+
+func (m *PayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutsRequest.Unmarshal(m, b)
+}
+func (m *PayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutsRequest.Marshal(b, m, deterministic)
+}
+func (m *PayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutsRequest.Merge(m, src)
+}
+func (m *PayoutsRequest) XXX_Size() int {
+	return xxx_messageInfo_PayoutsRequest.Size(m)
+}
+func (m *PayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutsRequest proto.InternalMessageInfo
+
+type PayoutsResponse struct {
+	Payouts              []*Payout `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PayoutsResponse) Reset()         { *m = PayoutsResponse{} }
+func (m *PayoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*PayoutsResponse) ProtoMessage()    {}
+func (*PayoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+
+func (m *PayoutsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutsResponse.Unmarshal(m, b)
+}
+func (m *PayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutsResponse.Marshal(b, m, deterministic)
+}
+func (m *PayoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutsResponse.Merge(m, src)
+}
+func (m *PayoutsResponse) XXX_Size() int {
+	return xxx_messageInfo_PayoutsResponse.Size(m)
+}
+func (m *PayoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutsResponse proto.InternalMessageInfo
+
+func (m *PayoutsResponse) GetPayouts() []*Payout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+type FrozenOutput struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex     uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Reason          string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The unix time the freeze expires at, or zero if it does not expire.
+	ExpiryTime           int64    `protobuf:"varint,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrozenOutput) Reset()         { *m = FrozenOutput{} }
+func (m *FrozenOutput) String() string { return proto.CompactTextString(m) }
+func (*FrozenOutput) ProtoMessage()    {}
+func (*FrozenOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+}
+
+func (m *FrozenOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrozenOutput.Unmarshal(m, b)
+}
+func (m *FrozenOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrozenOutput.Marshal(b, m, deterministic)
+}
+func (m *FrozenOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenOutput.Merge(m, src)
+}
+func (m *FrozenOutput) XXX_Size() int {
+	return xxx_messageInfo_FrozenOutput.Size(m)
+}
+func (m *FrozenOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenOutput proto.InternalMessageInfo
+
+func (m *FrozenOutput) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *FrozenOutput) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *FrozenOutput) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FrozenOutput) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+type FreezeOutputsRequest struct {
+	Outputs              []*FrozenOutput `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FreezeOutputsRequest) Reset()         { *m = FreezeOutputsRequest{} }
+func (m *FreezeOutputsRequest) String() string { return proto.CompactTextString(m) }
+func (*FreezeOutputsRequest) ProtoMessage()    {}
+func (*FreezeOutputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+
+func (m *FreezeOutputsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezeOutputsRequest.Unmarshal(m, b)
+}
+func (m *FreezeOutputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezeOutputsRequest.Marshal(b, m, deterministic)
+}
+func (m *FreezeOutputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeOutputsRequest.Merge(m, src)
+}
+func (m *FreezeOutputsRequest) XXX_Size() int {
+	return xxx_messageInfo_FreezeOutputsRequest.Size(m)
+}
+func (m *FreezeOutputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeOutputsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeOutputsRequest proto.InternalMessageInfo
+
+func (m *FreezeOutputsRequest) GetOutputs() []*FrozenOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+type FreezeOutputsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FreezeOutputsResponse) Reset()         { *m = FreezeOutputsResponse{} }
+func (m *FreezeOutputsResponse) String() string { return proto.CompactTextString(m) }
+func (*FreezeOutputsResponse) ProtoMessage()    {}
+func (*FreezeOutputsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+
+func (m *FreezeOutputsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezeOutputsResponse.Unmarshal(m, b)
+}
+func (m *FreezeOutputsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezeOutputsResponse.Marshal(b, m, deterministic)
+}
+func (m *FreezeOutputsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeOutputsResponse.Merge(m, src)
+}
+func (m *FreezeOutputsResponse) XXX_Size() int {
+	return xxx_messageInfo_FreezeOutputsResponse.Size(m)
+}
+func (m *FreezeOutputsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeOutputsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeOutputsResponse proto.InternalMessageInfo
+
+type UnfreezeOutputsRequest struct {
+	Outputs []*FundTransactionRequest_OutPoint `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Unfreeze all frozen outputs, outputs must be empty.
+	All                  bool     `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeOutputsRequest) Reset()         { *m = UnfreezeOutputsRequest{} }
+func (m *UnfreezeOutputsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeOutputsRequest) ProtoMessage()    {}
+func (*UnfreezeOutputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+
+func (m *UnfreezeOutputsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeOutputsRequest.Unmarshal(m, b)
+}
+func (m *UnfreezeOutputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeOutputsRequest.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeOutputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeOutputsRequest.Merge(m, src)
+}
+func (m *UnfreezeOutputsRequest) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeOutputsRequest.Size(m)
+}
+func (m *UnfreezeOutputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeOutputsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeOutputsRequest proto.InternalMessageInfo
+
+func (m *UnfreezeOutputsRequest) GetOutputs() []*FundTransactionRequest_OutPoint {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *UnfreezeOutputsRequest) GetAll() bool {
+	if m != nil {
+		return m.All
+	}
+	return false
+}
+
+type UnfreezeOutputsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeOutputsResponse) Reset()         { *m = UnfreezeOutputsResponse{} }
+func (m *UnfreezeOutputsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeOutputsResponse) ProtoMessage()    {}
+func (*UnfreezeOutputsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+
+func (m *UnfreezeOutputsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeOutputsResponse.Unmarshal(m, b)
+}
+func (m *UnfreezeOutputsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeOutputsResponse.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeOutputsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeOutputsResponse.Merge(m, src)
+}
+func (m *UnfreezeOutputsResponse) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeOutputsResponse.Size(m)
+}
+func (m *UnfreezeOutputsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeOutputsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeOutputsResponse proto.InternalMessageInfo
+
+type FrozenOutputsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrozenOutputsRequest) Reset()         { *m = FrozenOutputsRequest{} }
+func (m *FrozenOutputsRequest) String() string { return proto.CompactTextString(m) }
+func (*FrozenOutputsRequest) ProtoMessage()    {}
+func (*FrozenOutputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+
+func (m *FrozenOutputsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrozenOutputsRequest.Unmarshal(m, b)
+}
+func (m *FrozenOutputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrozenOutputsRequest.Marshal(b, m, deterministic)
+}
+func (m *FrozenOutputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenOutputsRequest.Merge(m, src)
+}
+func (m *FrozenOutputsRequest) XXX_Size() int {
+	return xxx_messageInfo_FrozenOutputsRequest.Size(m)
+}
+func (m *FrozenOutputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenOutputsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenOutputsRequest proto.InternalMessageInfo
+
+type FrozenOutputsResponse struct {
+	Outputs              []*FrozenOutput `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FrozenOutputsResponse) Reset()         { *m = FrozenOutputsResponse{} }
+func (m *FrozenOutputsResponse) String() string { return proto.CompactTextString(m) }
+func (*FrozenOutputsResponse) ProtoMessage()    {}
+func (*FrozenOutputsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+
+func (m *FrozenOutputsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrozenOutputsResponse.Unmarshal(m, b)
+}
+func (m *FrozenOutputsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrozenOutputsResponse.Marshal(b, m, deterministic)
+}
+func (m *FrozenOutputsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenOutputsResponse.Merge(m, src)
+}
+func (m *FrozenOutputsResponse) XXX_Size() int {
+	return xxx_messageInfo_FrozenOutputsResponse.Size(m)
+}
+func (m *FrozenOutputsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenOutputsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenOutputsResponse proto.InternalMessageInfo
+
+func (m *FrozenOutputsResponse) GetOutputs() []*FrozenOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+type OutputLease struct {
+	// The 32 byte lock ID the output is leased to.
+	Id       []byte                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Outpoint *FundTransactionRequest_OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The unix time the lease expires at.
+	Expiration           int64    `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OutputLease) Reset()         { *m = OutputLease{} }
+func (m *OutputLease) String() string { return proto.CompactTextString(m) }
+func (*OutputLease) ProtoMessage()    {}
+func (*OutputLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+
+func (m *OutputLease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutputLease.Unmarshal(m, b)
+}
+func (m *OutputLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutputLease.Marshal(b, m, deterministic)
+}
+func (m *OutputLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputLease.Merge(m, src)
+}
+func (m *OutputLease) XXX_Size() int {
+	return xxx_messageInfo_OutputLease.Size(m)
+}
+func (m *OutputLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputLease proto.InternalMessageInfo
+
+func (m *OutputLease) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *OutputLease) GetOutpoint() *FundTransactionRequest_OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *OutputLease) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type LeaseOutputRequest struct {
+	Id                   []byte                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Outpoint             *FundTransactionRequest_OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	DurationSeconds      uint64                           `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *LeaseOutputRequest) Reset()         { *m = LeaseOutputRequest{} }
+func (m *LeaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputRequest) ProtoMessage()    {}
+func (*LeaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+
+func (m *LeaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputRequest.Unmarshal(m, b)
+}
+func (m *LeaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (m *LeaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputRequest.Merge(m, src)
+}
+func (m *LeaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputRequest.Size(m)
+}
+func (m *LeaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputRequest proto.InternalMessageInfo
+
+func (m *LeaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *LeaseOutputRequest) GetOutpoint() *FundTransactionRequest_OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *LeaseOutputRequest) GetDurationSeconds() uint64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+type LeaseOutputResponse struct {
+	Expiration           int64    `protobuf:"varint,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaseOutputResponse) Reset()         { *m = LeaseOutputResponse{} }
+func (m *LeaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputResponse) ProtoMessage()    {}
+func (*LeaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+
+func (m *LeaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputResponse.Unmarshal(m, b)
+}
+func (m *LeaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *LeaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputResponse.Merge(m, src)
+}
+func (m *LeaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputResponse.Size(m)
+}
+func (m *LeaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputResponse proto.InternalMessageInfo
+
+func (m *LeaseOutputResponse) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type ReleaseOutputRequest struct {
+	Id                   []byte                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Outpoint             *FundTransactionRequest_OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ReleaseOutputRequest) Reset()         { *m = ReleaseOutputRequest{} }
+func (m *ReleaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputRequest) ProtoMessage()    {}
+func (*ReleaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
+}
+
+func (m *ReleaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputRequest.Unmarshal(m, b)
+}
+func (m *ReleaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputRequest.Merge(m, src)
+}
+func (m *ReleaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputRequest.Size(m)
+}
+func (m *ReleaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputRequest proto.InternalMessageInfo
+
+func (m *ReleaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReleaseOutputRequest) GetOutpoint() *FundTransactionRequest_OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+type ReleaseOutputResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseOutputResponse) Reset()         { *m = ReleaseOutputResponse{} }
+func (m *ReleaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputResponse) ProtoMessage()    {}
+func (*ReleaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
+}
+
+func (m *ReleaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputResponse.Unmarshal(m, b)
+}
+func (m *ReleaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *ReleaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputResponse.Merge(m, src)
+}
+func (m *ReleaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputResponse.Size(m)
+}
+func (m *ReleaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputResponse proto.InternalMessageInfo
+
+type LeasesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeasesRequest) Reset()         { *m = LeasesRequest{} }
+func (m *LeasesRequest) String() string { return proto.CompactTextString(m) }
+func (*LeasesRequest) ProtoMessage()    {}
+func (*LeasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
+}
+
+func (m *LeasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeasesRequest.Unmarshal(m, b)
+}
+func (m *LeasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeasesRequest.Marshal(b, m, deterministic)
+}
+func (m *LeasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeasesRequest.Merge(m, src)
+}
+func (m *LeasesRequest) XXX_Size() int {
+	return xxx_messageInfo_LeasesRequest.Size(m)
+}
+func (m *LeasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeasesRequest proto.InternalMessageInfo
+
+type LeasesResponse struct {
+	Leases               []*OutputLease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LeasesResponse) Reset()         { *m = LeasesResponse{} }
+func (m *LeasesResponse) String() string { return proto.CompactTextString(m) }
+func (*LeasesResponse) ProtoMessage()    {}
+func (*LeasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+}
+
+func (m *LeasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeasesResponse.Unmarshal(m, b)
+}
+func (m *LeasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeasesResponse.Marshal(b, m, deterministic)
+}
+func (m *LeasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeasesResponse.Merge(m, src)
+}
+func (m *LeasesResponse) XXX_Size() int {
+	return xxx_messageInfo_LeasesResponse.Size(m)
+}
+func (m *LeasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeasesResponse proto.InternalMessageInfo
+
+func (m *LeasesResponse) GetLeases() []*OutputLease {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
+type TransactionNotificationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionNotificationsRequest) Reset()         { *m = TransactionNotificationsRequest{} }
+func (m *TransactionNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*TransactionNotificationsRequest) ProtoMessage()    {}
+func (*TransactionNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
+}
+
+func (m *TransactionNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionNotificationsRequest.Unmarshal(m, b)
+}
+func (m *TransactionNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *TransactionNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionNotificationsRequest.Merge(m, src)
+}
+func (m *TransactionNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_TransactionNotificationsRequest.Size(m)
+}
+func (m *TransactionNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionNotificationsRequest proto.InternalMessageInfo
+
+type TransactionNotificationsResponse struct {
+	// Sorted by increasing height.  This is a repeated field so many new blocks
+	// in a new best chain can be notified at once during a reorganize.
+	AttachedBlocks []*BlockDetails `protobuf:"bytes,1,rep,name=attached_blocks,json=attachedBlocks,proto3" json:"attached_blocks,omitempty"`
+	// If there was a chain reorganize, there may have been blocks with wallet
+	// transactions that are no longer in the best chain.  These are those
+	// block's hashes.
+	DetachedBlocks [][]byte `protobuf:"bytes,2,rep,name=detached_blocks,json=detachedBlocks,proto3" json:"detached_blocks,omitempty"`
+	// Any new unmined transactions are included here.  These unmined transactions
+	// refer to the current best chain, so transactions from detached blocks may
+	// be moved to mempool and included here if they are not mined or double spent
+	// in the new chain.  Additonally, if no new blocks were attached but a relevant
+	// unmined transaction is seen by the wallet, it will be reported here.
+	UnminedTransactions []*TransactionDetails `protobuf:"bytes,3,rep,name=unmined_transactions,json=unminedTransactions,proto3" json:"unmined_transactions,omitempty"`
+	// Instead of notifying all of the removed unmined transactions,
+	// just send all of the current hashes.
+	UnminedTransactionHashes [][]byte `protobuf:"bytes,4,rep,name=unmined_transaction_hashes,json=unminedTransactionHashes,proto3" json:"unmined_transaction_hashes,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *TransactionNotificationsResponse) Reset()         { *m = TransactionNotificationsResponse{} }
+func (m *TransactionNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*TransactionNotificationsResponse) ProtoMessage()    {}
+func (*TransactionNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
+}
+
+func (m *TransactionNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionNotificationsResponse.Unmarshal(m, b)
+}
+func (m *TransactionNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *TransactionNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionNotificationsResponse.Merge(m, src)
+}
+func (m *TransactionNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_TransactionNotificationsResponse.Size(m)
+}
+func (m *TransactionNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionNotificationsResponse proto.InternalMessageInfo
+
+func (m *TransactionNotificationsResponse) GetAttachedBlocks() []*BlockDetails {
+	if m != nil {
+		return m.AttachedBlocks
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetDetachedBlocks() [][]byte {
+	if m != nil {
+		return m.DetachedBlocks
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetUnminedTransactions() []*TransactionDetails {
+	if m != nil {
+		return m.UnminedTransactions
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetUnminedTransactionHashes() [][]byte {
+	if m != nil {
+		return m.UnminedTransactionHashes
+	}
+	return nil
+}
+
+type SpentnessNotificationsRequest struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	NoNotifyUnspent      bool     `protobuf:"varint,2,opt,name=no_notify_unspent,json=noNotifyUnspent,proto3" json:"no_notify_unspent,omitempty"`
+	NoNotifySpent        bool     `protobuf:"varint,3,opt,name=no_notify_spent,json=noNotifySpent,proto3" json:"no_notify_spent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpentnessNotificationsRequest) Reset()         { *m = SpentnessNotificationsRequest{} }
+func (m *SpentnessNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*SpentnessNotificationsRequest) ProtoMessage()    {}
+func (*SpentnessNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
+}
+
+func (m *SpentnessNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpentnessNotificationsRequest.Unmarshal(m, b)
+}
+func (m *SpentnessNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpentnessNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *SpentnessNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpentnessNotificationsRequest.Merge(m, src)
+}
+func (m *SpentnessNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_SpentnessNotificationsRequest.Size(m)
+}
+func (m *SpentnessNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpentnessNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpentnessNotificationsRequest proto.InternalMessageInfo
+
+func (m *SpentnessNotificationsRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *SpentnessNotificationsRequest) GetNoNotifyUnspent() bool {
+	if m != nil {
+		return m.NoNotifyUnspent
+	}
+	return false
+}
+
+func (m *SpentnessNotificationsRequest) GetNoNotifySpent() bool {
+	if m != nil {
+		return m.NoNotifySpent
+	}
+	return false
+}
+
+type SpentnessNotificationsResponse struct {
+	TransactionHash      []byte                                  `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32                                  `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Spender              *SpentnessNotificationsResponse_Spender `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *SpentnessNotificationsResponse) Reset()         { *m = SpentnessNotificationsResponse{} }
+func (m *SpentnessNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*SpentnessNotificationsResponse) ProtoMessage()    {}
+func (*SpentnessNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
+}
+
+func (m *SpentnessNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpentnessNotificationsResponse.Unmarshal(m, b)
+}
+func (m *SpentnessNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpentnessNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *SpentnessNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpentnessNotificationsResponse.Merge(m, src)
+}
+func (m *SpentnessNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_SpentnessNotificationsResponse.Size(m)
+}
+func (m *SpentnessNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpentnessNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpentnessNotificationsResponse proto.InternalMessageInfo
+
+func (m *SpentnessNotificationsResponse) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *SpentnessNotificationsResponse) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *SpentnessNotificationsResponse) GetSpender() *SpentnessNotificationsResponse_Spender {
+	if m != nil {
+		return m.Spender
+	}
+	return nil
+}
+
+type SpentnessNotificationsResponse_Spender struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	InputIndex           uint32   `protobuf:"varint,2,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpentnessNotificationsResponse_Spender) Reset() {
+	*m = SpentnessNotificationsResponse_Spender{}
+}
+func (m *SpentnessNotificationsResponse_Spender) String() string { return proto.CompactTextString(m) }
+func (*SpentnessNotificationsResponse_Spender) ProtoMessage()    {}
+func (*SpentnessNotificationsResponse_Spender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76, 0}
+}
+
+func (m *SpentnessNotificationsResponse_Spender) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpentnessNotificationsResponse_Spender.Unmarshal(m, b)
+}
+func (m *SpentnessNotificationsResponse_Spender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpentnessNotificationsResponse_Spender.Marshal(b, m, deterministic)
+}
+func (m *SpentnessNotificationsResponse_Spender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpentnessNotificationsResponse_Spender.Merge(m, src)
+}
+func (m *SpentnessNotificationsResponse_Spender) XXX_Size() int {
+	return xxx_messageInfo_SpentnessNotificationsResponse_Spender.Size(m)
+}
+func (m *SpentnessNotificationsResponse_Spender) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpentnessNotificationsResponse_Spender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpentnessNotificationsResponse_Spender proto.InternalMessageInfo
+
+func (m *SpentnessNotificationsResponse_Spender) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *SpentnessNotificationsResponse_Spender) GetInputIndex() uint32 {
+	if m != nil {
+		return m.InputIndex
+	}
+	return 0
+}
+
+type AccountNotificationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountNotificationsRequest) Reset()         { *m = AccountNotificationsRequest{} }
+func (m *AccountNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountNotificationsRequest) ProtoMessage()    {}
+func (*AccountNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+}
+
+func (m *AccountNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountNotificationsRequest.Unmarshal(m, b)
+}
+func (m *AccountNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountNotificationsRequest.Merge(m, src)
+}
+func (m *AccountNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountNotificationsRequest.Size(m)
+}
+func (m *AccountNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountNotificationsRequest proto.InternalMessageInfo
+
+type AccountNotificationsResponse struct {
+	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountName          string   `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	ExternalKeyCount     uint32   `protobuf:"varint,3,opt,name=external_key_count,json=externalKeyCount,proto3" json:"external_key_count,omitempty"`
+	InternalKeyCount     uint32   `protobuf:"varint,4,opt,name=internal_key_count,json=internalKeyCount,proto3" json:"internal_key_count,omitempty"`
+	ImportedKeyCount     uint32   `protobuf:"varint,5,opt,name=imported_key_count,json=importedKeyCount,proto3" json:"imported_key_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountNotificationsResponse) Reset()         { *m = AccountNotificationsResponse{} }
+func (m *AccountNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountNotificationsResponse) ProtoMessage()    {}
+func (*AccountNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *AccountNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountNotificationsResponse.Unmarshal(m, b)
+}
+func (m *AccountNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountNotificationsResponse.Merge(m, src)
+}
+func (m *AccountNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountNotificationsResponse.Size(m)
+}
+func (m *AccountNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountNotificationsResponse proto.InternalMessageInfo
+
+func (m *AccountNotificationsResponse) GetAccountNumber() uint32 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *AccountNotificationsResponse) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
+}
+
+func (m *AccountNotificationsResponse) GetExternalKeyCount() uint32 {
+	if m != nil {
+		return m.ExternalKeyCount
+	}
+	return 0
+}
+
+func (m *AccountNotificationsResponse) GetInternalKeyCount() uint32 {
+	if m != nil {
+		return m.InternalKeyCount
+	}
+	return 0
+}
+
+func (m *AccountNotificationsResponse) GetImportedKeyCount() uint32 {
+	if m != nil {
+		return m.ImportedKeyCount
+	}
+	return 0
+}
+
+type CreateWalletRequest struct {
+	PublicPassphrase     []byte   `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+	PrivatePassphrase    []byte   `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
+	Seed                 []byte   `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Mnemonic             string   `protobuf:"bytes,4,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	MnemonicPassphrase   []byte   `protobuf:"bytes,5,opt,name=mnemonic_passphrase,json=mnemonicPassphrase,proto3" json:"mnemonic_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWalletRequest) Reset()         { *m = CreateWalletRequest{} }
+func (m *CreateWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateWalletRequest) ProtoMessage()    {}
+func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+
+func (m *CreateWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWalletRequest.Unmarshal(m, b)
+}
+func (m *CreateWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWalletRequest.Merge(m, src)
+}
+func (m *CreateWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateWalletRequest.Size(m)
+}
+func (m *CreateWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWalletRequest proto.InternalMessageInfo
+
+func (m *CreateWalletRequest) GetPublicPassphrase() []byte {
+	if m != nil {
+		return m.PublicPassphrase
+	}
+	return nil
+}
+
+func (m *CreateWalletRequest) GetPrivatePassphrase() []byte {
+	if m != nil {
+		return m.PrivatePassphrase
+	}
+	return nil
+}
+
+func (m *CreateWalletRequest) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+func (m *CreateWalletRequest) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *CreateWalletRequest) GetMnemonicPassphrase() []byte {
+	if m != nil {
+		return m.MnemonicPassphrase
+	}
+	return nil
+}
+
+type CreateWalletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWalletResponse) Reset()         { *m = CreateWalletResponse{} }
+func (m *CreateWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateWalletResponse) ProtoMessage()    {}
+func (*CreateWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *CreateWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWalletResponse.Unmarshal(m, b)
+}
+func (m *CreateWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWalletResponse.Merge(m, src)
+}
+func (m *CreateWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateWalletResponse.Size(m)
+}
+func (m *CreateWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWalletResponse proto.InternalMessageInfo
+
+type CreateWatchingOnlyWalletRequest struct {
+	PublicPassphrase     []byte                                     `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+	Accounts             []*CreateWatchingOnlyWalletRequest_Account `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Birthday             int64                                      `protobuf:"varint,3,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *CreateWatchingOnlyWalletRequest) Reset()         { *m = CreateWatchingOnlyWalletRequest{} }
+func (m *CreateWatchingOnlyWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateWatchingOnlyWalletRequest) ProtoMessage()    {}
+func (*CreateWatchingOnlyWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+
+func (m *CreateWatchingOnlyWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWatchingOnlyWalletRequest.Unmarshal(m, b)
+}
+func (m *CreateWatchingOnlyWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWatchingOnlyWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateWatchingOnlyWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWatchingOnlyWalletRequest.Merge(m, src)
+}
+func (m *CreateWatchingOnlyWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateWatchingOnlyWalletRequest.Size(m)
+}
+func (m *CreateWatchingOnlyWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWatchingOnlyWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWatchingOnlyWalletRequest proto.InternalMessageInfo
+
+func (m *CreateWatchingOnlyWalletRequest) GetPublicPassphrase() []byte {
+	if m != nil {
+		return m.PublicPassphrase
+	}
+	return nil
+}
+
+func (m *CreateWatchingOnlyWalletRequest) GetAccounts() []*CreateWatchingOnlyWalletRequest_Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *CreateWatchingOnlyWalletRequest) GetBirthday() int64 {
+	if m != nil {
+		return m.Birthday
+	}
+	return 0
+}
+
+type CreateWatchingOnlyWalletRequest_Account struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Purpose              uint32   `protobuf:"varint,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Coin                 uint32   `protobuf:"varint,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	ExtendedPublicKey    string   `protobuf:"bytes,4,opt,name=extended_public_key,json=extendedPublicKey,proto3" json:"extended_public_key,omitempty"`
+	MasterKeyFingerprint uint32   `protobuf:"varint,5,opt,name=master_key_fingerprint,json=masterKeyFingerprint,proto3" json:"master_key_fingerprint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWatchingOnlyWalletRequest_Account) Reset() {
+	*m = CreateWatchingOnlyWalletRequest_Account{}
+}
+func (m *CreateWatchingOnlyWalletRequest_Account) String() string { return proto.CompactTextString(m) }
+func (*CreateWatchingOnlyWalletRequest_Account) ProtoMessage()    {}
+func (*CreateWatchingOnlyWalletRequest_Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81, 0}
+}
+
+func (m *CreateWatchingOnlyWalletRequest_Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWatchingOnlyWalletRequest_Account.Unmarshal(m, b)
+}
+func (m *CreateWatchingOnlyWalletRequest_Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWatchingOnlyWalletRequest_Account.Marshal(b, m, deterministic)
+}
+func (m *CreateWatchingOnlyWalletRequest_Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWatchingOnlyWalletRequest_Account.Merge(m, src)
+}
+func (m *CreateWatchingOnlyWalletRequest_Account) XXX_Size() int {
+	return xxx_messageInfo_CreateWatchingOnlyWalletRequest_Account.Size(m)
+}
+func (m *CreateWatchingOnlyWalletRequest_Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWatchingOnlyWalletRequest_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWatchingOnlyWalletRequest_Account proto.InternalMessageInfo
+
+func (m *CreateWatchingOnlyWalletRequest_Account) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateWatchingOnlyWalletRequest_Account) GetPurpose() uint32 {
+	if m != nil {
+		return m.Purpose
+	}
+	return 0
+}
+
+func (m *CreateWatchingOnlyWalletRequest_Account) GetCoin() uint32 {
+	if m != nil {
+		return m.Coin
+	}
+	return 0
+}
+
+func (m *CreateWatchingOnlyWalletRequest_Account) GetExtendedPublicKey() string {
+	if m != nil {
+		return m.ExtendedPublicKey
+	}
+	return ""
+}
+
+func (m *CreateWatchingOnlyWalletRequest_Account) GetMasterKeyFingerprint() uint32 {
+	if m != nil {
+		return m.MasterKeyFingerprint
+	}
+	return 0
+}
+
+type CreateWatchingOnlyWalletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWatchingOnlyWalletResponse) Reset()         { *m = CreateWatchingOnlyWalletResponse{} }
+func (m *CreateWatchingOnlyWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateWatchingOnlyWalletResponse) ProtoMessage()    {}
+func (*CreateWatchingOnlyWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *CreateWatchingOnlyWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWatchingOnlyWalletResponse.Unmarshal(m, b)
+}
+func (m *CreateWatchingOnlyWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWatchingOnlyWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateWatchingOnlyWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWatchingOnlyWalletResponse.Merge(m, src)
+}
+func (m *CreateWatchingOnlyWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateWatchingOnlyWalletResponse.Size(m)
+}
+func (m *CreateWatchingOnlyWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWatchingOnlyWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWatchingOnlyWalletResponse proto.InternalMessageInfo
+
+type OpenWalletRequest struct {
+	PublicPassphrase     []byte   `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpenWalletRequest) Reset()         { *m = OpenWalletRequest{} }
+func (m *OpenWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*OpenWalletRequest) ProtoMessage()    {}
+func (*OpenWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+
+func (m *OpenWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenWalletRequest.Unmarshal(m, b)
+}
+func (m *OpenWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *OpenWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenWalletRequest.Merge(m, src)
+}
+func (m *OpenWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_OpenWalletRequest.Size(m)
+}
+func (m *OpenWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenWalletRequest proto.InternalMessageInfo
+
+func (m *OpenWalletRequest) GetPublicPassphrase() []byte {
+	if m != nil {
+		return m.PublicPassphrase
+	}
+	return nil
+}
+
+type OpenWalletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpenWalletResponse) Reset()         { *m = OpenWalletResponse{} }
+func (m *OpenWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*OpenWalletResponse) ProtoMessage()    {}
+func (*OpenWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *OpenWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenWalletResponse.Unmarshal(m, b)
+}
+func (m *OpenWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *OpenWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenWalletResponse.Merge(m, src)
+}
+func (m *OpenWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_OpenWalletResponse.Size(m)
+}
+func (m *OpenWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenWalletResponse proto.InternalMessageInfo
+
+type CloseWalletRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseWalletRequest) Reset()         { *m = CloseWalletRequest{} }
+func (m *CloseWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseWalletRequest) ProtoMessage()    {}
+func (*CloseWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+
+func (m *CloseWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseWalletRequest.Unmarshal(m, b)
+}
+func (m *CloseWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *CloseWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseWalletRequest.Merge(m, src)
+}
+func (m *CloseWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseWalletRequest.Size(m)
+}
+func (m *CloseWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseWalletRequest proto.InternalMessageInfo
+
+type CloseWalletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseWalletResponse) Reset()         { *m = CloseWalletResponse{} }
+func (m *CloseWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*CloseWalletResponse) ProtoMessage()    {}
+func (*CloseWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
+}
+
+func (m *CloseWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseWalletResponse.Unmarshal(m, b)
+}
+func (m *CloseWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *CloseWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseWalletResponse.Merge(m, src)
+}
+func (m *CloseWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_CloseWalletResponse.Size(m)
+}
+func (m *CloseWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseWalletResponse proto.InternalMessageInfo
+
+type WalletExistsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletExistsRequest) Reset()         { *m = WalletExistsRequest{} }
+func (m *WalletExistsRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletExistsRequest) ProtoMessage()    {}
+func (*WalletExistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+}
+
+func (m *WalletExistsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletExistsRequest.Unmarshal(m, b)
+}
+func (m *WalletExistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletExistsRequest.Marshal(b, m, deterministic)
+}
+func (m *WalletExistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletExistsRequest.Merge(m, src)
+}
+func (m *WalletExistsRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletExistsRequest.Size(m)
+}
+func (m *WalletExistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletExistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletExistsRequest proto.InternalMessageInfo
+
+type WalletExistsResponse struct {
+	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletExistsResponse) Reset()         { *m = WalletExistsResponse{} }
+func (m *WalletExistsResponse) String() string { return proto.CompactTextString(m) }
+func (*WalletExistsResponse) ProtoMessage()    {}
+func (*WalletExistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+}
+
+func (m *WalletExistsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletExistsResponse.Unmarshal(m, b)
+}
+func (m *WalletExistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletExistsResponse.Marshal(b, m, deterministic)
+}
+func (m *WalletExistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletExistsResponse.Merge(m, src)
+}
+func (m *WalletExistsResponse) XXX_Size() int {
+	return xxx_messageInfo_WalletExistsResponse.Size(m)
+}
+func (m *WalletExistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletExistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletExistsResponse proto.InternalMessageInfo
+
+func (m *WalletExistsResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+type StartConsensusRpcRequest struct {
+	NetworkAddress       string   `protobuf:"bytes,1,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             []byte   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Certificate          []byte   `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartConsensusRpcRequest) Reset()         { *m = StartConsensusRpcRequest{} }
+func (m *StartConsensusRpcRequest) String() string { return proto.CompactTextString(m) }
+func (*StartConsensusRpcRequest) ProtoMessage()    {}
+func (*StartConsensusRpcRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
+}
+
+func (m *StartConsensusRpcRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartConsensusRpcRequest.Unmarshal(m, b)
+}
+func (m *StartConsensusRpcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartConsensusRpcRequest.Marshal(b, m, deterministic)
+}
+func (m *StartConsensusRpcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartConsensusRpcRequest.Merge(m, src)
+}
+func (m *StartConsensusRpcRequest) XXX_Size() int {
+	return xxx_messageInfo_StartConsensusRpcRequest.Size(m)
+}
+func (m *StartConsensusRpcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartConsensusRpcRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartConsensusRpcRequest proto.InternalMessageInfo
+
+func (m *StartConsensusRpcRequest) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+func (m *StartConsensusRpcRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *StartConsensusRpcRequest) GetPassword() []byte {
+	if m != nil {
+		return m.Password
+	}
+	return nil
+}
+
+func (m *StartConsensusRpcRequest) GetCertificate() []byte {
+	if m != nil {
+		return m.Certificate
+	}
+	return nil
+}
+
+type StartConsensusRpcResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartConsensusRpcResponse) Reset()         { *m = StartConsensusRpcResponse{} }
+func (m *StartConsensusRpcResponse) String() string { return proto.CompactTextString(m) }
+func (*StartConsensusRpcResponse) ProtoMessage()    {}
+func (*StartConsensusRpcResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
+}
+
+func (m *StartConsensusRpcResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartConsensusRpcResponse.Unmarshal(m, b)
+}
+func (m *StartConsensusRpcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartConsensusRpcResponse.Marshal(b, m, deterministic)
+}
+func (m *StartConsensusRpcResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartConsensusRpcResponse.Merge(m, src)
+}
+func (m *StartConsensusRpcResponse) XXX_Size() int {
+	return xxx_messageInfo_StartConsensusRpcResponse.Size(m)
+}
+func (m *StartConsensusRpcResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartConsensusRpcResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartConsensusRpcResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("walletrpc.NextAddressRequest_Kind", NextAddressRequest_Kind_name, NextAddressRequest_Kind_value)
+	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
+	proto.RegisterEnum("walletrpc.FundTransactionRequest_CoinSelection", FundTransactionRequest_CoinSelection_name, FundTransactionRequest_CoinSelection_value)
+	proto.RegisterEnum("walletrpc.Payout_State", Payout_State_name, Payout_State_value)
+	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
+	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
+	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
+	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
+	proto.RegisterType((*ConflictedTransactionDetails)(nil), "walletrpc.ConflictedTransactionDetails")
+	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
+	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
+	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
+	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
+	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
+	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
+	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
+	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
+	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
+	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
+	proto.RegisterType((*AccountXpubRequest)(nil), "walletrpc.AccountXpubRequest")
+	proto.RegisterType((*AccountXpubResponse)(nil), "walletrpc.AccountXpubResponse")
+	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
+	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
+	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
+	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
+	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
+	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
+	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
+	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
+	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
+	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
+	proto.RegisterType((*WalletInfoRequest)(nil), "walletrpc.WalletInfoRequest")
+	proto.RegisterType((*WalletInfoResponse)(nil), "walletrpc.WalletInfoResponse")
+	proto.RegisterType((*WalletInfoResponse_ScopeAccounts)(nil), "walletrpc.WalletInfoResponse.ScopeAccounts")
+	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
+	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
+	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
+	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
+	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
+	proto.RegisterType((*FundTransactionRequest_OutPoint)(nil), "walletrpc.FundTransactionRequest.OutPoint")
+	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
+	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
+	proto.RegisterType((*PreviewTransactionRequest)(nil), "walletrpc.PreviewTransactionRequest")
+	proto.RegisterType((*PreviewTransactionRequest_Output)(nil), "walletrpc.PreviewTransactionRequest.Output")
+	proto.RegisterType((*PreviewTransactionResponse)(nil), "walletrpc.PreviewTransactionResponse")
+	proto.RegisterType((*PreviewTransactionResponse_Input)(nil), "walletrpc.PreviewTransactionResponse.Input")
+	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
+	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
+	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
+	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
+	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
+	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
+	proto.RegisterType((*AbandonTransactionRequest)(nil), "walletrpc.AbandonTransactionRequest")
+	proto.RegisterType((*AbandonTransactionResponse)(nil), "walletrpc.AbandonTransactionResponse")
+	proto.RegisterType((*FundPsbtRequest)(nil), "walletrpc.FundPsbtRequest")
+	proto.RegisterType((*FundPsbtResponse)(nil), "walletrpc.FundPsbtResponse")
+	proto.RegisterType((*SignPsbtRequest)(nil), "walletrpc.SignPsbtRequest")
+	proto.RegisterType((*SignPsbtResponse)(nil), "walletrpc.SignPsbtResponse")
+	proto.RegisterType((*FinalizePsbtRequest)(nil), "walletrpc.FinalizePsbtRequest")
+	proto.RegisterType((*FinalizePsbtResponse)(nil), "walletrpc.FinalizePsbtResponse")
+	proto.RegisterType((*AbandonPsbtRequest)(nil), "walletrpc.AbandonPsbtRequest")
+	proto.RegisterType((*AbandonPsbtResponse)(nil), "walletrpc.AbandonPsbtResponse")
+	proto.RegisterType((*Payout)(nil), "walletrpc.Payout")
+	proto.RegisterType((*EnqueuePayoutRequest)(nil), "walletrpc.EnqueuePayoutRequest")
+	proto.RegisterType((*EnqueuePayoutResponse)(nil), "walletrpc.EnqueuePayoutResponse")
+	proto.RegisterType((*CancelPayoutRequest)(nil), "walletrpc.CancelPayoutRequest")
+	proto.RegisterType((*CancelPayoutResponse)(nil), "walletrpc.CancelPayoutResponse")
+	proto.RegisterType((*PayoutsRequest)(nil), "walletrpc.PayoutsRequest")
+	proto.RegisterType((*PayoutsResponse)(nil), "walletrpc.PayoutsResponse")
+	proto.RegisterType((*FrozenOutput)(nil), "walletrpc.FrozenOutput")
+	proto.RegisterType((*FreezeOutputsRequest)(nil), "walletrpc.FreezeOutputsRequest")
+	proto.RegisterType((*FreezeOutputsResponse)(nil), "walletrpc.FreezeOutputsResponse")
+	proto.RegisterType((*UnfreezeOutputsRequest)(nil), "walletrpc.UnfreezeOutputsRequest")
+	proto.RegisterType((*UnfreezeOutputsResponse)(nil), "walletrpc.UnfreezeOutputsResponse")
+	proto.RegisterType((*FrozenOutputsRequest)(nil), "walletrpc.FrozenOutputsRequest")
+	proto.RegisterType((*FrozenOutputsResponse)(nil), "walletrpc.FrozenOutputsResponse")
+	proto.RegisterType((*OutputLease)(nil), "walletrpc.OutputLease")
+	proto.RegisterType((*LeaseOutputRequest)(nil), "walletrpc.LeaseOutputRequest")
+	proto.RegisterType((*LeaseOutputResponse)(nil), "walletrpc.LeaseOutputResponse")
+	proto.RegisterType((*ReleaseOutputRequest)(nil), "walletrpc.ReleaseOutputRequest")
+	proto.RegisterType((*ReleaseOutputResponse)(nil), "walletrpc.ReleaseOutputResponse")
+	proto.RegisterType((*LeasesRequest)(nil), "walletrpc.LeasesRequest")
+	proto.RegisterType((*LeasesResponse)(nil), "walletrpc.LeasesResponse")
+	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
+	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
+	proto.RegisterType((*SpentnessNotificationsRequest)(nil), "walletrpc.SpentnessNotificationsRequest")
+	proto.RegisterType((*SpentnessNotificationsResponse)(nil), "walletrpc.SpentnessNotificationsResponse")
+	proto.RegisterType((*SpentnessNotificationsResponse_Spender)(nil), "walletrpc.SpentnessNotificationsResponse.Spender")
+	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
+	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
+	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
+	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
+	proto.RegisterType((*CreateWatchingOnlyWalletRequest)(nil), "walletrpc.CreateWatchingOnlyWalletRequest")
+	proto.RegisterType((*CreateWatchingOnlyWalletRequest_Account)(nil), "walletrpc.CreateWatchingOnlyWalletRequest.Account")
+	proto.RegisterType((*CreateWatchingOnlyWalletResponse)(nil), "walletrpc.CreateWatchingOnlyWalletResponse")
+	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
+	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
+	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
+	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
+	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
+	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
+	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
+	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 4512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x4d, 0x73, 0x1c, 0x49,
+	0x56, 0xd3, 0x1f, 0x52, 0xb7, 0x5e, 0x7f, 0x97, 0xda, 0x52, 0xab, 0x6c, 0x4b, 0x9a, 0xf2, 0x7c,
+	0xf8, 0x63, 0x47, 0xe3, 0x31, 0x33, 0xb0, 0x04, 0xb3, 0xb3, 0x23, 0xeb, 0x63, 0x56, 0x6b, 0x59,
+	0x12, 0x25, 0xd9, 0xe3, 0x8d, 0x25, 0xb6, 0x28, 0x55, 0xa5, 0xa4, 0xc2, 0xdd, 0x59, 0xed, 0xfa,
+	0xb0, 0x2c, 0x1f, 0x89, 0x80, 0x03, 0x11, 0x5c, 0x20, 0x36, 0x20, 0x80, 0xbd, 0xc1, 0x0f, 0xe0,
+	0x04, 0x7b, 0x83, 0xeb, 0x46, 0x70, 0x80, 0x2b, 0x37, 0x2e, 0xdc, 0x38, 0xf0, 0x0b, 0x88, 0xfc,
+	0xaa, 0xca, 0xec, 0xaa, 0x6a, 0x49, 0xb3, 0x33, 0xec, 0xad, 0xeb, 0xbd, 0x97, 0x2f, 0x5f, 0x66,
+	0xbe, 0xaf, 0x7c, 0xf9, 0x1a, 0xe6, 0xec, 0xb1, 0xb7, 0x36, 0x0e, 0xfc, 0xc8, 0xd7, 0xe6, 0xce,
+	0xed, 0xe1, 0x10, 0x45, 0xc1, 0xd8, 0x31, 0xba, 0xd0, 0x7e, 0x8e, 0x82, 0xd0, 0xf3, 0xb1, 0x89,
+	0x5e, 0xc5, 0x28, 0x8c, 0x8c, 0x7f, 0x2d, 0x41, 0x27, 0x01, 0x85, 0x63, 0x1f, 0x87, 0x48, 0x7b,
+	0x1f, 0xda, 0xaf, 0x19, 0xc8, 0x0a, 0xa3, 0xc0, 0xc3, 0xa7, 0x83, 0xd2, 0x6a, 0xe9, 0xee, 0x9c,
+	0xd9, 0xe2, 0xd0, 0x43, 0x0a, 0xd4, 0xfa, 0x30, 0x33, 0xb2, 0xff, 0xc8, 0x0f, 0x06, 0xe5, 0xd5,
+	0xd2, 0xdd, 0x96, 0xc9, 0x3e, 0x28, 0xd4, 0xc3, 0x7e, 0x30, 0xa8, 0x70, 0xa8, 0x87, 0x19, 0x74,
+	0x6c, 0x47, 0xce, 0xd9, 0xa0, 0xca, 0xa0, 0xf4, 0x43, 0x5b, 0x06, 0x18, 0x07, 0x28, 0x40, 0x43,
+	0x64, 0x87, 0x68, 0x30, 0x43, 0x27, 0x91, 0x20, 0x44, 0x90, 0xe3, 0xd8, 0x1b, 0xba, 0xd6, 0x08,
+	0x45, 0xb6, 0x6b, 0x47, 0xf6, 0x60, 0x96, 0x09, 0x42, 0xa1, 0x4f, 0x39, 0xd0, 0xf8, 0x97, 0x0a,
+	0x68, 0x47, 0x81, 0x8d, 0x43, 0xdb, 0x89, 0x3c, 0x1f, 0x6f, 0xa2, 0xc8, 0xf6, 0x86, 0xa1, 0xa6,
+	0x41, 0xf5, 0xcc, 0x0e, 0xcf, 0xa8, 0xf0, 0x4d, 0x93, 0xfe, 0xd6, 0x56, 0xa1, 0x11, 0xa5, 0x94,
+	0x54, 0xf2, 0xa6, 0x29, 0x83, 0xb4, 0xdf, 0x83, 0x59, 0x17, 0x1d, 0x7b, 0x51, 0x38, 0xa8, 0xac,
+	0x56, 0xee, 0x36, 0x1e, 0xdd, 0x59, 0x4b, 0xb6, 0x6f, 0x2d, 0x3b, 0xc9, 0xda, 0x0e, 0x1e, 0xc7,
+	0x91, 0xc9, 0x87, 0x68, 0x5f, 0x40, 0xcd, 0x09, 0x90, 0x4b, 0x46, 0x57, 0xe9, 0xe8, 0xf7, 0xa6,
+	0x8f, 0xde, 0x8f, 0x23, 0x32, 0x5c, 0x0c, 0xd2, 0xba, 0x50, 0x39, 0x41, 0x6c, 0x27, 0x2a, 0x26,
+	0xf9, 0xa9, 0xdd, 0x82, 0xb9, 0xc8, 0x1b, 0xa1, 0x30, 0xb2, 0x47, 0x63, 0xba, 0xfa, 0x8a, 0x99,
+	0x02, 0xf4, 0x57, 0x30, 0x43, 0x05, 0x20, 0xfb, 0xeb, 0x61, 0x17, 0xbd, 0xa1, 0x8b, 0x6d, 0x99,
+	0xec, 0x43, 0xbb, 0x07, 0xdd, 0x71, 0x80, 0x5e, 0x7b, 0x7e, 0x1c, 0x5a, 0xb6, 0xe3, 0xf8, 0x31,
+	0x8e, 0xf8, 0x61, 0x75, 0x04, 0x7c, 0x9d, 0x81, 0xb5, 0x0f, 0xa1, 0x93, 0x92, 0x8e, 0x28, 0x65,
+	0x85, 0xce, 0xd6, 0x4e, 0x28, 0x29, 0x54, 0x3f, 0x82, 0x59, 0x26, 0x75, 0xc1, 0x9c, 0x03, 0xa8,
+	0xa9, 0x53, 0x89, 0x4f, 0x4d, 0x87, 0xba, 0x87, 0x23, 0x14, 0x60, 0x7b, 0x48, 0x79, 0xd7, 0xcd,
+	0xe4, 0xdb, 0xf8, 0x65, 0x09, 0x6e, 0x6d, 0xf8, 0xf8, 0x64, 0xe8, 0x39, 0x11, 0x72, 0x73, 0x0e,
+	0xf3, 0x87, 0xea, 0xc1, 0x91, 0x29, 0x1b, 0x8f, 0x6e, 0x4f, 0xdd, 0x5d, 0xf5, 0x5c, 0xef, 0x41,
+	0xd7, 0xe1, 0x13, 0x78, 0xf8, 0xd4, 0xa2, 0x9a, 0xc1, 0x8e, 0xbf, 0x23, 0xc1, 0x7f, 0x44, 0x94,
+	0xe4, 0x23, 0xd0, 0x14, 0x52, 0xe4, 0x9d, 0x9e, 0xb1, 0xed, 0x98, 0x31, 0x7b, 0x32, 0x31, 0x45,
+	0x18, 0x7f, 0x5b, 0x82, 0xe6, 0xe3, 0xa1, 0xef, 0xbc, 0x9c, 0xa6, 0x78, 0x0b, 0x30, 0xcb, 0xf9,
+	0x94, 0x29, 0x1f, 0xfe, 0xa5, 0x9e, 0x6f, 0x65, 0xe2, 0x7c, 0xb5, 0x75, 0x68, 0x4a, 0x6b, 0x10,
+	0x4a, 0x75, 0xc9, 0xb2, 0x95, 0x21, 0xc6, 0x3e, 0xb4, 0xf9, 0x19, 0x3f, 0xb6, 0x87, 0x36, 0x76,
+	0x90, 0x7c, 0x42, 0x25, 0xf5, 0x84, 0xee, 0x40, 0x2b, 0xf2, 0x23, 0x7b, 0x68, 0x1d, 0x33, 0x52,
+	0x2a, 0x6b, 0xc5, 0x6c, 0x52, 0x20, 0x1f, 0x6e, 0xb4, 0xa0, 0x71, 0xe0, 0xe1, 0x53, 0xe1, 0x40,
+	0xda, 0xd0, 0x64, 0x9f, 0xcc, 0x79, 0x10, 0x17, 0xb3, 0x87, 0xa2, 0x73, 0x3f, 0x78, 0x29, 0x28,
+	0xbe, 0x0f, 0x9d, 0x04, 0x92, 0x7a, 0x18, 0x22, 0xdf, 0x6b, 0x64, 0x61, 0x86, 0xe1, 0x92, 0xb4,
+	0x18, 0x94, 0x93, 0x1b, 0xbf, 0x0b, 0x7d, 0x2e, 0xfb, 0x5e, 0x3c, 0x3a, 0x46, 0x01, 0xe7, 0xa8,
+	0xbd, 0x0b, 0x4d, 0x2e, 0xb2, 0x85, 0xed, 0x11, 0xe2, 0xee, 0xa9, 0xc1, 0x61, 0x7b, 0xf6, 0x08,
+	0x19, 0x5f, 0xc0, 0x8d, 0x89, 0xa1, 0xf2, 0xd4, 0x7c, 0x2c, 0xc5, 0xa4, 0x53, 0x4b, 0xe4, 0x46,
+	0x0f, 0x3a, 0x7c, 0x7c, 0x28, 0xd6, 0xf1, 0xcf, 0x15, 0xe8, 0xa6, 0x30, 0xce, 0xee, 0x87, 0x50,
+	0xe7, 0x03, 0xc3, 0x41, 0x29, 0xe3, 0x30, 0x26, 0xc9, 0x05, 0xc0, 0x4c, 0x06, 0x69, 0xdf, 0x03,
+	0xcd, 0x89, 0x83, 0x00, 0xe1, 0xc8, 0x3a, 0x26, 0x4a, 0x24, 0x6b, 0x66, 0x97, 0x63, 0xa8, 0x76,
+	0x51, 0xd5, 0x7c, 0x08, 0xfd, 0x09, 0x6a, 0x59, 0x39, 0x35, 0x85, 0x9e, 0x62, 0xf4, 0x3f, 0x2e,
+	0x43, 0x4d, 0x18, 0xf9, 0xd5, 0xd6, 0x9e, 0xd9, 0xde, 0x72, 0x66, 0x7b, 0xb3, 0x9a, 0x52, 0xc9,
+	0x6a, 0x0a, 0x59, 0x1a, 0x7a, 0xc3, 0x0c, 0xdc, 0x7a, 0x89, 0x2e, 0x2c, 0xa6, 0x73, 0x2c, 0x02,
+	0x74, 0x05, 0xe6, 0x09, 0xba, 0xd8, 0xa0, 0xc2, 0x7d, 0x0f, 0x34, 0x0f, 0x67, 0xa8, 0x67, 0x18,
+	0xb5, 0x87, 0x73, 0xa8, 0x47, 0x63, 0x3f, 0x88, 0x90, 0x2b, 0x51, 0xcf, 0x72, 0x6a, 0x8e, 0x11,
+	0xd4, 0x86, 0x07, 0x1a, 0xdf, 0x83, 0x17, 0xe3, 0xf8, 0x58, 0xa8, 0xd1, 0x15, 0xb7, 0x63, 0x00,
+	0xb5, 0x71, 0x1c, 0x8c, 0xfd, 0x10, 0x09, 0x8f, 0xc6, 0x3f, 0x89, 0xa1, 0x3b, 0xbe, 0x87, 0x79,
+	0xa8, 0xa3, 0xbf, 0x8d, 0xbf, 0x2f, 0xc1, 0xbc, 0x32, 0x17, 0x57, 0x94, 0x35, 0x98, 0x27, 0x4b,
+	0xc6, 0x2e, 0x72, 0xad, 0x71, 0x7c, 0x3c, 0xf4, 0x1c, 0x22, 0x37, 0x57, 0xdd, 0x9e, 0x40, 0x1d,
+	0x50, 0xcc, 0x13, 0x74, 0xa1, 0x7d, 0x0a, 0x0b, 0x23, 0x3b, 0x8c, 0x50, 0x40, 0x97, 0x77, 0xe2,
+	0xe1, 0x53, 0x14, 0x8c, 0x03, 0x2f, 0x71, 0xab, 0x7d, 0x86, 0x7d, 0x82, 0x2e, 0xb6, 0x53, 0x1c,
+	0x71, 0xe3, 0x2e, 0x0a, 0xbc, 0xd7, 0x36, 0x31, 0x7e, 0x6b, 0x6c, 0x47, 0x67, 0x34, 0x8c, 0xb5,
+	0xcc, 0x76, 0x0a, 0x3e, 0xb0, 0xa3, 0x33, 0xe3, 0x05, 0xf4, 0x4d, 0x44, 0x4e, 0x57, 0x68, 0xe4,
+	0xf5, 0xf6, 0x64, 0x09, 0xea, 0x18, 0x9d, 0xcb, 0xea, 0x51, 0xc3, 0xe8, 0x9c, 0x5a, 0xde, 0x22,
+	0xdc, 0x98, 0xe0, 0xcc, 0x3d, 0xc3, 0xd7, 0xa0, 0xed, 0xa1, 0x37, 0xd1, 0xc4, 0x84, 0x24, 0x07,
+	0xb0, 0xc3, 0x70, 0x7c, 0x16, 0x90, 0x1c, 0x80, 0xb9, 0x4c, 0x09, 0x72, 0x05, 0x65, 0x34, 0x3e,
+	0x87, 0x79, 0x85, 0xf1, 0xf5, 0x2c, 0xfd, 0x6f, 0x4a, 0x5c, 0x2e, 0xd7, 0x0d, 0x50, 0x28, 0xac,
+	0x7d, 0x8a, 0x97, 0xfc, 0x6d, 0xa8, 0xbe, 0xf4, 0xb0, 0x4b, 0x25, 0x69, 0x3f, 0x32, 0x24, 0x73,
+	0xcf, 0xb2, 0x59, 0x7b, 0xe2, 0x61, 0xd7, 0xa4, 0xf4, 0xc6, 0x23, 0xa8, 0x92, 0x2f, 0xad, 0x0f,
+	0xdd, 0xc7, 0x3b, 0x07, 0x0f, 0x1f, 0x7e, 0xfa, 0xa9, 0xb5, 0xf5, 0xe2, 0x68, 0xcb, 0xdc, 0x5b,
+	0xdf, 0xed, 0xbe, 0x23, 0x43, 0x77, 0xf6, 0x38, 0xb4, 0x64, 0x7c, 0x0c, 0xf3, 0x0a, 0x53, 0xbe,
+	0x34, 0x22, 0x1c, 0x03, 0x71, 0x05, 0x12, 0x9f, 0xc6, 0x5f, 0x96, 0x60, 0x71, 0x87, 0xaa, 0xff,
+	0x01, 0x3d, 0x6f, 0xf4, 0x04, 0x5d, 0x5c, 0x75, 0xab, 0x8b, 0x43, 0xf7, 0x07, 0x24, 0x3b, 0xa0,
+	0xec, 0xa8, 0x36, 0x9e, 0x7b, 0x27, 0x54, 0xe7, 0xe7, 0xcc, 0xd6, 0x38, 0x99, 0xe5, 0x6b, 0xef,
+	0x84, 0x44, 0xb9, 0x00, 0x85, 0x8e, 0x8d, 0xa9, 0x95, 0xd7, 0x4d, 0xfe, 0x65, 0xe8, 0x30, 0xc8,
+	0x0a, 0xc5, 0xd5, 0x02, 0x43, 0x9b, 0x3b, 0x8c, 0x6b, 0xea, 0xe0, 0x67, 0xb0, 0x10, 0xa0, 0x57,
+	0xb1, 0x17, 0x20, 0xd7, 0x22, 0x51, 0xd9, 0x0b, 0x46, 0x36, 0x0b, 0x93, 0x2c, 0xc4, 0xde, 0x10,
+	0xd8, 0x0d, 0x19, 0x69, 0xfc, 0x69, 0x09, 0x3a, 0xc9, 0x84, 0x7c, 0x3f, 0xfb, 0x30, 0x43, 0x3d,
+	0x17, 0x9d, 0xa8, 0x62, 0xb2, 0x0f, 0x12, 0x9b, 0xc3, 0x31, 0xc2, 0xae, 0x7d, 0x3c, 0x14, 0xa1,
+	0x30, 0x05, 0x10, 0x53, 0xf3, 0x46, 0x23, 0x3b, 0x8a, 0x03, 0x64, 0x05, 0xe8, 0xdc, 0x0e, 0x5c,
+	0x91, 0x31, 0x09, 0xb0, 0x49, 0xa1, 0x64, 0x53, 0x4e, 0x02, 0xff, 0x2d, 0x62, 0x9b, 0x52, 0x31,
+	0xf9, 0x97, 0x31, 0x0f, 0xbd, 0xaf, 0xa9, 0xea, 0xec, 0xe0, 0x13, 0x5f, 0x04, 0x99, 0x7f, 0x9f,
+	0x01, 0x4d, 0x86, 0x72, 0x01, 0x1f, 0x40, 0x8f, 0x2f, 0x11, 0xb9, 0x89, 0xcf, 0x65, 0xc2, 0x76,
+	0x13, 0x84, 0xf0, 0xbb, 0x1f, 0xc3, 0x7c, 0x8c, 0xb3, 0xe4, 0x6c, 0x05, 0x5a, 0x8c, 0x33, 0x03,
+	0xee, 0x41, 0x37, 0x59, 0x8a, 0xea, 0xd0, 0x93, 0x25, 0x0a, 0xd2, 0x25, 0xa8, 0x47, 0x6f, 0x24,
+	0x4f, 0x5e, 0x31, 0x6b, 0xd1, 0x1b, 0xe6, 0x92, 0xbf, 0x92, 0x42, 0xe1, 0x0c, 0x0d, 0x85, 0x0f,
+	0x24, 0xdb, 0xc8, 0x2e, 0x6a, 0xed, 0xd0, 0xf1, 0xc7, 0x28, 0x09, 0x91, 0xc9, 0x60, 0xb2, 0x61,
+	0x24, 0x80, 0x21, 0x97, 0xfa, 0xf3, 0xba, 0xc9, 0xbf, 0x88, 0x5e, 0xc4, 0x98, 0xfd, 0xb6, 0x62,
+	0x1c, 0x79, 0xc3, 0x41, 0x8d, 0x4a, 0xd0, 0x12, 0xd0, 0x67, 0x04, 0xa8, 0xdd, 0x06, 0x38, 0x27,
+	0xd7, 0x0b, 0xcb, 0xc7, 0xc3, 0x8b, 0x41, 0x9d, 0xb2, 0x98, 0xa3, 0x90, 0x7d, 0x3c, 0xbc, 0x20,
+	0x69, 0xe8, 0xb1, 0x17, 0x44, 0x67, 0xae, 0x7d, 0x31, 0x98, 0xa3, 0xe3, 0x93, 0x6f, 0x72, 0xa6,
+	0xe2, 0xb7, 0x88, 0xac, 0x40, 0x75, 0xa9, 0x2d, 0xc0, 0x2c, 0xaa, 0x92, 0xf8, 0x97, 0x12, 0x92,
+	0x80, 0xdd, 0xa0, 0xd6, 0xd4, 0x4c, 0xc8, 0x48, 0xb0, 0xbe, 0x03, 0xad, 0xf0, 0x02, 0x3b, 0xc8,
+	0x15, 0xbc, 0x9a, 0x94, 0x57, 0x93, 0x01, 0x39, 0xa7, 0x15, 0x68, 0x08, 0x22, 0xc2, 0xa7, 0xc5,
+	0xac, 0x92, 0x93, 0x10, 0x2e, 0xcb, 0x00, 0xcc, 0x8a, 0x30, 0xb9, 0x89, 0xb5, 0xe9, 0x72, 0x24,
+	0x08, 0xc3, 0x3b, 0xfe, 0x6b, 0x44, 0x6f, 0x6a, 0x1d, 0x81, 0x17, 0x10, 0xb2, 0x6b, 0x4c, 0xe1,
+	0x92, 0xa3, 0xed, 0xb2, 0x5d, 0x63, 0x50, 0x7e, 0xb0, 0xfa, 0x4f, 0xa0, 0xa5, 0x9c, 0x87, 0x1c,
+	0xf6, 0x4a, 0xf9, 0x61, 0xaf, 0x9c, 0x86, 0x3d, 0xb2, 0xab, 0xc9, 0xe1, 0xb3, 0x70, 0x98, 0x7c,
+	0x1b, 0x7f, 0x5d, 0x86, 0x85, 0xaf, 0x50, 0x24, 0xa5, 0xaa, 0x89, 0x97, 0x5d, 0x83, 0xf9, 0x30,
+	0xb2, 0x03, 0x9a, 0x67, 0x4b, 0xe9, 0x0f, 0xf3, 0x4d, 0x3d, 0x81, 0x4a, 0xf3, 0x9f, 0x47, 0x70,
+	0x63, 0x92, 0x3e, 0xcd, 0xaa, 0x7b, 0xe6, 0xbc, 0x3a, 0x82, 0xed, 0xf0, 0x7d, 0xe8, 0x21, 0xec,
+	0x4e, 0xcc, 0x50, 0x61, 0xa9, 0x3f, 0x43, 0xa4, 0xfc, 0x49, 0x94, 0x56, 0x68, 0x19, 0xf7, 0x2a,
+	0xcb, 0xfd, 0x65, 0x6a, 0xc6, 0xfb, 0x0b, 0xb8, 0x39, 0xf2, 0xb0, 0x37, 0x8a, 0x47, 0x56, 0x80,
+	0x1c, 0x92, 0x96, 0x29, 0xf9, 0xfa, 0x0c, 0x1d, 0xb7, 0xc4, 0x49, 0x4c, 0x4a, 0x21, 0x6f, 0x83,
+	0xf1, 0xf3, 0x32, 0x2c, 0x66, 0xb6, 0x86, 0xdb, 0xfc, 0x36, 0x68, 0x23, 0x0f, 0x23, 0x57, 0x65,
+	0xc9, 0x92, 0xcc, 0x45, 0xc9, 0xb2, 0xe4, 0xbb, 0x87, 0xd9, 0xa3, 0x43, 0x64, 0x7e, 0xda, 0x01,
+	0xf4, 0x63, 0x9c, 0xc3, 0xa9, 0x7c, 0x95, 0xcb, 0xc4, 0x3c, 0x1f, 0xaa, 0x70, 0xfc, 0x43, 0x58,
+	0x74, 0x92, 0xcb, 0x9a, 0xca, 0x94, 0x5d, 0x9a, 0x3f, 0x94, 0x98, 0x4e, 0xbb, 0xd6, 0x99, 0x0b,
+	0x4e, 0x1e, 0x36, 0x24, 0x65, 0x89, 0xc5, 0x8d, 0x33, 0x1b, 0x9f, 0xa2, 0x83, 0x24, 0x3e, 0x09,
+	0x9d, 0xf9, 0x3e, 0x54, 0x44, 0xe6, 0xd4, 0x7e, 0xf4, 0x81, 0x3c, 0x53, 0xfe, 0x80, 0x35, 0x12,
+	0x6d, 0xc8, 0x10, 0x62, 0x0a, 0xfe, 0xd0, 0xb5, 0xa4, 0x20, 0xc8, 0xf2, 0xec, 0x96, 0x3f, 0x74,
+	0xd3, 0x61, 0x84, 0x8c, 0x24, 0x37, 0x12, 0x19, 0xd3, 0x96, 0x16, 0x46, 0xe7, 0x29, 0x99, 0xb1,
+	0x0c, 0x15, 0x92, 0xa8, 0x35, 0xa0, 0x76, 0x60, 0xee, 0x3c, 0x5f, 0x3f, 0xda, 0xea, 0xbe, 0xa3,
+	0x01, 0xcc, 0x1e, 0x3c, 0x7b, 0xbc, 0xbb, 0xb3, 0xd1, 0x2d, 0x91, 0xa0, 0x97, 0x95, 0x88, 0x07,
+	0xbd, 0x5f, 0xd6, 0x60, 0x61, 0x3b, 0xc6, 0xf2, 0xa2, 0x2f, 0x4f, 0x3c, 0x48, 0xd2, 0x6d, 0x07,
+	0xa7, 0x28, 0x12, 0x37, 0x74, 0x71, 0x3d, 0xa3, 0x40, 0x76, 0x3f, 0x9f, 0x12, 0x15, 0x2b, 0x53,
+	0xa2, 0xa2, 0xf6, 0x39, 0xe8, 0x1e, 0x76, 0x86, 0xb1, 0x8b, 0xac, 0x24, 0x14, 0x10, 0xc3, 0x3e,
+	0xb6, 0x43, 0x14, 0xf2, 0x68, 0x3e, 0xe0, 0x14, 0x3b, 0x9c, 0x60, 0x43, 0xe0, 0x89, 0x59, 0x8a,
+	0xd1, 0x0e, 0x5d, 0xb2, 0x15, 0x3a, 0x81, 0x37, 0x66, 0xe9, 0x7b, 0xdd, 0x9c, 0xe7, 0x48, 0xb6,
+	0x1d, 0x87, 0x14, 0xa5, 0x3d, 0x87, 0x36, 0x99, 0xc0, 0x0a, 0xd1, 0x10, 0xb1, 0x4b, 0xfd, 0x2c,
+	0x3d, 0xd1, 0x8f, 0xa5, 0x13, 0xcd, 0xdf, 0xa2, 0x35, 0x32, 0xf1, 0xa1, 0x18, 0x66, 0xb6, 0x1c,
+	0xf9, 0x93, 0x38, 0x54, 0xb2, 0x6e, 0x8b, 0xed, 0x0a, 0x0d, 0x11, 0x2d, 0x13, 0x08, 0xe8, 0x88,
+	0x42, 0x48, 0x46, 0x19, 0xc6, 0xc7, 0x51, 0x60, 0x3b, 0x91, 0x45, 0xaa, 0x2d, 0x2c, 0x42, 0x34,
+	0x04, 0x6c, 0x1b, 0xd1, 0x28, 0x17, 0x22, 0xec, 0x5a, 0xf6, 0x70, 0x48, 0x63, 0x44, 0xdd, 0xac,
+	0x91, 0xef, 0xf5, 0xe1, 0x50, 0x3b, 0x84, 0x4e, 0xb2, 0xbf, 0x1e, 0x1e, 0xc7, 0x51, 0x38, 0x00,
+	0xaa, 0xf3, 0xf7, 0x2f, 0x97, 0x7b, 0x3f, 0x8e, 0x0e, 0x7c, 0x0f, 0x47, 0x66, 0x5b, 0xb0, 0xa0,
+	0xd5, 0x1b, 0x7a, 0x09, 0x8c, 0xc3, 0x64, 0xef, 0xc4, 0xf1, 0x37, 0xe8, 0xcc, 0xdd, 0x38, 0xe4,
+	0x1b, 0x27, 0x5d, 0xe3, 0x26, 0x28, 0x9b, 0x2c, 0x3f, 0x72, 0x14, 0xb2, 0x89, 0x5a, 0x57, 0x2b,
+	0x5b, 0xeb, 0x5a, 0x83, 0x79, 0x69, 0xda, 0xb1, 0x1f, 0x7a, 0x94, 0x92, 0xc5, 0x98, 0x5e, 0x32,
+	0xef, 0x01, 0x47, 0x90, 0xf0, 0x38, 0x49, 0xdb, 0xa1, 0x33, 0xb7, 0x1d, 0x95, 0x50, 0x83, 0x2a,
+	0x2d, 0xd7, 0x75, 0x59, 0x05, 0x84, 0xfc, 0xd6, 0x5f, 0x40, 0x5d, 0xac, 0x9f, 0x24, 0x1c, 0x92,
+	0x1c, 0xb2, 0xcf, 0xef, 0x48, 0x70, 0xea, 0x91, 0xdf, 0x85, 0xa6, 0x4f, 0xeb, 0x4d, 0x16, 0x2b,
+	0x36, 0xb1, 0xa0, 0xd3, 0x60, 0xb0, 0x1d, 0x02, 0x32, 0x46, 0xd0, 0x52, 0x34, 0x82, 0x98, 0xe4,
+	0xe6, 0xd6, 0xf6, 0xfa, 0xb3, 0xdd, 0xa3, 0xee, 0x3b, 0x5a, 0x0f, 0x5a, 0xbb, 0xeb, 0xe6, 0x57,
+	0x5b, 0x87, 0x47, 0xd6, 0xf6, 0x8e, 0x79, 0x78, 0xd4, 0x2d, 0x69, 0x1a, 0xb4, 0x0f, 0x9f, 0xae,
+	0xef, 0xee, 0xa6, 0xb0, 0x32, 0xcd, 0xbf, 0xcd, 0xf5, 0xbd, 0x8d, 0x1f, 0x59, 0xeb, 0x7b, 0x9b,
+	0xd6, 0xe3, 0xfd, 0x67, 0x7b, 0x9b, 0xdd, 0x0a, 0xa1, 0x34, 0xd7, 0xf7, 0x36, 0xf7, 0x9f, 0x5a,
+	0x3b, 0x4f, 0x0f, 0xcc, 0xfd, 0xe7, 0x5b, 0xdd, 0xaa, 0xf1, 0x77, 0x55, 0x58, 0xcc, 0x1c, 0x30,
+	0xf7, 0xd9, 0x7f, 0x00, 0x5d, 0xa6, 0xcf, 0xc8, 0xb5, 0x98, 0x88, 0xc2, 0x63, 0x7f, 0x32, 0x4d,
+	0x3d, 0xd8, 0xe8, 0xb5, 0x03, 0x5e, 0x6a, 0xe3, 0x65, 0xc1, 0x8e, 0x60, 0xc5, 0xbe, 0x43, 0xb2,
+	0x17, 0xec, 0xd6, 0xad, 0xd8, 0x7f, 0x83, 0xc2, 0xb8, 0xf9, 0xdf, 0x85, 0xae, 0x38, 0xa2, 0x97,
+	0xc2, 0x08, 0x99, 0xf7, 0x12, 0x67, 0xf4, 0x92, 0xdb, 0xdf, 0x12, 0xd4, 0x4f, 0x10, 0xb2, 0x02,
+	0x3b, 0x42, 0x22, 0x93, 0x3b, 0x41, 0xc8, 0xb4, 0x23, 0x44, 0x0a, 0x60, 0x27, 0x31, 0xbd, 0xa9,
+	0xca, 0x0a, 0x34, 0xc3, 0x82, 0x32, 0xc3, 0x1c, 0xa9, 0x6a, 0xc4, 0xe7, 0x54, 0x4e, 0x6a, 0x96,
+	0x17, 0xcc, 0x28, 0x6a, 0x3f, 0x3d, 0x2f, 0xfd, 0x3f, 0x4b, 0xd0, 0x56, 0x97, 0xfa, 0xed, 0x2a,
+	0x04, 0x49, 0x20, 0x95, 0x1a, 0x26, 0xff, 0xd2, 0x6e, 0xc2, 0x5c, 0xba, 0x2b, 0x55, 0xca, 0xbe,
+	0x3e, 0x16, 0xfb, 0xf1, 0x2e, 0x34, 0x49, 0x08, 0x27, 0x45, 0x29, 0x52, 0x80, 0xe3, 0x45, 0xd8,
+	0x06, 0x87, 0x1d, 0x79, 0xac, 0xea, 0x71, 0x12, 0xf8, 0xa3, 0xc4, 0x31, 0xf2, 0xfc, 0xb4, 0x49,
+	0x80, 0xc2, 0x19, 0x1a, 0xff, 0x56, 0x81, 0x25, 0xba, 0x3a, 0x74, 0x7e, 0x2d, 0xef, 0xbe, 0x05,
+	0x35, 0xa1, 0x31, 0xe5, 0x4c, 0xf6, 0x5c, 0xc8, 0x30, 0x29, 0x21, 0xf3, 0xb1, 0xdf, 0xd4, 0xff,
+	0x4f, 0xd1, 0x86, 0x09, 0x87, 0x3a, 0x93, 0x71, 0xa8, 0xdf, 0x95, 0x27, 0xbf, 0x0f, 0x3d, 0xd9,
+	0x51, 0x5b, 0x64, 0x9b, 0x07, 0x35, 0x5a, 0xce, 0xe8, 0x48, 0xde, 0x7a, 0x3b, 0xf0, 0x47, 0x8a,
+	0xc7, 0xae, 0x2b, 0x1e, 0x5b, 0xff, 0x41, 0x52, 0xb1, 0x56, 0xce, 0xbf, 0x34, 0x71, 0xfe, 0xa9,
+	0xd2, 0x94, 0x65, 0xa5, 0x31, 0x7e, 0x55, 0x01, 0x3d, 0x6f, 0xfb, 0xb9, 0xc5, 0x7f, 0x42, 0xb2,
+	0xab, 0xd0, 0x3b, 0x9d, 0x48, 0xaf, 0x38, 0xfb, 0x79, 0x81, 0x93, 0xed, 0x65, 0x03, 0x66, 0x3d,
+	0x7c, 0x8d, 0x83, 0xe6, 0xde, 0x81, 0x3f, 0x35, 0xb0, 0xa1, 0x24, 0x08, 0xa0, 0x30, 0xf2, 0x46,
+	0x36, 0x71, 0x35, 0xa1, 0xf7, 0x56, 0xdc, 0xd8, 0x5a, 0x09, 0xf4, 0xd0, 0x7b, 0x8b, 0xc4, 0x8b,
+	0x42, 0x35, 0x7d, 0x51, 0x90, 0x4f, 0x7a, 0x46, 0x3d, 0xe9, 0x3b, 0xd0, 0x12, 0x81, 0x65, 0x94,
+	0xd4, 0xd3, 0x2a, 0x66, 0x93, 0xc7, 0x15, 0x0a, 0x2b, 0xb2, 0xf6, 0x5a, 0x91, 0xb5, 0xff, 0x59,
+	0x49, 0x3c, 0x52, 0xfc, 0xe6, 0x8d, 0xdc, 0xf8, 0xa7, 0x12, 0x2c, 0x1c, 0x7a, 0xa7, 0x38, 0xc7,
+	0x32, 0x2f, 0xab, 0x8e, 0x7c, 0x06, 0x0b, 0x21, 0x0a, 0x3c, 0x7b, 0xe8, 0xbd, 0x9d, 0x38, 0x6a,
+	0x96, 0x44, 0xde, 0x48, 0xb1, 0xf2, 0x61, 0xdf, 0x81, 0x96, 0x87, 0x93, 0x85, 0xa0, 0x90, 0xd7,
+	0xe3, 0x9a, 0x1e, 0x16, 0x2b, 0x41, 0xd4, 0xb1, 0x33, 0xa2, 0xd7, 0xf6, 0x30, 0x46, 0xac, 0xce,
+	0x5f, 0x31, 0x1b, 0x14, 0xf6, 0x9c, 0x82, 0x8c, 0x57, 0xb0, 0x98, 0x11, 0x9c, 0xab, 0xe0, 0x6a,
+	0xf6, 0x6d, 0x64, 0x22, 0xd0, 0x7f, 0x0a, 0x0b, 0x89, 0x92, 0xaa, 0xd2, 0x94, 0xa9, 0x34, 0x89,
+	0x0a, 0xef, 0x48, 0x52, 0x19, 0x3f, 0x86, 0x25, 0x5a, 0x8f, 0x0c, 0xcf, 0x72, 0xb6, 0xeb, 0x23,
+	0xd0, 0x0a, 0xb5, 0xbe, 0x97, 0xd1, 0x79, 0xe3, 0x16, 0xe8, 0x79, 0xbc, 0x78, 0x3a, 0xfc, 0x1a,
+	0xda, 0x8f, 0xe3, 0xd1, 0x78, 0x1b, 0xa1, 0xab, 0x9e, 0x46, 0x9e, 0x2e, 0x95, 0xf3, 0x75, 0x49,
+	0x56, 0xf8, 0x8a, 0xa2, 0xf0, 0xe4, 0xd9, 0xa9, 0x93, 0x4c, 0x7c, 0xe5, 0xdd, 0xbc, 0xc6, 0xdc,
+	0xdc, 0xfc, 0x2a, 0xa9, 0xf9, 0xdd, 0x26, 0x0b, 0xa3, 0x05, 0xfc, 0xd4, 0x2e, 0xe7, 0x18, 0x84,
+	0x64, 0x9e, 0x77, 0xa1, 0x3b, 0xb6, 0x9d, 0x97, 0xf6, 0x29, 0xb2, 0x26, 0xac, 0xb4, 0xcd, 0xe1,
+	0xdb, 0x5c, 0xf6, 0x6d, 0x58, 0x5a, 0x3f, 0xb6, 0xb1, 0xeb, 0xe7, 0x29, 0xf3, 0xd5, 0x4d, 0xcd,
+	0xf8, 0x19, 0xe8, 0x79, 0x7c, 0xf8, 0x6e, 0x7c, 0x09, 0xb7, 0x6c, 0x86, 0x55, 0x4f, 0x9a, 0xb2,
+	0x44, 0x2c, 0xb9, 0x69, 0x9a, 0x7a, 0x42, 0x73, 0xa4, 0x72, 0x47, 0xa1, 0xf1, 0x5f, 0x25, 0xe8,
+	0x10, 0xef, 0x7f, 0x10, 0x1e, 0x27, 0x45, 0x5f, 0x0d, 0xaa, 0xe3, 0xf0, 0x58, 0xf8, 0x60, 0xfa,
+	0x7b, 0x4a, 0xf5, 0xf1, 0xdb, 0x0f, 0x69, 0x4b, 0x50, 0xa7, 0x2f, 0xcc, 0x96, 0xe7, 0xf2, 0xb4,
+	0xa6, 0x46, 0xbf, 0x77, 0x5c, 0x62, 0x2a, 0x0c, 0xe5, 0xc6, 0x01, 0x65, 0x64, 0x85, 0xc8, 0xf1,
+	0xb1, 0x1b, 0x52, 0x67, 0x58, 0x35, 0xfb, 0x14, 0xbb, 0xc9, 0x91, 0x87, 0x0c, 0x67, 0x38, 0xd0,
+	0x4d, 0xd7, 0xc8, 0xb7, 0x6e, 0x05, 0x1a, 0x3c, 0x8b, 0x92, 0xd6, 0x0a, 0x0c, 0x44, 0x08, 0x8b,
+	0x3c, 0x69, 0xb9, 0xc0, 0x93, 0x1a, 0x5b, 0xd0, 0x21, 0x2e, 0x40, 0xde, 0xc8, 0xcb, 0xcc, 0x44,
+	0x6c, 0x74, 0x39, 0xdd, 0x68, 0x03, 0x41, 0x37, 0x65, 0x93, 0xca, 0xca, 0xad, 0x59, 0x96, 0x95,
+	0x81, 0xa8, 0xac, 0x0f, 0xa1, 0x3f, 0xc5, 0x7f, 0x68, 0x39, 0xde, 0x63, 0x07, 0xe6, 0xb7, 0x3d,
+	0x4c, 0x1d, 0xe2, 0xaf, 0x2b, 0xf1, 0x4f, 0xa0, 0xaf, 0xb2, 0xba, 0xaa, 0xd4, 0xab, 0xd0, 0x0c,
+	0xec, 0x73, 0xf2, 0x7a, 0x62, 0x0f, 0xad, 0xe8, 0x0d, 0x67, 0x0a, 0x81, 0x7d, 0x4e, 0xf9, 0x1d,
+	0xbd, 0x31, 0x36, 0x40, 0xe3, 0xda, 0x7f, 0x99, 0x7e, 0xca, 0x3a, 0x53, 0x56, 0x74, 0xc6, 0xb8,
+	0x01, 0xf3, 0x0a, 0x13, 0xee, 0xd5, 0x7e, 0x55, 0x86, 0xd9, 0x03, 0xfb, 0xc2, 0x8f, 0x23, 0xad,
+	0x9b, 0xd6, 0x2c, 0xe6, 0x58, 0x2d, 0xa2, 0x58, 0xdd, 0x95, 0x00, 0x56, 0x29, 0xcc, 0x52, 0xaa,
+	0x4a, 0xd4, 0xfb, 0x08, 0x66, 0xc2, 0x48, 0x38, 0x8b, 0xb6, 0x52, 0x1f, 0x62, 0x22, 0xac, 0x1d,
+	0x12, 0xb4, 0xc9, 0xa8, 0x72, 0xfd, 0xc3, 0x6c, 0x61, 0x28, 0x76, 0x02, 0x44, 0xd3, 0x0c, 0x9a,
+	0x17, 0xb3, 0x9a, 0x6b, 0x83, 0xc3, 0x68, 0x5e, 0xdc, 0x87, 0x19, 0x14, 0x04, 0x7e, 0x40, 0x33,
+	0xaf, 0x39, 0x93, 0x7d, 0x18, 0xbb, 0x30, 0x43, 0xe7, 0x24, 0x45, 0x91, 0xdf, 0x7f, 0xb6, 0xf5,
+	0x6c, 0x6b, 0xb3, 0xfb, 0x8e, 0xd6, 0x82, 0xb9, 0xc7, 0xe6, 0xfe, 0xfa, 0xe6, 0xc6, 0x3a, 0xbd,
+	0x89, 0xb5, 0x60, 0x6e, 0x63, 0x7f, 0x6f, 0x7b, 0xc7, 0x7c, 0xba, 0xb5, 0xd9, 0x2d, 0xd3, 0xcf,
+	0xf5, 0xbd, 0x8d, 0xad, 0xdd, 0xdd, 0x2d, 0x72, 0xfb, 0x02, 0x98, 0xdd, 0x5e, 0xdf, 0x21, 0xbf,
+	0xab, 0xc6, 0x39, 0xf4, 0xb7, 0xf0, 0xab, 0x18, 0xc5, 0x88, 0xad, 0x47, 0x1c, 0xd5, 0x77, 0xbd,
+	0xb3, 0xc6, 0x63, 0xb8, 0x31, 0x31, 0x31, 0xd7, 0xbe, 0x7b, 0x30, 0x3b, 0xa6, 0x10, 0xde, 0x8d,
+	0xd0, 0xcb, 0xec, 0xb9, 0xc9, 0x09, 0x8c, 0x0f, 0x61, 0x7e, 0xc3, 0xc6, 0x0e, 0x1a, 0x5e, 0x22,
+	0xbb, 0xb1, 0x0e, 0x7d, 0x95, 0xf0, 0xfa, 0x73, 0x75, 0xa1, 0xcd, 0x20, 0xc9, 0xc3, 0xf5, 0x17,
+	0xd0, 0x49, 0x20, 0xc9, 0x7b, 0x42, 0x8d, 0x91, 0x8b, 0xeb, 0x69, 0x0e, 0x43, 0x41, 0x61, 0xfc,
+	0xbc, 0x04, 0xcd, 0x6d, 0x5a, 0x2c, 0xfe, 0xae, 0x6e, 0x6b, 0x01, 0xb2, 0x43, 0x1f, 0xf3, 0x37,
+	0x25, 0xfe, 0x45, 0xac, 0x1b, 0xbd, 0x19, 0x7b, 0xc1, 0x05, 0xd3, 0x3b, 0x76, 0x2a, 0xc0, 0x40,
+	0x44, 0xed, 0x8c, 0x1d, 0xe8, 0x6f, 0x07, 0x08, 0xbd, 0xe5, 0x4e, 0x52, 0xac, 0x57, 0xfb, 0x24,
+	0xbd, 0x49, 0x65, 0xab, 0xa5, 0xf2, 0x42, 0x92, 0x5b, 0x13, 0x79, 0xb4, 0x9c, 0x60, 0xc5, 0x6d,
+	0x78, 0x0c, 0x0b, 0xcf, 0xf0, 0x49, 0xde, 0x2c, 0x9b, 0x93, 0xb3, 0x5c, 0xa7, 0x00, 0x24, 0x86,
+	0x12, 0x15, 0x20, 0x57, 0x96, 0x32, 0xbd, 0xb2, 0x90, 0x9f, 0xc6, 0x12, 0x2c, 0x66, 0x66, 0xe4,
+	0xc2, 0x2c, 0x40, 0x5f, 0x16, 0x3f, 0x39, 0xe0, 0x1f, 0xc3, 0x8d, 0x09, 0x78, 0x72, 0x39, 0xb9,
+	0xf6, 0x4e, 0xfc, 0x49, 0x09, 0x1a, 0x0c, 0xb6, 0x4b, 0x7b, 0xb0, 0xda, 0x50, 0xf6, 0x5c, 0x7e,
+	0xba, 0x65, 0xcf, 0xd5, 0xb6, 0xa1, 0x4e, 0x48, 0x7d, 0xf1, 0x12, 0x7d, 0xbd, 0x75, 0x27, 0x63,
+	0x49, 0x1c, 0xa0, 0x47, 0x49, 0xc3, 0x28, 0x4f, 0x90, 0x24, 0x88, 0xf1, 0x57, 0x25, 0xd0, 0xa8,
+	0x04, 0x5c, 0x40, 0xbe, 0xeb, 0xdf, 0x95, 0x38, 0xf7, 0xa0, 0x9b, 0x09, 0xf8, 0x15, 0x1a, 0xf0,
+	0x3b, 0xee, 0x44, 0xac, 0xff, 0x0c, 0xe6, 0x15, 0xc1, 0xf8, 0x5e, 0xab, 0x0b, 0x2a, 0x65, 0x16,
+	0x84, 0xc9, 0x8b, 0xfb, 0xf0, 0xff, 0x6d, 0x45, 0xec, 0x1d, 0x7e, 0x98, 0x15, 0xd4, 0xe8, 0x40,
+	0x8b, 0xca, 0x9f, 0xa8, 0xcf, 0x97, 0xd0, 0x16, 0x80, 0xa4, 0x59, 0x61, 0x96, 0x8e, 0x14, 0x6a,
+	0xb3, 0x20, 0x49, 0x20, 0x29, 0x87, 0xc9, 0xa9, 0x8c, 0x77, 0x61, 0x45, 0x12, 0x6a, 0xcf, 0x8f,
+	0xbc, 0x13, 0xcf, 0xb1, 0xe5, 0x97, 0x1e, 0xe3, 0x17, 0x65, 0x58, 0x2d, 0xa6, 0x49, 0xb2, 0xcd,
+	0x8e, 0x1d, 0x45, 0xb6, 0x73, 0x46, 0x9e, 0x2d, 0xc9, 0xb3, 0xc6, 0xa5, 0xef, 0x1d, 0x6d, 0x41,
+	0x4f, 0xa1, 0x21, 0x6b, 0x80, 0x50, 0x39, 0x94, 0x69, 0x8a, 0xda, 0x76, 0x91, 0x42, 0x58, 0xf4,
+	0x2a, 0x52, 0xf9, 0xc6, 0xaf, 0x22, 0x9f, 0x83, 0x9e, 0xc3, 0x51, 0x24, 0xca, 0x55, 0x2a, 0xc5,
+	0x20, 0x3b, 0x90, 0xa7, 0xc9, 0x7f, 0x5e, 0x82, 0xdb, 0x87, 0x63, 0x84, 0x23, 0x8c, 0xc2, 0x30,
+	0x6f, 0x07, 0xa7, 0x94, 0x8e, 0xee, 0x43, 0x0f, 0xfb, 0x16, 0x26, 0x83, 0x2e, 0xac, 0x18, 0x87,
+	0x63, 0xc4, 0x75, 0xa7, 0x6e, 0x76, 0xb0, 0x4f, 0x99, 0x5d, 0x3c, 0x63, 0x60, 0xf2, 0x94, 0x9f,
+	0xd2, 0x32, 0x4a, 0xd6, 0x8c, 0xd7, 0x12, 0x94, 0x54, 0x0a, 0xe3, 0x2f, 0xca, 0xb0, 0x5c, 0x24,
+	0x4f, 0x12, 0x94, 0xbe, 0xcd, 0x30, 0xf0, 0x04, 0x6a, 0xf4, 0x71, 0x1d, 0xb1, 0xd6, 0x51, 0xb5,
+	0x62, 0x3a, 0x5d, 0x12, 0x8a, 0x76, 0x51, 0x60, 0x0a, 0x0e, 0xfa, 0x33, 0xa8, 0x71, 0xd8, 0x75,
+	0xa4, 0x5c, 0x81, 0x86, 0x87, 0x27, 0x85, 0x84, 0xf4, 0xa6, 0x6e, 0xdc, 0x86, 0x9b, 0xa2, 0xab,
+	0x2c, 0x4f, 0xc7, 0xff, 0xb7, 0x04, 0xb7, 0xf2, 0xf1, 0xd7, 0x6a, 0x49, 0xb9, 0x4a, 0x03, 0x56,
+	0x7e, 0x6f, 0x55, 0xe5, 0x5a, 0xbd, 0x55, 0xd5, 0x6b, 0xf5, 0x56, 0xcd, 0x14, 0xf4, 0x56, 0xfd,
+	0x47, 0x09, 0xe6, 0x37, 0x68, 0x32, 0xc8, 0x9e, 0xf8, 0x85, 0xba, 0x3e, 0x80, 0x1e, 0xef, 0x73,
+	0xca, 0xe4, 0xfb, 0x5d, 0x86, 0x90, 0x9e, 0xdc, 0x3e, 0x02, 0x4d, 0x34, 0x98, 0x64, 0x5e, 0xe7,
+	0x7a, 0x1c, 0x73, 0xa0, 0x5c, 0x12, 0x42, 0x84, 0x5c, 0x9e, 0xc3, 0xd1, 0xdf, 0xe4, 0x05, 0x7a,
+	0x84, 0xd1, 0xc8, 0xc7, 0x9e, 0x43, 0x57, 0x36, 0x67, 0x26, 0xdf, 0xa4, 0x23, 0x42, 0xfc, 0x96,
+	0xf9, 0xb3, 0xab, 0x9f, 0x26, 0x50, 0xe9, 0x04, 0x24, 0xd2, 0xaa, 0x6b, 0xe2, 0xbe, 0xf3, 0x7f,
+	0xca, 0xb0, 0x22, 0x10, 0x91, 0x73, 0xe6, 0xe1, 0x53, 0xd2, 0x53, 0xf0, 0x6b, 0x2c, 0x7c, 0x4f,
+	0x7a, 0x37, 0x67, 0xd5, 0xc0, 0x47, 0xf2, 0x8b, 0xe6, 0xf4, 0xa9, 0x72, 0xda, 0x09, 0xe5, 0xee,
+	0x86, 0x8a, 0xda, 0xdd, 0xa0, 0xff, 0x63, 0x29, 0x6d, 0x05, 0xd4, 0xa0, 0x2a, 0xb5, 0x4e, 0xd2,
+	0xdf, 0xd7, 0x6b, 0x74, 0x2b, 0x6a, 0x68, 0xab, 0x5e, 0xbf, 0xa1, 0x6d, 0xa6, 0xb8, 0xa1, 0xcd,
+	0x30, 0x60, 0xb5, 0x78, 0x13, 0xf8, 0xa1, 0x7c, 0x09, 0xbd, 0xfd, 0x31, 0xc2, 0xdf, 0xfc, 0x14,
+	0x8c, 0x3e, 0x68, 0x32, 0x07, 0xce, 0xb7, 0x0f, 0xda, 0xc6, 0xd0, 0x0f, 0x55, 0xbd, 0x26, 0x97,
+	0x3d, 0x05, 0xca, 0x89, 0x6f, 0xc0, 0x3c, 0x83, 0x6c, 0xbd, 0xf1, 0xc2, 0x34, 0x35, 0x5b, 0x83,
+	0xbe, 0x0a, 0x66, 0xe4, 0x24, 0xe9, 0x45, 0x14, 0x42, 0x65, 0xaa, 0x9b, 0xfc, 0xcb, 0xf8, 0x45,
+	0x09, 0x06, 0x87, 0x91, 0x1d, 0x44, 0x1b, 0x84, 0x0c, 0x87, 0x71, 0x68, 0x8e, 0x1d, 0xb1, 0xa6,
+	0x0f, 0xa1, 0xc3, 0xfb, 0x65, 0x2d, 0xb5, 0xfd, 0xab, 0xcd, 0xc1, 0xbc, 0x4f, 0x8c, 0x68, 0x41,
+	0x1c, 0xa2, 0x40, 0x72, 0x1e, 0xc9, 0x37, 0xc1, 0x91, 0x1d, 0x39, 0xf7, 0x03, 0x37, 0xb9, 0x03,
+	0xf1, 0x6f, 0x52, 0xfb, 0x72, 0x50, 0xc0, 0x3d, 0x17, 0xe2, 0xd5, 0x53, 0x19, 0x64, 0xdc, 0x84,
+	0xa5, 0x1c, 0xf1, 0xd8, 0xa2, 0x1e, 0x99, 0xc9, 0xdf, 0x0b, 0x0e, 0x51, 0xf0, 0xda, 0x73, 0x48,
+	0x40, 0xaf, 0x71, 0x88, 0xb6, 0x24, 0xe9, 0xb5, 0xfa, 0x27, 0x04, 0x5d, 0xcf, 0x43, 0x71, 0x9e,
+	0xff, 0xbd, 0x00, 0x2d, 0xb6, 0x83, 0x82, 0xe7, 0xef, 0x40, 0x95, 0x74, 0x1c, 0x6b, 0x72, 0x52,
+	0x22, 0x75, 0x24, 0xeb, 0x8b, 0x19, 0x78, 0x92, 0x5d, 0xd4, 0x78, 0x67, 0xb1, 0x22, 0x8c, 0xda,
+	0xae, 0xac, 0xeb, 0x79, 0x28, 0xce, 0xc1, 0x84, 0x96, 0xd2, 0x55, 0xac, 0xad, 0x64, 0x9b, 0x7d,
+	0x95, 0x56, 0x65, 0x7d, 0xb5, 0x98, 0x80, 0xf3, 0xdc, 0x80, 0xfa, 0x7a, 0x62, 0xbd, 0xb9, 0xbd,
+	0xc3, 0x8c, 0xd3, 0xcd, 0x29, 0x7d, 0xc5, 0xda, 0x2e, 0x34, 0xa4, 0xa6, 0x53, 0xed, 0x76, 0x96,
+	0x56, 0x6a, 0x7c, 0xd5, 0x97, 0x8b, 0xd0, 0xe9, 0x46, 0x25, 0xfd, 0x5e, 0x72, 0xe2, 0xa5, 0xb4,
+	0xe9, 0xe9, 0x7a, 0x1e, 0x8a, 0x73, 0xd8, 0x01, 0x48, 0x1b, 0xbe, 0xb4, 0x5b, 0x05, 0x7d, 0x60,
+	0x8c, 0xcf, 0xed, 0xa9, 0x5d, 0x62, 0xda, 0x0b, 0xe8, 0x4c, 0x74, 0xc8, 0x68, 0xef, 0x4a, 0x23,
+	0xf2, 0x1b, 0x8b, 0x74, 0x63, 0x1a, 0x09, 0xe7, 0x1c, 0xc3, 0xa0, 0x28, 0x23, 0xd5, 0xee, 0xe7,
+	0x27, 0x80, 0x79, 0x61, 0x5f, 0x7f, 0x70, 0x25, 0x5a, 0x36, 0xe9, 0xc3, 0x92, 0xe6, 0xc3, 0x42,
+	0x7e, 0x3a, 0xa3, 0xdd, 0xbd, 0x42, 0xc6, 0xc3, 0xa6, 0xbc, 0x77, 0xe5, 0xdc, 0xe8, 0x61, 0x49,
+	0xf3, 0xd2, 0x36, 0x7a, 0x65, 0xba, 0x0f, 0x72, 0x74, 0x33, 0x6f, 0xb2, 0x0f, 0x2f, 0xa5, 0x4b,
+	0xa6, 0xfa, 0x29, 0x74, 0x27, 0x7b, 0x5e, 0x34, 0xe3, 0xf2, 0x16, 0x1d, 0xfd, 0xce, 0x54, 0x9a,
+	0xd4, 0xfa, 0x94, 0xce, 0x62, 0xc5, 0xfa, 0xf2, 0xba, 0x99, 0xf5, 0xd5, 0x62, 0x82, 0xd4, 0x70,
+	0xa4, 0xde, 0x61, 0xc5, 0x70, 0xb2, 0xcd, 0xca, 0xfa, 0x72, 0x11, 0x7a, 0x82, 0x1b, 0x77, 0xc3,
+	0xb7, 0xa7, 0xf6, 0x06, 0xeb, 0xcb, 0x45, 0x68, 0xce, 0xed, 0xa7, 0xd0, 0x9d, 0xec, 0x9a, 0x55,
+	0x36, 0xb3, 0xa0, 0xcf, 0x57, 0xbf, 0x33, 0x95, 0x26, 0x35, 0xab, 0x89, 0xbb, 0xa4, 0x62, 0x56,
+	0xf9, 0xf7, 0x4c, 0xdd, 0x98, 0x46, 0xc2, 0x39, 0xdb, 0xa0, 0x65, 0x5f, 0x31, 0xb5, 0xf7, 0xae,
+	0xf2, 0x9a, 0xad, 0xbf, 0x7f, 0xa5, 0xa7, 0x50, 0x22, 0xfc, 0xc4, 0x63, 0x98, 0x22, 0x7c, 0xfe,
+	0x0b, 0x9f, 0x6e, 0x4c, 0x23, 0x91, 0x84, 0xcf, 0xbc, 0x53, 0xa9, 0xc2, 0x17, 0x3d, 0x89, 0xe9,
+	0xef, 0x5f, 0x42, 0x25, 0x79, 0x57, 0xf6, 0xe6, 0xa4, 0x7a, 0x57, 0xe5, 0x01, 0x4c, 0xd7, 0xf3,
+	0x50, 0xa9, 0x90, 0xd9, 0x27, 0x1b, 0x45, 0xc8, 0xc2, 0x97, 0x21, 0xfd, 0xfd, 0x4b, 0xa8, 0xd2,
+	0xa8, 0x24, 0x1e, 0x34, 0x94, 0xa8, 0x34, 0xf1, 0x92, 0xa3, 0xdf, 0xcc, 0xc5, 0xa5, 0x4c, 0xc4,
+	0x4b, 0x83, 0xc2, 0x64, 0xe2, 0x15, 0x43, 0xbf, 0x99, 0x8b, 0xe3, 0x4c, 0xf6, 0xa1, 0x29, 0x17,
+	0xff, 0x35, 0xd9, 0x6a, 0x72, 0x1e, 0x18, 0xf4, 0x95, 0x42, 0xbc, 0x14, 0x2b, 0xd3, 0x6a, 0xbd,
+	0x1a, 0x2b, 0x33, 0x4f, 0x01, 0xfa, 0x72, 0x11, 0x3a, 0x75, 0x4a, 0x4a, 0x79, 0x58, 0x71, 0x4a,
+	0x79, 0x15, 0x6b, 0x7d, 0xb5, 0x98, 0x20, 0x5d, 0xb2, 0x5c, 0x05, 0x56, 0x96, 0x9c, 0x53, 0x47,
+	0xd6, 0x57, 0x0a, 0xf1, 0xa9, 0xca, 0x31, 0x48, 0xa8, 0xa8, 0x9c, 0x5a, 0x27, 0xd6, 0xf5, 0x3c,
+	0x54, 0xba, 0x4c, 0xa5, 0x40, 0xaa, 0x2c, 0x33, 0xaf, 0x0a, 0xab, 0xaf, 0x16, 0x13, 0xa4, 0x56,
+	0x3c, 0x51, 0xe9, 0x54, 0xac, 0x38, 0xbf, 0xee, 0xaa, 0x1b, 0xd3, 0x48, 0x64, 0x69, 0xa5, 0x82,
+	0xe8, 0x84, 0xb4, 0xd9, 0x12, 0xaa, 0xbe, 0x5a, 0x4c, 0x90, 0xaa, 0x8d, 0x54, 0xf6, 0x53, 0xd4,
+	0x26, 0x5b, 0xa7, 0xd4, 0x97, 0x8b, 0xd0, 0x72, 0x2c, 0x93, 0xaa, 0x73, 0x13, 0xb1, 0x2c, 0x5b,
+	0x27, 0xd4, 0x57, 0x8b, 0x09, 0x38, 0xcf, 0x1f, 0xc0, 0x2c, 0x9d, 0x2a, 0xd4, 0x06, 0x93, 0xb3,
+	0x27, 0xeb, 0x5c, 0xca, 0xc1, 0xf0, 0x4c, 0xfb, 0x1f, 0xaa, 0xe2, 0x0a, 0xb3, 0xeb, 0xdb, 0x2e,
+	0x0a, 0x44, 0xbe, 0xbd, 0x0f, 0x4d, 0xf9, 0x0a, 0xa3, 0x68, 0x63, 0xce, 0x95, 0x47, 0x5f, 0x29,
+	0xc4, 0x4b, 0xea, 0x2d, 0x5d, 0xae, 0x55, 0xf5, 0xce, 0x56, 0x12, 0xf4, 0x95, 0x42, 0x3c, 0x67,
+	0x18, 0xc2, 0xa0, 0xe8, 0x92, 0xa8, 0x24, 0x72, 0x97, 0x5c, 0xa7, 0xf5, 0x07, 0x57, 0xa2, 0x4d,
+	0x53, 0xdc, 0xf4, 0xce, 0xa8, 0xa4, 0xb8, 0x99, 0xcb, 0xa8, 0x7e, 0xbb, 0x00, 0x9b, 0xaa, 0x96,
+	0x74, 0xa5, 0x54, 0x54, 0x2b, 0x7b, 0x01, 0xd5, 0x97, 0x8b, 0xd0, 0x9c, 0xdb, 0xcf, 0xa0, 0x97,
+	0xb9, 0xa2, 0x69, 0x72, 0x4e, 0x50, 0x74, 0xbf, 0xd4, 0xdf, 0x9b, 0x4e, 0xc4, 0xf8, 0x1f, 0xcf,
+	0xd2, 0xbf, 0x95, 0xff, 0xd6, 0xff, 0x0d, 0x00, 0xc4, 0x94, 0xb1, 0x2c, 0x63, 0x3e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// VersionServiceClient is the client API for VersionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VersionServiceClient interface {
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+}
+
+type versionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVersionServiceClient(cc grpc.ClientConnInterface) VersionServiceClient {
+	return &versionServiceClient{cc}
+}
+
+func (c *versionServiceClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.VersionService/Version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VersionServiceServer is the server API for VersionService service.
+type VersionServiceServer interface {
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+}
+
+// UnimplementedVersionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedVersionServiceServer struct {
+}
+
+func (*UnimplementedVersionServiceServer) Version(ctx context.Context, req *VersionRequest) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+
+func RegisterVersionServiceServer(s *grpc.Server, srv VersionServiceServer) {
+	s.RegisterService(&_VersionService_serviceDesc, srv)
+}
+
+func _VersionService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.VersionService/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).Version(ctx, req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _VersionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "walletrpc.VersionService",
+	HandlerType: (*VersionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Version",
+			Handler:    _VersionService_Version_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
+
+// WalletServiceClient is the client API for WalletService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletServiceClient interface {
+	// Queries
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error)
+	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
+	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	AccountXpub(ctx context.Context, in *AccountXpubRequest, opts ...grpc.CallOption) (*AccountXpubResponse, error)
+	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
+	WalletInfo(ctx context.Context, in *WalletInfoRequest, opts ...grpc.CallOption) (*WalletInfoResponse, error)
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	// Notifications
+	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error)
+	SpentnessNotifications(ctx context.Context, in *SpentnessNotificationsRequest, opts ...grpc.CallOption) (WalletService_SpentnessNotificationsClient, error)
+	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error)
+	// Control
+	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
+	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
+	NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error)
+	NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error)
+	ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error)
+	FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error)
+	PreviewTransaction(ctx context.Context, in *PreviewTransactionRequest, opts ...grpc.CallOption) (*PreviewTransactionResponse, error)
+	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
+	PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error)
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	AbandonTransaction(ctx context.Context, in *AbandonTransactionRequest, opts ...grpc.CallOption) (*AbandonTransactionResponse, error)
+	FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error)
+	SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error)
+	FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error)
+	AbandonPsbt(ctx context.Context, in *AbandonPsbtRequest, opts ...grpc.CallOption) (*AbandonPsbtResponse, error)
+	EnqueuePayout(ctx context.Context, in *EnqueuePayoutRequest, opts ...grpc.CallOption) (*EnqueuePayoutResponse, error)
+	CancelPayout(ctx context.Context, in *CancelPayoutRequest, opts ...grpc.CallOption) (*CancelPayoutResponse, error)
+	Payouts(ctx context.Context, in *PayoutsRequest, opts ...grpc.CallOption) (*PayoutsResponse, error)
+	FreezeOutputs(ctx context.Context, in *FreezeOutputsRequest, opts ...grpc.CallOption) (*FreezeOutputsResponse, error)
+	UnfreezeOutputs(ctx context.Context, in *UnfreezeOutputsRequest, opts ...grpc.CallOption) (*UnfreezeOutputsResponse, error)
+	FrozenOutputs(ctx context.Context, in *FrozenOutputsRequest, opts ...grpc.CallOption) (*FrozenOutputsResponse, error)
+	LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error)
+	ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error)
+	Leases(ctx context.Context, in *LeasesRequest, opts ...grpc.CallOption) (*LeasesResponse, error)
+}
+
+type walletServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletServiceClient(cc grpc.ClientConnInterface) WalletServiceClient {
+	return &walletServiceClient{cc}
+}
+
+func (c *walletServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error) {
+	out := new(NetworkResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Network", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error) {
+	out := new(AccountNumberResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AccountNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
+	out := new(AccountsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AccountXpub(ctx context.Context, in *AccountXpubRequest, opts ...grpc.CallOption) (*AccountXpubResponse, error) {
+	out := new(AccountXpubResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AccountXpub", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	out := new(BalanceResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) WalletInfo(ctx context.Context, in *WalletInfoRequest, opts ...grpc.CallOption) (*WalletInfoResponse, error) {
+	out := new(WalletInfoResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/WalletInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/GetTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[0], "/walletrpc.WalletService/TransactionNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceTransactionNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_TransactionNotificationsClient interface {
+	Recv() (*TransactionNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceTransactionNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceTransactionNotificationsClient) Recv() (*TransactionNotificationsResponse, error) {
+	m := new(TransactionNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) SpentnessNotifications(ctx context.Context, in *SpentnessNotificationsRequest, opts ...grpc.CallOption) (WalletService_SpentnessNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[1], "/walletrpc.WalletService/SpentnessNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceSpentnessNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_SpentnessNotificationsClient interface {
+	Recv() (*SpentnessNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceSpentnessNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceSpentnessNotificationsClient) Recv() (*SpentnessNotificationsResponse, error) {
+	m := new(SpentnessNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[2], "/walletrpc.WalletService/AccountNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceAccountNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_AccountNotificationsClient interface {
+	Recv() (*AccountNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceAccountNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceAccountNotificationsClient) Recv() (*AccountNotificationsResponse, error) {
+	m := new(AccountNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
+	out := new(ChangePassphraseResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ChangePassphrase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error) {
+	out := new(RenameAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/RenameAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error) {
+	out := new(NextAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/NextAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error) {
+	out := new(NextAddressResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/NextAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error) {
+	out := new(ImportPrivateKeyResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ImportPrivateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error) {
+	out := new(FundTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/FundTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) PreviewTransaction(ctx context.Context, in *PreviewTransactionRequest, opts ...grpc.CallOption) (*PreviewTransactionResponse, error) {
+	out := new(PreviewTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/PreviewTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
+	out := new(SignTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SignTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error) {
+	out := new(PublishTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/PublishTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AbandonTransaction(ctx context.Context, in *AbandonTransactionRequest, opts ...grpc.CallOption) (*AbandonTransactionResponse, error) {
+	out := new(AbandonTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AbandonTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error) {
+	out := new(FundPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/FundPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error) {
+	out := new(SignPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SignPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error) {
+	out := new(FinalizePsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/FinalizePsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AbandonPsbt(ctx context.Context, in *AbandonPsbtRequest, opts ...grpc.CallOption) (*AbandonPsbtResponse, error) {
+	out := new(AbandonPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AbandonPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) EnqueuePayout(ctx context.Context, in *EnqueuePayoutRequest, opts ...grpc.CallOption) (*EnqueuePayoutResponse, error) {
+	out := new(EnqueuePayoutResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/EnqueuePayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CancelPayout(ctx context.Context, in *CancelPayoutRequest, opts ...grpc.CallOption) (*CancelPayoutResponse, error) {
+	out := new(CancelPayoutResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CancelPayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Payouts(ctx context.Context, in *PayoutsRequest, opts ...grpc.CallOption) (*PayoutsResponse, error) {
+	out := new(PayoutsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Payouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FreezeOutputs(ctx context.Context, in *FreezeOutputsRequest, opts ...grpc.CallOption) (*FreezeOutputsResponse, error) {
+	out := new(FreezeOutputsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/FreezeOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UnfreezeOutputs(ctx context.Context, in *UnfreezeOutputsRequest, opts ...grpc.CallOption) (*UnfreezeOutputsResponse, error) {
+	out := new(UnfreezeOutputsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/UnfreezeOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FrozenOutputs(ctx context.Context, in *FrozenOutputsRequest, opts ...grpc.CallOption) (*FrozenOutputsResponse, error) {
+	out := new(FrozenOutputsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/FrozenOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error) {
+	out := new(LeaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/LeaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error) {
+	out := new(ReleaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ReleaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Leases(ctx context.Context, in *LeasesRequest, opts ...grpc.CallOption) (*LeasesResponse, error) {
+	out := new(LeasesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Leases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServiceServer is the server API for WalletService service.
+type WalletServiceServer interface {
 	// Queries
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	Network(context.Context, *NetworkRequest) (*NetworkResponse, error)
 	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
 	Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
+	AccountXpub(context.Context, *AccountXpubRequest) (*AccountXpubResponse, error)
 	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
+	WalletInfo(context.Context, *WalletInfoRequest) (*WalletInfoResponse, error)
 	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
 	// Notifications
 	TransactionNotifications(*TransactionNotificationsRequest, WalletService_TransactionNotificationsServer) error
@@ -1886,325 +6274,775 @@ type WalletServiceServer interface {
 	NextAddress(context.Context, *NextAddressRequest) (*NextAddressResponse, error)
 	ImportPrivateKey(context.Context, *ImportPrivateKeyRequest) (*ImportPrivateKeyResponse, error)
 	FundTransaction(context.Context, *FundTransactionRequest) (*FundTransactionResponse, error)
+	PreviewTransaction(context.Context, *PreviewTransactionRequest) (*PreviewTransactionResponse, error)
 	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
 	PublishTransaction(context.Context, *PublishTransactionRequest) (*PublishTransactionResponse, error)
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	AbandonTransaction(context.Context, *AbandonTransactionRequest) (*AbandonTransactionResponse, error)
+	FundPsbt(context.Context, *FundPsbtRequest) (*FundPsbtResponse, error)
+	SignPsbt(context.Context, *SignPsbtRequest) (*SignPsbtResponse, error)
+	FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error)
+	AbandonPsbt(context.Context, *AbandonPsbtRequest) (*AbandonPsbtResponse, error)
+	EnqueuePayout(context.Context, *EnqueuePayoutRequest) (*EnqueuePayoutResponse, error)
+	CancelPayout(context.Context, *CancelPayoutRequest) (*CancelPayoutResponse, error)
+	Payouts(context.Context, *PayoutsRequest) (*PayoutsResponse, error)
+	FreezeOutputs(context.Context, *FreezeOutputsRequest) (*FreezeOutputsResponse, error)
+	UnfreezeOutputs(context.Context, *UnfreezeOutputsRequest) (*UnfreezeOutputsResponse, error)
+	FrozenOutputs(context.Context, *FrozenOutputsRequest) (*FrozenOutputsResponse, error)
+	LeaseOutput(context.Context, *LeaseOutputRequest) (*LeaseOutputResponse, error)
+	ReleaseOutput(context.Context, *ReleaseOutputRequest) (*ReleaseOutputResponse, error)
+	Leases(context.Context, *LeasesRequest) (*LeasesResponse, error)
+}
+
+// UnimplementedWalletServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWalletServiceServer struct {
+}
+
+func (*UnimplementedWalletServiceServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedWalletServiceServer) Network(ctx context.Context, req *NetworkRequest) (*NetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Network not implemented")
+}
+func (*UnimplementedWalletServiceServer) AccountNumber(ctx context.Context, req *AccountNumberRequest) (*AccountNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountNumber not implemented")
+}
+func (*UnimplementedWalletServiceServer) Accounts(ctx context.Context, req *AccountsRequest) (*AccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (*UnimplementedWalletServiceServer) AccountXpub(ctx context.Context, req *AccountXpubRequest) (*AccountXpubResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountXpub not implemented")
+}
+func (*UnimplementedWalletServiceServer) Balance(ctx context.Context, req *BalanceRequest) (*BalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
+func (*UnimplementedWalletServiceServer) WalletInfo(ctx context.Context, req *WalletInfoRequest) (*WalletInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletInfo not implemented")
+}
+func (*UnimplementedWalletServiceServer) GetTransactions(ctx context.Context, req *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (*UnimplementedWalletServiceServer) TransactionNotifications(req *TransactionNotificationsRequest, srv WalletService_TransactionNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TransactionNotifications not implemented")
+}
+func (*UnimplementedWalletServiceServer) SpentnessNotifications(req *SpentnessNotificationsRequest, srv WalletService_SpentnessNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SpentnessNotifications not implemented")
+}
+func (*UnimplementedWalletServiceServer) AccountNotifications(req *AccountNotificationsRequest, srv WalletService_AccountNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AccountNotifications not implemented")
+}
+func (*UnimplementedWalletServiceServer) ChangePassphrase(ctx context.Context, req *ChangePassphraseRequest) (*ChangePassphraseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassphrase not implemented")
+}
+func (*UnimplementedWalletServiceServer) RenameAccount(ctx context.Context, req *RenameAccountRequest) (*RenameAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameAccount not implemented")
+}
+func (*UnimplementedWalletServiceServer) NextAccount(ctx context.Context, req *NextAccountRequest) (*NextAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextAccount not implemented")
+}
+func (*UnimplementedWalletServiceServer) NextAddress(ctx context.Context, req *NextAddressRequest) (*NextAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextAddress not implemented")
+}
+func (*UnimplementedWalletServiceServer) ImportPrivateKey(ctx context.Context, req *ImportPrivateKeyRequest) (*ImportPrivateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPrivateKey not implemented")
+}
+func (*UnimplementedWalletServiceServer) FundTransaction(ctx context.Context, req *FundTransactionRequest) (*FundTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) PreviewTransaction(ctx context.Context, req *PreviewTransactionRequest) (*PreviewTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) SignTransaction(ctx context.Context, req *SignTransactionRequest) (*SignTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) PublishTransaction(ctx context.Context, req *PublishTransactionRequest) (*PublishTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) BumpFee(ctx context.Context, req *BumpFeeRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
+func (*UnimplementedWalletServiceServer) AbandonTransaction(ctx context.Context, req *AbandonTransactionRequest) (*AbandonTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbandonTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) FundPsbt(ctx context.Context, req *FundPsbtRequest) (*FundPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundPsbt not implemented")
+}
+func (*UnimplementedWalletServiceServer) SignPsbt(ctx context.Context, req *SignPsbtRequest) (*SignPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignPsbt not implemented")
+}
+func (*UnimplementedWalletServiceServer) FinalizePsbt(ctx context.Context, req *FinalizePsbtRequest) (*FinalizePsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizePsbt not implemented")
+}
+func (*UnimplementedWalletServiceServer) AbandonPsbt(ctx context.Context, req *AbandonPsbtRequest) (*AbandonPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbandonPsbt not implemented")
+}
+func (*UnimplementedWalletServiceServer) EnqueuePayout(ctx context.Context, req *EnqueuePayoutRequest) (*EnqueuePayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnqueuePayout not implemented")
+}
+func (*UnimplementedWalletServiceServer) CancelPayout(ctx context.Context, req *CancelPayoutRequest) (*CancelPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPayout not implemented")
+}
+func (*UnimplementedWalletServiceServer) Payouts(ctx context.Context, req *PayoutsRequest) (*PayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Payouts not implemented")
+}
+func (*UnimplementedWalletServiceServer) FreezeOutputs(ctx context.Context, req *FreezeOutputsRequest) (*FreezeOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeOutputs not implemented")
+}
+func (*UnimplementedWalletServiceServer) UnfreezeOutputs(ctx context.Context, req *UnfreezeOutputsRequest) (*UnfreezeOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeOutputs not implemented")
+}
+func (*UnimplementedWalletServiceServer) FrozenOutputs(ctx context.Context, req *FrozenOutputsRequest) (*FrozenOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenOutputs not implemented")
+}
+func (*UnimplementedWalletServiceServer) LeaseOutput(ctx context.Context, req *LeaseOutputRequest) (*LeaseOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseOutput not implemented")
+}
+func (*UnimplementedWalletServiceServer) ReleaseOutput(ctx context.Context, req *ReleaseOutputRequest) (*ReleaseOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseOutput not implemented")
+}
+func (*UnimplementedWalletServiceServer) Leases(ctx context.Context, req *LeasesRequest) (*LeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leases not implemented")
+}
+
+func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
+	s.RegisterService(&_WalletService_serviceDesc, srv)
+}
+
+func _WalletService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Network_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Network(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Network",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Network(ctx, req.(*NetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
-	s.RegisterService(&_WalletService_serviceDesc, srv)
+func _WalletService_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AccountNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AccountNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AccountNumber(ctx, req.(*AccountNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
+func _WalletService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Accounts(ctx, req.(*AccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_AccountXpub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountXpubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AccountXpub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AccountXpub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AccountXpub(ctx, req.(*AccountXpubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Balance(ctx, req.(*BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_WalletInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).WalletInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/WalletInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).WalletInfo(ctx, req.(*WalletInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_TransactionNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).TransactionNotifications(m, &walletServiceTransactionNotificationsServer{stream})
+}
+
+type WalletService_TransactionNotificationsServer interface {
+	Send(*TransactionNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceTransactionNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceTransactionNotificationsServer) Send(m *TransactionNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WalletService_SpentnessNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpentnessNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).SpentnessNotifications(m, &walletServiceSpentnessNotificationsServer{stream})
+}
+
+type WalletService_SpentnessNotificationsServer interface {
+	Send(*SpentnessNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceSpentnessNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceSpentnessNotificationsServer) Send(m *SpentnessNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WalletService_AccountNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).AccountNotifications(m, &walletServiceAccountNotificationsServer{stream})
+}
+
+type WalletService_AccountNotificationsServer interface {
+	Send(*AccountNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceAccountNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceAccountNotificationsServer) Send(m *AccountNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePassphraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ChangePassphrase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ChangePassphrase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ChangePassphrase(ctx, req.(*ChangePassphraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RenameAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RenameAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/RenameAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RenameAccount(ctx, req.(*RenameAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_NextAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).NextAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/NextAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).NextAccount(ctx, req.(*NextAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_NextAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).NextAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/NextAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).NextAddress(ctx, req.(*NextAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ImportPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPrivateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ImportPrivateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ImportPrivateKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ImportPrivateKey(ctx, req.(*ImportPrivateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_FundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Ping(ctx, in)
+		return srv.(WalletServiceServer).FundTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Ping",
+		FullMethod: "/walletrpc.WalletService/FundTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Ping(ctx, req.(*PingRequest))
+		return srv.(WalletServiceServer).FundTransaction(ctx, req.(*FundTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Network_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetworkRequest)
+func _WalletService_PreviewTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Network(ctx, in)
+		return srv.(WalletServiceServer).PreviewTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Network",
+		FullMethod: "/walletrpc.WalletService/PreviewTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Network(ctx, req.(*NetworkRequest))
+		return srv.(WalletServiceServer).PreviewTransaction(ctx, req.(*PreviewTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountNumberRequest)
+func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).AccountNumber(ctx, in)
+		return srv.(WalletServiceServer).SignTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/AccountNumber",
+		FullMethod: "/walletrpc.WalletService/SignTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).AccountNumber(ctx, req.(*AccountNumberRequest))
+		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountsRequest)
+func _WalletService_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Accounts(ctx, in)
+		return srv.(WalletServiceServer).PublishTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Accounts",
+		FullMethod: "/walletrpc.WalletService/PublishTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Accounts(ctx, req.(*AccountsRequest))
+		return srv.(WalletServiceServer).PublishTransaction(ctx, req.(*PublishTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BalanceRequest)
+func _WalletService_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Balance(ctx, in)
+		return srv.(WalletServiceServer).BumpFee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Balance",
+		FullMethod: "/walletrpc.WalletService/BumpFee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Balance(ctx, req.(*BalanceRequest))
+		return srv.(WalletServiceServer).BumpFee(ctx, req.(*BumpFeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionsRequest)
+func _WalletService_AbandonTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).GetTransactions(ctx, in)
+		return srv.(WalletServiceServer).AbandonTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/GetTransactions",
+		FullMethod: "/walletrpc.WalletService/AbandonTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+		return srv.(WalletServiceServer).AbandonTransaction(ctx, req.(*AbandonTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_TransactionNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(TransactionNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _WalletService_FundPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(WalletServiceServer).TransactionNotifications(m, &walletServiceTransactionNotificationsServer{stream})
-}
-
-type WalletService_TransactionNotificationsServer interface {
-	Send(*TransactionNotificationsResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceTransactionNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceTransactionNotificationsServer) Send(m *TransactionNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _WalletService_SpentnessNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SpentnessNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(WalletServiceServer).FundPsbt(ctx, in)
 	}
-	return srv.(WalletServiceServer).SpentnessNotifications(m, &walletServiceSpentnessNotificationsServer{stream})
-}
-
-type WalletService_SpentnessNotificationsServer interface {
-	Send(*SpentnessNotificationsResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceSpentnessNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceSpentnessNotificationsServer) Send(m *SpentnessNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/FundPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).FundPsbt(ctx, req.(*FundPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_AccountNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AccountNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _WalletService_SignPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(WalletServiceServer).AccountNotifications(m, &walletServiceAccountNotificationsServer{stream})
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SignPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignPsbt(ctx, req.(*SignPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type WalletService_AccountNotificationsServer interface {
-	Send(*AccountNotificationsResponse) error
-	grpc.ServerStream
+func _WalletService_FinalizePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).FinalizePsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/FinalizePsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).FinalizePsbt(ctx, req.(*FinalizePsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type walletServiceAccountNotificationsServer struct {
-	grpc.ServerStream
+func _WalletService_AbandonPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AbandonPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AbandonPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AbandonPsbt(ctx, req.(*AbandonPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *walletServiceAccountNotificationsServer) Send(m *AccountNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _WalletService_EnqueuePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueuePayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).EnqueuePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/EnqueuePayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).EnqueuePayout(ctx, req.(*EnqueuePayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePassphraseRequest)
+func _WalletService_CancelPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPayoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ChangePassphrase(ctx, in)
+		return srv.(WalletServiceServer).CancelPayout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ChangePassphrase",
+		FullMethod: "/walletrpc.WalletService/CancelPayout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ChangePassphrase(ctx, req.(*ChangePassphraseRequest))
+		return srv.(WalletServiceServer).CancelPayout(ctx, req.(*CancelPayoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_RenameAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenameAccountRequest)
+func _WalletService_Payouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).RenameAccount(ctx, in)
+		return srv.(WalletServiceServer).Payouts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/RenameAccount",
+		FullMethod: "/walletrpc.WalletService/Payouts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).RenameAccount(ctx, req.(*RenameAccountRequest))
+		return srv.(WalletServiceServer).Payouts(ctx, req.(*PayoutsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_NextAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextAccountRequest)
+func _WalletService_FreezeOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeOutputsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).NextAccount(ctx, in)
+		return srv.(WalletServiceServer).FreezeOutputs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/NextAccount",
+		FullMethod: "/walletrpc.WalletService/FreezeOutputs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).NextAccount(ctx, req.(*NextAccountRequest))
+		return srv.(WalletServiceServer).FreezeOutputs(ctx, req.(*FreezeOutputsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_NextAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextAddressRequest)
+func _WalletService_UnfreezeOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeOutputsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).NextAddress(ctx, in)
+		return srv.(WalletServiceServer).UnfreezeOutputs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/NextAddress",
+		FullMethod: "/walletrpc.WalletService/UnfreezeOutputs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).NextAddress(ctx, req.(*NextAddressRequest))
+		return srv.(WalletServiceServer).UnfreezeOutputs(ctx, req.(*UnfreezeOutputsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_ImportPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportPrivateKeyRequest)
+func _WalletService_FrozenOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FrozenOutputsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ImportPrivateKey(ctx, in)
+		return srv.(WalletServiceServer).FrozenOutputs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ImportPrivateKey",
+		FullMethod: "/walletrpc.WalletService/FrozenOutputs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ImportPrivateKey(ctx, req.(*ImportPrivateKeyRequest))
+		return srv.(WalletServiceServer).FrozenOutputs(ctx, req.(*FrozenOutputsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_FundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FundTransactionRequest)
+func _WalletService_LeaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseOutputRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).FundTransaction(ctx, in)
+		return srv.(WalletServiceServer).LeaseOutput(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/FundTransaction",
+		FullMethod: "/walletrpc.WalletService/LeaseOutput",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).FundTransaction(ctx, req.(*FundTransactionRequest))
+		return srv.(WalletServiceServer).LeaseOutput(ctx, req.(*LeaseOutputRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignTransactionRequest)
+func _WalletService_ReleaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseOutputRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+		return srv.(WalletServiceServer).ReleaseOutput(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/SignTransaction",
+		FullMethod: "/walletrpc.WalletService/ReleaseOutput",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+		return srv.(WalletServiceServer).ReleaseOutput(ctx, req.(*ReleaseOutputRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishTransactionRequest)
+func _WalletService_Leases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeasesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).PublishTransaction(ctx, in)
+		return srv.(WalletServiceServer).Leases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/PublishTransaction",
+		FullMethod: "/walletrpc.WalletService/Leases",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).PublishTransaction(ctx, req.(*PublishTransactionRequest))
+		return srv.(WalletServiceServer).Leases(ctx, req.(*LeasesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2229,10 +7067,18 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Accounts",
 			Handler:    _WalletService_Accounts_Handler,
 		},
+		{
+			MethodName: "AccountXpub",
+			Handler:    _WalletService_AccountXpub_Handler,
+		},
 		{
 			MethodName: "Balance",
 			Handler:    _WalletService_Balance_Handler,
 		},
+		{
+			MethodName: "WalletInfo",
+			Handler:    _WalletService_WalletInfo_Handler,
+		},
 		{
 			MethodName: "GetTransactions",
 			Handler:    _WalletService_GetTransactions_Handler,
@@ -2261,6 +7107,10 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundTransaction",
 			Handler:    _WalletService_FundTransaction_Handler,
 		},
+		{
+			MethodName: "PreviewTransaction",
+			Handler:    _WalletService_PreviewTransaction_Handler,
+		},
 		{
 			MethodName: "SignTransaction",
 			Handler:    _WalletService_SignTransaction_Handler,
@@ -2269,6 +7119,66 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PublishTransaction",
 			Handler:    _WalletService_PublishTransaction_Handler,
 		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _WalletService_BumpFee_Handler,
+		},
+		{
+			MethodName: "AbandonTransaction",
+			Handler:    _WalletService_AbandonTransaction_Handler,
+		},
+		{
+			MethodName: "FundPsbt",
+			Handler:    _WalletService_FundPsbt_Handler,
+		},
+		{
+			MethodName: "SignPsbt",
+			Handler:    _WalletService_SignPsbt_Handler,
+		},
+		{
+			MethodName: "FinalizePsbt",
+			Handler:    _WalletService_FinalizePsbt_Handler,
+		},
+		{
+			MethodName: "AbandonPsbt",
+			Handler:    _WalletService_AbandonPsbt_Handler,
+		},
+		{
+			MethodName: "EnqueuePayout",
+			Handler:    _WalletService_EnqueuePayout_Handler,
+		},
+		{
+			MethodName: "CancelPayout",
+			Handler:    _WalletService_CancelPayout_Handler,
+		},
+		{
+			MethodName: "Payouts",
+			Handler:    _WalletService_Payouts_Handler,
+		},
+		{
+			MethodName: "FreezeOutputs",
+			Handler:    _WalletService_FreezeOutputs_Handler,
+		},
+		{
+			MethodName: "UnfreezeOutputs",
+			Handler:    _WalletService_UnfreezeOutputs_Handler,
+		},
+		{
+			MethodName: "FrozenOutputs",
+			Handler:    _WalletService_FrozenOutputs_Handler,
+		},
+		{
+			MethodName: "LeaseOutput",
+			Handler:    _WalletService_LeaseOutput_Handler,
+		},
+		{
+			MethodName: "ReleaseOutput",
+			Handler:    _WalletService_ReleaseOutput_Handler,
+		},
+		{
+			MethodName: "Leases",
+			Handler:    _WalletService_Leases_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2290,27 +7200,29 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "api.proto",
 }
 
-// Client API for WalletLoaderService service
-
+// WalletLoaderServiceClient is the client API for WalletLoaderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type WalletLoaderServiceClient interface {
 	WalletExists(ctx context.Context, in *WalletExistsRequest, opts ...grpc.CallOption) (*WalletExistsResponse, error)
 	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
+	CreateWatchingOnlyWallet(ctx context.Context, in *CreateWatchingOnlyWalletRequest, opts ...grpc.CallOption) (*CreateWatchingOnlyWalletResponse, error)
 	OpenWallet(ctx context.Context, in *OpenWalletRequest, opts ...grpc.CallOption) (*OpenWalletResponse, error)
 	CloseWallet(ctx context.Context, in *CloseWalletRequest, opts ...grpc.CallOption) (*CloseWalletResponse, error)
 	StartConsensusRpc(ctx context.Context, in *StartConsensusRpcRequest, opts ...grpc.CallOption) (*StartConsensusRpcResponse, error)
 }
 
 type walletLoaderServiceClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewWalletLoaderServiceClient(cc *grpc.ClientConn) WalletLoaderServiceClient {
+func NewWalletLoaderServiceClient(cc grpc.ClientConnInterface) WalletLoaderServiceClient {
 	return &walletLoaderServiceClient{cc}
 }
 
 func (c *walletLoaderServiceClient) WalletExists(ctx context.Context, in *WalletExistsRequest, opts ...grpc.CallOption) (*WalletExistsResponse, error) {
 	out := new(WalletExistsResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletLoaderService/WalletExists", in, out, c.cc, opts...)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletLoaderService/WalletExists", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2319,7 +7231,16 @@ func (c *walletLoaderServiceClient) WalletExists(ctx context.Context, in *Wallet
 
 func (c *walletLoaderServiceClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
 	out := new(CreateWalletResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletLoaderService/CreateWallet", in, out, c.cc, opts...)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletLoaderService/CreateWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletLoaderServiceClient) CreateWatchingOnlyWallet(ctx context.Context, in *CreateWatchingOnlyWalletRequest, opts ...grpc.CallOption) (*CreateWatchingOnlyWalletResponse, error) {
+	out := new(CreateWatchingOnlyWalletResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletLoaderService/CreateWatchingOnlyWallet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2328,7 +7249,7 @@ func (c *walletLoaderServiceClient) CreateWallet(ctx context.Context, in *Create
 
 func (c *walletLoaderServiceClient) OpenWallet(ctx context.Context, in *OpenWalletRequest, opts ...grpc.CallOption) (*OpenWalletResponse, error) {
 	out := new(OpenWalletResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletLoaderService/OpenWallet", in, out, c.cc, opts...)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletLoaderService/OpenWallet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2337,7 +7258,7 @@ func (c *walletLoaderServiceClient) OpenWallet(ctx context.Context, in *OpenWall
 
 func (c *walletLoaderServiceClient) CloseWallet(ctx context.Context, in *CloseWalletRequest, opts ...grpc.CallOption) (*CloseWalletResponse, error) {
 	out := new(CloseWalletResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletLoaderService/CloseWallet", in, out, c.cc, opts...)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletLoaderService/CloseWallet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2346,23 +7267,46 @@ func (c *walletLoaderServiceClient) CloseWallet(ctx context.Context, in *CloseWa
 
 func (c *walletLoaderServiceClient) StartConsensusRpc(ctx context.Context, in *StartConsensusRpcRequest, opts ...grpc.CallOption) (*StartConsensusRpcResponse, error) {
 	out := new(StartConsensusRpcResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletLoaderService/StartConsensusRpc", in, out, c.cc, opts...)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletLoaderService/StartConsensusRpc", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for WalletLoaderService service
-
+// WalletLoaderServiceServer is the server API for WalletLoaderService service.
 type WalletLoaderServiceServer interface {
 	WalletExists(context.Context, *WalletExistsRequest) (*WalletExistsResponse, error)
 	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
+	CreateWatchingOnlyWallet(context.Context, *CreateWatchingOnlyWalletRequest) (*CreateWatchingOnlyWalletResponse, error)
 	OpenWallet(context.Context, *OpenWalletRequest) (*OpenWalletResponse, error)
 	CloseWallet(context.Context, *CloseWalletRequest) (*CloseWalletResponse, error)
 	StartConsensusRpc(context.Context, *StartConsensusRpcRequest) (*StartConsensusRpcResponse, error)
 }
 
+// UnimplementedWalletLoaderServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWalletLoaderServiceServer struct {
+}
+
+func (*UnimplementedWalletLoaderServiceServer) WalletExists(ctx context.Context, req *WalletExistsRequest) (*WalletExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletExists not implemented")
+}
+func (*UnimplementedWalletLoaderServiceServer) CreateWallet(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+}
+func (*UnimplementedWalletLoaderServiceServer) CreateWatchingOnlyWallet(ctx context.Context, req *CreateWatchingOnlyWalletRequest) (*CreateWatchingOnlyWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWatchingOnlyWallet not implemented")
+}
+func (*UnimplementedWalletLoaderServiceServer) OpenWallet(ctx context.Context, req *OpenWalletRequest) (*OpenWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenWallet not implemented")
+}
+func (*UnimplementedWalletLoaderServiceServer) CloseWallet(ctx context.Context, req *CloseWalletRequest) (*CloseWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseWallet not implemented")
+}
+func (*UnimplementedWalletLoaderServiceServer) StartConsensusRpc(ctx context.Context, req *StartConsensusRpcRequest) (*StartConsensusRpcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartConsensusRpc not implemented")
+}
+
 func RegisterWalletLoaderServiceServer(s *grpc.Server, srv WalletLoaderServiceServer) {
 	s.RegisterService(&_WalletLoaderService_serviceDesc, srv)
 }
@@ -2403,6 +7347,24 @@ func _WalletLoaderService_CreateWallet_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletLoaderService_CreateWatchingOnlyWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWatchingOnlyWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletLoaderServiceServer).CreateWatchingOnlyWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletLoaderService/CreateWatchingOnlyWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletLoaderServiceServer).CreateWatchingOnlyWallet(ctx, req.(*CreateWatchingOnlyWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletLoaderService_OpenWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenWalletRequest)
 	if err := dec(in); err != nil {
@@ -2469,6 +7431,10 @@ var _WalletLoaderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateWallet",
 			Handler:    _WalletLoaderService_CreateWallet_Handler,
 		},
+		{
+			MethodName: "CreateWatchingOnlyWallet",
+			Handler:    _WalletLoaderService_CreateWatchingOnlyWallet_Handler,
+		},
 		{
 			MethodName: "OpenWallet",
 			Handler:    _WalletLoaderService_OpenWallet_Handler,
@@ -2485,159 +7451,3 @@ var _WalletLoaderService_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
-
-func init() { proto.RegisterFile("api.proto", fileDescriptor0) }
-
-var fileDescriptor0 = []byte{
-	// 2391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x59, 0x5b, 0x73, 0xdc, 0x48,
-	0x15, 0x66, 0x2c, 0xdf, 0x72, 0xe6, 0xde, 0xbe, 0x4d, 0x94, 0x38, 0xc9, 0x2a, 0xbb, 0xd9, 0xec,
-	0x2e, 0x98, 0x60, 0x02, 0x2c, 0xc5, 0x56, 0xd8, 0xc4, 0x64, 0xd9, 0x21, 0xc1, 0x99, 0x92, 0x93,
-	0x4d, 0xaa, 0xa0, 0x98, 0x92, 0x35, 0xed, 0x58, 0x78, 0x46, 0x9a, 0x48, 0x9a, 0x38, 0xe1, 0x89,
-	0xa2, 0x8a, 0x47, 0x5e, 0x80, 0x07, 0x0a, 0x6a, 0x5f, 0xf8, 0x05, 0x54, 0xf1, 0xc2, 0x23, 0xfc,
-	0x06, 0x1e, 0xf9, 0x17, 0xfc, 0x02, 0xfa, 0x72, 0x7a, 0xd4, 0x2d, 0x69, 0xc6, 0xf6, 0x16, 0x6f,
-	0xa3, 0xaf, 0xcf, 0x39, 0x7d, 0xfa, 0xf4, 0xb9, 0xf6, 0xc0, 0x25, 0x6f, 0x1c, 0xec, 0x8c, 0xe3,
-	0x28, 0x8d, 0xc8, 0xa5, 0x53, 0x6f, 0x38, 0xa4, 0x69, 0x3c, 0xf6, 0x9d, 0x16, 0x34, 0xbe, 0xa0,
-	0x71, 0x12, 0x44, 0xa1, 0x4b, 0x5f, 0x4d, 0x68, 0x92, 0x3a, 0xff, 0xaa, 0x40, 0x73, 0x0a, 0x25,
-	0xe3, 0x28, 0x4c, 0x28, 0x79, 0x0f, 0x1a, 0xaf, 0x25, 0xd4, 0x4f, 0xd2, 0x38, 0x08, 0x5f, 0x76,
-	0x2a, 0x37, 0x2a, 0xb7, 0x2f, 0xb9, 0x75, 0x44, 0x0f, 0x04, 0x48, 0xd6, 0x61, 0x69, 0xe4, 0xfd,
-	0x32, 0x8a, 0x3b, 0x0b, 0x6c, 0xb5, 0xee, 0xca, 0x0f, 0x81, 0x06, 0x21, 0x43, 0x2d, 0x44, 0xf9,
-	0x07, 0x47, 0xc7, 0x5e, 0xea, 0x1f, 0x77, 0x16, 0x25, 0x2a, 0x3e, 0xc8, 0x35, 0x80, 0x71, 0x4c,
-	0x63, 0x3a, 0xa4, 0x5e, 0x42, 0x3b, 0x4b, 0x62, 0x13, 0x0d, 0xe1, 0x8a, 0x1c, 0x4e, 0x82, 0xe1,
-	0xa0, 0x3f, 0xa2, 0xa9, 0x37, 0xf0, 0x52, 0xaf, 0xb3, 0x2c, 0x15, 0x11, 0xe8, 0x4f, 0x11, 0x74,
-	0xfe, 0x69, 0x01, 0x79, 0x1a, 0x7b, 0x61, 0xe2, 0xf9, 0x29, 0x53, 0xef, 0x47, 0x0c, 0x0f, 0x86,
-	0x09, 0x21, 0xb0, 0x78, 0xec, 0x25, 0xc7, 0x42, 0xf9, 0x9a, 0x2b, 0x7e, 0x93, 0x1b, 0x50, 0x4d,
-	0x33, 0x4a, 0xa1, 0x79, 0xcd, 0xd5, 0x21, 0xf2, 0x03, 0x58, 0x1e, 0xd0, 0xc3, 0x20, 0x4d, 0xd8,
-	0x01, 0xac, 0xdb, 0xd5, 0xdd, 0x9b, 0x3b, 0x53, 0xf3, 0xed, 0x14, 0x37, 0xd9, 0xe9, 0x86, 0xe3,
-	0x49, 0xea, 0x22, 0x0b, 0xb9, 0x07, 0x2b, 0x7e, 0x4c, 0x07, 0x9c, 0x7b, 0x51, 0x70, 0xbf, 0x3b,
-	0x9f, 0xfb, 0xc9, 0x24, 0xe5, 0xec, 0x8a, 0x89, 0xb4, 0xc0, 0x3a, 0xa2, 0xd2, 0x12, 0x96, 0xcb,
-	0x7f, 0x92, 0xab, 0x70, 0x29, 0x0d, 0x46, 0xec, 0xa6, 0xbc, 0xd1, 0x58, 0x9c, 0xde, 0x72, 0x33,
-	0xc0, 0x7e, 0x05, 0x4b, 0x42, 0x01, 0x6e, 0xdf, 0x20, 0x1c, 0xd0, 0x37, 0xe2, 0xb0, 0xcc, 0xbe,
-	0xe2, 0x83, 0x7c, 0x00, 0x2d, 0x66, 0xcd, 0xd7, 0x41, 0x34, 0x49, 0xfa, 0x9e, 0xef, 0x47, 0x93,
-	0x30, 0xc5, 0xcb, 0x6a, 0x2a, 0xfc, 0xbe, 0x84, 0xc9, 0xfb, 0xd0, 0xcc, 0x48, 0x47, 0x82, 0xd2,
-	0x12, 0xbb, 0x35, 0xa6, 0x94, 0x02, 0xb5, 0x9f, 0xc2, 0xb2, 0xd4, 0x7a, 0xc6, 0x9e, 0x1d, 0x58,
-	0x31, 0xb7, 0x52, 0x9f, 0xc4, 0x86, 0xd5, 0x20, 0x4c, 0x69, 0x1c, 0x7a, 0x43, 0x21, 0x7b, 0xd5,
-	0x9d, 0x7e, 0x3b, 0x7f, 0xa9, 0x40, 0xed, 0xc1, 0x30, 0xf2, 0x4f, 0xe6, 0x5d, 0xde, 0x26, 0x2c,
-	0x1f, 0xd3, 0xe0, 0xe5, 0xb1, 0x94, 0xbc, 0xe4, 0xe2, 0x97, 0x69, 0x23, 0x2b, 0x67, 0x23, 0x72,
-	0x1f, 0x6a, 0xda, 0xfd, 0xaa, 0x8b, 0xd9, 0x9e, 0x7b, 0x31, 0xae, 0xc1, 0xe2, 0x3c, 0x81, 0x06,
-	0xda, 0xe9, 0x81, 0x37, 0xf4, 0x42, 0x9f, 0xea, 0xa7, 0xac, 0x98, 0xa7, 0xbc, 0x09, 0xf5, 0x34,
-	0x4a, 0xbd, 0x61, 0xff, 0x50, 0x92, 0x0a, 0x5d, 0x2d, 0x26, 0x90, 0x83, 0xc8, 0xee, 0xd4, 0xa1,
-	0xda, 0x63, 0x21, 0xa4, 0x82, 0xb0, 0x01, 0x35, 0xf9, 0x29, 0x03, 0x90, 0x87, 0xe9, 0x3e, 0x4d,
-	0x4f, 0xa3, 0xf8, 0x44, 0x51, 0x7c, 0x0c, 0xcd, 0x29, 0x92, 0x45, 0x29, 0xd7, 0xef, 0x35, 0xed,
-	0x87, 0x72, 0x05, 0x35, 0xa9, 0x4b, 0x14, 0xc9, 0x9d, 0xef, 0xc3, 0x3a, 0xea, 0xbe, 0x3f, 0x19,
-	0x1d, 0xd2, 0x18, 0x25, 0x92, 0x77, 0xa0, 0x86, 0x2a, 0xf7, 0x43, 0x6f, 0x44, 0x31, 0xc4, 0xab,
-	0x88, 0xed, 0x33, 0xc8, 0xb9, 0x07, 0x1b, 0x39, 0x56, 0x7d, 0x6b, 0xe4, 0x15, 0x2b, 0xd9, 0xd6,
-	0x1a, 0xb9, 0xd3, 0x86, 0x26, 0xf2, 0x27, 0xea, 0x1c, 0xff, 0xb0, 0xa0, 0x95, 0x61, 0x28, 0xee,
-	0x87, 0xb0, 0x8a, 0x8c, 0x09, 0x13, 0x94, 0x0f, 0xba, 0x3c, 0xb9, 0x02, 0xdc, 0x29, 0x13, 0xf9,
-	0x3a, 0x10, 0x7f, 0x12, 0xc7, 0x94, 0xe9, 0x73, 0xc8, 0x9d, 0xa8, 0x2f, 0x5c, 0x47, 0x06, 0x77,
-	0x0b, 0x57, 0x84, 0x77, 0x7d, 0xce, 0xdd, 0xe8, 0x0e, 0xac, 0xe7, 0xa8, 0xa5, 0x53, 0x59, 0xc2,
-	0xa9, 0x88, 0x41, 0x2f, 0x56, 0xec, 0xdf, 0x2c, 0xc0, 0x8a, 0x0a, 0x94, 0xf3, 0x9d, 0xbd, 0x60,
-	0xde, 0x85, 0x82, 0x79, 0x8b, 0x9e, 0x62, 0x15, 0x3d, 0x85, 0x1f, 0x8d, 0xbe, 0x91, 0x41, 0xd2,
-	0x3f, 0xa1, 0x6f, 0xfb, 0xd2, 0xe7, 0x64, 0x16, 0x6d, 0xa9, 0x95, 0x47, 0xf4, 0xed, 0x9e, 0x50,
-	0x8e, 0x51, 0xab, 0x90, 0xd2, 0xa8, 0x97, 0x24, 0xb5, 0x5a, 0x31, 0xa8, 0x47, 0xe3, 0x28, 0x4e,
-	0xe9, 0x40, 0xa3, 0x5e, 0x46, 0x6a, 0x5c, 0x51, 0xd4, 0xce, 0x0b, 0x58, 0x77, 0x29, 0x3f, 0x8b,
-	0xb2, 0x3f, 0x3a, 0xd2, 0x39, 0x0d, 0x72, 0x19, 0x56, 0x43, 0x7a, 0xaa, 0x1b, 0x63, 0x85, 0x7d,
-	0x0b, 0x3f, 0xdb, 0x82, 0x8d, 0x9c, 0x64, 0x8c, 0x83, 0xe7, 0x40, 0xf6, 0xd9, 0x19, 0x73, 0x1b,
-	0xf2, 0xaa, 0xe1, 0x25, 0xc9, 0xf8, 0x38, 0xe6, 0x55, 0x43, 0x26, 0x08, 0x0d, 0x39, 0x87, 0xe9,
-	0x9d, 0x4f, 0x60, 0xcd, 0x10, 0x7c, 0x31, 0xbf, 0xfe, 0x73, 0x05, 0xf5, 0x1a, 0x0c, 0x62, 0x9a,
-	0x28, 0xdf, 0x9e, 0x93, 0x13, 0xbe, 0x0b, 0x8b, 0x27, 0x2c, 0x3b, 0x0a, 0x4d, 0x1a, 0xbb, 0x8e,
-	0xe6, 0xdc, 0x45, 0x31, 0x3b, 0x8f, 0x18, 0xa5, 0x2b, 0xe8, 0x9d, 0x5d, 0x58, 0xe4, 0x5f, 0x2c,
-	0xd3, 0xb6, 0x1e, 0x74, 0x7b, 0x77, 0xee, 0xdc, 0xbd, 0xdb, 0x7f, 0xf8, 0xe2, 0xe9, 0x43, 0x77,
-	0xff, 0xfe, 0xe3, 0xd6, 0xd7, 0x74, 0xb4, 0xbb, 0x8f, 0x68, 0xc5, 0xf9, 0x26, 0x1e, 0x4d, 0x09,
-	0xc5, 0xa3, 0x71, 0xe5, 0x24, 0x84, 0x91, 0xae, 0x3e, 0x9d, 0x3f, 0x54, 0x60, 0xab, 0x2b, 0x2e,
-	0xbb, 0x17, 0x07, 0xaf, 0xbd, 0x94, 0xb2, 0x1b, 0x3f, 0xaf, 0xa9, 0x67, 0x27, 0xfb, 0x5b, 0xbc,
-	0x9e, 0x08, 0x71, 0xc2, 0xb5, 0x4e, 0x83, 0x23, 0xe1, 0xde, 0xac, 0x76, 0x8f, 0xa7, 0xbb, 0x3c,
-	0x0f, 0x8e, 0x78, 0x4e, 0x67, 0x5a, 0xf8, 0x5e, 0x28, 0x7c, 0x7a, 0xd5, 0xc5, 0x2f, 0xc7, 0x86,
-	0x4e, 0x51, 0x29, 0x74, 0x8b, 0x10, 0x1a, 0x18, 0x1e, 0x17, 0xf4, 0xc1, 0xef, 0xc0, 0x66, 0xcc,
-	0x38, 0x02, 0x56, 0x6d, 0x99, 0xb3, 0x87, 0x47, 0x41, 0x3c, 0xf2, 0x64, 0x51, 0x90, 0x05, 0x65,
-	0x43, 0xad, 0xee, 0xe9, 0x8b, 0x6c, 0xbf, 0xe6, 0x74, 0x3f, 0x34, 0x27, 0xab, 0x7d, 0x22, 0x4c,
-	0xc5, 0x3e, 0x96, 0x2b, 0x3f, 0x78, 0x21, 0x4a, 0xc6, 0x34, 0x1c, 0x78, 0x87, 0x43, 0x95, 0xf7,
-	0x33, 0x80, 0x97, 0xd8, 0x60, 0xc4, 0x64, 0x4e, 0x62, 0xda, 0x8f, 0xe9, 0xa9, 0x17, 0x0f, 0x54,
-	0x89, 0x55, 0xb0, 0x2b, 0x50, 0xe7, 0x4f, 0x0b, 0xb0, 0xf9, 0x63, 0x9a, 0x6a, 0x65, 0x69, 0xea,
-	0x63, 0x3b, 0xb0, 0xc6, 0xaa, 0x5a, 0x9c, 0xb2, 0x6a, 0xa1, 0xa7, 0x3a, 0x79, 0x33, 0x6d, 0xb5,
-	0x94, 0xe5, 0xba, 0x5d, 0xd8, 0xc8, 0xd3, 0x67, 0x15, 0xb4, 0xed, 0xae, 0x99, 0x1c, 0xb2, 0x9c,
-	0x7e, 0x08, 0x6d, 0xa6, 0x72, 0x6e, 0x07, 0x4b, 0xec, 0xd0, 0x94, 0x0b, 0x99, 0x7c, 0xa6, 0x8f,
-	0x49, 0x2b, 0xa5, 0x2f, 0x0a, 0x73, 0xb6, 0x75, 0x6a, 0x29, 0xfb, 0x1e, 0x5c, 0x61, 0x0d, 0x61,
-	0x30, 0x9a, 0x8c, 0x98, 0x09, 0x7c, 0x9e, 0x82, 0x8d, 0xda, 0xbc, 0x24, 0xf8, 0x2e, 0x23, 0x89,
-	0x2b, 0x28, 0x74, 0x33, 0x38, 0x7f, 0x67, 0xce, 0x5a, 0x30, 0x0d, 0xde, 0xc9, 0x67, 0x40, 0x18,
-	0x23, 0xbb, 0x5a, 0x43, 0xa4, 0x2c, 0x28, 0x5b, 0x5a, 0xcc, 0xe9, 0x7d, 0x86, 0xdb, 0x16, 0x2c,
-	0xba, 0x3c, 0xd2, 0x83, 0xf5, 0x49, 0x58, 0x22, 0x69, 0xe1, 0x3c, 0x8d, 0xc3, 0x1a, 0xb2, 0x1a,
-	0x5a, 0xb3, 0x26, 0x7b, 0x6b, 0xef, 0xd8, 0x0b, 0x5f, 0xd2, 0xde, 0x34, 0x76, 0xd4, 0x8d, 0x7e,
-	0x0c, 0x16, 0x0b, 0x10, 0x71, 0x83, 0x8d, 0xdd, 0x5b, 0x9a, 0xf0, 0x19, 0x0c, 0x3b, 0x3c, 0x12,
-	0x38, 0x0b, 0x77, 0xfa, 0x88, 0xf5, 0xc6, 0x5a, 0x80, 0xca, 0x8a, 0x57, 0x67, 0x68, 0xc6, 0xc6,
-	0xc9, 0x78, 0xe2, 0xd5, 0xc8, 0xe4, 0x5d, 0xd6, 0x19, 0x9a, 0x91, 0x39, 0xd7, 0xc0, 0x62, 0x92,
-	0x49, 0x15, 0x56, 0x7a, 0x6e, 0xf7, 0x8b, 0xfb, 0x4f, 0x1f, 0xb2, 0x0c, 0x03, 0xb0, 0xdc, 0x7b,
-	0xf6, 0xe0, 0x71, 0x77, 0x8f, 0xe5, 0x15, 0x16, 0x90, 0x45, 0x8d, 0x30, 0x20, 0x7f, 0xcd, 0x1c,
-	0xf6, 0xb3, 0x49, 0xa8, 0x1f, 0xfa, 0xec, 0xa4, 0xc8, 0xcb, 0x9f, 0x17, 0xbf, 0xa4, 0xa9, 0xea,
-	0x37, 0x55, 0xa3, 0x24, 0x40, 0xd9, 0x6d, 0xce, 0x89, 0x58, 0x6b, 0x4e, 0xc4, 0x92, 0x4f, 0xc0,
-	0x0e, 0x42, 0x7f, 0x38, 0x19, 0xd0, 0xfe, 0x34, 0xe4, 0xfc, 0x28, 0x08, 0x0f, 0x99, 0xd6, 0x09,
-	0x66, 0x9a, 0x0e, 0x52, 0x74, 0x91, 0x60, 0x4f, 0xad, 0xf3, 0xa0, 0x51, 0xdc, 0xbe, 0x38, 0x72,
-	0x3f, 0xf1, 0xe3, 0x60, 0x2c, 0x0b, 0xe9, 0xaa, 0xbb, 0x86, 0x8b, 0xd2, 0x1c, 0x07, 0x62, 0xc9,
-	0xf9, 0xab, 0x05, 0x5b, 0x05, 0x13, 0xa0, 0x63, 0xfe, 0x1c, 0x5a, 0x09, 0x9b, 0x68, 0x7c, 0x5e,
-	0x67, 0x23, 0xd1, 0x3b, 0x2b, 0xb7, 0xfc, 0x96, 0x76, 0xdf, 0x33, 0xb8, 0x77, 0x7a, 0xd8, 0x7f,
-	0xe3, 0xac, 0xd0, 0x54, 0xa2, 0xe4, 0x77, 0xc2, 0xcb, 0x9d, 0x6c, 0x23, 0x0c, 0x33, 0x56, 0x05,
-	0x86, 0x56, 0xbc, 0x0d, 0x2d, 0x3c, 0xc8, 0xf8, 0x44, 0x9d, 0x45, 0x3a, 0x41, 0x43, 0xe2, 0xbd,
-	0x13, 0x79, 0x0c, 0xfb, 0x3f, 0x15, 0x68, 0x98, 0x1b, 0xf2, 0x21, 0x42, 0x0b, 0x03, 0x3d, 0xdf,
-	0x34, 0x35, 0x5c, 0x64, 0x03, 0xa6, 0x8a, 0x3c, 0x5f, 0x5f, 0x0e, 0x06, 0xb2, 0x26, 0x54, 0x25,
-	0xd6, 0x15, 0xe3, 0x01, 0xcb, 0xf7, 0xc6, 0x78, 0x81, 0x5f, 0xe4, 0x0a, 0x5c, 0xca, 0x74, 0x5b,
-	0x14, 0xe2, 0x57, 0xc7, 0xa8, 0x15, 0x97, 0xcb, 0xb3, 0x05, 0xef, 0x75, 0x79, 0x5f, 0x8f, 0xf3,
-	0x51, 0x15, 0xb1, 0xa7, 0x81, 0x6c, 0xa6, 0x8e, 0xe2, 0x68, 0x34, 0xbd, 0x65, 0xd1, 0xc6, 0xac,
-	0xba, 0x35, 0x0e, 0xaa, 0x9b, 0x75, 0xfe, 0x58, 0x81, 0xcd, 0x83, 0xe0, 0x65, 0x58, 0xe2, 0xa7,
-	0x67, 0x55, 0x3a, 0xe6, 0x88, 0x09, 0x8d, 0x03, 0x6f, 0x18, 0xfc, 0xca, 0xcc, 0x0b, 0x18, 0x74,
-	0x1b, 0xd9, 0xaa, 0x26, 0x9d, 0xab, 0x15, 0x84, 0x53, 0x83, 0x50, 0x39, 0x54, 0xd6, 0xdd, 0x9a,
-	0x00, 0xbb, 0x12, 0x73, 0x5e, 0xc1, 0x56, 0x41, 0x2b, 0x74, 0x9d, 0xdc, 0xbc, 0x5a, 0x29, 0xce,
-	0xab, 0x77, 0x61, 0x73, 0x12, 0x26, 0x8c, 0x9d, 0xa9, 0x65, 0x6e, 0xb5, 0x20, 0xb6, 0x5a, 0x57,
-	0xab, 0x5d, 0x7d, 0xcb, 0x9f, 0xc0, 0xe5, 0xde, 0xe4, 0x70, 0x18, 0x24, 0xc7, 0x25, 0xb6, 0xf8,
-	0x06, 0x10, 0x14, 0x58, 0xdc, 0xbb, 0x2d, 0x57, 0x34, 0x2e, 0xe7, 0x2a, 0xd8, 0x65, 0xb2, 0x30,
-	0x37, 0xbc, 0x03, 0xd7, 0x35, 0x78, 0x3f, 0x4a, 0x83, 0xa3, 0xc0, 0xf7, 0xf4, 0xa2, 0xe6, 0x7c,
-	0xb9, 0x00, 0x37, 0x66, 0xd3, 0xa0, 0x25, 0x3e, 0x85, 0xa6, 0x97, 0xa6, 0x9e, 0x7f, 0xcc, 0xd4,
-	0x12, 0xb5, 0xe6, 0xcc, 0xd4, 0xde, 0x50, 0xf4, 0x02, 0x4d, 0x78, 0xfd, 0x1d, 0x50, 0x53, 0x02,
-	0x37, 0x11, 0x0b, 0x02, 0x05, 0x23, 0xe1, 0xac, 0x02, 0x60, 0x7d, 0xd5, 0x02, 0xc0, 0xf3, 0x51,
-	0x89, 0x44, 0x11, 0x4b, 0x54, 0x4e, 0xa4, 0x35, 0xb7, 0x53, 0x64, 0xfc, 0x5c, 0xac, 0x3b, 0xbf,
-	0xab, 0xc0, 0xf6, 0x01, 0x6b, 0x23, 0xd2, 0x90, 0xf5, 0x6b, 0x65, 0x16, 0x9c, 0x93, 0x65, 0x59,
-	0x31, 0x0f, 0xa3, 0x7e, 0xc8, 0x99, 0xde, 0xf6, 0x99, 0x2b, 0x70, 0x31, 0xc2, 0x65, 0x57, 0xdd,
-	0x66, 0x18, 0x09, 0x61, 0x6f, 0x9f, 0x49, 0x98, 0xf7, 0x6c, 0x19, 0xad, 0xa4, 0x94, 0x73, 0x7a,
-	0x5d, 0x51, 0x0a, 0x2d, 0x9c, 0xdf, 0x2f, 0xc0, 0xb5, 0x59, 0xfa, 0xe0, 0x6d, 0xfd, 0x7f, 0x93,
-	0xc6, 0x23, 0x58, 0x11, 0x6d, 0x14, 0x95, 0xaf, 0x4a, 0x66, 0xde, 0x9c, 0xaf, 0x89, 0x58, 0x66,
-	0x8c, 0xae, 0x92, 0x60, 0x3f, 0x83, 0x15, 0xc4, 0x2e, 0xa2, 0xe5, 0x75, 0xa8, 0x6a, 0xd1, 0x85,
-	0x4a, 0x42, 0x16, 0xc6, 0xce, 0x36, 0x5c, 0x51, 0xc3, 0x72, 0x99, 0x8f, 0xff, 0xb7, 0x02, 0x57,
-	0xcb, 0xd7, 0x2f, 0x34, 0x7b, 0x9c, 0x67, 0xae, 0x2c, 0x1f, 0x19, 0xad, 0x0b, 0x8d, 0x8c, 0x8b,
-	0x17, 0x1a, 0x19, 0x97, 0x66, 0x8c, 0x8c, 0xbf, 0xad, 0xc0, 0xda, 0x5e, 0x4c, 0x59, 0xfb, 0xfe,
-	0x5c, 0x5c, 0x97, 0x72, 0xd7, 0x8f, 0xa0, 0x3d, 0xe6, 0x19, 0xc3, 0xef, 0x17, 0x72, 0x6e, 0x4b,
-	0x2e, 0x68, 0xfd, 0x0b, 0xcb, 0x46, 0x6a, 0x92, 0x28, 0xb4, 0x3a, 0x6d, 0x5c, 0xd1, 0xc8, 0x09,
-	0x2c, 0x26, 0x94, 0x0e, 0xb0, 0xbe, 0x89, 0xdf, 0xce, 0x26, 0xac, 0x9b, 0x6a, 0x60, 0x6e, 0xfa,
-	0x14, 0xda, 0x4f, 0x98, 0x2b, 0x7c, 0x75, 0xe5, 0x9c, 0x75, 0x20, 0xba, 0x04, 0x94, 0xcb, 0xd0,
-	0xbd, 0x61, 0x94, 0x98, 0xa7, 0x76, 0x36, 0x98, 0x31, 0x74, 0x14, 0x89, 0x19, 0x2c, 0x91, 0x87,
-	0x6f, 0x82, 0x24, 0x7b, 0x29, 0xd9, 0x81, 0x75, 0x13, 0x46, 0x3f, 0x61, 0x05, 0x94, 0x0a, 0x44,
-	0xe8, 0xc4, 0x06, 0x26, 0xf9, 0xe5, 0x7c, 0x59, 0x81, 0xce, 0x01, 0xef, 0xe6, 0xf7, 0x38, 0x59,
-	0x98, 0x4c, 0x12, 0x77, 0xec, 0xab, 0x33, 0xb1, 0xd4, 0x87, 0x8f, 0x44, 0x7d, 0x73, 0x0a, 0x6c,
-	0x20, 0x8c, 0xe3, 0x22, 0x7f, 0xa3, 0x9b, 0x24, 0xfc, 0xca, 0xa7, 0xae, 0x35, 0xfd, 0xe6, 0x6b,
-	0xdc, 0x22, 0x8c, 0x5c, 0x59, 0x77, 0xfa, 0xcd, 0xeb, 0x94, 0x4f, 0x63, 0xf4, 0x6b, 0x8a, 0x05,
-	0x5c, 0x87, 0x9c, 0x2b, 0x70, 0xb9, 0x44, 0x3d, 0x79, 0xa8, 0x5d, 0x77, 0xfa, 0x2e, 0x7d, 0x40,
-	0xe3, 0xd7, 0x81, 0xcf, 0xd3, 0xfd, 0x0a, 0x22, 0xe4, 0xb2, 0x16, 0xec, 0xe6, 0xeb, 0xb5, 0x6d,
-	0x97, 0x2d, 0xa1, 0xcc, 0x7f, 0x57, 0xa1, 0x2e, 0x2d, 0xa8, 0x64, 0x7e, 0x0f, 0x16, 0xf9, 0x33,
-	0x1b, 0xd9, 0xd4, 0xb8, 0xb4, 0x67, 0x38, 0x7b, 0xab, 0x80, 0x4f, 0x6b, 0xcf, 0x0a, 0x3e, 0xa7,
-	0x19, 0xca, 0x98, 0x6f, 0x74, 0x86, 0x32, 0xf9, 0xc7, 0x3a, 0x17, 0xea, 0xc6, 0x53, 0x1a, 0xb9,
-	0x5e, 0x7c, 0xe1, 0x32, 0xde, 0xe7, 0xec, 0x1b, 0xb3, 0x09, 0x50, 0xe6, 0x1e, 0xac, 0xaa, 0xb7,
-	0x31, 0x62, 0x97, 0x3e, 0x98, 0x49, 0x49, 0x57, 0xe6, 0x3c, 0xa6, 0xf1, 0xa3, 0xa9, 0xa7, 0x26,
-	0xfd, 0x68, 0xe6, 0x7c, 0x6d, 0x1c, 0x2d, 0x3f, 0x0a, 0xbf, 0x80, 0x66, 0x6e, 0x22, 0x23, 0xef,
-	0x68, 0xe4, 0xe5, 0x83, 0xac, 0xed, 0xcc, 0x23, 0x41, 0xc9, 0x13, 0xe8, 0xcc, 0x6a, 0x0b, 0xc8,
-	0x87, 0xe5, 0x55, 0xb8, 0x2c, 0xf7, 0xda, 0x1f, 0x9d, 0x8b, 0x56, 0x6e, 0x7a, 0xa7, 0x42, 0x22,
-	0xd6, 0x24, 0x96, 0xd6, 0x14, 0x72, 0xfb, 0x1c, 0x65, 0x47, 0x6e, 0xf9, 0xc1, 0xb9, 0x0b, 0x14,
-	0xdb, 0x30, 0xc8, 0x9e, 0x68, 0x8d, 0xed, 0x6e, 0x95, 0xb8, 0x40, 0xd9, 0x66, 0xef, 0x9f, 0x49,
-	0x37, 0xdd, 0xea, 0x67, 0xd0, 0xca, 0x4f, 0x71, 0xc4, 0x39, 0x7b, 0xe8, 0xb4, 0x6f, 0xce, 0xa5,
-	0xc9, 0x9c, 0xdc, 0x78, 0xc7, 0x33, 0x9c, 0xbc, 0xec, 0xed, 0xd0, 0x70, 0xf2, 0xd2, 0x27, 0x40,
-	0xf2, 0x18, 0xaa, 0xda, 0x4b, 0x1d, 0xd9, 0xce, 0xbf, 0x9d, 0x99, 0xf2, 0xae, 0xcd, 0x5a, 0xce,
-	0x49, 0xc3, 0x6c, 0xb7, 0x3d, 0xf7, 0x25, 0xae, 0x28, 0x2d, 0xf7, 0xa6, 0xc6, 0x8c, 0x99, 0x7f,
-	0xa3, 0x32, 0x8c, 0x39, 0xe3, 0x55, 0xcd, 0x30, 0xe6, 0xac, 0x47, 0x2e, 0x1e, 0x56, 0xb9, 0x89,
-	0xd0, 0x08, 0xab, 0xf2, 0x71, 0xdb, 0x08, 0xab, 0x59, 0xe3, 0x28, 0x93, 0x9c, 0x1b, 0x37, 0x0c,
-	0xc9, 0xe5, 0x03, 0x92, 0x21, 0x79, 0xd6, 0xb4, 0xe2, 0x01, 0x29, 0x4e, 0x02, 0x44, 0xff, 0x0f,
-	0x6c, 0xe6, 0xd0, 0x61, 0xbf, 0x77, 0x06, 0x15, 0x66, 0xf5, 0xbf, 0x59, 0xaa, 0x5c, 0x3e, 0x8e,
-	0x3c, 0xd6, 0xc3, 0xa9, 0xdc, 0xfe, 0x04, 0x6a, 0x7a, 0xb9, 0x24, 0xfa, 0xdd, 0x95, 0x94, 0x57,
-	0xfb, 0xfa, 0xcc, 0x75, 0x3c, 0x0b, 0x13, 0xa8, 0xf7, 0x0c, 0x86, 0xc0, 0x92, 0x9e, 0xc6, 0x10,
-	0x58, 0xd6, 0x6c, 0x90, 0x2e, 0x40, 0xd6, 0x2a, 0x90, 0xab, 0x1a, 0x79, 0xa1, 0x07, 0xb1, 0xb7,
-	0x67, 0xac, 0x66, 0x6e, 0xac, 0x75, 0x12, 0x86, 0x1b, 0x17, 0xfb, 0x0e, 0xc3, 0x8d, 0x4b, 0x1a,
-	0x10, 0xf2, 0x0b, 0x68, 0x17, 0x2a, 0x33, 0xd1, 0x7d, 0x74, 0x56, 0x5b, 0x61, 0xbf, 0x3b, 0x9f,
-	0x48, 0xca, 0x3f, 0x5c, 0x16, 0x7f, 0x43, 0x7f, 0xfb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x22,
-	0xfc, 0x70, 0xb3, 0x93, 0x1e, 0x00, 0x00,
-}
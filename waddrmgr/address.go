@@ -120,6 +120,30 @@ type ManagedScriptAddress interface {
 	Script() ([]byte, error)
 }
 
+// ManagedMultiSigAddress extends ManagedScriptAddress and represents an m-of-n
+// multisig pay-to-script-hash address derived for a multisig account.  It
+// additionally provides the keys of the cosigners of the address.
+type ManagedMultiSigAddress interface {
+	ManagedScriptAddress
+
+	// RequiredSigs returns the number of signatures required to spend
+	// outputs paying to the address.
+	RequiredSigs() int
+
+	// PubKeys returns the sorted public keys of the cosigners of the
+	// address, in the order they appear in the redeem script.
+	PubKeys() []*czzec.PublicKey
+
+	// PrivKeys returns the private keys held by the address manager for
+	// any of the cosigner keys of the address.  It can fail if the
+	// address manager is watching-only or locked.
+	PrivKeys(ns walletdb.ReadBucket) ([]*czzec.PrivateKey, error)
+
+	// DerivationInfo contains the information required to derive the
+	// cosigner keys of the address from the cosigner account keys.
+	DerivationInfo() (KeyScope, DerivationPath, bool)
+}
+
 // managedAddress represents a public key address.  It also may or may not have
 // the private key associated with the public key.
 type managedAddress struct {
@@ -651,3 +675,171 @@ func newScriptAddress(m *ScopedKeyManager, account uint32, scriptHash,
 		scriptEncrypted: scriptEncrypted,
 	}, nil
 }
+
+// multiSigAddress represents an m-of-n multisig pay-to-script-hash address
+// derived for a multisig account.  Unlike imported script addresses, the
+// redeem script is derived from public data only and is therefore always
+// available in clear text.
+type multiSigAddress struct {
+	manager        *ScopedKeyManager
+	derivationPath DerivationPath
+	address        *czzutil.AddressScriptHash
+	internal       bool
+	script         []byte
+	nRequired      int
+	pubKeys        []*czzec.PublicKey
+}
+
+// Enforce multiSigAddress satisfies the ManagedMultiSigAddress interface.
+var _ ManagedMultiSigAddress = (*multiSigAddress)(nil)
+
+// InternalAccount returns the multisig account the address is associated
+// with.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multiSigAddress) InternalAccount() uint32 {
+	return a.derivationPath.InternalAccount
+}
+
+// AddrType returns the address type of the managed address. This can be used
+// to quickly discern the address type without further processing
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multiSigAddress) AddrType() AddressType {
+	return Script
+}
+
+// Address returns the czzutil.Address which represents the managed address.
+// This will be a pay-to-script-hash address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multiSigAddress) Address() czzutil.Address {
+	return a.address
+}
+
+// AddrHash returns the script hash for the address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multiSigAddress) AddrHash() []byte {
+	return a.address.Hash160()[:]
+}
+
+// Imported always returns false since multisig addresses are part of the
+// address chains of their account.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multiSigAddress) Imported() bool {
+	return false
+}
+
+// Internal returns true if the address was created for internal use such as a
+// change output of a transaction.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multiSigAddress) Internal() bool {
+	return a.internal
+}
+
+// Compressed returns false since script addresses are never compressed.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multiSigAddress) Compressed() bool {
+	return false
+}
+
+// Used returns true if the address has been used in a transaction.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multiSigAddress) Used(ns walletdb.ReadBucket) bool {
+	return a.manager.fetchUsed(ns, a.AddrHash())
+}
+
+// Script returns the multisig redeem script of the address.
+//
+// This is part of the ManagedScriptAddress interface implementation.
+func (a *multiSigAddress) Script() ([]byte, error) {
+	script := make([]byte, len(a.script))
+	copy(script, a.script)
+	return script, nil
+}
+
+// RequiredSigs returns the number of signatures required to spend outputs
+// paying to the address.
+//
+// This is part of the ManagedMultiSigAddress interface implementation.
+func (a *multiSigAddress) RequiredSigs() int {
+	return a.nRequired
+}
+
+// PubKeys returns the sorted public keys of the cosigners of the address.
+//
+// This is part of the ManagedMultiSigAddress interface implementation.
+func (a *multiSigAddress) PubKeys() []*czzec.PublicKey {
+	return a.pubKeys
+}
+
+// PrivKeys returns the private keys held by the address manager for any of
+// the cosigner keys of the address.  A cosigner key is held by the address
+// manager when its account public key is the public key of another account of
+// the same scope, including accounts created after the multisig account.
+//
+// This is part of the ManagedMultiSigAddress interface implementation.
+func (a *multiSigAddress) PrivKeys(ns walletdb.ReadBucket) (
+	[]*czzec.PrivateKey, error) {
+
+	// No private keys are available for a watching-only address manager.
+	if a.manager.rootManager.WatchOnly() {
+		return nil, managerError(ErrWatchingOnly, errWatchingOnly, nil)
+	}
+
+	a.manager.mtx.Lock()
+	defer a.manager.mtx.Unlock()
+
+	// Account manager must be unlocked to derive the private keys.
+	if a.manager.rootManager.IsLocked() {
+		return nil, managerError(ErrLocked, errLocked, nil)
+	}
+
+	acctInfo, err := a.manager.loadAccountInfo(
+		ns, a.derivationPath.InternalAccount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	cosigners, err := a.manager.localCosigners(ns, acctInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	var privKeys []*czzec.PrivateKey
+	for _, cosignerInfo := range cosigners {
+		if cosignerInfo.acctKeyPriv == nil {
+			continue
+		}
+
+		key, err := a.manager.deriveKey(
+			cosignerInfo, a.derivationPath.Branch,
+			a.derivationPath.Index, true,
+		)
+		if err != nil {
+			return nil, err
+		}
+		privKey, err := key.ECPrivKey()
+		key.Zero()
+		if err != nil {
+			str := "failed to get private key of cosigner key"
+			return nil, managerError(ErrKeyChain, str, err)
+		}
+		privKeys = append(privKeys, privKey)
+	}
+
+	return privKeys, nil
+}
+
+// DerivationInfo contains the information required to derive the cosigner
+// keys of the address from the cosigner account keys.
+//
+// This is part of the ManagedMultiSigAddress interface implementation.
+func (a *multiSigAddress) DerivationInfo() (KeyScope, DerivationPath, bool) {
+	return a.manager.Scope(), a.derivationPath, true
+}
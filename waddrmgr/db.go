@@ -90,6 +90,13 @@ const (
 	// derivation schema of BIP0044-like accounts and does not store private
 	// keys.
 	accountWatchOnly accountType = 1
+
+	// accountMultiSig is the account type used for storing multisig
+	// accounts within the database. This is an account whose addresses are
+	// m-of-n pay-to-script-hash multisig addresses, built from the keys
+	// derived at the same branch and index of each cosigner's account
+	// public key.  It was introduced by version 9 of the database.
+	accountMultiSig accountType = 2
 )
 
 // dbAccountRow houses information stored about an account in the database.
@@ -121,6 +128,17 @@ type dbWatchOnlyAccountRow struct {
 	addrSchema           *ScopeAddrSchema
 }
 
+// dbMultiSigAccountRow houses additional information stored about a multisig
+// account in the database.
+type dbMultiSigAccountRow struct {
+	dbAccountRow
+	nRequired         uint8
+	pubKeysEncrypted  [][]byte
+	nextExternalIndex uint32
+	nextInternalIndex uint32
+	name              string
+}
+
 // dbAddressRow houses common information stored about an address in the
 // database.
 type dbAddressRow struct {
@@ -948,6 +966,146 @@ func serializeWatchOnlyAccountRow(encryptedPubKey []byte, masterKeyFingerprint,
 	return buf.Bytes(), nil
 }
 
+// deserializeMultiSigAccountRow deserializes the raw data from the passed
+// account row as a multisig account.
+func deserializeMultiSigAccountRow(accountID []byte,
+	row *dbAccountRow) (*dbMultiSigAccountRow, error) {
+
+	// The serialized multisig account raw data format is:
+	//   <nrequired><numkeys><encpubkeylen><encpubkey>...<nextextidx>
+	//   <nextintidx><namelen><name>
+	//
+	// 1 byte required signatures + 1 byte number of cosigner keys + 4
+	// bytes encrypted pubkey len + encrypted pubkey for each cosigner key
+	// + 4 bytes next external index + 4 bytes next internal index + 4
+	// bytes name len + name
+
+	// Given the above, the length of the entry must be at a minimum
+	// the constant value sizes.
+	if len(row.rawData) < 14 {
+		str := fmt.Sprintf("malformed serialized multisig account "+
+			"for key %x", accountID)
+		return nil, managerError(ErrDatabase, str, nil)
+	}
+
+	retRow := dbMultiSigAccountRow{
+		dbAccountRow: *row,
+	}
+	r := bytes.NewReader(row.rawData)
+
+	err := binary.Read(r, binary.LittleEndian, &retRow.nRequired)
+	if err != nil {
+		return nil, err
+	}
+
+	var numKeys uint8
+	err = binary.Read(r, binary.LittleEndian, &numKeys)
+	if err != nil {
+		return nil, err
+	}
+	retRow.pubKeysEncrypted = make([][]byte, numKeys)
+	for i := range retRow.pubKeysEncrypted {
+		var pubLen uint32
+		err := binary.Read(r, binary.LittleEndian, &pubLen)
+		if err != nil {
+			return nil, err
+		}
+		retRow.pubKeysEncrypted[i] = make([]byte, pubLen)
+		err = binary.Read(
+			r, binary.LittleEndian, &retRow.pubKeysEncrypted[i],
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = binary.Read(r, binary.LittleEndian, &retRow.nextExternalIndex)
+	if err != nil {
+		return nil, err
+	}
+	err = binary.Read(r, binary.LittleEndian, &retRow.nextInternalIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	var nameLen uint32
+	err = binary.Read(r, binary.LittleEndian, &nameLen)
+	if err != nil {
+		return nil, err
+	}
+	name := make([]byte, nameLen)
+	err = binary.Read(r, binary.LittleEndian, &name)
+	if err != nil {
+		return nil, err
+	}
+	retRow.name = string(name)
+
+	return &retRow, nil
+}
+
+// serializeMultiSigAccountRow returns the serialization of the raw data field
+// for a multisig account.
+func serializeMultiSigAccountRow(nRequired uint8, encryptedPubKeys [][]byte,
+	nextExternalIndex, nextInternalIndex uint32, name string) ([]byte, error) {
+
+	// The serialized multisig account raw data format is:
+	//   <nrequired><numkeys><encpubkeylen><encpubkey>...<nextextidx>
+	//   <nextintidx><namelen><name>
+	//
+	// 1 byte required signatures + 1 byte number of cosigner keys + 4
+	// bytes encrypted pubkey len + encrypted pubkey for each cosigner key
+	// + 4 bytes next external index + 4 bytes next internal index + 4
+	// bytes name len + name
+	nameLen := uint32(len(name))
+
+	bufLen := 14 + nameLen
+	for _, encryptedPubKey := range encryptedPubKeys {
+		bufLen += 4 + uint32(len(encryptedPubKey))
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, bufLen))
+
+	err := binary.Write(buf, binary.LittleEndian, nRequired)
+	if err != nil {
+		return nil, err
+	}
+
+	err = binary.Write(buf, binary.LittleEndian, uint8(len(encryptedPubKeys)))
+	if err != nil {
+		return nil, err
+	}
+	for _, encryptedPubKey := range encryptedPubKeys {
+		pubLen := uint32(len(encryptedPubKey))
+		err := binary.Write(buf, binary.LittleEndian, pubLen)
+		if err != nil {
+			return nil, err
+		}
+		err = binary.Write(buf, binary.LittleEndian, encryptedPubKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = binary.Write(buf, binary.LittleEndian, nextExternalIndex)
+	if err != nil {
+		return nil, err
+	}
+	err = binary.Write(buf, binary.LittleEndian, nextInternalIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	err = binary.Write(buf, binary.LittleEndian, nameLen)
+	if err != nil {
+		return nil, err
+	}
+	err = binary.Write(buf, binary.LittleEndian, []byte(name))
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
 // forEachKeyScope calls the given function for each known manager scope
 // within the set of scopes known by the root manager.
 func forEachKeyScope(ns walletdb.ReadBucket, fn func(KeyScope) error) error {
@@ -1088,6 +1246,8 @@ func fetchAccountInfo(ns walletdb.ReadBucket, scope *KeyScope,
 		return deserializeDefaultAccountRow(accountID, row)
 	case accountWatchOnly:
 		return deserializeWatchOnlyAccountRow(accountID, row)
+	case accountMultiSig:
+		return deserializeMultiSigAccountRow(accountID, row)
 	}
 
 	str := fmt.Sprintf("unsupported account type '%d'", row.acctType)
@@ -1272,6 +1432,27 @@ func putWatchOnlyAccountInfo(ns walletdb.ReadWriteBucket, scope *KeyScope,
 	return putAccountInfo(ns, scope, account, &acctRow, name)
 }
 
+// putMultiSigAccountInfo stores the provided multisig account information to
+// the database.
+func putMultiSigAccountInfo(ns walletdb.ReadWriteBucket, scope *KeyScope,
+	account uint32, nRequired uint8, encryptedPubKeys [][]byte,
+	nextExternalIndex, nextInternalIndex uint32, name string) error {
+
+	rawData, err := serializeMultiSigAccountRow(
+		nRequired, encryptedPubKeys, nextExternalIndex,
+		nextInternalIndex, name,
+	)
+	if err != nil {
+		return err
+	}
+
+	acctRow := dbAccountRow{
+		acctType: accountMultiSig,
+		rawData:  rawData,
+	}
+	return putAccountInfo(ns, scope, account, &acctRow, name)
+}
+
 // putAccountInfo stores the provided account information to the database.
 func putAccountInfo(ns walletdb.ReadWriteBucket, scope *KeyScope,
 	account uint32, acctRow *dbAccountRow, name string) error {
@@ -1697,6 +1878,31 @@ func putChainedAddress(ns walletdb.ReadWriteBucket, scope *KeyScope,
 		if err != nil {
 			return err
 		}
+
+	case accountMultiSig:
+		arow, err := deserializeMultiSigAccountRow(accountID, row)
+		if err != nil {
+			return err
+		}
+
+		// Increment the appropriate next index depending on whether the
+		// branch is internal or external.
+		nextExternalIndex := arow.nextExternalIndex
+		nextInternalIndex := arow.nextInternalIndex
+		if branch == InternalBranch {
+			nextInternalIndex = index + 1
+		} else {
+			nextExternalIndex = index + 1
+		}
+
+		// Reserialize the account with the updated index and store it.
+		row.rawData, err = serializeMultiSigAccountRow(
+			arow.nRequired, arow.pubKeysEncrypted,
+			nextExternalIndex, nextInternalIndex, arow.name,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = bucket.Put(accountID, serializeAccountRow(row))
@@ -1943,8 +2149,9 @@ func deletePrivateKeys(ns walletdb.ReadWriteBucket) error {
 					return managerError(ErrDatabase, str, err)
 				}
 
-			// Watch-only accounts don't contain any private keys.
-			case accountWatchOnly:
+			// Watch-only and multisig accounts don't contain any
+			// private keys.
+			case accountWatchOnly, accountMultiSig:
 			}
 
 			return nil
@@ -7,6 +7,7 @@ package waddrmgr
 import (
 	"crypto/rand"
 	"crypto/sha512"
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"time"
@@ -174,6 +175,13 @@ type accountInfo struct {
 	// derivation path m/). This may be required by some hardware wallets
 	// for proper identification and signing.
 	masterKeyFingerprint uint32
+
+	// nRequired and cosignerKeys describe a multisig account.  They are
+	// the number of signatures required to spend from the account's
+	// addresses, and the account public keys of all of its cosigners.
+	// The account public and private keys are nil for multisig accounts.
+	nRequired    int
+	cosignerKeys []*hdkeychain.ExtendedKey
 }
 
 // AccountProperties contains properties associated with each account, such as
@@ -216,9 +224,24 @@ type AccountProperties struct {
 	// doesn't contain any private key information.
 	IsWatchOnly bool
 
+	// IsImported indicates whether the account was imported with its
+	// account public key rather than derived from the master key of the
+	// wallet.  Unlike IsWatchOnly, it does not depend on whether the
+	// wallet is locked.
+	IsImported bool
+
 	// AddrSchema, if non-nil, specifies an address schema override for
 	// address generation only applicable to the account.
 	AddrSchema *ScopeAddrSchema
+
+	// RequiredSigs is the number of signatures required to spend from
+	// the addresses of a multisig account.  It is zero for all other
+	// accounts.
+	RequiredSigs int
+
+	// CosignerKeys are the account public keys of the cosigners of a
+	// multisig account.
+	CosignerKeys []*hdkeychain.ExtendedKey
 }
 
 // unlockDeriveInfo houses the information needed to derive a private key for a
@@ -690,6 +713,41 @@ func (m *Manager) NeuterRootKey(ns walletdb.ReadWriteBucket) error {
 	return ns.NestedReadWriteBucket(mainBucketName).Delete(masterHDPrivName)
 }
 
+// MasterKeyFingerprint returns the fingerprint of the master HD key of the
+// manager, which is the first four bytes of the hash160 of its public key, read
+// in little-endian order as done for the BIP0032 derivations of PSBTs.
+// Watching-only managers do not know their master key, in which case
+// ErrWatchingOnly is returned.
+func (m *Manager) MasterKeyFingerprint(ns walletdb.ReadBucket) (uint32, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	_, masterRootPubEnc := fetchMasterHDKeys(ns)
+	if masterRootPubEnc == nil {
+		return 0, managerError(ErrWatchingOnly, errWatchingOnly, nil)
+	}
+	serializedMasterRootPub, err := m.cryptoKeyPub.Decrypt(masterRootPubEnc)
+	if err != nil {
+		str := "failed to decrypt master root serialized public key"
+		return 0, managerError(ErrCrypto, str, err)
+	}
+	rootPub, err := hdkeychain.NewKeyFromString(
+		string(serializedMasterRootPub),
+	)
+	if err != nil {
+		str := "failed to create master extended public key"
+		return 0, managerError(ErrKeyChain, str, err)
+	}
+	pubKey, err := rootPub.ECPubKey()
+	if err != nil {
+		str := "failed to get master public key"
+		return 0, managerError(ErrKeyChain, str, err)
+	}
+
+	pubKeyHash := czzutil.Hash160(pubKey.SerializeCompressed())
+	return binary.LittleEndian.Uint32(pubKeyHash[:4]), nil
+}
+
 // Address returns a managed address given the passed address if it is known to
 // the address manager. A managed address differs from the passed address in
 // that it also potentially contains extra information needed to sign
@@ -1219,6 +1277,12 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 	// extended keys.
 	for _, manager := range m.scopedManagers {
 		for account, acctInfo := range manager.acctInfo {
+			// Watch-only and multisig accounts don't have any
+			// private keys to decrypt.
+			if len(acctInfo.acctKeyEncrypted) == 0 {
+				continue
+			}
+
 			decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
 			if err != nil {
 				m.lock()
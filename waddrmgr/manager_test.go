@@ -2470,3 +2470,28 @@ func TestNewRawAccount(t *testing.T) {
 			accountTargetAddr.AddrHash())
 	}
 }
+
+// TestMasterKeyFingerprint checks that the fingerprint of the master key of
+// the test seed is reported in the little-endian order of PSBT derivations.
+func TestMasterKeyFingerprint(t *testing.T) {
+	teardown, db, mgr := setupManager(t)
+	defer teardown()
+
+	var fingerprint uint32
+	err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		fingerprint, err = mgr.MasterKeyFingerprint(ns)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to get master key fingerprint: %v", err)
+	}
+
+	// The fingerprint of the master key is baf4e672.
+	const expected = 0x72e6f4ba
+	if fingerprint != expected {
+		t.Fatalf("expected fingerprint %08x, got %08x", expected,
+			fingerprint)
+	}
+}
@@ -35,6 +35,14 @@ var versions = []migration.Version{
 		Number:    8,
 		Migration: storeMaxReorgDepth,
 	},
+
+	// Version 9 introduces the multisig account type.  No data needs to
+	// be migrated, but older versions are unable to deserialize multisig
+	// account rows, so they must not open databases that may contain them.
+	{
+		Number:    9,
+		Migration: nil,
+	},
 }
 
 // getLatestVersion returns the version number of the latest database version.
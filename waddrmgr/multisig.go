@@ -0,0 +1,506 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package waddrmgr
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// MaxMultiSigCosigners is the maximum number of cosigners of a multisig
+// account.  The redeem script of a multisig address with this many compressed
+// public keys still fits in a standard pay-to-script-hash signature script.
+const MaxMultiSigCosigners = 15
+
+// NewMultiSigAccount creates and returns a new multisig account for the scoped
+// manager, whose addresses are nRequired-of-n pay-to-script-hash multisig
+// addresses of the n cosigner account public keys.  The address at a given
+// branch and index is built from the keys derived at the same branch and index
+// of every cosigner key, sorted as in BIP0067, so every cosigner derives the
+// same addresses regardless of the order of the keys.
+//
+// Cosigner keys which are the account public keys of other accounts of the
+// scoped manager can be used to sign for the addresses of the multisig
+// account.
+func (s *ScopedKeyManager) NewMultiSigAccount(ns walletdb.ReadWriteBucket,
+	name string, nRequired int,
+	cosignerKeys []*hdkeychain.ExtendedKey) (uint32, error) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// Validate the account name.
+	if err := ValidateAccountName(name); err != nil {
+		return 0, err
+	}
+
+	// Check that account with the same name does not exist
+	_, err := s.lookupAccount(ns, name)
+	if err == nil {
+		str := fmt.Sprintf("account with the same name already exists")
+		return 0, managerError(ErrDuplicateAccount, str, err)
+	}
+
+	if len(cosignerKeys) == 0 || len(cosignerKeys) > MaxMultiSigCosigners {
+		str := fmt.Sprintf("multisig account must have between 1 and "+
+			"%d cosigners", MaxMultiSigCosigners)
+		return 0, managerError(ErrInvalidAccount, str, nil)
+	}
+	if nRequired < 1 || nRequired > len(cosignerKeys) {
+		str := fmt.Sprintf("invalid number of required signatures %d "+
+			"for %d cosigners", nRequired, len(cosignerKeys))
+		return 0, managerError(ErrInvalidAccount, str, nil)
+	}
+
+	// Encrypt the cosigner keys with the crypto public key, ensuring each
+	// of them is a distinct public key.
+	encryptedPubKeys := make([][]byte, 0, len(cosignerKeys))
+	seen := make(map[string]struct{}, len(cosignerKeys))
+	for _, key := range cosignerKeys {
+		if key.IsPrivate() {
+			str := "cosigner keys must be extended public keys"
+			return 0, managerError(ErrInvalidKeyType, str, nil)
+		}
+		pubKey, err := key.ECPubKey()
+		if err != nil {
+			str := "invalid cosigner key"
+			return 0, managerError(ErrKeyChain, str, err)
+		}
+		serializedPubKey := string(pubKey.SerializeCompressed())
+		if _, ok := seen[serializedPubKey]; ok {
+			str := fmt.Sprintf("duplicate cosigner key %v", key)
+			return 0, managerError(ErrInvalidAccount, str, nil)
+		}
+		seen[serializedPubKey] = struct{}{}
+
+		pubKeyEnc, err := s.rootManager.cryptoKeyPub.Encrypt(
+			[]byte(key.String()),
+		)
+		if err != nil {
+			str := "failed to encrypt cosigner key for account"
+			return 0, managerError(ErrCrypto, str, err)
+		}
+		encryptedPubKeys = append(encryptedPubKeys, pubKeyEnc)
+	}
+
+	// Fetch latest account, and create a new account in the same
+	// transaction.
+	account, err := fetchLastAccount(ns, &s.scope)
+	if err != nil {
+		return 0, err
+	}
+	account++
+	if account > MaxAccountNum {
+		return 0, managerError(ErrAccountNumTooHigh, errAcctTooHigh, nil)
+	}
+
+	err = putMultiSigAccountInfo(
+		ns, &s.scope, account, uint8(nRequired), encryptedPubKeys, 0, 0,
+		name,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Save last account metadata
+	if err := putLastAccount(ns, &s.scope, account); err != nil {
+		return 0, err
+	}
+
+	return account, nil
+}
+
+// loadMultiSigAccountInfo returns the account information of a multisig
+// account loaded from the database, including the last derived external and
+// internal addresses.
+//
+// This function MUST be called with the manager lock held for writes.
+func (s *ScopedKeyManager) loadMultiSigAccountInfo(ns walletdb.ReadBucket,
+	account uint32, row *dbMultiSigAccountRow) (*accountInfo, error) {
+
+	acctInfo := &accountInfo{
+		acctName:          row.name,
+		acctType:          row.acctType,
+		nextExternalIndex: row.nextExternalIndex,
+		nextInternalIndex: row.nextInternalIndex,
+		nRequired:         int(row.nRequired),
+	}
+
+	// Use the crypto public key to decrypt the cosigner keys.
+	for i, pubKeyEncrypted := range row.pubKeysEncrypted {
+		serializedKey, err := s.rootManager.cryptoKeyPub.Decrypt(
+			pubKeyEncrypted,
+		)
+		if err != nil {
+			str := fmt.Sprintf("failed to decrypt cosigner key %d "+
+				"for account %d", i, account)
+			return nil, managerError(ErrCrypto, str, err)
+		}
+		key, err := hdkeychain.NewKeyFromString(string(serializedKey))
+		if err != nil {
+			str := fmt.Sprintf("invalid cosigner key %d for "+
+				"account %d", i, account)
+			return nil, managerError(ErrKeyChain, str, err)
+		}
+		acctInfo.cosignerKeys = append(acctInfo.cosignerKeys, key)
+	}
+
+	// Derive and cache the managed addresses for the last external and
+	// internal addresses.
+	index := acctInfo.nextExternalIndex
+	if index > 0 {
+		index--
+	}
+	var err error
+	acctInfo.lastExternalAddr, err = s.deriveMultiSigAddress(
+		acctInfo, account, ExternalBranch, index,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	index = acctInfo.nextInternalIndex
+	if index > 0 {
+		index--
+	}
+	acctInfo.lastInternalAddr, err = s.deriveMultiSigAddress(
+		acctInfo, account, InternalBranch, index,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return acctInfo, nil
+}
+
+// localCosigners returns the information of the accounts of the scope whose
+// account public key is one of the cosigner keys of a multisig account, as the
+// private keys of those cosigners are held by the manager.  The accounts are
+// looked up on every use, since they may be created after the multisig
+// account.
+//
+// This function MUST be called with the manager lock held for writes.
+func (s *ScopedKeyManager) localCosigners(ns walletdb.ReadBucket,
+	acctInfo *accountInfo) ([]*accountInfo, error) {
+
+	var cosigners []*accountInfo
+	err := forEachAccount(ns, &s.scope, func(account uint32) error {
+		if account == ImportedAddrAccount {
+			return nil
+		}
+		row, err := fetchAccountInfo(ns, &s.scope, account)
+		if err != nil {
+			return err
+		}
+		if _, ok := row.(*dbDefaultAccountRow); !ok {
+			return nil
+		}
+
+		localInfo, err := s.loadAccountInfo(ns, account)
+		if err != nil {
+			return err
+		}
+		for _, key := range acctInfo.cosignerKeys {
+			if sameExtendedPubKey(key, localInfo.acctKeyPub) {
+				cosigners = append(cosigners, localInfo)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, maybeConvertDbError(err)
+	}
+	return cosigners, nil
+}
+
+// sameExtendedPubKey returns whether the two extended keys have the same
+// public key and chain code, and therefore derive the same child public keys.
+func sameExtendedPubKey(a, b *hdkeychain.ExtendedKey) bool {
+	aPubKey, err := a.ECPubKey()
+	if err != nil {
+		return false
+	}
+	bPubKey, err := b.ECPubKey()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(
+		aPubKey.SerializeCompressed(), bPubKey.SerializeCompressed(),
+	) && bytes.Equal(a.ChainCode(), b.ChainCode())
+}
+
+// deriveMultiSigAddress returns the multisig address of a multisig account at
+// the given branch and index.  The hdkeychain.ErrInvalidChild error is
+// returned unwrapped when the child of any of the cosigner keys at the branch
+// and index is invalid, so callers can skip to the next index.
+func (s *ScopedKeyManager) deriveMultiSigAddress(acctInfo *accountInfo,
+	account, branch, index uint32) (*multiSigAddress, error) {
+
+	pubKeys := make([]*czzec.PublicKey, 0, len(acctInfo.cosignerKeys))
+	for _, cosignerKey := range acctInfo.cosignerKeys {
+		branchKey, err := cosignerKey.DeriveNonStandard(branch) // nolint:staticcheck
+		if err == hdkeychain.ErrInvalidChild {
+			return nil, err
+		}
+		if err != nil {
+			str := fmt.Sprintf("failed to derive extended key "+
+				"branch %d", branch)
+			return nil, managerError(ErrKeyChain, str, err)
+		}
+
+		key, err := branchKey.DeriveNonStandard(index) // nolint:staticcheck
+		branchKey.Zero()
+		if err == hdkeychain.ErrInvalidChild {
+			return nil, err
+		}
+		if err != nil {
+			str := fmt.Sprintf("failed to derive child extended "+
+				"key -- branch %d, child %d", branch, index)
+			return nil, managerError(ErrKeyChain, str, err)
+		}
+
+		pubKey, err := key.ECPubKey()
+		if err != nil {
+			str := "failed to get public key of cosigner key"
+			return nil, managerError(ErrKeyChain, str, err)
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	// Sort the keys by their compressed serialization, so the redeem
+	// script doesn't depend on the order of the cosigner keys.
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(
+			pubKeys[i].SerializeCompressed(),
+			pubKeys[j].SerializeCompressed(),
+		) < 0
+	})
+
+	chainParams := s.rootManager.chainParams
+	pubKeyAddrs := make([]*czzutil.AddressPubKey, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		pubKeyAddr, err := czzutil.NewAddressPubKey(
+			pubKey.SerializeCompressed(), chainParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+		pubKeyAddrs = append(pubKeyAddrs, pubKeyAddr)
+	}
+
+	script, err := txscript.MultiSigScript(pubKeyAddrs, acctInfo.nRequired)
+	if err != nil {
+		return nil, err
+	}
+	address, err := czzutil.NewAddressScriptHash(script, chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multiSigAddress{
+		manager: s,
+		derivationPath: DerivationPath{
+			InternalAccount: account,
+			Branch:          branch,
+			Index:           index,
+		},
+		address:   address,
+		internal:  branch == InternalBranch,
+		script:    script,
+		nRequired: acctInfo.nRequired,
+		pubKeys:   pubKeys,
+	}, nil
+}
+
+// nextMultiSigAddresses returns the specified number of next multisig
+// addresses of a multisig account from the branch indicated by the internal
+// flag.
+//
+// This function MUST be called with the manager lock held for writes.
+func (s *ScopedKeyManager) nextMultiSigAddresses(ns walletdb.ReadWriteBucket,
+	account uint32, acctInfo *accountInfo, numAddresses uint32,
+	internal bool) ([]ManagedAddress, error) {
+
+	// Choose the branch and index depending on whether or not this is an
+	// internal address.
+	branchNum, nextIndex := ExternalBranch, acctInfo.nextExternalIndex
+	if internal {
+		branchNum = InternalBranch
+		nextIndex = acctInfo.nextInternalIndex
+	}
+
+	// Ensure the requested number of addresses doesn't exceed the maximum
+	// allowed for this account.
+	if numAddresses > MaxAddressesPerAccount || nextIndex+numAddresses >
+		MaxAddressesPerAccount {
+		str := fmt.Sprintf("%d new addresses would exceed the maximum "+
+			"allowed number of addresses per account of %d",
+			numAddresses, MaxAddressesPerAccount)
+		return nil, managerError(ErrTooManyAddresses, str, nil)
+	}
+
+	addrs := make([]*multiSigAddress, 0, numAddresses)
+	for uint32(len(addrs)) < numAddresses {
+		addr, err := s.deriveMultiSigAddress(
+			acctInfo, account, branchNum, nextIndex,
+		)
+		nextIndex++
+
+		// There is an extremely small chance that a child of one of
+		// the cosigner keys is invalid, in which case the index is
+		// skipped.
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	if err := s.putMultiSigAddresses(ns, account, addrs); err != nil {
+		return nil, err
+	}
+
+	// Update the next address tracking and add the addresses to the cache
+	// once the newly generated addresses have been successfully committed
+	// to the db.
+	ns.Tx().OnCommit(func() {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+
+		s.cacheMultiSigAddresses(acctInfo, addrs, nextIndex, internal)
+	})
+
+	managedAddresses := make([]ManagedAddress, 0, len(addrs))
+	for _, addr := range addrs {
+		managedAddresses = append(managedAddresses, addr)
+	}
+	return managedAddresses, nil
+}
+
+// extendMultiSigAddresses ensures that all multisig addresses of a multisig
+// account up to and including the lastIndex are derived for either an internal
+// or external branch.  If the address at lastIndex is invalid, this method will
+// proceed until the next valid address is found.
+//
+// This function MUST be called with the manager lock held for writes.
+func (s *ScopedKeyManager) extendMultiSigAddresses(ns walletdb.ReadWriteBucket,
+	account uint32, acctInfo *accountInfo, lastIndex uint32,
+	internal bool) error {
+
+	// Choose the branch and index depending on whether or not this is an
+	// internal address.
+	branchNum, nextIndex := ExternalBranch, acctInfo.nextExternalIndex
+	if internal {
+		branchNum = InternalBranch
+		nextIndex = acctInfo.nextInternalIndex
+	}
+
+	// If the last index requested is already lower than the next index, we
+	// can return early.
+	if lastIndex < nextIndex {
+		return nil
+	}
+
+	// Ensure the requested number of addresses doesn't exceed the maximum
+	// allowed for this account.
+	if lastIndex > MaxAddressesPerAccount {
+		str := fmt.Sprintf("last index %d would exceed the maximum "+
+			"allowed number of addresses per account of %d",
+			lastIndex, MaxAddressesPerAccount)
+		return managerError(ErrTooManyAddresses, str, nil)
+	}
+
+	addrs := make([]*multiSigAddress, 0, lastIndex-nextIndex+1)
+	for {
+		addr, err := s.deriveMultiSigAddress(
+			acctInfo, account, branchNum, nextIndex,
+		)
+		nextIndex++
+
+		// Skip invalid children, and derive past the last index until a
+		// valid one is found.
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+
+		if nextIndex > lastIndex {
+			break
+		}
+	}
+
+	if err := s.putMultiSigAddresses(ns, account, addrs); err != nil {
+		return err
+	}
+
+	// Update the next address tracking and add the addresses to the cache
+	// once the newly derived addresses have been successfully committed to
+	// the db.
+	ns.Tx().OnCommit(func() {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+
+		s.cacheMultiSigAddresses(acctInfo, addrs, nextIndex, internal)
+	})
+
+	return nil
+}
+
+// putMultiSigAddresses stores the given multisig addresses of a multisig
+// account as chained addresses in the database.
+func (s *ScopedKeyManager) putMultiSigAddresses(ns walletdb.ReadWriteBucket,
+	account uint32, addrs []*multiSigAddress) error {
+
+	for _, addr := range addrs {
+		err := putChainedAddress(
+			ns, &s.scope, addr.AddrHash(), account, ssFull,
+			addr.derivationPath.Branch, addr.derivationPath.Index,
+			adtChain,
+		)
+		if err != nil {
+			return maybeConvertDbError(err)
+		}
+	}
+	return nil
+}
+
+// cacheMultiSigAddresses adds the given multisig addresses to the address
+// cache and updates the next address tracking of their multisig account.
+//
+// This function MUST be called with the manager lock held for writes.
+func (s *ScopedKeyManager) cacheMultiSigAddresses(acctInfo *accountInfo,
+	addrs []*multiSigAddress, nextIndex uint32, internal bool) {
+
+	if len(addrs) == 0 {
+		return
+	}
+
+	for _, addr := range addrs {
+		s.addrs[addrKey(addr.AddrHash())] = addr
+	}
+
+	// Set the last address and next address for tracking.
+	ma := addrs[len(addrs)-1]
+	if internal {
+		acctInfo.nextInternalIndex = nextIndex
+		acctInfo.lastInternalAddr = ma
+	} else {
+		acctInfo.nextExternalIndex = nextIndex
+		acctInfo.lastExternalAddr = ma
+	}
+}
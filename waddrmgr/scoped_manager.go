@@ -102,10 +102,24 @@ type ScopedIndex struct {
 	// Scope is the BIP44 account' used to derive the child key.
 	Scope KeyScope
 
+	// Account is the internal account number of the account the child key
+	// belongs to.
+	Account uint32
+
 	// Index is the BIP44 address_index used to derive the child key.
 	Index uint32
 }
 
+// ScopedAccount is a tuple of KeyScope and internal account number. This is
+// used to identify an account across the scoped managers of the root manager.
+type ScopedAccount struct {
+	// Scope is the key scope of the account.
+	Scope KeyScope
+
+	// Account is the internal account number of the account.
+	Account uint32
+}
+
 // String returns a human readable version describing the keypath encapsulated
 // by the target key scope.
 func (k KeyScope) String() string {
@@ -238,8 +252,16 @@ func (s *ScopedKeyManager) AddrSchema() ScopeAddrSchema {
 func (s *ScopedKeyManager) zeroSensitivePublicData() {
 	// Clear all of the account private keys.
 	for _, acctInfo := range s.acctInfo {
-		acctInfo.acctKeyPub.Zero()
-		acctInfo.acctKeyPub = nil
+		// Multisig accounts have no account public key, but the
+		// public keys of their cosigners.
+		if acctInfo.acctKeyPub != nil {
+			acctInfo.acctKeyPub.Zero()
+			acctInfo.acctKeyPub = nil
+		}
+		for _, cosignerKey := range acctInfo.cosignerKeys {
+			cosignerKey.Zero()
+		}
+		acctInfo.cosignerKeys = nil
 	}
 }
 
@@ -431,6 +453,16 @@ func (s *ScopedKeyManager) loadAccountInfo(ns walletdb.ReadBucket,
 
 		hasPrivateKey = false
 
+	case *dbMultiSigAccountRow:
+		// The addresses of multisig accounts are derived from the
+		// keys of all of their cosigners instead of an account key.
+		acctInfo, err = s.loadMultiSigAccountInfo(ns, account, row)
+		if err != nil {
+			return nil, err
+		}
+		s.acctInfo[account] = acctInfo
+		return acctInfo, nil
+
 	default:
 		str := fmt.Sprintf("unsupported account type %T", row)
 		return nil, managerError(ErrDatabase, str, nil)
@@ -521,7 +553,20 @@ func (s *ScopedKeyManager) AccountProperties(ns walletdb.ReadBucket,
 		props.MasterKeyFingerprint = acctInfo.masterKeyFingerprint
 		props.IsWatchOnly = s.rootManager.WatchOnly() ||
 			acctInfo.acctKeyPriv == nil
+		props.IsImported = acctInfo.acctType == accountWatchOnly
 		props.AddrSchema = acctInfo.addrSchema
+		props.RequiredSigs = acctInfo.nRequired
+
+		// Return copies of the cosigner keys, as the cached keys are
+		// zeroed when the manager is closed.
+		for _, key := range acctInfo.cosignerKeys {
+			keyCopy, err := hdkeychain.NewKeyFromString(key.String())
+			if err != nil {
+				return nil, fmt.Errorf("failed to copy cosigner "+
+					"key: %v", err)
+			}
+			props.CosignerKeys = append(props.CosignerKeys, keyCopy)
+		}
 
 		// Export the account public key with the correct version
 		// corresponding to the manager's key scope for non-watch-only
@@ -577,6 +622,20 @@ func (s *ScopedKeyManager) DeriveFromKeyPath(ns walletdb.ReadBucket,
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
+	acctInfo, err := s.loadAccountInfo(ns, kp.InternalAccount)
+	if err != nil {
+		return nil, err
+	}
+	if acctInfo.acctType == accountMultiSig {
+		addr, err := s.deriveMultiSigAddress(
+			acctInfo, kp.InternalAccount, kp.Branch, kp.Index,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return addr, nil
+	}
+
 	watchOnly := s.rootManager.WatchOnly()
 	private := !s.rootManager.IsLocked() && !watchOnly
 
@@ -587,10 +646,6 @@ func (s *ScopedKeyManager) DeriveFromKeyPath(ns walletdb.ReadBucket,
 		return nil, err
 	}
 
-	acctInfo, err := s.loadAccountInfo(ns, kp.InternalAccount)
-	if err != nil {
-		return nil, err
-	}
 	return s.keyToManaged(addrKey, kp, acctInfo)
 }
 
@@ -630,6 +685,20 @@ func (s *ScopedKeyManager) deriveKeyFromPath(ns walletdb.ReadBucket,
 func (s *ScopedKeyManager) chainAddressRowToManaged(ns walletdb.ReadBucket,
 	row *dbChainAddressRow) (ManagedAddress, error) {
 
+	acctInfo, err := s.loadAccountInfo(ns, row.account)
+	if err != nil {
+		return nil, err
+	}
+	if acctInfo.acctType == accountMultiSig {
+		addr, err := s.deriveMultiSigAddress(
+			acctInfo, row.account, row.branch, row.index,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return addr, nil
+	}
+
 	// Since the manger's mutex is assumed to held when invoking this
 	// function, we use the internal isLocked to avoid a deadlock.
 	private := !s.rootManager.isLocked() && !s.rootManager.watchOnly()
@@ -641,10 +710,6 @@ func (s *ScopedKeyManager) chainAddressRowToManaged(ns walletdb.ReadBucket,
 		return nil, err
 	}
 
-	acctInfo, err := s.loadAccountInfo(ns, row.account)
-	if err != nil {
-		return nil, err
-	}
 	return s.keyToManaged(
 		addressKey, DerivationPath{
 			InternalAccount:      row.account,
@@ -847,6 +912,11 @@ func (s *ScopedKeyManager) nextAddresses(ns walletdb.ReadWriteBucket,
 	if err != nil {
 		return nil, err
 	}
+	if acctInfo.acctType == accountMultiSig {
+		return s.nextMultiSigAddresses(
+			ns, account, acctInfo, numAddresses, internal,
+		)
+	}
 
 	// Choose the account key to used based on whether the address manager
 	// is locked.
@@ -1044,6 +1114,11 @@ func (s *ScopedKeyManager) extendAddresses(ns walletdb.ReadWriteBucket,
 	if err != nil {
 		return err
 	}
+	if acctInfo.acctType == accountMultiSig {
+		return s.extendMultiSigAddresses(
+			ns, account, acctInfo, lastIndex, internal,
+		)
+	}
 
 	// Choose the account key to used based on whether the address manager
 	// is locked.
@@ -1681,6 +1756,21 @@ func (s *ScopedKeyManager) RenameAccount(ns walletdb.ReadWriteBucket,
 			return err
 		}
 
+	case *dbMultiSigAccountRow:
+		// Remove the old name key from the account name index.
+		if err = deleteAccountNameIndex(ns, &s.scope, row.name); err != nil {
+			return err
+		}
+
+		err = putMultiSigAccountInfo(
+			ns, &s.scope, account, row.nRequired,
+			row.pubKeysEncrypted, row.nextExternalIndex,
+			row.nextInternalIndex, name,
+		)
+		if err != nil {
+			return err
+		}
+
 	default:
 		str := fmt.Sprintf("unsupported account type %T", row)
 		return managerError(ErrDatabase, str, nil)
@@ -1785,6 +1875,30 @@ func (s *ScopedKeyManager) ImportPublicKey(ns walletdb.ReadWriteBucket,
 	return s.toImportedPublicManagedAddress(pubKey, true)
 }
 
+// ImportUncompressedPublicKey imports a public key into the address manager
+// with the address of its uncompressed serialization, as used by the keys of
+// uncompressed WIFs imported with ImportPrivateKey.
+//
+// All imported addresses will be part of the account defined by the
+// ImportedAddrAccount constant.
+func (s *ScopedKeyManager) ImportUncompressedPublicKey(
+	ns walletdb.ReadWriteBucket, pubKey *czzec.PublicKey,
+	bs *BlockStamp) (ManagedAddress, error) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	serializedPubKey := pubKey.SerializeUncompressed()
+	err := s.importPublicKey(
+		ns, serializedPubKey, nil, s.addrSchema.ExternalAddrType, bs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toImportedPublicManagedAddress(pubKey, false)
+}
+
 // importPublicKey imports a public key into the address manager and updates the
 // wallet's start block if necessary. An error is returned if the public key
 // already exists.
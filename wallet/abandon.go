@@ -0,0 +1,49 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// AbandonTransaction removes an unmined transaction, and all unmined
+// transactions spending its outputs, from the wallet.  The outputs spent by
+// the removed transactions become spendable again, and the transactions are no
+// longer rebroadcast.  The removed transactions are still listed by
+// ListTransactions and ListAllTransactions, marked as abandoned, unless they
+// are seen again on the network.  The hashes of the abandoned transactions
+// are returned, starting with txHash.
+//
+// A transaction should only be abandoned if it is not in the mempool of the
+// backend, as it may otherwise be mined regardless.
+func (w *Wallet) AbandonTransaction(txHash *chainhash.Hash) ([]chainhash.Hash, error) {
+	var abandoned []chainhash.Hash
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		details, err := w.TxStore.TxDetails(txmgrNs, txHash)
+		if err != nil {
+			return err
+		}
+		if details == nil {
+			return ErrTxNotFound
+		}
+		if details.Block.Height != -1 {
+			return ErrTxMined
+		}
+
+		abandoned, err = w.TxStore.AbandonTx(
+			txmgrNs, &details.TxRecord, time.Now(),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return abandoned, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// TestAbandonTransaction checks that abandoning an unmined transaction makes
+// the outputs it spends spendable again, and that it is listed as abandoned.
+func TestAbandonTransaction(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+
+	insertTx := func(msgTx *wire.MsgTx, block *wtxmgr.BlockMeta) *wtxmgr.TxRecord {
+		t.Helper()
+		var b bytes.Buffer
+		if err := msgTx.Serialize(&b); err != nil {
+			t.Fatalf("unable to serialize tx: %v", err)
+		}
+		rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+		if err != nil {
+			t.Fatalf("unable to create tx record: %v", err)
+		}
+		err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+			err := w.TxStore.InsertTx(ns, rec, block)
+			if err != nil {
+				return err
+			}
+			return w.TxStore.AddCredit(ns, rec, block, 0, false)
+		})
+		if err != nil {
+			t.Fatalf("failed inserting tx: %v", err)
+		}
+		return rec
+	}
+
+	// A mined payment to the wallet, spent by an unmined transaction
+	// paying back to the wallet.
+	blockHash, _ := chainhash.NewHashFromStr(
+		"00000000000000017188b968a371bab95aa43522665353b646e41865abae02a4")
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *blockHash, Height: 276425},
+		Time:  time.Unix(1387737310, 0),
+	}
+	mined := insertTx(&wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(200000, pkScript)},
+	}, block)
+	spend := insertTx(&wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			wire.NewTxIn(&wire.OutPoint{Hash: mined.Hash}, nil),
+		},
+		TxOut: []*wire.TxOut{wire.NewTxOut(150000, pkScript)},
+	}, nil)
+
+	abandoned, err := w.AbandonTransaction(&spend.Hash)
+	if err != nil {
+		t.Fatalf("unable to abandon transaction: %v", err)
+	}
+	if len(abandoned) != 1 || abandoned[0] != spend.Hash {
+		t.Fatalf("unexpected abandoned transactions %v", abandoned)
+	}
+
+	// The mined output is the only unspent output again.
+	unspent, err := w.ListUnspent(0, 9999999, "")
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	if len(unspent) != 1 || unspent[0].TxID != mined.Hash.String() {
+		t.Fatalf("expected the mined output to be unspent, found %v",
+			unspent)
+	}
+
+	// The abandoned transaction is still listed, and marked abandoned.
+	txList, err := w.ListAllTransactions()
+	if err != nil {
+		t.Fatalf("unable to list transactions: %v", err)
+	}
+	var found bool
+	for _, result := range txList {
+		switch result.TxID {
+		case spend.Hash.String():
+			if !result.Abandoned {
+				t.Fatalf("abandoned transaction not marked " +
+					"abandoned")
+			}
+			found = true
+		case mined.Hash.String():
+			if result.Abandoned {
+				t.Fatalf("mined transaction marked abandoned")
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("abandoned transaction not listed")
+	}
+
+	// Mined and unknown transactions can not be abandoned.
+	_, err = w.AbandonTransaction(&mined.Hash)
+	if err != ErrTxMined {
+		t.Fatalf("expected mined transaction error, found %v", err)
+	}
+	_, err = w.AbandonTransaction(&spend.Hash)
+	if err != ErrTxNotFound {
+		t.Fatalf("expected transaction not found error, found %v", err)
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// GroupedAddress is a wallet address that is part of an AddressGroup.
+type GroupedAddress struct {
+	Address czzutil.Address
+
+	// Balance is the value of all unspent outputs paying to the address,
+	// including outputs of unmined transactions.
+	Balance czzutil.Amount
+
+	// Scope, Account and AccountName describe the account the address
+	// belongs to.
+	Scope       waddrmgr.KeyScope
+	Account     uint32
+	AccountName string
+}
+
+// AddressGroup is a set of wallet addresses that can be linked to each other
+// by the public using the common-input-ownership heuristic: addresses spent
+// together as inputs of a transaction, and the change addresses of such
+// transactions, are assumed to be controlled by the same wallet.
+type AddressGroup struct {
+	// Addresses are the addresses of the group, ordered by their string
+	// encoding.
+	Addresses []GroupedAddress
+
+	// Balance is the total balance of all addresses of the group.
+	Balance czzutil.Amount
+}
+
+// CrossesAccounts returns whether the group contains addresses of more than a
+// single account, which means that the accounts can be linked to each other.
+func (g *AddressGroup) CrossesAccounts() bool {
+	for _, a := range g.Addresses[1:] {
+		if a.Scope != g.Addresses[0].Scope ||
+			a.Account != g.Addresses[0].Account {
+
+			return true
+		}
+	}
+	return false
+}
+
+// AddressGroupings clusters all wallet addresses that were ever paid to by
+// their common ownership as seen by the public.  The inputs of every
+// transaction that spends wallet outputs are grouped together along with the
+// transaction's change outputs, and groups sharing an address are merged.
+// Addresses that were never spent together with another one form groups of
+// their own.  Groups are ordered by their first address.
+func (w *Wallet) AddressGroupings() ([]AddressGroup, error) {
+	var groups []AddressGroup
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		var err error
+		groups, err = w.addressGroupings(tx)
+		return err
+	})
+	return groups, err
+}
+
+// AccountAddressGroupings returns the address groups, as returned by
+// AddressGroupings, that contain at least one address of an account.  The
+// groups include the addresses of all other accounts linked to the account.
+func (w *Wallet) AccountAddressGroupings(scope waddrmgr.KeyScope,
+	account uint32) ([]AddressGroup, error) {
+
+	var groups []AddressGroup
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		// Make sure the account exists so that unknown accounts are
+		// reported as an error instead of an empty result.
+		manager, err := w.Manager.FetchScopedKeyManager(scope)
+		if err != nil {
+			return err
+		}
+		_, err = manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+
+		allGroups, err := w.addressGroupings(tx)
+		if err != nil {
+			return err
+		}
+		for _, group := range allGroups {
+			for _, a := range group.Addresses {
+				if a.Scope == scope && a.Account == account {
+					groups = append(groups, group)
+					break
+				}
+			}
+		}
+		return nil
+	})
+	return groups, err
+}
+
+// addressGroupings implements AddressGroupings within an open database
+// transaction.
+func (w *Wallet) addressGroupings(tx walletdb.ReadTx) ([]AddressGroup, error) {
+	addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+	// The wallet addresses found so far, keyed by their string encoding.
+	// Groups are tracked as a disjoint-set forest over the addresses:
+	// parent links every address towards the root representing its
+	// group, and size is the number of addresses in the group of a root.
+	addrs := make(map[string]*GroupedAddress)
+	parent := make(map[string]string)
+	size := make(map[string]int)
+
+	lookup := func(pkScript []byte) string {
+		_, scriptAddrs, _, err := txscript.ExtractPkScriptAddrs(
+			pkScript, w.chainParams,
+		)
+		if err != nil || len(scriptAddrs) != 1 {
+			return ""
+		}
+		encoded := scriptAddrs[0].EncodeAddress()
+		if _, ok := addrs[encoded]; ok {
+			return encoded
+		}
+
+		scopedMgr, account, err := w.Manager.AddrAccount(
+			addrmgrNs, scriptAddrs[0],
+		)
+		if err != nil {
+			return ""
+		}
+		accountName, err := scopedMgr.AccountName(addrmgrNs, account)
+		if err != nil {
+			return ""
+		}
+		addrs[encoded] = &GroupedAddress{
+			Address:     scriptAddrs[0],
+			Scope:       scopedMgr.Scope(),
+			Account:     account,
+			AccountName: accountName,
+		}
+		return encoded
+	}
+
+	// find returns the root of the group of an address, adding the
+	// address as a group of its own when it is not part of one yet.
+	find := func(addr string) string {
+		if _, ok := parent[addr]; !ok {
+			parent[addr] = addr
+			size[addr] = 1
+			return addr
+		}
+		root := addr
+		for parent[root] != root {
+			root = parent[root]
+		}
+		for addr != root {
+			next := parent[addr]
+			parent[addr] = root
+			addr = next
+		}
+		return root
+	}
+
+	// group merges the groups of all addresses into a single group.
+	group := func(members []string) {
+		root := find(members[0])
+		for _, addr := range members[1:] {
+			other := find(addr)
+			if other == root {
+				continue
+			}
+			if size[other] > size[root] {
+				root, other = other, root
+			}
+			parent[other] = root
+			size[root] += size[other]
+		}
+	}
+
+	rangeFn := func(details []wtxmgr.TxDetails) (bool, error) {
+		for i := range details {
+			detail := &details[i]
+
+			var members []string
+			if len(detail.Debits) != 0 {
+				var block *wtxmgr.Block
+				if detail.Block.Height != -1 {
+					block = &detail.Block.Block
+				}
+				prevScripts, err := w.TxStore.PreviousPkScripts(
+					txmgrNs, &detail.TxRecord, block,
+				)
+				if err != nil {
+					return false, err
+				}
+				for _, pkScript := range prevScripts {
+					if addr := lookup(pkScript); addr != "" {
+						members = append(members, addr)
+					}
+				}
+			}
+
+			for _, cred := range detail.Credits {
+				pkScript := detail.MsgTx.TxOut[cred.Index].PkScript
+				addr := lookup(pkScript)
+				if addr == "" {
+					continue
+				}
+
+				// Change is only linked to the inputs if the
+				// wallet funded the transaction.
+				if cred.Change && len(members) != 0 {
+					members = append(members, addr)
+				} else {
+					group([]string{addr})
+				}
+			}
+
+			if len(members) != 0 {
+				group(members)
+			}
+		}
+		return false, nil
+	}
+	err := w.TxStore.RangeTransactions(txmgrNs, 0, -1, rangeFn)
+	if err != nil {
+		return nil, err
+	}
+
+	unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range unspent {
+		addr := lookup(unspent[i].PkScript)
+		if addr == "" {
+			continue
+		}
+		addrs[addr].Balance += unspent[i].Amount
+	}
+
+	// Collect the distinct groups.
+	groupOf := make(map[string]*AddressGroup)
+	for addr := range parent {
+		root := find(addr)
+		ag, ok := groupOf[root]
+		if !ok {
+			ag = &AddressGroup{}
+			groupOf[root] = ag
+		}
+		a := addrs[addr]
+		ag.Addresses = append(ag.Addresses, *a)
+		ag.Balance += a.Balance
+	}
+	groups := make([]AddressGroup, 0, len(groupOf))
+	for _, ag := range groupOf {
+		sort.Slice(ag.Addresses, func(i, j int) bool {
+			return ag.Addresses[i].Address.EncodeAddress() <
+				ag.Addresses[j].Address.EncodeAddress()
+		})
+		groups = append(groups, *ag)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Addresses[0].Address.EncodeAddress() <
+			groups[j].Addresses[0].Address.EncodeAddress()
+	})
+
+	return groups, nil
+}
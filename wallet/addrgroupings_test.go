@@ -0,0 +1,196 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// TestAddressGroupings checks that addresses spent together, and the change
+// of the spending transaction, are grouped, and that the group is reported
+// for all accounts it links.
+func TestAddressGroupings(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	account, err := w.NextAccount(scope, "other")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+
+	newAddr := func(account uint32, change bool) czzutil.Address {
+		t.Helper()
+		var (
+			addr czzutil.Address
+			err  error
+		)
+		if change {
+			addr, err = w.NewChangeAddress(account, scope)
+		} else {
+			addr, err = w.NewAddress(account, scope)
+		}
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		return addr
+	}
+	payTo := func(addr czzutil.Address) []byte {
+		t.Helper()
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatalf("unable to create output script: %v", err)
+		}
+		return pkScript
+	}
+	insertTx := func(msgTx *wire.MsgTx, block *wtxmgr.BlockMeta,
+		credits map[uint32]bool) *wtxmgr.TxRecord {
+
+		t.Helper()
+		var b bytes.Buffer
+		if err := msgTx.Serialize(&b); err != nil {
+			t.Fatalf("unable to serialize tx: %v", err)
+		}
+		rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+		if err != nil {
+			t.Fatalf("unable to create tx record: %v", err)
+		}
+		err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+			err := w.TxStore.InsertTx(ns, rec, block)
+			if err != nil {
+				return err
+			}
+			for index, change := range credits {
+				err := w.TxStore.AddCredit(
+					ns, rec, block, index, change,
+				)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("failed inserting tx: %v", err)
+		}
+		return rec
+	}
+
+	addrA := newAddr(0, false)
+	addrB := newAddr(account, false)
+	addrC := newAddr(0, true)
+	addrD := newAddr(0, false)
+
+	blockHash, _ := chainhash.NewHashFromStr(
+		"00000000000000017188b968a371bab95aa43522665353b646e41865abae02a4")
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *blockHash, Height: 276425},
+		Time:  time.Unix(1387737310, 0),
+	}
+
+	// Fund addresses A and B of different accounts, and D on its own.
+	fundTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{
+			wire.NewTxOut(100000, payTo(addrA)),
+			wire.NewTxOut(200000, payTo(addrB)),
+			wire.NewTxOut(300000, payTo(addrD)),
+		},
+	}
+	fundRec := insertTx(fundTx, block, map[uint32]bool{
+		0: false, 1: false, 2: false,
+	})
+
+	// Spend A and B together, paying change to C.
+	spendTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: fundRec.Hash, Index: 0}},
+			{PreviousOutPoint: wire.OutPoint{Hash: fundRec.Hash, Index: 1}},
+		},
+		TxOut: []*wire.TxOut{
+			wire.NewTxOut(150000, []byte{txscript.OP_TRUE}),
+			wire.NewTxOut(140000, payTo(addrC)),
+		},
+	}
+	insertTx(spendTx, nil, map[uint32]bool{1: true})
+
+	groups, err := w.AddressGroupings()
+	if err != nil {
+		t.Fatalf("unable to get address groupings: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, found %d", len(groups))
+	}
+
+	var linked, lone *AddressGroup
+	for i := range groups {
+		switch len(groups[i].Addresses) {
+		case 3:
+			linked = &groups[i]
+		case 1:
+			lone = &groups[i]
+		}
+	}
+	if linked == nil || lone == nil {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+	for _, addr := range []czzutil.Address{addrA, addrB, addrC} {
+		found := false
+		for _, a := range linked.Addresses {
+			if a.Address.EncodeAddress() == addr.EncodeAddress() {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("address %v missing from linked group", addr)
+		}
+	}
+	if linked.Balance != 140000 {
+		t.Fatalf("expected linked group balance 140000, found %v",
+			linked.Balance)
+	}
+	if !linked.CrossesAccounts() {
+		t.Fatalf("linked group does not cross accounts")
+	}
+	if lone.Addresses[0].Address.EncodeAddress() != addrD.EncodeAddress() ||
+		lone.Balance != 300000 || lone.CrossesAccounts() {
+
+		t.Fatalf("unexpected lone group %v", *lone)
+	}
+
+	// The linked group is reported for the other account as well, but
+	// the lone group only for the default account.
+	accountGroups, err := w.AccountAddressGroupings(scope, account)
+	if err != nil {
+		t.Fatalf("unable to get account address groupings: %v", err)
+	}
+	if len(accountGroups) != 1 || len(accountGroups[0].Addresses) != 3 {
+		t.Fatalf("unexpected account groups %v", accountGroups)
+	}
+	accountGroups, err = w.AccountAddressGroupings(scope, 0)
+	if err != nil {
+		t.Fatalf("unable to get account address groupings: %v", err)
+	}
+	if len(accountGroups) != 2 {
+		t.Fatalf("expected 2 default account groups, found %d",
+			len(accountGroups))
+	}
+
+	_, err = w.AccountAddressGroupings(scope, 42)
+	if !waddrmgr.IsError(err, waddrmgr.ErrAccountNotFound) {
+		t.Fatalf("expected account not found error, found %v", err)
+	}
+}
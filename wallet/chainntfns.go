@@ -23,6 +23,11 @@ const (
 	// birthday timestamp and our birthday block's timestamp when searching
 	// for a better birthday block candidate (if possible).
 	birthdayBlockDelta = 2 * time.Hour
+
+	// conflictedTxDepth is the number of confirmations after which the
+	// records of transactions conflicted by a mined transaction are
+	// deleted.
+	conflictedTxDepth = 1008
 )
 
 func (w *Wallet) handleChainNotifications() {
@@ -212,6 +217,16 @@ func (w *Wallet) connectBlock(dbtx walletdb.ReadWriteTx, b wtxmgr.BlockMeta) err
 		return err
 	}
 
+	// Conflicts this deep are not expected to be reorged out, so their
+	// records are no longer kept.
+	err = w.TxStore.DeleteConflictedTxs(
+		dbtx.ReadWriteBucket(wtxmgrNamespaceKey),
+		b.Height-conflictedTxDepth,
+	)
+	if err != nil {
+		return err
+	}
+
 	// Notify interested clients of the connected block.
 	//
 	// TODO: move all notifications outside of the database transaction.
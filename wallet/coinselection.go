@@ -0,0 +1,507 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txauthor"
+	"github.com/classzz/czzwallet/wallet/txrules"
+	"github.com/classzz/czzwallet/wallet/txsizes"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// Names of the coin selection strategies, as accepted by CoinSelectorByName.
+const (
+	CoinSelectionLargestFirst   = "largest"
+	CoinSelectionSmallestFirst  = "smallest"
+	CoinSelectionBranchAndBound = "bnb"
+	CoinSelectionRandomImprove  = "random"
+)
+
+// CoinSelectionParams describes the value that the inputs selected for a
+// transaction must provide.
+type CoinSelectionParams struct {
+	// Target is the value the selected outputs must provide after paying
+	// for their own inputs.  It includes the transaction outputs and the
+	// fee of the transaction without any inputs or change output.
+	Target czzutil.Amount
+
+	// FeeRate is the fee rate, per kilobyte, at which the selected outputs
+	// pay for their own inputs.  The value an output contributes to the
+	// target, its effective value, is its amount minus the fee of its
+	// input as returned by InputFee.
+	FeeRate czzutil.Amount
+
+	// RedeemScripts looks up the redeem scripts of P2SH outputs, so that
+	// the inputs spending them are sized by their redeem script.  If it is
+	// nil or returns nil, these inputs are sized as P2PKH inputs.
+	RedeemScripts func(pkScript []byte) []byte
+
+	// ChangeCost is the excess over the target up to which no change
+	// output is created and the excess is paid as fee instead.  Selections
+	// within this window avoid the cost of creating, and later spending, a
+	// change output.
+	ChangeCost czzutil.Amount
+}
+
+// InputSize returns the estimated size of the input spending an output with
+// pkScript.
+func (p CoinSelectionParams) InputSize(pkScript []byte) int {
+	var redeemScript []byte
+	if p.RedeemScripts != nil && txscript.IsPayToScriptHash(pkScript) {
+		redeemScript = p.RedeemScripts(pkScript)
+	}
+	return txsizes.EstimateInputSize(pkScript, redeemScript)
+}
+
+// InputFee returns the fee of the input spending an output with pkScript.
+func (p CoinSelectionParams) InputFee(pkScript []byte) czzutil.Amount {
+	return feeForSize(p.FeeRate, p.InputSize(pkScript))
+}
+
+// CoinSelector selects the outputs a transaction spends.
+type CoinSelector interface {
+	// SelectCoins returns the outputs of eligible that a transaction
+	// described by params spends.  The total effective value of the
+	// selected outputs must reach params.Target.  If the eligible outputs
+	// can not reach the target, an InsufficientFundsError is returned.
+	SelectCoins(eligible []wtxmgr.Credit,
+		params CoinSelectionParams) ([]wtxmgr.Credit, error)
+}
+
+// InsufficientFundsError is returned by a CoinSelector when the eligible
+// outputs can not reach the selection target.  It implements
+// txauthor.InputSourceError.
+type InsufficientFundsError struct {
+	Available czzutil.Amount
+	Target    czzutil.Amount
+}
+
+// InputSourceError marks the error as a txauthor.InputSourceError.
+func (InsufficientFundsError) InputSourceError() {}
+
+// Error implements the error interface.
+func (e InsufficientFundsError) Error() string {
+	return fmt.Sprintf("insufficient funds available to construct "+
+		"transaction: %v available, %v needed", e.Available, e.Target)
+}
+
+// CoinSelectorByName returns the coin selector of a strategy by its name.
+func CoinSelectorByName(name string) (CoinSelector, error) {
+	switch name {
+	case CoinSelectionLargestFirst:
+		return LargestFirst{}, nil
+	case CoinSelectionSmallestFirst:
+		return SmallestFirst{}, nil
+	case CoinSelectionBranchAndBound:
+		return BranchAndBound{}, nil
+	case CoinSelectionRandomImprove:
+		return RandomImprove{}, nil
+	default:
+		return nil, fmt.Errorf("unknown coin selection strategy %q", name)
+	}
+}
+
+// effectiveCoins returns the outputs of eligible with a positive effective
+// value, along with these values and their total.  Spending the other outputs
+// costs more than they are worth.
+func effectiveCoins(eligible []wtxmgr.Credit, params CoinSelectionParams) (
+	[]wtxmgr.Credit, []czzutil.Amount, czzutil.Amount) {
+
+	coins := make([]wtxmgr.Credit, 0, len(eligible))
+	values := make([]czzutil.Amount, 0, len(eligible))
+	var total czzutil.Amount
+	for i := range eligible {
+		value := eligible[i].Amount - params.InputFee(eligible[i].PkScript)
+		if value <= 0 {
+			continue
+		}
+		coins = append(coins, eligible[i])
+		values = append(values, value)
+		total += value
+	}
+	return coins, values, total
+}
+
+// selectInOrder selects the outputs of eligible in the order given by less
+// until the target is reached.
+func selectInOrder(eligible []wtxmgr.Credit, params CoinSelectionParams,
+	less func(a, b *wtxmgr.Credit) bool) ([]wtxmgr.Credit, error) {
+
+	coins, values, total := effectiveCoins(eligible, params)
+	if total < params.Target {
+		return nil, InsufficientFundsError{total, params.Target}
+	}
+	order := make([]int, len(coins))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return less(&coins[order[i]], &coins[order[j]])
+	})
+
+	var (
+		selection []wtxmgr.Credit
+		selected  czzutil.Amount
+	)
+	for _, i := range order {
+		if selected >= params.Target {
+			break
+		}
+		selection = append(selection, coins[i])
+		selected += values[i]
+	}
+	return selection, nil
+}
+
+// LargestFirst is a CoinSelector that selects the largest outputs first.  It
+// spends few inputs, but leaves small outputs unspent.
+type LargestFirst struct{}
+
+// SelectCoins implements the CoinSelector interface.
+func (LargestFirst) SelectCoins(eligible []wtxmgr.Credit,
+	params CoinSelectionParams) ([]wtxmgr.Credit, error) {
+
+	return selectInOrder(eligible, params, func(a, b *wtxmgr.Credit) bool {
+		return a.Amount > b.Amount
+	})
+}
+
+// SmallestFirst is a CoinSelector that selects the smallest outputs first.  It
+// consolidates small outputs at the cost of larger transactions.
+type SmallestFirst struct{}
+
+// SelectCoins implements the CoinSelector interface.
+func (SmallestFirst) SelectCoins(eligible []wtxmgr.Credit,
+	params CoinSelectionParams) ([]wtxmgr.Credit, error) {
+
+	return selectInOrder(eligible, params, func(a, b *wtxmgr.Credit) bool {
+		return a.Amount < b.Amount
+	})
+}
+
+// DefaultBranchAndBoundTries is the default number of branches searched by
+// BranchAndBound.
+const DefaultBranchAndBoundTries = 100000
+
+// BranchAndBound is a CoinSelector that searches for a selection that does not
+// need a change output, that is, a selection exceeding the target by at most
+// the change cost.  Among the selections found, the one with the least excess
+// is used.  If there is no such selection, the Fallback selector is used.
+type BranchAndBound struct {
+	// Tries limits the number of branches searched.  If zero,
+	// DefaultBranchAndBoundTries is used.
+	Tries int
+
+	// Fallback selects the outputs if no changeless selection is found.
+	// If nil, LargestFirst is used.
+	Fallback CoinSelector
+}
+
+// SelectCoins implements the CoinSelector interface.
+func (s BranchAndBound) SelectCoins(eligible []wtxmgr.Credit,
+	params CoinSelectionParams) ([]wtxmgr.Credit, error) {
+
+	fallback := s.Fallback
+	if fallback == nil {
+		fallback = LargestFirst{}
+	}
+	tries := s.Tries
+	if tries == 0 {
+		tries = DefaultBranchAndBoundTries
+	}
+
+	coins, values, available := effectiveCoins(eligible, params)
+	if available < params.Target {
+		return nil, InsufficientFundsError{available, params.Target}
+	}
+
+	// Search larger outputs first so that the value still available
+	// drops below the target early and prunes the search.
+	order := make([]int, len(coins))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return values[order[i]] > values[order[j]]
+	})
+
+	// The search walks a binary tree in depth-first order, deciding at
+	// every depth whether the output at that depth is included.  The
+	// available value is the total of all outputs not decided yet.
+	var (
+		target    = params.Target
+		upper     = params.Target + params.ChangeCost
+		included  = make([]bool, len(coins))
+		best      []bool
+		bestWaste czzutil.Amount
+		selected  czzutil.Amount
+		depth     int
+	)
+	for try := 0; try < tries; try++ {
+		backtrack := false
+		switch {
+		case selected+available < target || selected > upper:
+			backtrack = true
+
+		case selected >= target:
+			waste := selected - target
+			if best == nil || waste < bestWaste {
+				best = append(best[:0], included...)
+				bestWaste = waste
+			}
+			backtrack = true
+		}
+		if best != nil && bestWaste == 0 {
+			break
+		}
+
+		if !backtrack {
+			// Include the output at this depth first.
+			value := values[order[depth]]
+			available -= value
+			selected += value
+			included[depth] = true
+			depth++
+			continue
+		}
+
+		// Walk back to the last included output, making the outputs
+		// omitted after it undecided again, and omit it instead.
+		for depth > 0 && !included[depth-1] {
+			depth--
+			available += values[order[depth]]
+		}
+		if depth == 0 {
+			break
+		}
+		depth--
+		included[depth] = false
+		selected -= values[order[depth]]
+		depth++
+	}
+
+	if best == nil {
+		return fallback.SelectCoins(eligible, params)
+	}
+	var selection []wtxmgr.Credit
+	for i, ok := range best {
+		if ok {
+			selection = append(selection, coins[order[i]])
+		}
+	}
+	return selection, nil
+}
+
+// coinSelectionRand is the default source of randomness of RandomImprove.
+var coinSelectionRand = struct {
+	*rand.Rand
+	sync.Mutex
+}{Rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+// RandomImprove is a CoinSelector implementing the random-improve algorithm.
+// Outputs are selected at random until the target is reached.  Then more
+// outputs are added at random as long as they bring the selected value closer
+// to twice the target, without exceeding three times the target.  The change
+// created this way tends to be of similar size as the payment, which keeps
+// the wallet's outputs useful for future payments of similar size.
+type RandomImprove struct {
+	// Rand is the source of randomness.  If nil, a source seeded when the
+	// package is initialized is used.
+	Rand *rand.Rand
+}
+
+// SelectCoins implements the CoinSelector interface.
+func (s RandomImprove) SelectCoins(eligible []wtxmgr.Credit,
+	params CoinSelectionParams) ([]wtxmgr.Credit, error) {
+
+	coins, values, available := effectiveCoins(eligible, params)
+	if available < params.Target {
+		return nil, InsufficientFundsError{available, params.Target}
+	}
+
+	order := make([]int, len(coins))
+	for i := range order {
+		order[i] = i
+	}
+	swap := func(i, j int) { order[i], order[j] = order[j], order[i] }
+	if s.Rand != nil {
+		s.Rand.Shuffle(len(order), swap)
+	} else {
+		coinSelectionRand.Lock()
+		coinSelectionRand.Shuffle(len(order), swap)
+		coinSelectionRand.Unlock()
+	}
+
+	// Select outputs until the target is reached.
+	var (
+		selection []wtxmgr.Credit
+		selected  czzutil.Amount
+		next      int
+	)
+	for ; next < len(order) && selected < params.Target; next++ {
+		selection = append(selection, coins[order[next]])
+		selected += values[order[next]]
+	}
+
+	// Improve the selection with the remaining outputs.
+	distance := func(a czzutil.Amount) czzutil.Amount {
+		if d := 2*params.Target - a; d > 0 {
+			return d
+		}
+		return a - 2*params.Target
+	}
+	for ; next < len(order); next++ {
+		improved := selected + values[order[next]]
+		if improved > 3*params.Target ||
+			distance(improved) >= distance(selected) {
+
+			continue
+		}
+		selection = append(selection, coins[order[next]])
+		selected = improved
+	}
+
+	return selection, nil
+}
+
+// feeForSize returns the fee of size bytes at feeRatePerKb, rounded up so that
+// the fees of the parts of a transaction are never less than the fee of the
+// whole.
+func feeForSize(feeRatePerKb czzutil.Amount, size int) czzutil.Amount {
+	return (feeRatePerKb*czzutil.Amount(size) + 999) / 1000
+}
+
+// coinSelectionParams returns the coin selection parameters of a transaction
+// paying to outputs at feeRatePerKb, with a change output script of
+// changeScriptSize bytes.  Inputs spending P2SH outputs are sized by the
+// redeem scripts returned by redeemScripts, which may be nil.
+func coinSelectionParams(outputs []*wire.TxOut, feeRatePerKb czzutil.Amount,
+	changeScriptSize int,
+	redeemScripts func(pkScript []byte) []byte) CoinSelectionParams {
+
+	baseSize := txsizes.EstimateVirtualSize(0, 0, 0, outputs, 0)
+	changeSize := 8 + wire.VarIntSerializeSize(uint64(changeScriptSize)) +
+		changeScriptSize
+
+	// Change below the dust limit is not created by
+	// txauthor.NewUnsignedTransaction, so it is part of the change cost.
+	dustLimit := txrules.GetDustThreshold(
+		changeScriptSize, txrules.DefaultRelayFeePerKb,
+	)
+
+	return CoinSelectionParams{
+		Target: txauthor.SumOutputValues(outputs) +
+			feeForSize(feeRatePerKb, baseSize),
+		FeeRate:       feeRatePerKb,
+		RedeemScripts: redeemScripts,
+		ChangeCost:    feeForSize(feeRatePerKb, changeSize) + dustLimit - 1,
+	}
+}
+
+// redeemScriptSource returns a lookup of the redeem scripts of P2SH outputs
+// paying to the wallet, as used by CoinSelectionParams.  Scripts that can not
+// be looked up, such as those of a locked or watching-only wallet, are
+// reported as unknown.  The lookup may only be used within the database
+// transaction addrmgrNs belongs to.
+func (w *Wallet) redeemScriptSource(
+	addrmgrNs walletdb.ReadBucket) func(pkScript []byte) []byte {
+
+	scripts := make(map[string][]byte)
+	return func(pkScript []byte) []byte {
+		if script, ok := scripts[string(pkScript)]; ok {
+			return script
+		}
+
+		var script []byte
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			pkScript, w.chainParams,
+		)
+		if err == nil && len(addrs) == 1 {
+			ma, _ := w.Manager.Address(addrmgrNs, addrs[0])
+			if msa, ok := ma.(waddrmgr.ManagedScriptAddress); ok {
+				script, _ = msa.Script()
+			}
+		}
+		scripts[string(pkScript)] = script
+		return script
+	}
+}
+
+// SetCoinSelector sets the coin selector used for transactions created
+// without a coin selector of their own.  The wallet uses LargestFirst unless
+// set otherwise.
+func (w *Wallet) SetCoinSelector(selector CoinSelector) {
+	w.coinSelectorMtx.Lock()
+	w.coinSelector = selector
+	w.coinSelectorMtx.Unlock()
+}
+
+// CoinSelector returns the coin selector used for transactions created
+// without a coin selector of their own.
+func (w *Wallet) CoinSelector() CoinSelector {
+	w.coinSelectorMtx.Lock()
+	defer w.coinSelectorMtx.Unlock()
+	return w.coinSelector
+}
+
+// SelectOutputs selects the outputs spent by a transaction from outputs, as
+// returned by UnspentOutputs, using selector.  A nil selector selects with the
+// wallet's coin selector.  If params has no redeem script lookup, the redeem
+// scripts of the wallet's P2SH addresses are used.
+func (w *Wallet) SelectOutputs(outputs []*TransactionOutput,
+	selector CoinSelector, params CoinSelectionParams) (
+	[]*TransactionOutput, error) {
+
+	if selector == nil {
+		selector = w.CoinSelector()
+	}
+
+	// Coin selectors work on credits, so the outputs are converted and
+	// found again by their outpoints after the selection.
+	eligible := make([]wtxmgr.Credit, 0, len(outputs))
+	byOutPoint := make(map[wire.OutPoint]*TransactionOutput, len(outputs))
+	for _, output := range outputs {
+		eligible = append(eligible, wtxmgr.Credit{
+			OutPoint:     output.OutPoint,
+			Amount:       czzutil.Amount(output.Output.Value),
+			PkScript:     output.Output.PkScript,
+			Received:     output.ReceiveTime,
+			FromCoinBase: output.OutputKind == OutputKindCoinbase,
+		})
+		byOutPoint[output.OutPoint] = output
+	}
+
+	var selection []wtxmgr.Credit
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		if params.RedeemScripts == nil {
+			params.RedeemScripts = w.redeemScriptSource(
+				tx.ReadBucket(waddrmgrNamespaceKey),
+			)
+		}
+
+		var err error
+		selection, err = selector.SelectCoins(eligible, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	selected := make([]*TransactionOutput, 0, len(selection))
+	for i := range selection {
+		selected = append(selected, byOutPoint[selection[i].OutPoint])
+	}
+	return selected, nil
+}
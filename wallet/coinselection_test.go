@@ -0,0 +1,335 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txsizes"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// testFeeRate is the fee rate, in satoshis per kilobyte, of the transactions
+// the coin selection tests select inputs for.
+const testFeeRate = czzutil.Amount(1000)
+
+// p2pkhScriptSize is the size of a P2PKH output script.
+const p2pkhScriptSize = 25
+
+// testPayment returns the outputs of a transaction paying amount to a P2PKH
+// script, along with the coin selection parameters of the transaction.
+func testPayment(amount int64) ([]*wire.TxOut, CoinSelectionParams) {
+	outputs := []*wire.TxOut{
+		wire.NewTxOut(amount, make([]byte, p2pkhScriptSize)),
+	}
+	return outputs, coinSelectionParams(
+		outputs, testFeeRate, p2pkhScriptSize, nil,
+	)
+}
+
+// testCredits returns credits of the given amounts, with distinct outpoints.
+// The credits have no output script, so their inputs are sized as inputs
+// redeeming P2PKH outputs.
+func testCredits(amounts ...czzutil.Amount) []wtxmgr.Credit {
+	credits := make([]wtxmgr.Credit, len(amounts))
+	for i, amount := range amounts {
+		credits[i] = wtxmgr.Credit{
+			OutPoint: wire.OutPoint{Index: uint32(i)},
+			Amount:   amount,
+		}
+	}
+	return credits
+}
+
+// checkSelection checks that the selected credits pay for the outputs and the
+// fee of the transaction spending them, as estimated by txsizes, and returns
+// their amounts.
+func checkSelection(t *testing.T, selection []wtxmgr.Credit,
+	outputs []*wire.TxOut) []czzutil.Amount {
+
+	t.Helper()
+
+	var (
+		amounts []czzutil.Amount
+		total   czzutil.Amount
+	)
+	for i := range selection {
+		amounts = append(amounts, selection[i].Amount)
+		total += selection[i].Amount
+	}
+
+	size := txsizes.EstimateVirtualSize(len(selection), 0, 0, outputs, 0)
+	needed := czzutil.Amount(outputs[0].Value) + feeForSize(testFeeRate, size)
+	if total < needed {
+		t.Fatalf("selected %v (%v) does not pay for %v", total, amounts,
+			needed)
+	}
+	return amounts
+}
+
+// checkAmounts checks that the selected amounts match the expected ones.
+func checkAmounts(t *testing.T, amounts, expected []czzutil.Amount) {
+	t.Helper()
+
+	if len(amounts) != len(expected) {
+		t.Fatalf("expected selection %v, found %v", expected, amounts)
+	}
+	for i := range amounts {
+		if amounts[i] != expected[i] {
+			t.Fatalf("expected selection %v, found %v", expected,
+				amounts)
+		}
+	}
+}
+
+// TestCoinSelectionParams checks that the selection target matches the fee of
+// the transaction estimated by txsizes.
+func TestCoinSelectionParams(t *testing.T) {
+	outputs, params := testPayment(100000)
+
+	size := txsizes.EstimateVirtualSize(1, 0, 0, outputs, 0)
+	fee := feeForSize(testFeeRate, size)
+	inputFee := params.InputFee(nil)
+	if params.Target+inputFee < 100000+fee {
+		t.Fatalf("target %v and input fee %v do not pay fee %v",
+			params.Target, inputFee, fee)
+	}
+	if inputFee != feeForSize(testFeeRate, txsizes.RedeemP2PKHInputSize) {
+		t.Fatalf("unexpected input fee %v", inputFee)
+	}
+	if params.ChangeCost <= 0 {
+		t.Fatalf("unexpected change cost %v", params.ChangeCost)
+	}
+}
+
+// TestCoinSelectionInputSize checks that inputs spending P2SH outputs of the
+// wallet are sized by their redeem script.
+func TestCoinSelectionInputSize(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	var addrs []czzutil.Address
+	for i := 0; i < 3; i++ {
+		addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		addrs = append(addrs, addr)
+	}
+	redeemScript, err := w.MakeMultiSigScript(addrs, 2)
+	if err != nil {
+		t.Fatalf("unable to create multisig script: %v", err)
+	}
+	scriptAddr, err := w.ImportP2SHRedeemScript(redeemScript)
+	if err != nil {
+		t.Fatalf("unable to import redeem script: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(scriptAddr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		outputs := []*wire.TxOut{wire.NewTxOut(100000, pkScript)}
+		params := coinSelectionParams(
+			outputs, testFeeRate, p2pkhScriptSize,
+			w.redeemScriptSource(ns),
+		)
+
+		size := txsizes.EstimateInputSize(pkScript, redeemScript)
+		if params.InputSize(pkScript) != size {
+			t.Fatalf("expected input size %d, found %d", size,
+				params.InputSize(pkScript))
+		}
+		if size <= txsizes.RedeemP2PKHInputSize {
+			t.Fatalf("multisig input size %d not larger than "+
+				"P2PKH input size", size)
+		}
+
+		// Inputs spending unknown P2SH outputs are sized as inputs
+		// redeeming P2PKH outputs.
+		unknown := append([]byte(nil), pkScript...)
+		unknown[2] ^= 0xff
+		if params.InputSize(unknown) != txsizes.RedeemP2PKHInputSize {
+			t.Fatalf("unexpected input size %d of unknown script",
+				params.InputSize(unknown))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestLargestFirst checks that LargestFirst selects the largest outputs.
+func TestLargestFirst(t *testing.T) {
+	outputs, params := testPayment(120000)
+	eligible := testCredits(10000, 100000, 50000, 90000, 200)
+
+	selection, err := LargestFirst{}.SelectCoins(eligible, params)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	amounts := checkSelection(t, selection, outputs)
+	checkAmounts(t, amounts, []czzutil.Amount{100000, 90000})
+}
+
+// TestSmallestFirst checks that SmallestFirst selects the smallest outputs,
+// skipping outputs that cost more to spend than they are worth.
+func TestSmallestFirst(t *testing.T) {
+	outputs, params := testPayment(120000)
+	eligible := testCredits(10000, 100000, 50000, 90000, 100)
+
+	selection, err := SmallestFirst{}.SelectCoins(eligible, params)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	amounts := checkSelection(t, selection, outputs)
+	checkAmounts(t, amounts, []czzutil.Amount{10000, 50000, 90000})
+}
+
+// TestBranchAndBound checks that BranchAndBound finds a selection without
+// change, and that it uses its fallback if there is none.
+func TestBranchAndBound(t *testing.T) {
+	outputs, params := testPayment(120000)
+
+	// Two outputs whose effective values add up to the target exactly.
+	inputFee := params.InputFee(nil)
+	a := 70000 + inputFee
+	b := params.Target - 70000 + inputFee
+	eligible := testCredits(300000, a, 100000, b, 40000)
+
+	selection, err := BranchAndBound{}.SelectCoins(eligible, params)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	amounts := checkSelection(t, selection, outputs)
+	checkAmounts(t, amounts, []czzutil.Amount{a, b})
+
+	// A selection exceeding the target by less than the change cost is
+	// used as well.
+	eligible = testCredits(300000, a, b+params.ChangeCost/2)
+	selection, err = BranchAndBound{}.SelectCoins(eligible, params)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	amounts = checkSelection(t, selection, outputs)
+	checkAmounts(t, amounts, []czzutil.Amount{a, b + params.ChangeCost/2})
+
+	// Without a changeless selection, the fallback selects the outputs.
+	eligible = testCredits(1000000, 500000)
+	selection, err = BranchAndBound{
+		Fallback: SmallestFirst{},
+	}.SelectCoins(eligible, params)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	amounts = checkSelection(t, selection, outputs)
+	checkAmounts(t, amounts, []czzutil.Amount{500000})
+
+	selection, err = BranchAndBound{}.SelectCoins(eligible, params)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	amounts = checkSelection(t, selection, outputs)
+	checkAmounts(t, amounts, []czzutil.Amount{1000000})
+}
+
+// TestRandomImprove checks that RandomImprove reaches the target and improves
+// the selection towards twice the target.
+func TestRandomImprove(t *testing.T) {
+	outputs, params := testPayment(100000)
+
+	amounts := make([]czzutil.Amount, 20)
+	for i := range amounts {
+		amounts[i] = 50000
+	}
+	eligible := testCredits(amounts...)
+
+	for seed := int64(0); seed < 10; seed++ {
+		selector := RandomImprove{Rand: rand.New(rand.NewSource(seed))}
+		selection, err := selector.SelectCoins(eligible, params)
+		if err != nil {
+			t.Fatalf("unable to select coins: %v", err)
+		}
+		checkSelection(t, selection, outputs)
+
+		// Three outputs reach the target, and a fourth brings the
+		// selection closest to twice the target.
+		if len(selection) != 4 {
+			t.Fatalf("seed %d: expected 4 selected outputs, found %d",
+				seed, len(selection))
+		}
+	}
+
+	// Either output reaches the target on its own, and adding the other
+	// one moves the selection away from twice the target.
+	eligible = testCredits(120000, 300000)
+	for seed := int64(0); seed < 10; seed++ {
+		selector := RandomImprove{Rand: rand.New(rand.NewSource(seed))}
+		selection, err := selector.SelectCoins(eligible, params)
+		if err != nil {
+			t.Fatalf("unable to select coins: %v", err)
+		}
+		checkSelection(t, selection, outputs)
+		if len(selection) != 1 {
+			t.Fatalf("seed %d: expected 1 selected output, found %d",
+				seed, len(selection))
+		}
+	}
+}
+
+// TestInsufficientFunds checks that all coin selectors report insufficient
+// funds when the target can not be reached.
+func TestInsufficientFunds(t *testing.T) {
+	_, params := testPayment(100000)
+	eligible := testCredits(50000, 40000, 10000)
+
+	selectors := []CoinSelector{
+		LargestFirst{},
+		SmallestFirst{},
+		BranchAndBound{},
+		RandomImprove{},
+	}
+	for _, selector := range selectors {
+		_, err := selector.SelectCoins(eligible, params)
+		fundsErr, ok := err.(InsufficientFundsError)
+		if !ok {
+			t.Fatalf("%T: expected insufficient funds error, found %v",
+				selector, err)
+		}
+		if fundsErr.Target != params.Target ||
+			fundsErr.Available != 100000-3*inputFee {
+
+			t.Fatalf("%T: unexpected error %v", selector, err)
+		}
+	}
+}
+
+// TestCoinSelectorByName checks that all coin selection strategies can be
+// found by their names.
+func TestCoinSelectorByName(t *testing.T) {
+	names := []string{
+		CoinSelectionLargestFirst,
+		CoinSelectionSmallestFirst,
+		CoinSelectionBranchAndBound,
+		CoinSelectionRandomImprove,
+	}
+	for _, name := range names {
+		if _, err := CoinSelectorByName(name); err != nil {
+			t.Fatalf("unable to find coin selector %q: %v", name, err)
+		}
+	}
+	if _, err := CoinSelectorByName("unknown"); err == nil {
+		t.Fatalf("found coin selector of unknown strategy")
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/czzutil/hdkeychain"
+	_ "github.com/classzz/czzwallet/walletdb/bdb"
+)
+
+// testWallet creates an unlocked wallet with a fresh seed and a mock chain
+// client in a temporary directory.  The returned function removes the wallet
+// directory again.
+func testWallet(t *testing.T) (*Wallet, func()) {
+	t.Helper()
+
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.MinSeedBytes)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	return testWalletFromSeed(t, seed)
+}
+
+// testWalletFromSeed creates an unlocked test wallet from the given seed.
+func testWalletFromSeed(t *testing.T, seed []byte) (*Wallet, func()) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "wallet_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+
+	pubPass := []byte("hello")
+	privPass := []byte("world")
+
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, 250)
+	w, err := loader.CreateNewWallet(pubPass, privPass, seed, time.Now())
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	w.chainClient = &mockChainClient{}
+	if err := w.Unlock(privPass, time.After(10*time.Minute)); err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+
+	return w, func() {
+		w.db.Close()
+		os.RemoveAll(dir)
+	}
+}
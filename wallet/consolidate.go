@@ -0,0 +1,554 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txauthor"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+const (
+	// DefaultConsolidationMaxInputs is the number of outputs merged by a
+	// consolidation transaction when no limit is configured.  It keeps
+	// transactions redeeming P2PKH outputs well below the standard
+	// transaction size.
+	DefaultConsolidationMaxInputs = 500
+
+	// DefaultConsolidationInterval is the time between two checks of the
+	// number of unspent outputs when no interval is configured.
+	DefaultConsolidationInterval = 10 * time.Minute
+
+	// ConsolidationLeaseDuration is the duration the outputs merged by a
+	// consolidation transaction remain leased if the wallet stops before
+	// the transaction is recorded.
+	ConsolidationLeaseDuration = 10 * time.Minute
+
+	// maxConsolidationHistory is the number of consolidation transactions
+	// reported by ConsolidationStatus.
+	maxConsolidationHistory = 20
+)
+
+var (
+	// ConsolidationLockID is the lock ID used to lease the outputs merged
+	// by a consolidation transaction while it is created and published.
+	ConsolidationLockID = wtxmgr.LockID{
+		'c', 'z', 'z', 'w', 'a', 'l', 'l', 'e', 't', '-', 'c', 'o', 'n',
+		's', 'o', 'l', 'i', 'd', 'a', 't', 'e',
+	}
+
+	// ErrConsolidationThreshold is returned when consolidation is started
+	// without a positive output threshold.
+	ErrConsolidationThreshold = errors.New("consolidation threshold " +
+		"must be positive")
+)
+
+// ConsolidationConfig configures the background consolidation of the unspent
+// outputs of wallet accounts.
+type ConsolidationConfig struct {
+	// Threshold is the number of spendable outputs an account must hold
+	// above which they are consolidated.
+	Threshold int
+
+	// MaxFeeRate is the highest estimated fee rate, per kilobyte, at which
+	// outputs are consolidated.  Outputs are consolidated at any fee rate
+	// if zero.
+	MaxFeeRate czzutil.Amount
+
+	// MaxInputs is the largest number of outputs merged by a single
+	// consolidation transaction.  DefaultConsolidationMaxInputs is used if
+	// zero.
+	MaxInputs int
+
+	// ConfTarget is the confirmation target the fee rate of consolidation
+	// transactions is estimated for.  DefaultConfTarget is used if zero.
+	ConfTarget uint32
+
+	// RequiredConfirmations is the number of confirmations an output
+	// needs before it is consolidated.
+	RequiredConfirmations int32
+
+	// Interval is the time between two checks of the number of unspent
+	// outputs.  DefaultConsolidationInterval is used if zero.
+	Interval time.Duration
+}
+
+// Consolidation describes a transaction merging the outputs of an account.
+type Consolidation struct {
+	Hash     chainhash.Hash
+	KeyScope waddrmgr.KeyScope
+	Account  uint32
+	Inputs   int
+	Amount   czzutil.Amount
+	Fee      czzutil.Amount
+	Time     time.Time
+}
+
+// AccountOutputCount is the number of spendable outputs held by an account.
+type AccountOutputCount struct {
+	KeyScope waddrmgr.KeyScope
+	Account  uint32
+	Outputs  int
+}
+
+// ConsolidationStatus describes the state of the background consolidation of
+// unspent outputs.
+type ConsolidationStatus struct {
+	// Enabled is whether outputs are consolidated in the background, and
+	// Config is the configuration of the consolidation if so.
+	Enabled bool
+	Config  ConsolidationConfig
+
+	// LastRun is the time the number of unspent outputs was last checked,
+	// FeeRate the fee rate estimated at that time, and Accounts the number
+	// of spendable outputs held by each account.  LastError is the error
+	// the last check failed with, if any.
+	LastRun   time.Time
+	FeeRate   czzutil.Amount
+	Accounts  []AccountOutputCount
+	LastError error
+
+	// Consolidations are the most recent consolidation transactions, oldest
+	// first.
+	Consolidations []Consolidation
+}
+
+// consolidationAccount identifies the account of the outputs consolidated
+// together.
+type consolidationAccount struct {
+	scope   waddrmgr.KeyScope
+	account uint32
+}
+
+// StartConsolidation starts consolidating the unspent outputs of the wallet's
+// accounts in the background.  Every configured interval, each account holding
+// more spendable outputs than the threshold has its smallest outputs merged
+// into a new internal address of the account, as long as the estimated fee
+// rate does not exceed the configured ceiling.  The merged outputs are leased
+// under ConsolidationLockID until the transaction is published.  Any previous
+// consolidation is stopped.
+//
+// Consolidation transactions are only created while the wallet is unlocked
+// and synced to the chain.  Outputs of the imported account are never
+// consolidated.
+func (w *Wallet) StartConsolidation(cfg ConsolidationConfig) error {
+	if cfg.Threshold <= 0 {
+		return ErrConsolidationThreshold
+	}
+	if cfg.MaxInputs <= 0 {
+		cfg.MaxInputs = DefaultConsolidationMaxInputs
+	}
+	if cfg.ConfTarget == 0 {
+		cfg.ConfTarget = DefaultConfTarget
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultConsolidationInterval
+	}
+
+	w.consolidationMtx.Lock()
+	if w.consolidationQuit != nil {
+		close(w.consolidationQuit)
+	}
+	stop := make(chan struct{})
+	w.consolidationQuit = stop
+	w.consolidationStatus.Enabled = true
+	w.consolidationStatus.Config = cfg
+	w.consolidationMtx.Unlock()
+
+	w.wg.Add(1)
+	go w.consolidationHandler(cfg, stop)
+	return nil
+}
+
+// StopConsolidation stops the background consolidation of unspent outputs.
+func (w *Wallet) StopConsolidation() {
+	w.consolidationMtx.Lock()
+	if w.consolidationQuit != nil {
+		close(w.consolidationQuit)
+		w.consolidationQuit = nil
+	}
+	w.consolidationStatus.Enabled = false
+	w.consolidationStatus.Config = ConsolidationConfig{}
+	w.consolidationMtx.Unlock()
+}
+
+// ConsolidationStatus returns the state of the background consolidation of
+// unspent outputs.
+func (w *Wallet) ConsolidationStatus() ConsolidationStatus {
+	w.consolidationMtx.Lock()
+	defer w.consolidationMtx.Unlock()
+
+	status := w.consolidationStatus
+	status.Accounts = append(
+		[]AccountOutputCount(nil), status.Accounts...,
+	)
+	status.Consolidations = append(
+		[]Consolidation(nil), status.Consolidations...,
+	)
+	return status
+}
+
+// consolidationHandler checks the number of unspent outputs of the wallet's
+// accounts every configured interval until consolidation is stopped or the
+// wallet shuts down.
+func (w *Wallet) consolidationHandler(cfg ConsolidationConfig,
+	stop <-chan struct{}) {
+
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	quit := w.quitChan()
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		case <-quit:
+			return
+		}
+
+		if !w.ChainSynced() {
+			continue
+		}
+		_, err := w.consolidate(cfg)
+		switch {
+		case waddrmgr.IsError(err, waddrmgr.ErrLocked):
+			log.Debugf("Not consolidating outputs while the " +
+				"wallet is locked")
+		case err != nil:
+			log.Errorf("Unable to consolidate outputs: %v", err)
+		}
+	}
+}
+
+// consolidate merges the outputs of every account holding more spendable
+// outputs than the configured threshold, if the estimated fee rate is below
+// the configured ceiling, and records the outcome in the consolidation
+// status.  An account that can not be consolidated does not keep the others
+// from being consolidated, and the last error is returned.
+func (w *Wallet) consolidate(cfg ConsolidationConfig) ([]Consolidation, error) {
+	feeRate := w.EstimateFeeRate(cfg.ConfTarget)
+	accounts, candidates, err := w.consolidationCandidates(
+		cfg.RequiredConfirmations,
+	)
+
+	var consolidations []Consolidation
+	switch {
+	case err != nil:
+	case cfg.MaxFeeRate != 0 && feeRate > cfg.MaxFeeRate:
+		log.Debugf("Not consolidating outputs at fee rate %v above %v",
+			feeRate, cfg.MaxFeeRate)
+	default:
+		for _, account := range accounts {
+			credits := candidates[account]
+			if len(credits) <= cfg.Threshold {
+				continue
+			}
+
+			c, accountErr := w.consolidateAccount(
+				account, credits, feeRate, cfg.MaxInputs,
+			)
+			if accountErr != nil {
+				log.Errorf("Unable to consolidate outputs of "+
+					"account %d: %v", account.account,
+					accountErr)
+				err = accountErr
+				continue
+			}
+			if c != nil {
+				log.Infof("Consolidated %d outputs of account %d "+
+					"in transaction %v", c.Inputs, c.Account,
+					c.Hash)
+				consolidations = append(consolidations, *c)
+			}
+		}
+	}
+
+	w.consolidationMtx.Lock()
+	status := &w.consolidationStatus
+	status.LastRun = time.Now()
+	status.FeeRate = feeRate
+	status.LastError = err
+	status.Accounts = status.Accounts[:0]
+	for _, account := range accounts {
+		status.Accounts = append(status.Accounts, AccountOutputCount{
+			KeyScope: account.scope,
+			Account:  account.account,
+			Outputs:  len(candidates[account]),
+		})
+	}
+	status.Consolidations = append(status.Consolidations, consolidations...)
+	if n := len(status.Consolidations); n > maxConsolidationHistory {
+		status.Consolidations = status.Consolidations[n-maxConsolidationHistory:]
+	}
+	w.consolidationMtx.Unlock()
+
+	return consolidations, err
+}
+
+// consolidationCandidates returns the spendable outputs of each account that
+// may be consolidated, along with the accounts holding them in a stable order.
+// Only outputs paying to a single public key of an account are returned, and
+// accounts without private keys, such as imported extended public keys or the
+// accounts of a watch-only wallet, are left out.
+func (w *Wallet) consolidationCandidates(minconf int32) (
+	[]consolidationAccount, map[consolidationAccount][]wtxmgr.Credit, error) {
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	bs, err := chainClient.BlockStamp()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		accounts   []consolidationAccount
+		candidates = make(map[consolidationAccount][]wtxmgr.Credit)
+		watchOnly  = make(map[consolidationAccount]bool)
+	)
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+
+		for _, output := range unspent {
+			if !confirmed(minconf, output.Height, bs.Height) {
+				continue
+			}
+			if output.FromCoinBase {
+				target := int32(w.chainParams.CoinbaseMaturity)
+				if !confirmed(target, output.Height, bs.Height) {
+					continue
+				}
+			}
+			if w.LockedOutpoint(output.OutPoint) ||
+				w.TxStore.IsFrozenOutput(txmgrNs, output.OutPoint) {
+
+				continue
+			}
+
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+				output.PkScript, w.chainParams,
+			)
+			if err != nil || len(addrs) != 1 {
+				continue
+			}
+			addr, err := w.Manager.Address(addrmgrNs, addrs[0])
+			if err != nil {
+				continue
+			}
+			if _, ok := addr.(waddrmgr.ManagedPubKeyAddress); !ok {
+				continue
+			}
+			scopedMgr, addrAccount, err := w.Manager.AddrAccount(
+				addrmgrNs, addrs[0],
+			)
+			if err != nil || addrAccount == waddrmgr.ImportedAddrAccount {
+				continue
+			}
+
+			account := consolidationAccount{
+				scope:   scopedMgr.Scope(),
+				account: addrAccount,
+			}
+			skip, ok := watchOnly[account]
+			if !ok {
+				props, err := scopedMgr.AccountProperties(
+					addrmgrNs, addrAccount,
+				)
+				if err != nil {
+					return err
+				}
+				skip = w.Manager.WatchOnly() || props.IsImported
+				watchOnly[account] = skip
+			}
+			if skip {
+				continue
+			}
+			if _, ok := candidates[account]; !ok {
+				accounts = append(accounts, account)
+			}
+			candidates[account] = append(candidates[account], output)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		a, b := accounts[i], accounts[j]
+		if a.scope != b.scope {
+			if a.scope.Purpose != b.scope.Purpose {
+				return a.scope.Purpose < b.scope.Purpose
+			}
+			return a.scope.Coin < b.scope.Coin
+		}
+		return a.account < b.account
+	})
+	return accounts, candidates, nil
+}
+
+// consolidateAccount merges up to maxInputs of the smallest outputs of an
+// account, skipping outputs worth less than the fee of spending them, into a
+// new internal address of the account.  The outputs are selected, leased and
+// spent while holding the transaction creation lock, as other transactions
+// created by the wallet are.  Nil is returned if fewer than two outputs are
+// worth merging.
+func (w *Wallet) consolidateAccount(account consolidationAccount,
+	credits []wtxmgr.Credit, feeRate czzutil.Amount,
+	maxInputs int) (*Consolidation, error) {
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+
+	heldUnlock, err := w.holdUnlock()
+	if err != nil {
+		return nil, err
+	}
+	defer heldUnlock.release()
+
+	w.txCreatorMtx.Lock()
+	defer w.txCreatorMtx.Unlock()
+
+	sort.Slice(credits, func(i, j int) bool {
+		return credits[i].Amount < credits[j].Amount
+	})
+	params := CoinSelectionParams{FeeRate: feeRate}
+	selected := make([]wtxmgr.Credit, 0, maxInputs)
+	for _, credit := range credits {
+		if len(selected) == maxInputs {
+			break
+		}
+		if credit.Amount > params.InputFee(credit.PkScript) {
+			selected = append(selected, credit)
+		}
+	}
+	if len(selected) < 2 {
+		return nil, nil
+	}
+
+	// Lease the outputs so that they are not selected by other
+	// transactions until the consolidation is recorded.  Outputs spent,
+	// leased or frozen since they were listed are left out.
+	var leased []wtxmgr.Credit
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for _, credit := range selected {
+			if !w.TxStore.IsUnspentOutput(ns, credit.OutPoint) ||
+				w.TxStore.IsFrozenOutput(ns, credit.OutPoint) {
+
+				continue
+			}
+			_, err := w.TxStore.LockOutput(
+				ns, ConsolidationLockID, credit.OutPoint,
+				ConsolidationLeaseDuration,
+			)
+			switch err {
+			case nil:
+				leased = append(leased, credit)
+			case wtxmgr.ErrOutputAlreadyLocked:
+			default:
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, credit := range leased {
+			err := w.ReleaseOutput(ConsolidationLockID, credit.OutPoint)
+			if err != nil {
+				log.Warnf("Unable to release output %v: %v",
+					credit.OutPoint, err)
+			}
+		}
+	}()
+	if len(leased) < 2 {
+		return nil, nil
+	}
+
+	var (
+		authoredTx *txauthor.AuthoredTx
+		addr       czzutil.Address
+	)
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		var err error
+		addr, err = w.newChangeAddress(
+			addrmgrNs, account.account, account.scope,
+		)
+		if err != nil {
+			return err
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return err
+		}
+
+		authoredTx, err = txauthor.NewUnsignedSweepTransaction(
+			pkScript, feeRate, makeSweepInputSource(leased),
+			w.redeemScriptSource(addrmgrNs),
+		)
+		if err != nil {
+			return err
+		}
+		err = authoredTx.AddAllInputScripts(
+			secretSource{w.Manager, addrmgrNs},
+		)
+		if err != nil {
+			return err
+		}
+		return validateMsgTx(
+			authoredTx.Tx, authoredTx.PrevScripts,
+			authoredTx.PrevInputValues,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The new address must be watched before the transaction is
+	// published.
+	if err := chainClient.NotifyReceived([]czzutil.Address{addr}); err != nil {
+		return nil, err
+	}
+	_, err = w.reliablyPublishTransaction(authoredTx.Tx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := czzutil.Amount(authoredTx.Tx.TxOut[0].Value)
+	return &Consolidation{
+		Hash:     authoredTx.Tx.TxHash(),
+		KeyScope: account.scope,
+		Account:  account.account,
+		Inputs:   len(authoredTx.Tx.TxIn),
+		Amount:   amount,
+		Fee:      authoredTx.TotalInput - amount,
+		Time:     time.Now(),
+	}, nil
+}
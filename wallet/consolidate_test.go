@@ -0,0 +1,127 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txrules"
+)
+
+// TestConsolidate checks that the outputs of an account above the threshold
+// are merged when the fee rate is below the ceiling and the wallet unlocked,
+// skipping outputs not worth spending, and that the leases taken while doing
+// so are released.
+func TestConsolidate(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	// Estimate fee rates at the relay fee.
+	w.SetFeeEstimator(FeeEstimators{})
+	addTestCredits(t, w, 10000, 20000, 30000, 100)
+
+	cfg := ConsolidationConfig{
+		Threshold:  3,
+		MaxFeeRate: txrules.DefaultRelayFeePerKb - 1,
+		MaxInputs:  DefaultConsolidationMaxInputs,
+	}
+	consolidations, err := w.consolidate(cfg)
+	if err != nil {
+		t.Fatalf("unable to consolidate outputs: %v", err)
+	}
+	if len(consolidations) != 0 {
+		t.Fatalf("outputs consolidated above the fee rate ceiling")
+	}
+	status := w.ConsolidationStatus()
+	if status.FeeRate != txrules.DefaultRelayFeePerKb ||
+		len(status.Accounts) != 1 || status.Accounts[0].Outputs != 4 {
+
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	// The wallet must be unlocked to sign the consolidation.
+	cfg.MaxFeeRate = txrules.DefaultRelayFeePerKb
+	w.Lock()
+	_, err = w.consolidate(cfg)
+	if !waddrmgr.IsError(err, waddrmgr.ErrLocked) {
+		t.Fatalf("expected locked wallet error, found %v", err)
+	}
+	if status := w.ConsolidationStatus(); status.LastError != err {
+		t.Fatalf("expected last error %v, found %v", err,
+			status.LastError)
+	}
+	if err := w.Unlock([]byte("world"), nil); err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+
+	consolidations, err = w.consolidate(cfg)
+	if err != nil {
+		t.Fatalf("unable to consolidate outputs: %v", err)
+	}
+	if len(consolidations) != 1 {
+		t.Fatalf("expected 1 consolidation, found %d",
+			len(consolidations))
+	}
+	c := consolidations[0]
+	if c.KeyScope != waddrmgr.KeyScopeBIP0044 || c.Account != 0 ||
+		c.Inputs != 3 || c.Amount+c.Fee != 60000 {
+
+		t.Fatalf("unexpected consolidation %+v", c)
+	}
+	status = w.ConsolidationStatus()
+	if status.LastError != nil || len(status.Consolidations) != 1 ||
+		status.Consolidations[0].Hash != c.Hash {
+
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	leases, err := w.ListLeasedOutputs()
+	if err != nil {
+		t.Fatalf("unable to list leased outputs: %v", err)
+	}
+	if len(leases) != 0 {
+		t.Fatalf("expected no leased outputs, found %d", len(leases))
+	}
+
+	// The unmined consolidation output and the output not worth spending
+	// remain, which is below the threshold.
+	consolidations, err = w.consolidate(cfg)
+	if err != nil {
+		t.Fatalf("unable to consolidate outputs: %v", err)
+	}
+	if len(consolidations) != 0 {
+		t.Fatalf("outputs consolidated below the threshold")
+	}
+}
+
+// TestStartConsolidation checks that consolidation requires a threshold and
+// is reported by the status until stopped.
+func TestStartConsolidation(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	err := w.StartConsolidation(ConsolidationConfig{})
+	if err != ErrConsolidationThreshold {
+		t.Fatalf("expected threshold error, found %v", err)
+	}
+
+	err = w.StartConsolidation(ConsolidationConfig{Threshold: 10})
+	if err != nil {
+		t.Fatalf("unable to start consolidation: %v", err)
+	}
+	status := w.ConsolidationStatus()
+	if !status.Enabled || status.Config.Threshold != 10 ||
+		status.Config.MaxInputs != DefaultConsolidationMaxInputs ||
+		status.Config.Interval != DefaultConsolidationInterval {
+
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	w.StopConsolidation()
+	if w.ConsolidationStatus().Enabled {
+		t.Fatalf("consolidation enabled after being stopped")
+	}
+}
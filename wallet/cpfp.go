@@ -0,0 +1,349 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"errors"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txauthor"
+	"github.com/classzz/czzwallet/wallet/txrules"
+	"github.com/classzz/czzwallet/wallet/txsizes"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+var (
+	// ErrTxNotFound is returned when a transaction is not recorded by the
+	// wallet.
+	ErrTxNotFound = errors.New("transaction not found in the wallet")
+
+	// ErrTxMined is returned when a fee bump or abandonment is requested
+	// for a transaction that has already been mined.
+	ErrTxMined = errors.New("transaction has already been mined")
+
+	// ErrNoCPFPOutput is returned when a transaction has no unspent
+	// wallet output that a child transaction can spend.
+	ErrNoCPFPOutput = errors.New("transaction has no unspent wallet " +
+		"output to spend from a child transaction")
+
+	// ErrFeeRateReached is returned when a transaction already pays at
+	// least the requested fee rate.
+	ErrFeeRateReached = errors.New("transaction already pays the " +
+		"requested fee rate")
+
+	// ErrCPFPOutputTooSmall is returned when the output spent by a child
+	// transaction does not cover the fee required by the package.
+	ErrCPFPOutputTooSmall = errors.New("output value too small to pay " +
+		"the fee of the transaction package")
+)
+
+// CPFPTx describes a child transaction created to bump the fee of its parent.
+type CPFPTx struct {
+	// Tx is the signed child transaction.
+	Tx *wire.MsgTx
+
+	// Fee is the fee paid by the child transaction.
+	Fee czzutil.Amount
+
+	// ParentFee is the fee paid by the parent transaction.  The fee of a
+	// parent that spends outputs not controlled by the wallet is not known
+	// and taken to be zero, so that the child pays for the whole package.
+	ParentFee czzutil.Amount
+
+	// Ancestors is the number of unmined ancestors of the parent recorded
+	// by the wallet, which are part of the package paid for by the child,
+	// and AncestorFee is the fee they pay.  Unknown fees are taken to be
+	// zero as with ParentFee.
+	Ancestors   int
+	AncestorFee czzutil.Amount
+
+	// PackageFeeRate is the fee rate, per kilobyte, of the parent, its
+	// unmined ancestors and the child transaction together.
+	PackageFeeRate czzutil.Amount
+}
+
+// BumpFeeCPFP bumps the fee of an unmined transaction by spending one of its
+// wallet outputs, either change or an incoming payment, with a child
+// transaction.  The child pays enough fee for the parent and child together
+// to reach targetFeeRate (per kilobyte), and its remaining value is paid to a
+// change address of the account controlling the spent output.  The child is
+// published and recorded like any transaction created by the wallet.  The
+// wallet must be unlocked.
+//
+// The parent is only mined together with its unmined ancestors, so the child
+// pays for the unmined ancestors recorded by the wallet as well.  Ancestors
+// the wallet has not recorded are taken to be mined.
+func (w *Wallet) BumpFeeCPFP(txid *chainhash.Hash,
+	targetFeeRate czzutil.Amount) (*CPFPTx, error) {
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// The wallet is kept unlocked until the child is signed.
+	heldUnlock, err := w.holdUnlock()
+	if err != nil {
+		return nil, err
+	}
+	defer heldUnlock.release()
+
+	// The output spent by the child is selected and published while
+	// holding the transaction creation lock, so that it is not selected by
+	// a transaction created at the same time.
+	w.txCreatorMtx.Lock()
+	defer w.txCreatorMtx.Unlock()
+
+	dbtx, err := w.db.BeginReadWriteTx()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = dbtx.Rollback() }()
+
+	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	parent, err := w.TxStore.TxDetails(txmgrNs, txid)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		return nil, ErrTxNotFound
+	}
+	if parent.Block.Height != -1 {
+		return nil, ErrTxMined
+	}
+
+	parentFee := knownFee(parent)
+	ancestors, ancestorFee, ancestorSize, err := w.unminedAncestors(
+		txmgrNs, parent,
+	)
+	if err != nil {
+		return nil, err
+	}
+	parentSize := parent.MsgTx.SerializeSize() + ancestorSize
+	if parentFee+ancestorFee >=
+		txrules.FeeForSerializeSize(targetFeeRate, parentSize) {
+
+		return nil, ErrFeeRateReached
+	}
+
+	credit, scope, account, err := w.cpfpCredit(addrmgrNs, txmgrNs, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Spends from the imported account pay the remaining value to the
+	// default account, as imported keys do not provide change addresses.
+	if account == waddrmgr.ImportedAddrAccount {
+		scope = waddrmgr.KeyScopeBIP0044
+		account = waddrmgr.DefaultAccountNum
+	}
+	changeAddr, err := w.newChangeAddress(addrmgrNs, account, scope)
+	if err != nil {
+		return nil, err
+	}
+	changeScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// The child pays the fee of the package at the target rate, minus the
+	// fee already paid by the parent and its ancestors, but at least its
+	// own fee at that rate.
+	changeOutput := wire.NewTxOut(0, changeScript)
+	childSize := txsizes.EstimateSerializeSize(
+		1, []*wire.TxOut{changeOutput}, false,
+	)
+	packageSize := parentSize + childSize
+	childFee := txrules.FeeForSerializeSize(targetFeeRate, packageSize) -
+		parentFee - ancestorFee
+	minFee := txrules.FeeForSerializeSize(targetFeeRate, childSize)
+	if childFee < minFee {
+		childFee = minFee
+	}
+
+	changeAmount := credit.Amount - childFee
+	if changeAmount <= 0 || txrules.IsDustAmount(changeAmount,
+		len(changeScript), txrules.DefaultRelayFeePerKb) {
+
+		return nil, ErrCPFPOutputTooSmall
+	}
+	changeOutput.Value = int64(changeAmount)
+
+	outPoint := wire.OutPoint{Hash: *txid, Index: credit.Index}
+	prevScript := parent.MsgTx.TxOut[credit.Index].PkScript
+	child := &txauthor.AuthoredTx{
+		Tx: &wire.MsgTx{
+			Version:  wire.TxVersion,
+			TxIn:     []*wire.TxIn{wire.NewTxIn(&outPoint, nil)},
+			TxOut:    []*wire.TxOut{changeOutput},
+			LockTime: 0,
+		},
+		PrevScripts:     [][]byte{prevScript},
+		PrevInputValues: []czzutil.Amount{credit.Amount},
+		TotalInput:      credit.Amount,
+		ChangeIndex:     0,
+	}
+	err = child.AddAllInputScripts(secretSource{w.Manager, addrmgrNs})
+	if err != nil {
+		return nil, err
+	}
+	err = validateMsgTx(child.Tx, child.PrevScripts, child.PrevInputValues)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dbtx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// The change address must be watched before the child is published.
+	err = chainClient.NotifyReceived([]czzutil.Address{changeAddr})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.reliablyPublishTransaction(child.Tx, "", nil); err != nil {
+		return nil, err
+	}
+
+	packageFee := parentFee + ancestorFee + childFee
+	return &CPFPTx{
+		Tx:          child.Tx,
+		Fee:         childFee,
+		ParentFee:   parentFee,
+		Ancestors:   ancestors,
+		AncestorFee: ancestorFee,
+		PackageFeeRate: packageFee * 1000 /
+			czzutil.Amount(parentSize+child.Tx.SerializeSize()),
+	}, nil
+}
+
+// knownFee returns the fee paid by a transaction, or zero if it spends outputs
+// not controlled by the wallet, whose values are not known.
+func knownFee(details *wtxmgr.TxDetails) czzutil.Amount {
+	if len(details.Debits) != len(details.MsgTx.TxIn) {
+		return 0
+	}
+
+	var fee czzutil.Amount
+	for _, debit := range details.Debits {
+		fee += debit.Amount
+	}
+	return fee - txauthor.SumOutputValues(details.MsgTx.TxOut)
+}
+
+// unminedAncestors returns the number of unmined transactions recorded by the
+// wallet that a transaction spends outputs of, directly or through other
+// unmined transactions, along with their total fee, as returned by knownFee,
+// and their total size.
+func (w *Wallet) unminedAncestors(txmgrNs walletdb.ReadBucket,
+	details *wtxmgr.TxDetails) (int, czzutil.Amount, int, error) {
+
+	var (
+		count int
+		fee   czzutil.Amount
+		size  int
+	)
+	seen := map[chainhash.Hash]struct{}{details.Hash: {}}
+	queue := []*wtxmgr.TxDetails{details}
+	for len(queue) != 0 {
+		tx := queue[0]
+		queue = queue[1:]
+		for _, txIn := range tx.MsgTx.TxIn {
+			hash := txIn.PreviousOutPoint.Hash
+			if _, ok := seen[hash]; ok {
+				continue
+			}
+			seen[hash] = struct{}{}
+
+			ancestor, err := w.TxStore.TxDetails(txmgrNs, &hash)
+			if err != nil {
+				return 0, 0, 0, err
+			}
+			if ancestor == nil || ancestor.Block.Height != -1 {
+				continue
+			}
+			count++
+			fee += knownFee(ancestor)
+			size += ancestor.MsgTx.SerializeSize()
+			queue = append(queue, ancestor)
+		}
+	}
+	return count, fee, size, nil
+}
+
+// cpfpCredit returns the largest unspent output of a transaction that is
+// controlled by a wallet key and neither locked, leased nor frozen, along with
+// the key scope and account of the key.
+func (w *Wallet) cpfpCredit(addrmgrNs, txmgrNs walletdb.ReadBucket,
+	details *wtxmgr.TxDetails) (*wtxmgr.CreditRecord, waddrmgr.KeyScope,
+	uint32, error) {
+
+	var (
+		best    *wtxmgr.CreditRecord
+		scope   waddrmgr.KeyScope
+		account uint32
+	)
+	for i := range details.Credits {
+		credit := &details.Credits[i]
+		if credit.Spent {
+			continue
+		}
+		if best != nil && credit.Amount <= best.Amount {
+			continue
+		}
+		outPoint := wire.OutPoint{
+			Hash:  details.Hash,
+			Index: credit.Index,
+		}
+		if w.LockedOutpoint(outPoint) {
+			continue
+		}
+		_, leased := w.TxStore.IsLockedOutput(txmgrNs, outPoint)
+		if leased {
+			continue
+		}
+		if w.TxStore.IsFrozenOutput(txmgrNs, outPoint) {
+			continue
+		}
+
+		// The child is only signed for outputs paying to a single
+		// public key of the wallet.
+		pkScript := details.MsgTx.TxOut[credit.Index].PkScript
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			pkScript, w.chainParams,
+		)
+		if err != nil || len(addrs) != 1 {
+			continue
+		}
+		addr, err := w.Manager.Address(addrmgrNs, addrs[0])
+		if err != nil {
+			continue
+		}
+		if _, ok := addr.(waddrmgr.ManagedPubKeyAddress); !ok {
+			continue
+		}
+		scopedMgr, addrAccount, err := w.Manager.AddrAccount(
+			addrmgrNs, addrs[0],
+		)
+		if err != nil {
+			continue
+		}
+
+		best = credit
+		scope = scopedMgr.Scope()
+		account = addrAccount
+	}
+	if best == nil {
+		return nil, scope, 0, ErrNoCPFPOutput
+	}
+	return best, scope, account, nil
+}
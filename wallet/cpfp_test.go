@@ -0,0 +1,249 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txrules"
+	"github.com/classzz/czzwallet/wallet/txsizes"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// TestBumpFeeCPFP checks that a child spending the wallet output of an unmined
+// transaction pays the fee of the package, and that mined transactions are
+// not bumped.
+func TestBumpFeeCPFP(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+
+	insertTx := func(msgTx *wire.MsgTx, block *wtxmgr.BlockMeta) *wtxmgr.TxRecord {
+		t.Helper()
+		var b bytes.Buffer
+		if err := msgTx.Serialize(&b); err != nil {
+			t.Fatalf("unable to serialize tx: %v", err)
+		}
+		rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+		if err != nil {
+			t.Fatalf("unable to create tx record: %v", err)
+		}
+		err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+			err := w.TxStore.InsertTx(ns, rec, block)
+			if err != nil {
+				return err
+			}
+			return w.TxStore.AddCredit(ns, rec, block, 0, false)
+		})
+		if err != nil {
+			t.Fatalf("failed inserting tx: %v", err)
+		}
+		return rec
+	}
+
+	// An incoming, unmined payment of unknown fee.
+	parentTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{
+			wire.NewTxOut(100000, pkScript),
+			wire.NewTxOut(50000, []byte{txscript.OP_TRUE}),
+		},
+	}
+	parent := insertTx(parentTx, nil)
+
+	feeRate := czzutil.Amount(5000)
+	child, err := w.BumpFeeCPFP(&parent.Hash, feeRate)
+	if err != nil {
+		t.Fatalf("unable to bump fee: %v", err)
+	}
+
+	if len(child.Tx.TxIn) != 1 || len(child.Tx.TxOut) != 1 {
+		t.Fatalf("unexpected child with %d inputs and %d outputs",
+			len(child.Tx.TxIn), len(child.Tx.TxOut))
+	}
+	prevOut := child.Tx.TxIn[0].PreviousOutPoint
+	if prevOut.Hash != parent.Hash || prevOut.Index != 0 {
+		t.Fatalf("child spends %v instead of the wallet output", prevOut)
+	}
+
+	// The parent's fee is unknown, so the child pays for the whole
+	// package.
+	childSize := txsizes.EstimateSerializeSize(1, child.Tx.TxOut, false)
+	packageFee := txrules.FeeForSerializeSize(
+		feeRate, parentTx.SerializeSize()+childSize,
+	)
+	if child.ParentFee != 0 || child.Fee != packageFee {
+		t.Fatalf("expected child fee %v, found %v (parent fee %v)",
+			packageFee, child.Fee, child.ParentFee)
+	}
+	if czzutil.Amount(child.Tx.TxOut[0].Value) != 100000-packageFee {
+		t.Fatalf("unexpected child output value %v",
+			child.Tx.TxOut[0].Value)
+	}
+	if child.PackageFeeRate < feeRate {
+		t.Fatalf("package fee rate %v below target %v",
+			child.PackageFeeRate, feeRate)
+	}
+
+	// The child was recorded, so the parent's output is spent and can not
+	// be used for another bump.
+	_, err = w.BumpFeeCPFP(&parent.Hash, feeRate)
+	if err != ErrNoCPFPOutput {
+		t.Fatalf("expected no spendable output error, found %v", err)
+	}
+
+	// Leased and frozen outputs are not spent by a child.
+	leasedTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Index: 2}},
+		},
+		TxOut: []*wire.TxOut{wire.NewTxOut(100000, pkScript)},
+	}
+	leased := insertTx(leasedTx, nil)
+	leasedOutPoint := wire.OutPoint{Hash: leased.Hash, Index: 0}
+	lockID := wtxmgr.LockID{1}
+	_, err = w.LeaseOutput(lockID, leasedOutPoint, time.Hour)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	_, err = w.BumpFeeCPFP(&leased.Hash, feeRate)
+	if err != ErrNoCPFPOutput {
+		t.Fatalf("expected no spendable output error for leased "+
+			"output, found %v", err)
+	}
+	if err := w.ReleaseOutput(lockID, leasedOutPoint); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	err = w.FreezeOutput(leasedOutPoint, "test", time.Time{})
+	if err != nil {
+		t.Fatalf("unable to freeze output: %v", err)
+	}
+	_, err = w.BumpFeeCPFP(&leased.Hash, feeRate)
+	if err != ErrNoCPFPOutput {
+		t.Fatalf("expected no spendable output error for frozen "+
+			"output, found %v", err)
+	}
+
+	// Mined and unknown transactions are not bumped.
+	blockHash, _ := chainhash.NewHashFromStr(
+		"00000000000000017188b968a371bab95aa43522665353b646e41865abae02a4")
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *blockHash, Height: 276425},
+		Time:  time.Unix(1387737310, 0),
+	}
+	minedTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Index: 1}},
+		},
+		TxOut: []*wire.TxOut{wire.NewTxOut(200000, pkScript)},
+	}
+	mined := insertTx(minedTx, block)
+	_, err = w.BumpFeeCPFP(&mined.Hash, feeRate)
+	if err != ErrTxMined {
+		t.Fatalf("expected mined transaction error, found %v", err)
+	}
+	_, err = w.BumpFeeCPFP(&chainhash.Hash{}, feeRate)
+	if err != ErrTxNotFound {
+		t.Fatalf("expected transaction not found error, found %v", err)
+	}
+}
+
+// TestBumpFeeCPFPAncestors checks that the child also pays for the unmined
+// ancestors of the parent recorded by the wallet.
+func TestBumpFeeCPFPAncestors(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+
+	insertTx := func(msgTx *wire.MsgTx) *wtxmgr.TxRecord {
+		t.Helper()
+		var b bytes.Buffer
+		if err := msgTx.Serialize(&b); err != nil {
+			t.Fatalf("unable to serialize tx: %v", err)
+		}
+		rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+		if err != nil {
+			t.Fatalf("unable to create tx record: %v", err)
+		}
+		err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+			err := w.TxStore.InsertTx(ns, rec, nil)
+			if err != nil {
+				return err
+			}
+			return w.TxStore.AddCredit(ns, rec, nil, 0, false)
+		})
+		if err != nil {
+			t.Fatalf("failed inserting tx: %v", err)
+		}
+		return rec
+	}
+
+	// An unmined payment to the wallet, and an unmined transaction
+	// spending it that is bumped.
+	grandparentTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Index: 3}},
+		},
+		TxOut: []*wire.TxOut{wire.NewTxOut(200000, pkScript)},
+	}
+	grandparent := insertTx(grandparentTx)
+	parentTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Hash: grandparent.Hash},
+		}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(190000, pkScript)},
+	}
+	parent := insertTx(parentTx)
+
+	feeRate := czzutil.Amount(5000)
+	child, err := w.BumpFeeCPFP(&parent.Hash, feeRate)
+	if err != nil {
+		t.Fatalf("unable to bump fee: %v", err)
+	}
+	if child.Ancestors != 1 {
+		t.Fatalf("expected 1 unmined ancestor, found %d",
+			child.Ancestors)
+	}
+
+	childSize := txsizes.EstimateSerializeSize(1, child.Tx.TxOut, false)
+	packageSize := grandparentTx.SerializeSize() +
+		parentTx.SerializeSize() + childSize
+	expectedFee := txrules.FeeForSerializeSize(feeRate, packageSize) -
+		child.ParentFee - child.AncestorFee
+	if child.Fee != expectedFee {
+		t.Fatalf("expected child fee %v, found %v", expectedFee,
+			child.Fee)
+	}
+	if child.PackageFeeRate < feeRate {
+		t.Fatalf("package fee rate %v below target %v",
+			child.PackageFeeRate, feeRate)
+	}
+}
@@ -6,8 +6,8 @@
 package wallet
 
 import (
+	"errors"
 	"fmt"
-	"sort"
 
 	"github.com/classzz/classzz/czzec"
 	"github.com/classzz/classzz/txscript"
@@ -20,39 +20,63 @@ import (
 	"github.com/classzz/czzwallet/wtxmgr"
 )
 
-// byAmount defines the methods needed to satisify sort.Interface to
-// sort credits by their output amount.
-type byAmount []wtxmgr.Credit
+// makeInputSource creates an input source that selects the inputs of a
+// transaction from the eligible outputs with the passed coin selector.
+func makeInputSource(eligible []wtxmgr.Credit, selector CoinSelector,
+	params CoinSelectionParams) txauthor.InputSource {
 
-func (s byAmount) Len() int           { return len(s) }
-func (s byAmount) Less(i, j int) bool { return s[i].Amount < s[j].Amount }
-func (s byAmount) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+	var firstTarget czzutil.Amount
+	return func(target czzutil.Amount) (czzutil.Amount, []*wire.TxIn,
+		[]czzutil.Amount, [][]byte, error) {
 
-func makeInputSource(eligible []wtxmgr.Credit) txauthor.InputSource {
-	// Pick largest outputs first.  This is only done for compatibility with
-	// previous tx creation code, not because it's a good idea.
-	sort.Sort(sort.Reverse(byAmount(eligible)))
+		// The selection target already accounts for the fee of the
+		// selected inputs.  Should the fee of the transaction still be
+		// underestimated, the target requested by the caller rises and
+		// the selection target is raised by the same amount.
+		if firstTarget == 0 {
+			firstTarget = target
+		}
+		selectionParams := params
+		selectionParams.Target += target - firstTarget
 
-	// Current inputs and their total value.  These are closed over by the
-	// returned input source and reused across multiple calls.
-	currentTotal := czzutil.Amount(0)
-	currentInputs := make([]*wire.TxIn, 0, len(eligible))
-	currentScripts := make([][]byte, 0, len(eligible))
-	currentInputValues := make([]czzutil.Amount, 0, len(eligible))
+		selected, err := selector.SelectCoins(eligible, selectionParams)
+		if err != nil {
+			return 0, nil, nil, nil, err
+		}
 
-	return func(target czzutil.Amount) (czzutil.Amount, []*wire.TxIn,
+		var total czzutil.Amount
+		inputs := make([]*wire.TxIn, 0, len(selected))
+		inputValues := make([]czzutil.Amount, 0, len(selected))
+		scripts := make([][]byte, 0, len(selected))
+		for i := range selected {
+			credit := &selected[i]
+			total += credit.Amount
+			inputs = append(inputs, wire.NewTxIn(&credit.OutPoint, nil))
+			inputValues = append(inputValues, credit.Amount)
+			scripts = append(scripts, credit.PkScript)
+		}
+		return total, inputs, inputValues, scripts, nil
+	}
+}
+
+// makeSweepInputSource creates an input source that provides all eligible
+// outputs, regardless of the target amount.
+func makeSweepInputSource(eligible []wtxmgr.Credit) txauthor.InputSource {
+	return func(czzutil.Amount) (czzutil.Amount, []*wire.TxIn,
 		[]czzutil.Amount, [][]byte, error) {
 
-		for currentTotal < target && len(eligible) != 0 {
-			nextCredit := &eligible[0]
-			eligible = eligible[1:]
-			nextInput := wire.NewTxIn(&nextCredit.OutPoint, nil)
-			currentTotal += nextCredit.Amount
-			currentInputs = append(currentInputs, nextInput)
-			currentScripts = append(currentScripts, nextCredit.PkScript)
-			currentInputValues = append(currentInputValues, nextCredit.Amount)
+		var total czzutil.Amount
+		inputs := make([]*wire.TxIn, 0, len(eligible))
+		inputValues := make([]czzutil.Amount, 0, len(eligible))
+		scripts := make([][]byte, 0, len(eligible))
+		for i := range eligible {
+			credit := &eligible[i]
+			total += credit.Amount
+			inputs = append(inputs, wire.NewTxIn(&credit.OutPoint, nil))
+			inputValues = append(inputValues, credit.Amount)
+			scripts = append(scripts, credit.PkScript)
 		}
-		return currentTotal, currentInputs, currentInputValues, currentScripts, nil
+		return total, inputs, inputValues, scripts, nil
 	}
 }
 
@@ -97,21 +121,35 @@ func (s secretSource) GetScript(addr czzutil.Address) ([]byte, error) {
 	return msa.Script()
 }
 
-// txToOutputs creates a signed transaction which includes each output from
-// outputs.  Previous outputs to reedeem are chosen from the passed account's
-// UTXO set and minconf policy. An additional output may be added to return
-// change to the wallet. This output will have an address generated from the
-// given key scope and account. If a key scope is not specified, the address
-// will always be generated from the P2WKH key scope. An appropriate fee is
-// included based on the wallet's current relay fee. The wallet must be
-// unlocked to create the transaction.
+// txToOutputs creates a signed transaction which includes each output of the
+// request.  Previous outputs to reedeem are chosen from the UTXO set of the
+// requested account and minconf policy. An additional output may be added to
+// return change to the wallet. This output will have an address generated from
+// the requested key scope and account. If a key scope is not specified, the
+// address will always be generated from the P2WKH key scope. An appropriate
+// fee is included based on the requested fee rate. Inputs are chosen by the
+// requested coin selector, or the wallet's default coin selector if nil. The
+// wallet must be unlocked to create the transaction.
+//
+// If the request subtracts the fee from outputs, the fee is subtracted from
+// the outputs at these indexes instead of being paid on top of the outputs.
+// If sweep is set, all eligible outputs are spent to the single output of the
+// request, whose value is set to the total input value minus the fee, and no
+// change is created.
 //
-// NOTE: The dryRun argument can be set true to create a tx that doesn't alter
+// If the requested lock time is not zero, it is set as the lock time of the
+// transaction, and the sequence numbers of its inputs are set so the lock time
+// is enforced.
+//
+// NOTE: The dryRun field can be set true to create a tx that doesn't alter
 // the database. A tx created with this set to true will intentionally have no
 // input scripts added and SHOULD NOT be broadcasted.
-func (w *Wallet) txToOutputs(outputs []*wire.TxOut, keyScope *waddrmgr.KeyScope,
-	account uint32, minconf int32, feeSatPerKb czzutil.Amount, dryRun bool) (
-	tx *txauthor.AuthoredTx, err error) {
+func (w *Wallet) txToOutputs(req createTxRequest) (tx *txauthor.AuthoredTx,
+	err error) {
+
+	outputs := req.outputs
+	keyScope, account := req.opts.KeyScope, req.opts.Account
+	feeSatPerKb := req.opts.FeeRate
 
 	chainClient, err := w.requireChainClient()
 	if err != nil {
@@ -138,16 +176,52 @@ func (w *Wallet) txToOutputs(outputs []*wire.TxOut, keyScope *waddrmgr.KeyScope,
 	}
 
 	eligible, err := w.findEligibleOutputs(
-		dbtx, keyScope, account, minconf, bs,
+		dbtx, keyScope, account, req.opts.RequiredConfirmations, bs,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	inputSource := makeInputSource(eligible)
-	tx, err = txauthor.NewUnsignedTransaction(
-		outputs, feeSatPerKb, inputSource, changeSource,
-	)
+	coinSelector := req.opts.CoinSelector
+	if coinSelector == nil {
+		coinSelector = w.CoinSelector()
+	}
+	redeemScripts := w.redeemScriptSource(addrmgrNs)
+	switch {
+	case req.sweep:
+		if len(outputs) != 1 {
+			return nil, errors.New("a sweep pays to exactly one output")
+		}
+		tx, err = txauthor.NewUnsignedSweepTransaction(
+			outputs[0].PkScript, feeSatPerKb,
+			makeSweepInputSource(eligible), redeemScripts,
+		)
+
+	case len(req.opts.SubtractFeeFrom) != 0:
+		// The outputs pay the fee, so the selected outputs only need
+		// to reach the output value.
+		inputSource := makeInputSource(
+			eligible, coinSelector, coinSelectionParams(
+				outputs, 0, changeSource.ScriptSize,
+				redeemScripts,
+			),
+		)
+		tx, err = txauthor.NewUnsignedTransactionSubtractFee(
+			outputs, req.opts.SubtractFeeFrom, feeSatPerKb,
+			inputSource, changeSource, redeemScripts,
+		)
+
+	default:
+		inputSource := makeInputSource(
+			eligible, coinSelector, coinSelectionParams(
+				outputs, feeSatPerKb, changeSource.ScriptSize,
+				redeemScripts,
+			),
+		)
+		tx, err = txauthor.NewUnsignedTransaction(
+			outputs, feeSatPerKb, inputSource, changeSource,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -159,11 +233,20 @@ func (w *Wallet) txToOutputs(outputs []*wire.TxOut, keyScope *waddrmgr.KeyScope,
 		tx.RandomizeChangePosition()
 	}
 
+	// The lock time is only enforced when an input is not final.  Neither
+	// changes the serialize size, so the fee is still valid.
+	if req.opts.LockTime != 0 {
+		tx.Tx.LockTime = req.opts.LockTime
+		for _, txIn := range tx.Tx.TxIn {
+			txIn.Sequence = wire.MaxTxInSequenceNum - 1
+		}
+	}
+
 	// If a dry run was requested, we return now before adding the input
 	// scripts, and don't commit the database transaction. The DB will be
 	// rolled back when this method returns to ensure the dry run didn't
 	// alter the DB in any way.
-	if dryRun {
+	if req.dryRun {
 		return tx, nil
 	}
 
@@ -261,8 +344,20 @@ func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx,
 			}
 		}
 
-		// Locked unspent outputs are skipped.
-		if w.LockedOutpoint(output.OutPoint) {
+		// Locked and frozen unspent outputs are skipped.
+		if w.LockedOutpoint(output.OutPoint) ||
+			w.TxStore.IsFrozenOutput(txmgrNs, output.OutPoint) {
+
+			continue
+		}
+
+		// Time-locked outputs cannot be signed for by the transaction
+		// author, and are only spent explicitly.
+		timeLock, err := w.timeLockForOutput(dbtx, output.PkScript)
+		if err != nil {
+			return nil, err
+		}
+		if timeLock != nil {
 			continue
 		}
 
@@ -6,6 +6,7 @@ package wallet
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -17,6 +18,9 @@ import (
 	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzutil/hdkeychain"
 	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txauthor"
+	"github.com/classzz/czzwallet/wallet/txrules"
+	"github.com/classzz/czzwallet/wallet/txsizes"
 	"github.com/classzz/czzwallet/walletdb"
 	_ "github.com/classzz/czzwallet/walletdb/bdb"
 	"github.com/classzz/czzwallet/wtxmgr"
@@ -119,7 +123,15 @@ func TestTxToOutputsDryRun(t *testing.T) {
 
 	// First do a few dry-runs, making sure the number of addresses in the
 	// database us not inflated.
-	dryRunTx, err := w.txToOutputs(txOuts, 0, 1, 1000, true)
+	dryRunTx, err := w.txToOutputs(createTxRequest{
+		outputs: txOuts,
+		opts: SendOptions{
+			KeyScope:              &waddrmgr.KeyScopeBIP0044,
+			RequiredConfirmations: 1,
+			FeeRate:               1000,
+		},
+		dryRun: true,
+	})
 	if err != nil {
 		t.Fatalf("unable to author tx: %v", err)
 	}
@@ -134,7 +146,15 @@ func TestTxToOutputsDryRun(t *testing.T) {
 		t.Fatalf("expected 20 addresses, found %v", len(addresses))
 	}
 
-	dryRunTx2, err := w.txToOutputs(txOuts, 0, 1, 1000, true)
+	dryRunTx2, err := w.txToOutputs(createTxRequest{
+		outputs: txOuts,
+		opts: SendOptions{
+			KeyScope:              &waddrmgr.KeyScopeBIP0044,
+			RequiredConfirmations: 1,
+			FeeRate:               1000,
+		},
+		dryRun: true,
+	})
 	if err != nil {
 		t.Fatalf("unable to author tx: %v", err)
 	}
@@ -167,7 +187,14 @@ func TestTxToOutputsDryRun(t *testing.T) {
 
 	// Now we do a proper, non-dry run. This should add a change address
 	// to the database.
-	tx, err := w.txToOutputs(txOuts, 0, 1, 1000, false)
+	tx, err := w.txToOutputs(createTxRequest{
+		outputs: txOuts,
+		opts: SendOptions{
+			KeyScope:              &waddrmgr.KeyScopeBIP0044,
+			RequiredConfirmations: 1,
+			FeeRate:               1000,
+		},
+	})
 	if err != nil {
 		t.Fatalf("unable to author tx: %v", err)
 	}
@@ -198,3 +225,266 @@ func TestTxToOutputsDryRun(t *testing.T) {
 			"than wet run")
 	}
 }
+
+// addTestCredits inserts a mined transaction paying the given amounts to a
+// wallet address, and returns the output script of the address.
+func addTestCredits(t *testing.T, w *Wallet, amounts ...int64) []byte {
+	t.Helper()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+
+	incomingTx := &wire.MsgTx{TxIn: []*wire.TxIn{{}}}
+	for _, amount := range amounts {
+		incomingTx.AddTxOut(wire.NewTxOut(amount, pkScript))
+	}
+	var b bytes.Buffer
+	if err := incomingTx.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+	if err != nil {
+		t.Fatalf("unable to create tx record: %v", err)
+	}
+
+	blockHash, _ := chainhash.NewHashFromStr(
+		"00000000000000017188b968a371bab95aa43522665353b646e41865abae02a4")
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *blockHash, Height: 276425},
+		Time:  time.Unix(1387737310, 0),
+	}
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.TxStore.InsertTx(ns, rec, block); err != nil {
+			return err
+		}
+		for i := range amounts {
+			err := w.TxStore.AddCredit(ns, rec, block, uint32(i), false)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed inserting tx: %v", err)
+	}
+	return pkScript
+}
+
+// TestTxToOutputsSubtractFee checks that the fee of a transaction can be
+// subtracted from its outputs, in proportion to their values.
+func TestTxToOutputsSubtractFee(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	pkScript := addTestCredits(t, w, 100000)
+	txOuts := []*wire.TxOut{
+		wire.NewTxOut(10000, pkScript),
+		wire.NewTxOut(30000, pkScript),
+	}
+
+	tx, err := w.txToOutputs(createTxRequest{
+		outputs: txOuts,
+		opts: SendOptions{
+			KeyScope:              &waddrmgr.KeyScopeBIP0044,
+			RequiredConfirmations: 1,
+			FeeRate:               1000,
+			SubtractFeeFrom:       []int{0, 1},
+		},
+		dryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to author tx: %v", err)
+	}
+
+	// The outputs pay the fee, so the change is the input value not paid
+	// to the requested outputs.
+	if tx.ChangeIndex < 0 {
+		t.Fatalf("expected change output")
+	}
+	if change := tx.Tx.TxOut[tx.ChangeIndex].Value; change != 60000 {
+		t.Fatalf("expected change 60000, found %v", change)
+	}
+
+	var paid []int64
+	for i, txOut := range tx.Tx.TxOut {
+		if i != tx.ChangeIndex {
+			paid = append(paid, txOut.Value)
+		}
+	}
+	fee := 40000 - paid[0] - paid[1]
+	size := txsizes.EstimateVirtualSize(1, 0, 0, txOuts, len(pkScript))
+	if fee != int64(txrules.FeeForSerializeSize(1000, size)) {
+		t.Fatalf("unexpected fee %v for size %v", fee, size)
+	}
+	if (10000-paid[0])*3 > 30000-paid[1] {
+		t.Fatalf("fee not split in proportion to the outputs: paid %v",
+			paid)
+	}
+	if txOuts[0].Value != 10000 || txOuts[1].Value != 30000 {
+		t.Fatalf("requested outputs were modified")
+	}
+
+	// An output too small to pay the fee is rejected.
+	txOuts[0].Value = 600
+	_, err = w.txToOutputs(createTxRequest{
+		outputs: txOuts,
+		opts: SendOptions{
+			KeyScope:              &waddrmgr.KeyScopeBIP0044,
+			RequiredConfirmations: 1,
+			FeeRate:               1000,
+			SubtractFeeFrom:       []int{0},
+		},
+		dryRun: true,
+	})
+	if err != txauthor.ErrOutputsTooSmall {
+		t.Fatalf("expected outputs too small error, found %v", err)
+	}
+}
+
+// TestTxToOutputsSweep checks that a sweep spends all eligible outputs to a
+// single output without change.
+func TestTxToOutputsSweep(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	pkScript := addTestCredits(t, w, 50000, 70000)
+	txOuts := []*wire.TxOut{wire.NewTxOut(0, pkScript)}
+
+	tx, err := w.txToOutputs(createTxRequest{
+		outputs: txOuts,
+		opts: SendOptions{
+			KeyScope:              &waddrmgr.KeyScopeBIP0044,
+			RequiredConfirmations: 1,
+			FeeRate:               1000,
+		},
+		sweep:  true,
+		dryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to author tx: %v", err)
+	}
+
+	if len(tx.Tx.TxIn) != 2 || len(tx.Tx.TxOut) != 1 ||
+		tx.ChangeIndex != -1 {
+
+		t.Fatalf("expected 2 inputs and 1 output without change, "+
+			"found %d inputs, %d outputs and change index %d",
+			len(tx.Tx.TxIn), len(tx.Tx.TxOut), tx.ChangeIndex)
+	}
+	size := txsizes.EstimateVirtualSize(2, 0, 0, tx.Tx.TxOut, 0)
+	fee := txrules.FeeForSerializeSize(1000, size)
+	if tx.Tx.TxOut[0].Value != int64(120000-fee) {
+		t.Fatalf("expected swept value %v, found %v", 120000-fee,
+			tx.Tx.TxOut[0].Value)
+	}
+}
+
+// TestTxToOutputsLockTime checks that a lock time is set on the authored
+// transaction with non-final inputs so that it is enforced.
+func TestTxToOutputsLockTime(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	pkScript := addTestCredits(t, w, 100000)
+	txOuts := []*wire.TxOut{wire.NewTxOut(10000, pkScript)}
+
+	tx, err := w.txToOutputs(createTxRequest{
+		outputs: txOuts,
+		opts: SendOptions{
+			KeyScope:              &waddrmgr.KeyScopeBIP0044,
+			RequiredConfirmations: 1,
+			FeeRate:               1000,
+			LockTime:              276500,
+		},
+		dryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to author tx: %v", err)
+	}
+	if tx.Tx.LockTime != 276500 {
+		t.Fatalf("expected lock time 276500, found %d", tx.Tx.LockTime)
+	}
+	for i, txIn := range tx.Tx.TxIn {
+		if txIn.Sequence == wire.MaxTxInSequenceNum {
+			t.Fatalf("input %d is final", i)
+		}
+	}
+}
+
+// TestTxToOutputsNullData checks that a zero-value null-data output is
+// accepted as a transaction output, and that its data is listed by
+// listtransactions.
+func TestTxToOutputsNullData(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	pkScript := addTestCredits(t, w, 100000)
+
+	data := bytes.Repeat([]byte{0xaa}, txrules.MaxNullDataSize+1)
+	_, err := txrules.NullDataOutput(data)
+	if err != txrules.ErrNullDataTooLarge {
+		t.Fatalf("expected ErrNullDataTooLarge, got %v", err)
+	}
+	data = data[:txrules.MaxNullDataSize]
+	dataOutput, err := txrules.NullDataOutput(data)
+	if err != nil {
+		t.Fatalf("unable to create null-data output: %v", err)
+	}
+	err = txrules.CheckOutput(dataOutput, txrules.DefaultRelayFeePerKb)
+	if err != nil {
+		t.Fatalf("null-data output rejected: %v", err)
+	}
+
+	txOuts := []*wire.TxOut{wire.NewTxOut(10000, pkScript), dataOutput}
+	tx, err := w.txToOutputs(createTxRequest{
+		outputs: txOuts,
+		opts: SendOptions{
+			KeyScope:              &waddrmgr.KeyScopeBIP0044,
+			RequiredConfirmations: 1,
+			FeeRate:               1000,
+		},
+		dryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to author tx: %v", err)
+	}
+
+	details := &wtxmgr.TxDetails{
+		TxRecord: wtxmgr.TxRecord{MsgTx: *tx.Tx, Hash: tx.Tx.TxHash()},
+		Block:    wtxmgr.BlockMeta{Block: wtxmgr.Block{Height: -1}},
+		Debits:   []wtxmgr.DebitRecord{{Amount: 100000, Index: 0}},
+	}
+	var found bool
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		results := listTransactions(
+			dbtx, details, w.Manager, 0, w.chainParams,
+		)
+		for _, result := range results {
+			if result.Data == "" {
+				continue
+			}
+			found = true
+			if result.Data != fmt.Sprintf("%x", data) ||
+				result.Amount != 0 {
+
+				t.Fatalf("unexpected null-data result %v",
+					result)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to list transaction: %v", err)
+	}
+	if !found {
+		t.Fatalf("null-data output not listed")
+	}
+}
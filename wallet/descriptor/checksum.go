@@ -0,0 +1,79 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package descriptor
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// checksumInputCharset is the character set of descriptors.  The
+	// position of each character selects the symbols it is encoded as in
+	// the checksum input.
+	checksumInputCharset = "0123456789()[],'/*abcdefgh@:$%{}" +
+		"IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~" +
+		"ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+
+	// checksumCharset is the character set of the checksum symbols.
+	checksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// checksumLen is the number of characters of a checksum.
+	checksumLen = 8
+)
+
+// checksumGenerator holds the generator of the BCH code of the checksum.
+var checksumGenerator = [5]uint64{
+	0xf5dee51989, 0xa9fdca3312, 0x1bae9d9ca2, 0x3706b1677a, 0x644d626ffd,
+}
+
+// polyMod feeds the symbol value to the checksum c.
+func polyMod(c uint64, value int) uint64 {
+	top := c >> 35
+	c = (c&0x7ffffffff)<<5 ^ uint64(value)
+	for i, g := range checksumGenerator {
+		if top>>uint(i)&1 == 1 {
+			c ^= g
+		}
+	}
+	return c
+}
+
+// Checksum returns the checksum of a descriptor without checksum, as defined
+// by BIP0380.
+func Checksum(desc string) (string, error) {
+	c := uint64(1)
+	var class, classCount int
+	for _, ch := range desc {
+		pos := strings.IndexRune(checksumInputCharset, ch)
+		if pos == -1 {
+			return "", fmt.Errorf("invalid descriptor character %q", ch)
+		}
+
+		// Each character contributes the lower five bits of its
+		// position, and every group of three characters contributes
+		// the classes of its characters.
+		c = polyMod(c, pos&31)
+		class = class*3 + pos>>5
+		classCount++
+		if classCount == 3 {
+			c = polyMod(c, class)
+			class, classCount = 0, 0
+		}
+	}
+	if classCount > 0 {
+		c = polyMod(c, class)
+	}
+	for i := 0; i < checksumLen; i++ {
+		c = polyMod(c, 0)
+	}
+	c ^= 1
+
+	checksum := make([]byte, checksumLen)
+	for i := range checksum {
+		checksum[i] = checksumCharset[c>>(5*uint(checksumLen-1-i))&31]
+	}
+	return string(checksum), nil
+}
@@ -0,0 +1,458 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package descriptor implements parsing and formatting of the output script
+// descriptors of BIP0380 which describe the outputs watched by a wallet.
+//
+// Only the descriptors of output scripts of this chain are supported:
+//
+//	pkh(KEY)                      pay-to-pubkey-hash outputs
+//	sh(multi(k,KEY,...))          pay-to-script-hash multisig outputs
+//	sh(sortedmulti(k,KEY,...))    multisig outputs with sorted keys
+//
+// A KEY is a hex-encoded compressed public key or an extended public key,
+// optionally preceded by its origin, e.g. [d34db33f/44'/0'/0'], and followed
+// by unhardened derivation steps.  Extended public keys of accounts end with
+// /<0;1>/*, which describes both the external and internal branches of the
+// account, and such descriptors are said to be ranged.  Private keys are not
+// supported as descriptors are only used to watch outputs.
+package descriptor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
+)
+
+// MaxMultiSigKeys is the maximum number of keys of a multisig descriptor.  The
+// redeem script with this many compressed public keys still fits in a standard
+// pay-to-script-hash signature script.
+const MaxMultiSigKeys = 15
+
+// rangedSuffix is the derivation suffix of ranged account keys, which derive
+// the addresses of the external and internal branches.
+const rangedSuffix = "/<0;1>/*"
+
+var (
+	// ErrChecksum is returned when the checksum of a descriptor does not
+	// match the descriptor.
+	ErrChecksum = errors.New("invalid descriptor checksum")
+
+	// ErrPrivateKey is returned when a descriptor contains a private key.
+	ErrPrivateKey = errors.New("descriptors with private keys are not " +
+		"supported")
+)
+
+// Type identifies the output script described by a descriptor.
+type Type uint8
+
+const (
+	// PubKeyHash describes pay-to-pubkey-hash outputs as pkh(KEY).
+	PubKeyHash Type = iota
+
+	// MultiSig describes pay-to-script-hash outputs of multisig scripts
+	// with the keys in the given order as sh(multi(k,KEY,...)).
+	MultiSig
+
+	// SortedMultiSig describes pay-to-script-hash outputs of multisig
+	// scripts with the keys sorted as in BIP0067 as
+	// sh(sortedmulti(k,KEY,...)).
+	SortedMultiSig
+)
+
+// KeyOrigin is the origin of a key, made of the fingerprint of the master key
+// it is derived from and its derivation path.
+type KeyOrigin struct {
+	// Fingerprint is the fingerprint of the master key, which is the first
+	// four bytes of the hash160 of its public key, read in little-endian
+	// order as done for the BIP0032 derivations of PSBTs.
+	Fingerprint uint32
+
+	// Path is the derivation path of the key from the master key.
+	Path []uint32
+}
+
+// String encodes the key origin as the fingerprint followed by the path, using
+// ' to mark hardened steps, enclosed in square brackets.
+func (o *KeyOrigin) String() string {
+	return "[" + o.FingerprintString() + formatPath(o.Path) + "]"
+}
+
+// FingerprintString encodes the fingerprint of the master key as the
+// hexadecimal encoding of its four bytes.
+func (o *KeyOrigin) FingerprintString() string {
+	var fingerprint [4]byte
+	binary.LittleEndian.PutUint32(fingerprint[:], o.Fingerprint)
+	return hex.EncodeToString(fingerprint[:])
+}
+
+// PathString encodes the derivation path of the key from the master key m,
+// using ' to mark hardened steps.
+func (o *KeyOrigin) PathString() string {
+	return "m" + formatPath(o.Path)
+}
+
+// Key is a key expression of a descriptor.  Exactly one of PubKey and
+// ExtendedKey is set.
+type Key struct {
+	// Origin is the origin of the key, if known.
+	Origin *KeyOrigin
+
+	// PubKey is the public key of a key expression which is not an
+	// extended key.
+	PubKey *czzec.PublicKey
+
+	// ExtendedKey is the extended public key of the key expression.
+	ExtendedKey *hdkeychain.ExtendedKey
+
+	// Path holds the unhardened derivation steps of the key from the
+	// extended key.  It does not include the branch and index of ranged
+	// keys.
+	Path []uint32
+
+	// Ranged is true for extended keys of accounts which derive the keys
+	// of the external and internal branches.
+	Ranged bool
+}
+
+// String encodes the key expression.
+func (k *Key) String() string {
+	var s string
+	if k.Origin != nil {
+		s = k.Origin.String()
+	}
+	if k.PubKey != nil {
+		return s + hex.EncodeToString(k.PubKey.SerializeCompressed())
+	}
+	s += k.ExtendedKey.String() + formatPath(k.Path)
+	if k.Ranged {
+		s += rangedSuffix
+	}
+	return s
+}
+
+// DerivePubKey returns the public key of a key which is not ranged, deriving
+// it from the extended key along the path of the key when needed.
+func (k *Key) DerivePubKey() (*czzec.PublicKey, error) {
+	if k.PubKey != nil {
+		return k.PubKey, nil
+	}
+	if k.Ranged {
+		return nil, errors.New("ranged keys do not have a single " +
+			"public key")
+	}
+
+	key := k.ExtendedKey
+	for _, index := range k.Path {
+		var err error
+		key, err = key.DeriveNonStandard(index) // nolint:staticcheck
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key.ECPubKey()
+}
+
+// Descriptor is a parsed output script descriptor.
+type Descriptor struct {
+	// Type is the type of output script described.
+	Type Type
+
+	// RequiredSigs is the number of signatures required by multisig
+	// scripts.  It is zero for other types.
+	RequiredSigs int
+
+	// Keys holds the keys of the output script in the order of the
+	// descriptor.
+	Keys []*Key
+}
+
+// Ranged returns whether the descriptor describes the outputs of the external
+// and internal branches of accounts rather than a single output script.  The
+// keys of a descriptor are either all ranged or none of them.
+func (d *Descriptor) Ranged() bool {
+	return d.Keys[0].Ranged
+}
+
+// String encodes the descriptor, including its checksum.
+func (d *Descriptor) String() string {
+	var s string
+	switch d.Type {
+	case PubKeyHash:
+		s = "pkh(" + d.Keys[0].String() + ")"
+	default:
+		fn := "multi"
+		if d.Type == SortedMultiSig {
+			fn = "sortedmulti"
+		}
+		keys := make([]string, len(d.Keys))
+		for i, key := range d.Keys {
+			keys[i] = key.String()
+		}
+		s = fmt.Sprintf("sh(%s(%d,%s))", fn, d.RequiredSigs,
+			strings.Join(keys, ","))
+	}
+
+	// The descriptor only consists of characters of the checksum input
+	// character set, so the error is never returned.
+	checksum, _ := Checksum(s)
+	return s + "#" + checksum
+}
+
+// PubKeys returns the public keys of a descriptor which is not ranged in the
+// order of the descriptor.
+func (d *Descriptor) PubKeys() ([]*czzec.PublicKey, error) {
+	pubKeys := make([]*czzec.PublicKey, len(d.Keys))
+	for i, key := range d.Keys {
+		pubKey, err := key.DerivePubKey()
+		if err != nil {
+			return nil, err
+		}
+		pubKeys[i] = pubKey
+	}
+	return pubKeys, nil
+}
+
+// RedeemScript returns the multisig redeem script of a multisig descriptor
+// which is not ranged.
+func (d *Descriptor) RedeemScript(params *chaincfg.Params) ([]byte, error) {
+	if d.Type != MultiSig && d.Type != SortedMultiSig {
+		return nil, errors.New("descriptor does not describe a " +
+			"multisig script")
+	}
+	pubKeys, err := d.PubKeys()
+	if err != nil {
+		return nil, err
+	}
+	if d.Type == SortedMultiSig {
+		sort.Slice(pubKeys, func(i, j int) bool {
+			return bytes.Compare(
+				pubKeys[i].SerializeCompressed(),
+				pubKeys[j].SerializeCompressed(),
+			) < 0
+		})
+	}
+
+	pubKeyAddrs := make([]*czzutil.AddressPubKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pubKeyAddrs[i], err = czzutil.NewAddressPubKey(
+			pubKey.SerializeCompressed(), params,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return txscript.MultiSigScript(pubKeyAddrs, d.RequiredSigs)
+}
+
+// Parse parses an output script descriptor.  The checksum of the descriptor is
+// optional, but is checked when present.
+func Parse(desc string) (*Descriptor, error) {
+	desc = strings.TrimSpace(desc)
+	if i := strings.IndexByte(desc, '#'); i != -1 {
+		checksum, err := Checksum(desc[:i])
+		if err != nil {
+			return nil, err
+		}
+		if desc[i+1:] != checksum {
+			return nil, ErrChecksum
+		}
+		desc = desc[:i]
+	}
+
+	if args, ok := unwrap(desc, "pkh"); ok {
+		key, err := parseKey(args)
+		if err != nil {
+			return nil, err
+		}
+		return &Descriptor{Type: PubKeyHash, Keys: []*Key{key}}, nil
+	}
+
+	script, ok := unwrap(desc, "sh")
+	if !ok {
+		return nil, fmt.Errorf("unsupported descriptor %q", desc)
+	}
+	if args, ok := unwrap(script, "multi"); ok {
+		return parseMultiSig(MultiSig, args)
+	}
+	if args, ok := unwrap(script, "sortedmulti"); ok {
+		return parseMultiSig(SortedMultiSig, args)
+	}
+	return nil, fmt.Errorf("unsupported script in descriptor %q", desc)
+}
+
+// unwrap returns the arguments of the function fn when s is a call of it.
+func unwrap(s, fn string) (string, bool) {
+	if !strings.HasPrefix(s, fn+"(") || !strings.HasSuffix(s, ")") {
+		return "", false
+	}
+	return s[len(fn)+1 : len(s)-1], true
+}
+
+// parseMultiSig parses the arguments of a multisig script of a descriptor.
+func parseMultiSig(typ Type, args string) (*Descriptor, error) {
+	parts := strings.Split(args, ",")
+	if len(parts) < 2 {
+		return nil, errors.New("multisig descriptor must have a " +
+			"threshold and at least one key")
+	}
+	if len(parts)-1 > MaxMultiSigKeys {
+		return nil, fmt.Errorf("multisig descriptor must have at "+
+			"most %d keys", MaxMultiSigKeys)
+	}
+	nRequired, err := strconv.Atoi(parts[0])
+	if err != nil || nRequired < 1 || nRequired > len(parts)-1 {
+		return nil, fmt.Errorf("invalid multisig threshold %q",
+			parts[0])
+	}
+
+	d := &Descriptor{
+		Type:         typ,
+		RequiredSigs: nRequired,
+		Keys:         make([]*Key, 0, len(parts)-1),
+	}
+	for _, part := range parts[1:] {
+		key, err := parseKey(part)
+		if err != nil {
+			return nil, err
+		}
+		if len(d.Keys) > 0 && key.Ranged != d.Keys[0].Ranged {
+			return nil, errors.New("multisig descriptor keys must " +
+				"either all be ranged or none of them")
+		}
+		d.Keys = append(d.Keys, key)
+	}
+	return d, nil
+}
+
+// parseKey parses a key expression.
+func parseKey(s string) (*Key, error) {
+	var key Key
+	if strings.HasPrefix(s, "[") {
+		end := strings.IndexByte(s, ']')
+		if end == -1 {
+			return nil, fmt.Errorf("unterminated key origin in %q", s)
+		}
+		origin, err := parseKeyOrigin(s[1:end])
+		if err != nil {
+			return nil, err
+		}
+		key.Origin = origin
+		s = s[end+1:]
+	}
+
+	if strings.HasSuffix(s, rangedSuffix) {
+		key.Ranged = true
+		s = strings.TrimSuffix(s, rangedSuffix)
+	}
+	steps := strings.Split(s, "/")
+
+	// Keys which are not extended keys are hex-encoded public keys.
+	if serializedPubKey, err := hex.DecodeString(steps[0]); err == nil {
+		if len(steps) > 1 || key.Ranged {
+			return nil, fmt.Errorf("public key %q cannot be "+
+				"derived from", steps[0])
+		}
+		if len(serializedPubKey) != czzec.PubKeyBytesLenCompressed {
+			return nil, fmt.Errorf("public key %q must be "+
+				"compressed", steps[0])
+		}
+		key.PubKey, err = czzec.ParsePubKey(
+			serializedPubKey, czzec.S256(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q: %v",
+				steps[0], err)
+		}
+		return &key, nil
+	}
+
+	extendedKey, err := hdkeychain.NewKeyFromString(steps[0])
+	if err != nil {
+		if _, err := czzutil.DecodeWIF(steps[0]); err == nil {
+			return nil, ErrPrivateKey
+		}
+		return nil, fmt.Errorf("invalid key %q: %v", steps[0], err)
+	}
+	if extendedKey.IsPrivate() {
+		return nil, ErrPrivateKey
+	}
+	key.ExtendedKey = extendedKey
+
+	key.Path, err = parsePath(steps[1:])
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range key.Path {
+		if index >= hdkeychain.HardenedKeyStart {
+			return nil, errors.New("hardened derivation from " +
+				"extended public keys is not possible")
+		}
+	}
+	return &key, nil
+}
+
+// parseKeyOrigin parses the contents of the square brackets of a key origin.
+func parseKeyOrigin(s string) (*KeyOrigin, error) {
+	steps := strings.Split(s, "/")
+	fingerprint, err := hex.DecodeString(steps[0])
+	if err != nil || len(fingerprint) != 4 {
+		return nil, fmt.Errorf("invalid key origin fingerprint %q",
+			steps[0])
+	}
+	path, err := parsePath(steps[1:])
+	if err != nil {
+		return nil, err
+	}
+	return &KeyOrigin{
+		Fingerprint: binary.LittleEndian.Uint32(fingerprint),
+		Path:        path,
+	}, nil
+}
+
+// parsePath parses the steps of a derivation path.  Hardened steps are marked
+// with ' or h.
+func parsePath(steps []string) ([]uint32, error) {
+	path := make([]uint32, 0, len(steps))
+	for _, step := range steps {
+		var hardened bool
+		if strings.HasSuffix(step, "'") || strings.HasSuffix(step, "h") {
+			hardened = true
+			step = step[:len(step)-1]
+		}
+		index, err := strconv.ParseUint(step, 10, 32)
+		if err != nil || index >= hdkeychain.HardenedKeyStart {
+			return nil, fmt.Errorf("invalid derivation step %q", step)
+		}
+		if hardened {
+			index += hdkeychain.HardenedKeyStart
+		}
+		path = append(path, uint32(index))
+	}
+	return path, nil
+}
+
+// formatPath encodes the steps of a derivation path, each preceded by a slash.
+func formatPath(path []uint32) string {
+	var s strings.Builder
+	for _, index := range path {
+		if index >= hdkeychain.HardenedKeyStart {
+			fmt.Fprintf(&s, "/%d'", index-hdkeychain.HardenedKeyStart)
+			continue
+		}
+		fmt.Fprintf(&s, "/%d", index)
+	}
+	return s.String()
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package descriptor
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/czzutil/hdkeychain"
+)
+
+const (
+	// xpub1 and xpub2 are the extended public keys m/0' and m/0'/1 of the
+	// first test vector of BIP0032, whose master key has the fingerprint
+	// 3442193e.
+	xpub1 = "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6" +
+		"LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+	xpub2 = "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHK" +
+		"kNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ"
+	xprv = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiC" +
+		"hkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+
+	// pubKey2 and pubKey3 are the compressed public keys of the private
+	// keys 2 and 3.
+	pubKey2 = "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5"
+	pubKey3 = "02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9"
+)
+
+// TestChecksum checks descriptor checksums against the reference
+// implementation of BIP0380.
+func TestChecksum(t *testing.T) {
+	tests := []struct {
+		desc     string
+		checksum string
+	}{
+		{"raw(deadbeef)", "rgu0jqqx"},
+		{"pkh(" + pubKey2 + ")", "a727nxwz"},
+		{"sh(multi(2," + pubKey3 + "," + pubKey2 + "))", "3x8yu9d5"},
+	}
+	for _, test := range tests {
+		checksum, err := Checksum(test.desc)
+		if err != nil {
+			t.Fatalf("%s: unable to compute checksum: %v", test.desc,
+				err)
+		}
+		if checksum != test.checksum {
+			t.Fatalf("%s: expected checksum %s, got %s", test.desc,
+				test.checksum, checksum)
+		}
+	}
+
+	if _, err := Checksum("pkh(ü)"); err == nil {
+		t.Fatalf("computed checksum of invalid descriptor")
+	}
+}
+
+// TestParse checks that descriptors are parsed into their keys, and encoded
+// again in their canonical form.
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		desc      string
+		canonical string
+		typ       Type
+		nRequired int
+		numKeys   int
+		ranged    bool
+	}{{
+		name:      "pkh public key",
+		desc:      "pkh(" + pubKey2 + ")",
+		canonical: "pkh(" + pubKey2 + ")#a727nxwz",
+		typ:       PubKeyHash,
+		numKeys:   1,
+	}, {
+		name:      "pkh derived key",
+		desc:      "pkh([3442193e/0h]" + xpub1 + "/1)",
+		canonical: "pkh([3442193e/0']" + xpub1 + "/1)#3w8ul4d5",
+		typ:       PubKeyHash,
+		numKeys:   1,
+	}, {
+		name:      "pkh account",
+		desc:      "pkh([3442193e/0']" + xpub1 + "/<0;1>/*)#tfasemu6",
+		canonical: "pkh([3442193e/0']" + xpub1 + "/<0;1>/*)#tfasemu6",
+		typ:       PubKeyHash,
+		numKeys:   1,
+		ranged:    true,
+	}, {
+		name:      "multisig",
+		desc:      "sh(multi(2," + pubKey3 + "," + pubKey2 + "))",
+		canonical: "sh(multi(2," + pubKey3 + "," + pubKey2 + "))#3x8yu9d5",
+		typ:       MultiSig,
+		nRequired: 2,
+		numKeys:   2,
+	}, {
+		name: "sorted multisig accounts",
+		desc: "sh(sortedmulti(1," + xpub1 + "/<0;1>/*," + xpub2 +
+			"/<0;1>/*))",
+		canonical: "sh(sortedmulti(1," + xpub1 + "/<0;1>/*," + xpub2 +
+			"/<0;1>/*))#uy4auvye",
+		typ:       SortedMultiSig,
+		nRequired: 1,
+		numKeys:   2,
+		ranged:    true,
+	}}
+
+	for _, test := range tests {
+		desc, err := Parse(test.desc)
+		if err != nil {
+			t.Fatalf("%s: unable to parse descriptor: %v", test.name,
+				err)
+		}
+		if desc.Type != test.typ || desc.RequiredSigs != test.nRequired ||
+			len(desc.Keys) != test.numKeys ||
+			desc.Ranged() != test.ranged {
+
+			t.Fatalf("%s: unexpected descriptor %+v", test.name, desc)
+		}
+		if desc.String() != test.canonical {
+			t.Fatalf("%s: expected descriptor %s, got %s", test.name,
+				test.canonical, desc.String())
+		}
+	}
+}
+
+// TestKeyOrigin checks the encoding of the parts of key origins.
+func TestKeyOrigin(t *testing.T) {
+	desc, err := Parse("pkh([3442193e/0h/1]" + xpub2 + ")")
+	if err != nil {
+		t.Fatalf("unable to parse descriptor: %v", err)
+	}
+	origin := desc.Keys[0].Origin
+	if origin.FingerprintString() != "3442193e" {
+		t.Fatalf("unexpected fingerprint %s", origin.FingerprintString())
+	}
+	if origin.PathString() != "m/0'/1" {
+		t.Fatalf("unexpected path %s", origin.PathString())
+	}
+	if origin.String() != "[3442193e/0'/1]" {
+		t.Fatalf("unexpected key origin %s", origin)
+	}
+}
+
+// TestParseErrors checks that invalid and unsupported descriptors are
+// rejected.
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+		err  error
+	}{
+		{"checksum", "pkh(" + pubKey2 + ")#a727nxwq", ErrChecksum},
+		{"private key", "pkh(" + xprv + "/<0;1>/*)", ErrPrivateKey},
+		{"witness", "wpkh(" + pubKey2 + ")", nil},
+		{"bare multisig", "multi(1," + pubKey2 + ")", nil},
+		{"uncompressed", "pkh(0479be667ef9dcbbac55a06295ce870b07029bfcdb2" +
+			"dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd" +
+			"17b448a68554199c47d08ffb10d4b8)", nil},
+		{"derived public key", "pkh(" + pubKey2 + "/0)", nil},
+		{"hardened step", "pkh(" + xpub1 + "/0'/1)", nil},
+		{"single branch", "pkh(" + xpub1 + "/0/*)", nil},
+		{"origin", "pkh([3442193/0']" + xpub1 + ")", nil},
+		{"threshold", "sh(multi(3," + pubKey2 + "," + pubKey3 + "))", nil},
+		{"mixed ranges", "sh(multi(1," + xpub1 + "/<0;1>/*," + pubKey2 +
+			"))", nil},
+		{"no keys", "sh(multi(1))", nil},
+	}
+	for _, test := range tests {
+		_, err := Parse(test.desc)
+		if err == nil {
+			t.Fatalf("%s: parsed invalid descriptor", test.name)
+		}
+		if test.err != nil && !errors.Is(err, test.err) {
+			t.Fatalf("%s: expected %v, got %v", test.name, test.err,
+				err)
+		}
+	}
+}
+
+// TestDerive checks the keys and scripts of descriptors which are not ranged.
+func TestDerive(t *testing.T) {
+	desc, err := Parse("pkh(" + xpub1 + "/1)")
+	if err != nil {
+		t.Fatalf("unable to parse descriptor: %v", err)
+	}
+	pubKeys, err := desc.PubKeys()
+	if err != nil {
+		t.Fatalf("unable to derive public keys: %v", err)
+	}
+	key, err := hdkeychain.NewKeyFromString(xpub2)
+	if err != nil {
+		t.Fatalf("unable to parse key: %v", err)
+	}
+	pubKey, err := key.ECPubKey()
+	if err != nil {
+		t.Fatalf("unable to get public key: %v", err)
+	}
+	if !pubKeys[0].IsEqual(pubKey) {
+		t.Fatalf("derived key %x, expected %x",
+			pubKeys[0].SerializeCompressed(),
+			pubKey.SerializeCompressed())
+	}
+
+	// The keys of sorted multisig scripts are sorted, while the keys of
+	// other multisig scripts keep their order.
+	multi, err := Parse("sh(multi(1," + pubKey2 + "," + pubKey3 + "))")
+	if err != nil {
+		t.Fatalf("unable to parse descriptor: %v", err)
+	}
+	sorted, err := Parse("sh(sortedmulti(1," + pubKey3 + "," + pubKey2 +
+		"))")
+	if err != nil {
+		t.Fatalf("unable to parse descriptor: %v", err)
+	}
+	reversed, err := Parse("sh(multi(1," + pubKey3 + "," + pubKey2 + "))")
+	if err != nil {
+		t.Fatalf("unable to parse descriptor: %v", err)
+	}
+	params := &chaincfg.MainNetParams
+	multiScript, err := multi.RedeemScript(params)
+	if err != nil {
+		t.Fatalf("unable to create redeem script: %v", err)
+	}
+	sortedScript, err := sorted.RedeemScript(params)
+	if err != nil {
+		t.Fatalf("unable to create redeem script: %v", err)
+	}
+	reversedScript, err := reversed.RedeemScript(params)
+	if err != nil {
+		t.Fatalf("unable to create redeem script: %v", err)
+	}
+	if !bytes.Equal(multiScript, sortedScript) {
+		t.Fatalf("sorted multisig script does not have sorted keys")
+	}
+	if bytes.Equal(multiScript, reversedScript) {
+		t.Fatalf("multisig script does not keep the order of its keys")
+	}
+	if !strings.HasPrefix(multi.String(), "sh(multi(1,"+pubKey2) {
+		t.Fatalf("unexpected descriptor %s", multi)
+	}
+
+	if _, err := desc.RedeemScript(params); err == nil {
+		t.Fatalf("created redeem script of pkh descriptor")
+	}
+}
@@ -0,0 +1,496 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/chain"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/descriptor"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// descriptorLookahead is the number of addresses derived on each branch of the
+// account created for a ranged descriptor when it is imported.  It is the gap
+// limit of BIP0044.
+const descriptorLookahead = 20
+
+// ErrNoAccountPubKey is returned when the extended public key of an account
+// without one, such as a multisig account or the imported account, is
+// requested.
+var ErrNoAccountPubKey = errors.New("account has no account public key")
+
+// AccountDescriptor is the output script descriptor of an account.
+type AccountDescriptor struct {
+	// KeyScope is the key scope of the account.
+	KeyScope waddrmgr.KeyScope
+
+	// AccountNumber is the number of the account.
+	AccountNumber uint32
+
+	// AccountName is the name of the account.
+	AccountName string
+
+	// Descriptor describes the outputs of the external and internal
+	// branches of the account.
+	Descriptor *descriptor.Descriptor
+}
+
+// ImportDescriptor imports the outputs described by an output script
+// descriptor so that they are watched by the wallet.
+//
+// Ranged descriptors create the account with the given name, and the properties
+// of the account are returned.  A pkh() descriptor of an account public key
+// creates a watch-only account in the key scope of the purpose and coin type of
+// the key origin, which is created if needed, or in the BIP0044 key scope when
+// the key has no origin.  A sh(sortedmulti()) descriptor of account public keys
+// creates a multisig account of the BIP0044 key scope.  The first
+// descriptorLookahead addresses of both branches of the account are derived, as
+// they may already have been handed out by the owner of the account keys.
+//
+// Other descriptors import their public key or multisig redeem script into the
+// imported account, and no account properties are returned.
+//
+// The imported addresses are watched for new transactions, which requires a
+// chain client.  Unless the birthday is zero, the wallet is also rescanned for
+// previous outputs paying to them from the block matching the birthday, and the
+// wallet birthday is moved back to that block if needed.  The rescan is not
+// waited on.
+func (w *Wallet) ImportDescriptor(desc *descriptor.Descriptor, name string,
+	birthday time.Time) (*waddrmgr.AccountProperties, error) {
+
+	for _, key := range desc.Keys {
+		if key.ExtendedKey != nil && !w.isPubKeyForNet(key.ExtendedKey) {
+			return nil, fmt.Errorf("expected extended public key "+
+				"for current network %v", w.chainParams.Name)
+		}
+	}
+
+	// The chain client is required to watch the imported addresses, and
+	// the block of the birthday is located before anything is imported.
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+	var bs *waddrmgr.BlockStamp
+	if !birthday.IsZero() {
+		bs, err = locateBirthdayBlock(chainClient, birthday)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !desc.Ranged() {
+		addr, err := w.importDescriptorScript(desc)
+		if err != nil || addr == nil {
+			return nil, err
+		}
+		addrs := []czzutil.Address{addr}
+		return nil, w.watchDescriptorAddresses(chainClient, addrs, bs)
+	}
+
+	// The account is created and its addresses derived at once, so that a
+	// failure does not leave an account behind without its addresses.
+	var (
+		props *waddrmgr.AccountProperties
+		addrs []czzutil.Address
+	)
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		var err error
+		switch desc.Type {
+		case descriptor.PubKeyHash:
+			props, err = w.importDescriptorAccount(
+				ns, desc.Keys[0], name,
+			)
+
+		case descriptor.SortedMultiSig:
+			cosignerKeys := make(
+				[]*hdkeychain.ExtendedKey, len(desc.Keys),
+			)
+			for i, key := range desc.Keys {
+				cosignerKeys[i] = key.ExtendedKey
+			}
+			props, err = w.newMultiSigAccount(
+				ns, waddrmgr.KeyScopeBIP0044, name,
+				desc.RequiredSigs, cosignerKeys,
+			)
+
+		default:
+			return errors.New("ranged multisig descriptors must " +
+				"sort their keys with sortedmulti")
+		}
+		if err != nil {
+			return err
+		}
+
+		addrs, err = w.deriveDescriptorAddresses(ns, props)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return props, w.watchDescriptorAddresses(chainClient, addrs, bs)
+}
+
+// importDescriptorAccount creates a watch-only account for the account public
+// key of a ranged pkh() descriptor.
+func (w *Wallet) importDescriptorAccount(ns walletdb.ReadWriteBucket,
+	key *descriptor.Key, name string) (*waddrmgr.AccountProperties, error) {
+
+	if len(key.Path) != 0 {
+		return nil, errors.New("account keys of ranged descriptors " +
+			"must not be derived from")
+	}
+
+	// The key scope is given by the key origin, whose path must be the
+	// one of the account key.
+	scope := waddrmgr.KeyScopeBIP0044
+	var masterKeyFingerprint uint32
+	if key.Origin != nil {
+		path := key.Origin.Path
+		if len(path) != 3 || path[0] < hdkeychain.HardenedKeyStart ||
+			path[1] < hdkeychain.HardenedKeyStart ||
+			path[2] != key.ExtendedKey.ChildIndex() {
+
+			return nil, errors.New("key origin must be the " +
+				"account path m/purpose'/coin_type'/account'")
+		}
+		scope = waddrmgr.KeyScope{
+			Purpose: path[0] - hdkeychain.HardenedKeyStart,
+			Coin:    path[1] - hdkeychain.HardenedKeyStart,
+		}
+		masterKeyFingerprint = key.Origin.Fingerprint
+	}
+
+	return w.importAccountWithScope(
+		ns, name, key.ExtendedKey, masterKeyFingerprint, scope,
+	)
+}
+
+// importDescriptorScript imports the public key or multisig redeem script of a
+// descriptor which is not ranged into the imported account, and returns its
+// address.  Keys and scripts already known to the wallet are skipped, and no
+// address is returned for them.
+func (w *Wallet) importDescriptorScript(
+	desc *descriptor.Descriptor) (czzutil.Address, error) {
+
+	var addr czzutil.Address
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		if desc.Type != descriptor.PubKeyHash {
+			script, err := desc.RedeemScript(w.chainParams)
+			if err != nil {
+				return err
+			}
+			addr, err = w.importP2SHRedeemScript(ns, script)
+			return err
+		}
+
+		pubKey, err := desc.Keys[0].DerivePubKey()
+		if err != nil {
+			return err
+		}
+		manager, err := w.Manager.FetchScopedKeyManager(
+			waddrmgr.KeyScopeBIP0044,
+		)
+		if err != nil {
+			return err
+		}
+		maddr, err := manager.ImportPublicKey(ns, pubKey, nil)
+		if waddrmgr.IsError(err, waddrmgr.ErrDuplicateAddress) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		addr = maddr.Address()
+		return nil
+	})
+	if err != nil || addr == nil {
+		return nil, err
+	}
+
+	log.Infof("Imported address %v", addr)
+
+	return addr, nil
+}
+
+// deriveDescriptorAddresses derives the first descriptorLookahead addresses of
+// the external and internal branches of the account of a ranged descriptor.
+func (w *Wallet) deriveDescriptorAddresses(ns walletdb.ReadWriteBucket,
+	props *waddrmgr.AccountProperties) ([]czzutil.Address, error) {
+
+	scopedMgr, err := w.Manager.FetchScopedKeyManager(props.KeyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	external, err := scopedMgr.NextExternalAddresses(
+		ns, props.AccountNumber, descriptorLookahead,
+	)
+	if err != nil {
+		return nil, err
+	}
+	internal, err := scopedMgr.NextInternalAddresses(
+		ns, props.AccountNumber, descriptorLookahead,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]czzutil.Address, 0, len(external)+len(internal))
+	for _, maddr := range append(external, internal...) {
+		addrs = append(addrs, maddr.Address())
+	}
+	return addrs, nil
+}
+
+// watchDescriptorAddresses watches the addresses of an imported descriptor for
+// new transactions.  When the block stamp of the birthday of the descriptor is
+// given, the wallet birthday is moved back to it if needed, and a rescan of the
+// addresses from that block is started instead.
+func (w *Wallet) watchDescriptorAddresses(chainClient chain.Interface,
+	addrs []czzutil.Address, bs *waddrmgr.BlockStamp) error {
+
+	if bs == nil {
+		err := chainClient.NotifyReceived(addrs)
+		if err != nil {
+			return fmt.Errorf("unable to subscribe for address "+
+				"notifications: %v", err)
+		}
+		return nil
+	}
+
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.moveBirthdayBack(ns, bs)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The rescan success or failure is logged elsewhere, so the result
+	// channel is not waited on.
+	_ = w.SubmitRescan(&RescanJob{
+		Addrs:      addrs,
+		BlockStamp: *bs,
+	})
+	return nil
+}
+
+// AccountDescriptors returns the descriptors of the accounts of every key scope
+// of the wallet.  The imported accounts, which hold single keys and scripts,
+// have no descriptors.
+//
+// Account keys include their key origin when it is known: the keys of accounts
+// derived by the wallet originate from its master key, while imported accounts
+// originate from the master key whose fingerprint was provided when they were
+// imported.  The keys of multisig accounts only include their origin
+// when they are the account keys of other accounts of the wallet.
+func (w *Wallet) AccountDescriptors() ([]*AccountDescriptor, error) {
+	var descs []*AccountDescriptor
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		masterKeyFingerprint, err := w.masterKeyFingerprint(ns)
+		if err != nil {
+			return err
+		}
+
+		for _, scopedMgr := range w.Manager.ActiveScopedKeyManagers() {
+			scopeDescs, err := scopeDescriptors(
+				ns, scopedMgr, masterKeyFingerprint,
+			)
+			if err != nil {
+				return err
+			}
+			descs = append(descs, scopeDescs...)
+		}
+		return nil
+	})
+	return descs, err
+}
+
+// scopeDescriptors returns the descriptors of the accounts of a key scope.  The
+// master key fingerprint is zero when the wallet does not know its master key.
+func scopeDescriptors(ns walletdb.ReadBucket,
+	scopedMgr *waddrmgr.ScopedKeyManager,
+	masterKeyFingerprint uint32) ([]*AccountDescriptor, error) {
+
+	scope := scopedMgr.Scope()
+	var accounts []*waddrmgr.AccountProperties
+	err := scopedMgr.ForEachAccount(ns, func(account uint32) error {
+		props, err := scopedMgr.AccountProperties(ns, account)
+		if err != nil {
+			return err
+		}
+		if props.AccountPubKey != nil || props.RequiredSigs != 0 {
+			accounts = append(accounts, props)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The key of each account is described with its origin, which is
+	// also used for the cosigner keys of multisig accounts matching it.
+	origins := make(map[string]*descriptor.KeyOrigin)
+	for _, props := range accounts {
+		if props.RequiredSigs != 0 {
+			continue
+		}
+		origin := accountKeyOrigin(props, masterKeyFingerprint)
+		if origin.Fingerprint == 0 {
+			continue
+		}
+		id, err := extendedPubKeyID(props.AccountPubKey)
+		if err != nil {
+			return nil, err
+		}
+		origins[id] = origin
+	}
+
+	descs := make([]*AccountDescriptor, 0, len(accounts))
+	for _, props := range accounts {
+		desc := &descriptor.Descriptor{Type: descriptor.PubKeyHash}
+		accountKeys := []*hdkeychain.ExtendedKey{props.AccountPubKey}
+		if props.RequiredSigs != 0 {
+			desc.Type = descriptor.SortedMultiSig
+			desc.RequiredSigs = props.RequiredSigs
+			accountKeys = props.CosignerKeys
+		}
+		for _, accountKey := range accountKeys {
+			id, err := extendedPubKeyID(accountKey)
+			if err != nil {
+				return nil, err
+			}
+			desc.Keys = append(desc.Keys, &descriptor.Key{
+				Origin:      origins[id],
+				ExtendedKey: accountKey,
+				Ranged:      true,
+			})
+		}
+
+		descs = append(descs, &AccountDescriptor{
+			KeyScope:      scope,
+			AccountNumber: props.AccountNumber,
+			AccountName:   props.AccountName,
+			Descriptor:    desc,
+		})
+	}
+	return descs, nil
+}
+
+// AccountXpub is the extended public key of an account together with the
+// origin of the key.
+type AccountXpub struct {
+	// KeyScope is the key scope of the account.
+	KeyScope waddrmgr.KeyScope
+
+	// AccountNumber is the number of the account.
+	AccountNumber uint32
+
+	// AccountName is the name of the account.
+	AccountName string
+
+	// AccountPubKey is the extended public key of the account.
+	AccountPubKey *hdkeychain.ExtendedKey
+
+	// Origin is the origin of the account key, whose path is
+	// m/purpose'/coin_type'/account'.  Its fingerprint is zero when the
+	// master key the account key is derived from is unknown.
+	Origin *descriptor.KeyOrigin
+}
+
+// AccountXpub returns the extended public key of an account along with the
+// fingerprint of the master key it is derived from and its derivation path, as
+// needed by watch-only wallets and external signers.  Multisig accounts and
+// the imported account have no account public key.
+func (w *Wallet) AccountXpub(scope waddrmgr.KeyScope,
+	account uint32) (*AccountXpub, error) {
+
+	scopedMgr, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var xpub *AccountXpub
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		props, err := scopedMgr.AccountProperties(ns, account)
+		if err != nil {
+			return err
+		}
+		if props.AccountPubKey == nil || props.RequiredSigs != 0 {
+			return ErrNoAccountPubKey
+		}
+		masterKeyFingerprint, err := w.masterKeyFingerprint(ns)
+		if err != nil {
+			return err
+		}
+
+		xpub = &AccountXpub{
+			KeyScope:      scope,
+			AccountNumber: account,
+			AccountName:   props.AccountName,
+			AccountPubKey: props.AccountPubKey,
+			Origin:        accountKeyOrigin(props, masterKeyFingerprint),
+		}
+		return nil
+	})
+	return xpub, err
+}
+
+// masterKeyFingerprint returns the fingerprint of the master key of the
+// wallet, or zero when the wallet is watching-only and does not know it.
+func (w *Wallet) masterKeyFingerprint(ns walletdb.ReadBucket) (uint32, error) {
+	fingerprint, err := w.Manager.MasterKeyFingerprint(ns)
+	if waddrmgr.IsError(err, waddrmgr.ErrWatchingOnly) {
+		return 0, nil
+	}
+	return fingerprint, err
+}
+
+// accountKeyOrigin returns the origin of the public key of an account.  The
+// keys of accounts derived by the wallet originate from its master key, while
+// the keys of imported accounts originate from the master key whose
+// fingerprint was provided when they were imported.
+func accountKeyOrigin(props *waddrmgr.AccountProperties,
+	masterKeyFingerprint uint32) *descriptor.KeyOrigin {
+
+	if props.IsImported {
+		masterKeyFingerprint = props.MasterKeyFingerprint
+	}
+	return &descriptor.KeyOrigin{
+		Fingerprint: masterKeyFingerprint,
+		Path: []uint32{
+			props.KeyScope.Purpose + hdkeychain.HardenedKeyStart,
+			props.KeyScope.Coin + hdkeychain.HardenedKeyStart,
+			props.AccountPubKey.ChildIndex(),
+		},
+	}
+}
+
+// extendedPubKeyID identifies an extended public key by its public key and
+// chain code, which determine the keys derived from it regardless of the
+// version of the key.
+func extendedPubKeyID(key *hdkeychain.ExtendedKey) (string, error) {
+	pubKey, err := key.ECPubKey()
+	if err != nil {
+		return "", err
+	}
+	return string(pubKey.SerializeCompressed()) + string(key.ChainCode()),
+		nil
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/descriptor"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// TestDescriptors checks that the account descriptors exported by a wallet
+// include the origin of the account keys, and that they are imported by
+// another wallet as watch-only accounts deriving the same addresses.
+func TestDescriptors(t *testing.T) {
+	w1, cleanup1 := testWallet(t)
+	defer cleanup1()
+	w2, cleanup2 := testWallet(t)
+	defer cleanup2()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	descs, err := w1.AccountDescriptors()
+	if err != nil {
+		t.Fatalf("unable to get account descriptors: %v", err)
+	}
+	if len(descs) != 1 || descs[0].AccountNumber != 0 ||
+		descs[0].KeyScope != scope {
+
+		t.Fatalf("unexpected account descriptors %v", descs)
+	}
+
+	var fingerprint uint32
+	err = walletdb.View(w1.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		fingerprint, err = w1.Manager.MasterKeyFingerprint(ns)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to get master key fingerprint: %v", err)
+	}
+	origin := descs[0].Descriptor.Keys[0].Origin
+	if origin == nil || origin.Fingerprint != fingerprint {
+		t.Fatalf("unexpected key origin %v", origin)
+	}
+	encoded := descs[0].Descriptor.String()
+	if !strings.HasPrefix(encoded, "pkh("+origin.String()) ||
+		!strings.Contains(encoded, "/44'/0'/0']") {
+
+		t.Fatalf("unexpected descriptor %s", encoded)
+	}
+
+	// The key origin does not depend on whether the wallet is locked.
+	w1.Lock()
+	if !w1.Locked() {
+		t.Fatalf("wallet is not locked")
+	}
+	descs, err = w1.AccountDescriptors()
+	if err != nil {
+		t.Fatalf("unable to get account descriptors: %v", err)
+	}
+	if descs[0].Descriptor.String() != encoded {
+		t.Fatalf("descriptor %s of locked wallet, expected %s",
+			descs[0].Descriptor, encoded)
+	}
+
+	// The second wallet imports the default account of the first one.
+	desc, err := descriptor.Parse(encoded)
+	if err != nil {
+		t.Fatalf("unable to parse descriptor: %v", err)
+	}
+	props, err := w2.ImportDescriptor(desc, "watched", time.Time{})
+	if err != nil {
+		t.Fatalf("unable to import descriptor: %v", err)
+	}
+	if props == nil || !props.IsWatchOnly || !props.IsImported ||
+		props.MasterKeyFingerprint != fingerprint {
+
+		t.Fatalf("unexpected account properties %v", props)
+	}
+
+	// The watch-only account derives the addresses of both branches up to
+	// the lookahead, which include the addresses of the first wallet.
+	addr1, err := w1.CurrentAddress(0, scope)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	watched, err := w2.AddressInfo(addr1)
+	if err != nil {
+		t.Fatalf("address %v is not watched: %v", addr1, err)
+	}
+	if watched.InternalAccount() != props.AccountNumber {
+		t.Fatalf("address %v watched by account %d, expected %d",
+			addr1, watched.InternalAccount(), props.AccountNumber)
+	}
+	addrs, err := w2.AccountAddresses(props.AccountNumber)
+	if err != nil {
+		t.Fatalf("unable to get account addresses: %v", err)
+	}
+	if len(addrs) != 2*descriptorLookahead {
+		t.Fatalf("watch-only account derived %d addresses, expected "+
+			"%d", len(addrs), 2*descriptorLookahead)
+	}
+
+	descs, err = w2.AccountDescriptors()
+	if err != nil {
+		t.Fatalf("unable to get account descriptors: %v", err)
+	}
+	if len(descs) != 2 || descs[1].AccountName != "watched" ||
+		descs[1].Descriptor.String() != encoded {
+
+		t.Fatalf("unexpected account descriptors %v", descs)
+	}
+
+	// Descriptors of single keys and scripts are imported into the
+	// imported account.
+	info, err := w1.AddressInfo(addr1)
+	if err != nil {
+		t.Fatalf("unable to get address info: %v", err)
+	}
+	pubKey := info.(waddrmgr.ManagedPubKeyAddress).PubKey()
+	pubKeyHex := hex.EncodeToString(pubKey.SerializeCompressed())
+
+	w3, cleanup3 := testWallet(t)
+	defer cleanup3()
+	for _, s := range []string{
+		"pkh(" + pubKeyHex + ")",
+		"sh(multi(1," + pubKeyHex + "))",
+	} {
+		desc, err := descriptor.Parse(s)
+		if err != nil {
+			t.Fatalf("unable to parse descriptor: %v", err)
+		}
+		props, err := w3.ImportDescriptor(desc, "", time.Time{})
+		if err != nil || props != nil {
+			t.Fatalf("unable to import descriptor %s: %v", s, err)
+		}
+	}
+	if _, err := w3.AddressInfo(addr1); err != nil {
+		t.Fatalf("imported address is unknown: %v", err)
+	}
+	desc, err = descriptor.Parse("sh(multi(1," + pubKeyHex + "))")
+	if err != nil {
+		t.Fatalf("unable to parse descriptor: %v", err)
+	}
+	script, err := desc.RedeemScript(w3.chainParams)
+	if err != nil {
+		t.Fatalf("unable to create redeem script: %v", err)
+	}
+	p2shAddr, err := czzutil.NewAddressScriptHash(script, w3.chainParams)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	if _, err := w3.AddressInfo(p2shAddr); err != nil {
+		t.Fatalf("imported script address is unknown: %v", err)
+	}
+
+	// Account keys with an origin of another key scope are imported into
+	// that key scope.
+	key := descs[0].Descriptor.Keys[0]
+	other := &descriptor.Descriptor{
+		Type: descriptor.PubKeyHash,
+		Keys: []*descriptor.Key{{
+			Origin: &descriptor.KeyOrigin{
+				Fingerprint: fingerprint,
+				Path: []uint32{
+					45 + hdkeychain.HardenedKeyStart,
+					hdkeychain.HardenedKeyStart,
+					key.ExtendedKey.ChildIndex(),
+				},
+			},
+			ExtendedKey: key.ExtendedKey,
+			Ranged:      true,
+		}},
+	}
+	props, err = w3.ImportDescriptor(other, "other", time.Time{})
+	if err != nil {
+		t.Fatalf("unable to import descriptor: %v", err)
+	}
+	if props.KeyScope != (waddrmgr.KeyScope{Purpose: 45}) {
+		t.Fatalf("account imported into key scope %v", props.KeyScope)
+	}
+
+	// Ranged multisig descriptors must sort their keys, as done for the
+	// addresses of multisig accounts.
+	desc, err = descriptor.Parse("sh(multi(1," +
+		key.ExtendedKey.String() + "/<0;1>/*))")
+	if err != nil {
+		t.Fatalf("unable to parse descriptor: %v", err)
+	}
+	_, err = w3.ImportDescriptor(desc, "multisig", time.Time{})
+	if err == nil {
+		t.Fatalf("imported ranged multisig descriptor without " +
+			"sorted keys")
+	}
+
+	// Without a chain client to watch its addresses, nothing of a
+	// descriptor is imported.
+	desc, err = descriptor.Parse(encoded)
+	if err != nil {
+		t.Fatalf("unable to parse descriptor: %v", err)
+	}
+	w3.chainClient = nil
+	if _, err := w3.ImportDescriptor(desc, "offline", time.Time{}); err == nil {
+		t.Fatalf("imported descriptor without a chain client")
+	}
+	if _, err := w3.AccountNumber(scope, "offline"); err == nil {
+		t.Fatalf("account created without a chain client")
+	}
+}
+
+// TestAccountXpub checks that the extended public key of an account is
+// returned with its key origin.
+func TestAccountXpub(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	xpub, err := w.AccountXpub(scope, 0)
+	if err != nil {
+		t.Fatalf("unable to get account xpub: %v", err)
+	}
+	props, err := w.AccountProperties(scope, 0)
+	if err != nil {
+		t.Fatalf("unable to get account properties: %v", err)
+	}
+	if xpub.AccountPubKey.String() != props.AccountPubKey.String() {
+		t.Fatalf("account xpub %v, expected %v", xpub.AccountPubKey,
+			props.AccountPubKey)
+	}
+
+	var fingerprint uint32
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		fingerprint, err = w.Manager.MasterKeyFingerprint(ns)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to get master key fingerprint: %v", err)
+	}
+	if xpub.Origin.Fingerprint != fingerprint {
+		t.Fatalf("master key fingerprint %08x, expected %08x",
+			xpub.Origin.Fingerprint, fingerprint)
+	}
+	if xpub.Origin.PathString() != "m/44'/0'/0'" {
+		t.Fatalf("unexpected derivation path %s",
+			xpub.Origin.PathString())
+	}
+
+	// The key origin does not depend on whether the wallet is locked.
+	w.Lock()
+	if !w.Locked() {
+		t.Fatalf("wallet is not locked")
+	}
+	xpub, err = w.AccountXpub(scope, 0)
+	if err != nil {
+		t.Fatalf("unable to get account xpub: %v", err)
+	}
+	if xpub.Origin.Fingerprint != fingerprint {
+		t.Fatalf("master key fingerprint %08x of locked wallet, "+
+			"expected %08x", xpub.Origin.Fingerprint, fingerprint)
+	}
+
+	// The imported account has no account public key.
+	_, err = w.AccountXpub(scope, waddrmgr.ImportedAddrAccount)
+	if err != ErrNoAccountPubKey {
+		t.Fatalf("expected ErrNoAccountPubKey, got %v", err)
+	}
+}
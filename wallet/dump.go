@@ -0,0 +1,660 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// BackupWallet writes a consistent snapshot of the wallet database to the file
+// at path while the wallet remains in use.  The snapshot is first written to a
+// temporary file in the same directory, which then replaces any existing file
+// at path, so that an interrupted backup never leaves a truncated file behind.
+func (w *Wallet) BackupWallet(path string) error {
+	dir, name := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	f, err := ioutil.TempFile(dir, name+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath)
+
+	err = w.db.Copy(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// dumpTimeFormat is the format of the key timestamps of a wallet dump.
+const dumpTimeFormat = time.RFC3339
+
+// DumpWallet writes a human readable export of the wallet's keys to out.  The
+// export lists the HD key chains of every account, followed by the private key
+// of every wallet address, in WIF, and the script of every pay-to-script-hash
+// address.  Addresses without a private key, such as those of watch-only
+// accounts and imported public keys, are exported with their public key
+// instead.  Keys record the name of their account and, if derived by the
+// wallet, their derivation path.  The wallet must be unlocked to export private
+// keys.
+//
+// The wallet does not track when individual keys were created, so every entry
+// carries the wallet birthday as its timestamp.  The export can be read back
+// with ImportWallet.
+func (w *Wallet) DumpWallet(out io.Writer) error {
+	return walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		syncedTo := w.Manager.SyncedTo()
+		birthday := w.Manager.Birthday()
+
+		// The birthday block is only known once the wallet has synced.
+		birthdayBlock := "not yet known"
+		block, _, err := w.Manager.BirthdayBlock(addrmgrNs)
+		switch {
+		case err == nil:
+			birthdayBlock = fmt.Sprintf("%d (%v)", block.Height,
+				block.Hash)
+		case !waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet):
+			return err
+		}
+
+		bw := bufio.NewWriter(out)
+		fmt.Fprintf(bw, "# Wallet dump created by czzwallet\n")
+		fmt.Fprintf(bw, "# * Created on %s\n",
+			time.Now().UTC().Format(dumpTimeFormat))
+		fmt.Fprintf(bw, "# * Network %s\n", w.chainParams.Name)
+		fmt.Fprintf(bw, "# * Best block at time of backup was %d (%v)\n",
+			syncedTo.Height, syncedTo.Hash)
+		fmt.Fprintf(bw, "# * Wallet birthday %s, block %s\n",
+			birthday.UTC().Format(dumpTimeFormat), birthdayBlock)
+		fmt.Fprintf(bw, "#\n")
+		fmt.Fprintf(bw, "# HD key chains.  Keys of these chains are "+
+			"derived from the wallet seed,\n# which is not part "+
+			"of this dump.\n")
+
+		scopedMgrs := w.Manager.ActiveScopedKeyManagers()
+		sort.Slice(scopedMgrs, func(i, j int) bool {
+			si, sj := scopedMgrs[i].Scope(), scopedMgrs[j].Scope()
+			if si.Purpose != sj.Purpose {
+				return si.Purpose < sj.Purpose
+			}
+			return si.Coin < sj.Coin
+		})
+
+		accounts := make([][]uint32, len(scopedMgrs))
+		for i, scopedMgr := range scopedMgrs {
+			err := scopedMgr.ForEachAccount(addrmgrNs, func(account uint32) error {
+				accounts[i] = append(accounts[i], account)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			sort.Slice(accounts[i], func(a, b int) bool {
+				return accounts[i][a] < accounts[i][b]
+			})
+
+			for _, account := range accounts[i] {
+				if account == waddrmgr.ImportedAddrAccount {
+					continue
+				}
+				props, err := scopedMgr.AccountProperties(
+					addrmgrNs, account,
+				)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(bw, "# * %s/%d' account %d %q: %d "+
+					"external and %d internal keys\n",
+					scopedMgr.Scope(), account, account,
+					props.AccountName, props.ExternalKeyCount,
+					props.InternalKeyCount)
+			}
+		}
+		fmt.Fprintf(bw, "\n")
+
+		timestamp := birthday.UTC().Format(dumpTimeFormat)
+		for i, scopedMgr := range scopedMgrs {
+			for _, account := range accounts[i] {
+				name, err := scopedMgr.AccountName(addrmgrNs, account)
+				if err != nil {
+					return err
+				}
+				label := "label=" + url.PathEscape(name)
+
+				err = scopedMgr.ForEachAccountAddress(addrmgrNs, account,
+					func(maddr waddrmgr.ManagedAddress) error {
+						return writeDumpEntry(bw, maddr, timestamp, label)
+					})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		fmt.Fprintf(bw, "\n# End of dump\n")
+		return bw.Flush()
+	})
+}
+
+// writeDumpEntry writes the wallet dump line of a single address.
+func writeDumpEntry(out io.Writer, maddr waddrmgr.ManagedAddress,
+	timestamp, label string) error {
+
+	addr := maddr.Address().EncodeAddress()
+
+	switch a := maddr.(type) {
+	case waddrmgr.ManagedPubKeyAddress:
+		keyInfo := label
+		if a.Internal() {
+			keyInfo = "change=1"
+		}
+		if scope, path, ok := a.DerivationInfo(); ok && !a.Imported() {
+			keyInfo += fmt.Sprintf(" hdkeypath=%s/%d'/%d/%d", scope,
+				path.Account-hdkeychain.HardenedKeyStart,
+				path.Branch, path.Index)
+		}
+
+		wif, err := a.ExportPrivKey()
+		if waddrmgr.IsError(err, waddrmgr.ErrWatchingOnly) {
+			_, err = fmt.Fprintf(out, "%s %s pubkey=1 %s # addr=%s\n",
+				a.ExportPubKey(), timestamp, keyInfo, addr)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "%s %s %s # addr=%s\n", wif,
+			timestamp, keyInfo, addr)
+		return err
+
+	case waddrmgr.ManagedScriptAddress:
+		script, err := a.Script()
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "%x %s script=1 %s # addr=%s\n",
+			script, timestamp, label, addr)
+		return err
+
+	default:
+		return nil
+	}
+}
+
+// dumpAccount identifies an account of a key scope.
+type dumpAccount struct {
+	scope   waddrmgr.KeyScope
+	account uint32
+}
+
+// dumpEntry is a key or script read from a wallet dump.  Only one of wif,
+// pubKey and script is set.  Public keys keep the compression of their dumped
+// serialization, which determines their address.  The derivation path is only
+// set for keys derived by the wallet the dump was created from.
+type dumpEntry struct {
+	wif        *czzutil.WIF
+	pubKey     *czzec.PublicKey
+	compressed bool
+	script     []byte
+	timestamp  time.Time
+	label      string
+	path       *waddrmgr.DerivationPath
+	account    dumpAccount
+}
+
+// publicKey returns the serialized public key of a key entry, compressed or
+// not as it was dumped, or nil for a script entry.
+func (e *dumpEntry) publicKey() []byte {
+	switch {
+	case e.wif != nil:
+		return e.wif.SerializePubKey()
+	case e.pubKey != nil && e.compressed:
+		return e.pubKey.SerializeCompressed()
+	case e.pubKey != nil:
+		return e.pubKey.SerializeUncompressed()
+	default:
+		return nil
+	}
+}
+
+// parseDumpKeyPath parses the hdkeypath of a wallet dump entry.
+func parseDumpKeyPath(s string) (dumpAccount, *waddrmgr.DerivationPath,
+	error) {
+
+	var (
+		acct dumpAccount
+		path waddrmgr.DerivationPath
+	)
+	n, err := fmt.Sscanf(s, "m/%d'/%d'/%d'/%d/%d", &acct.scope.Purpose,
+		&acct.scope.Coin, &acct.account, &path.Branch, &path.Index)
+	if err != nil || n != 5 || path.Branch > waddrmgr.InternalBranch {
+		return acct, nil, fmt.Errorf("invalid key path %q", s)
+	}
+	path.InternalAccount = acct.account
+	path.Account = acct.account + hdkeychain.HardenedKeyStart
+	return acct, &path, nil
+}
+
+// parseWalletDump reads the keys and scripts of a wallet dump written by
+// DumpWallet.  Empty lines and comments are skipped, as are any unknown
+// key/value pairs following the timestamp of an entry.
+func parseWalletDump(r io.Reader, params *chaincfg.Params) ([]dumpEntry, error) {
+	var entries []dumpEntry
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: missing timestamp", lineNum)
+		}
+
+		timestamp, err := time.Parse(dumpTimeFormat, fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid timestamp: %v",
+				lineNum, err)
+		}
+		entry := dumpEntry{timestamp: timestamp}
+
+		isScript, isPubKey := false, false
+		for _, field := range fields[2:] {
+			switch {
+			case field == "script=1":
+				isScript = true
+			case field == "pubkey=1":
+				isPubKey = true
+			case strings.HasPrefix(field, "label="):
+				entry.label, err = url.PathUnescape(
+					strings.TrimPrefix(field, "label="),
+				)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid "+
+						"label: %v", lineNum, err)
+				}
+			case strings.HasPrefix(field, "hdkeypath="):
+				entry.account, entry.path, err = parseDumpKeyPath(
+					strings.TrimPrefix(field, "hdkeypath="),
+				)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %v",
+						lineNum, err)
+				}
+			}
+		}
+
+		switch {
+		case isScript:
+			entry.script, err = hex.DecodeString(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid script: %v",
+					lineNum, err)
+			}
+
+		case isPubKey:
+			pubKey, err := hex.DecodeString(fields[0])
+			if err == nil {
+				entry.pubKey, err = czzec.ParsePubKey(
+					pubKey, czzec.S256(),
+				)
+			}
+			entry.compressed = len(pubKey) ==
+				czzec.PubKeyBytesLenCompressed
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid public "+
+					"key: %v", lineNum, err)
+			}
+
+		default:
+			entry.wif, err = czzutil.DecodeWIF(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid private "+
+					"key: %v", lineNum, err)
+			}
+			if !entry.wif.IsForNet(params) {
+				return nil, fmt.Errorf("line %d: private key is "+
+					"not intended for %s", lineNum, params.Name)
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// restoreDumpAccounts restores the accounts of the keys of a wallet dump that
+// are derived from the seed of this wallet, which is the case when the dump was
+// created by a wallet restored from the same seed.  Missing accounts are
+// created with the names recorded by the dump, and the addresses of the
+// restored keys are derived in their accounts.  The entries of these keys are
+// marked as restored so they are not imported, and their addresses are
+// returned.
+//
+// Only the accounts of private keys are restored, as the accounts of public
+// keys may be watch-only accounts that are not derived from the seed.  Missing
+// accounts are only created once a key of an existing account shows that the
+// dump is of the seed of this wallet.
+func (w *Wallet) restoreDumpAccounts(ns walletdb.ReadWriteBucket,
+	entries []dumpEntry) ([]czzutil.Address, []bool, error) {
+
+	restored := make([]bool, len(entries))
+
+	names := make(map[dumpAccount]string)
+	for i := range entries {
+		entry := &entries[i]
+		if entry.wif != nil && entry.path != nil && entry.label != "" {
+			names[entry.account] = entry.label
+		}
+	}
+
+	var (
+		addrs    []czzutil.Address
+		sameSeed bool
+	)
+	for i := range entries {
+		entry := &entries[i]
+		if entry.wif == nil || entry.path == nil ||
+			entry.account.account > waddrmgr.MaxAccountNum {
+
+			continue
+		}
+		scopedMgr, err := w.Manager.FetchScopedKeyManager(
+			entry.account.scope,
+		)
+		if waddrmgr.IsError(err, waddrmgr.ErrScopeNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		lastAccount, err := scopedMgr.LastAccount(ns)
+		if err != nil {
+			return nil, nil, err
+		}
+		if entry.account.account > lastAccount {
+			if !sameSeed {
+				continue
+			}
+			ok, err := createDumpAccounts(
+				ns, scopedMgr, entry.account.account, names,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		maddr, err := scopedMgr.DeriveFromKeyPath(ns, *entry.path)
+		if err != nil {
+			return nil, nil, err
+		}
+		pubKeyAddr, ok := maddr.(waddrmgr.ManagedPubKeyAddress)
+		if !ok || !bytes.Equal(pubKeyAddr.PubKey().SerializeCompressed(),
+			entry.publicKey()) {
+
+			continue
+		}
+		sameSeed = true
+		restored[i] = true
+
+		// Addresses already known to the wallet are skipped.
+		_, err = scopedMgr.Address(ns, maddr.Address())
+		if err == nil {
+			continue
+		}
+		if !waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+			return nil, nil, err
+		}
+
+		if entry.path.Branch == waddrmgr.InternalBranch {
+			err = scopedMgr.ExtendInternalAddresses(
+				ns, entry.account.account, entry.path.Index,
+			)
+		} else {
+			err = scopedMgr.ExtendExternalAddresses(
+				ns, entry.account.account, entry.path.Index,
+			)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		addrs = append(addrs, maddr.Address())
+	}
+
+	return addrs, restored, nil
+}
+
+// createDumpAccounts creates the accounts of a key scope up to account with the
+// names recorded by a wallet dump.  It returns false if an account can not be
+// created because its name is unknown or already in use.
+func createDumpAccounts(ns walletdb.ReadWriteBucket,
+	scopedMgr *waddrmgr.ScopedKeyManager, account uint32,
+	names map[dumpAccount]string) (bool, error) {
+
+	lastAccount, err := scopedMgr.LastAccount(ns)
+	if err != nil {
+		return false, err
+	}
+	for lastAccount < account {
+		name, ok := names[dumpAccount{scopedMgr.Scope(), lastAccount + 1}]
+		if !ok {
+			return false, nil
+		}
+		lastAccount, err = scopedMgr.NewAccount(ns, name)
+		if waddrmgr.IsError(err, waddrmgr.ErrDuplicateAccount) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// moveBirthdayBack sets the wallet birthday to the block of imported keys and
+// scripts when it is before the current birthday block.  As with single
+// imported keys, the birthday is only moved back so that rescans don't miss any
+// relevant chain events.  The new birthday block is marked as unverified, as it
+// was provided by the caller.
+func (w *Wallet) moveBirthdayBack(addrmgrNs walletdb.ReadWriteBucket,
+	bs *waddrmgr.BlockStamp) error {
+
+	birthdayBlock, _, err := w.Manager.BirthdayBlock(addrmgrNs)
+	if err != nil {
+		return err
+	}
+	if bs.Height >= birthdayBlock.Height {
+		return nil
+	}
+	err = w.Manager.SetBirthday(addrmgrNs, bs.Timestamp)
+	if err != nil {
+		return err
+	}
+	return w.Manager.SetBirthdayBlock(addrmgrNs, *bs, false)
+}
+
+// ImportWallet imports all keys and scripts of a wallet dump, as written by
+// DumpWallet.  Private keys derived from the seed of this wallet, as found in
+// the dump of a wallet restored from the same seed, are restored into their
+// accounts, which are created with the names recorded by the dump if missing.
+// All other private keys, public keys and scripts are imported into the
+// imported account.  Keys and scripts already known to the wallet are skipped.
+// The number of newly imported or restored keys and scripts is returned.  The
+// wallet must be unlocked to import private keys.
+//
+// If rescan is true, a single rescan for all newly imported addresses is
+// started from the block matching the earliest timestamp of the dump, and the
+// wallet birthday is moved back to that block if needed.  The rescan is not
+// waited on.
+func (w *Wallet) ImportWallet(r io.Reader, rescan bool) (int, error) {
+	entries, err := parseWalletDump(r, w.chainParams)
+	if err != nil {
+		return 0, err
+	}
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return 0, err
+	}
+
+	// Keys are imported with the block of the earliest timestamp of the
+	// dump, which is also where the rescan starts.  Without a rescan, the
+	// genesis block is used as done for single imported keys.
+	bs := &waddrmgr.BlockStamp{
+		Hash:      *w.chainParams.GenesisHash,
+		Height:    0,
+		Timestamp: w.chainParams.GenesisBlock.Header.Timestamp,
+	}
+	if rescan {
+		earliest := entries[0].timestamp
+		for _, entry := range entries[1:] {
+			if entry.timestamp.Before(earliest) {
+				earliest = entry.timestamp
+			}
+		}
+		bs, err = locateBirthdayBlock(chainClient, earliest)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	manager, err := w.Manager.FetchScopedKeyManager(waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		addrs    []czzutil.Address
+		restored []bool
+	)
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		var err error
+		addrs, restored, err = w.restoreDumpAccounts(addrmgrNs, entries)
+		if err != nil {
+			return err
+		}
+
+		for i, entry := range entries {
+			if restored[i] {
+				continue
+			}
+
+			var (
+				maddr waddrmgr.ManagedAddress
+				err   error
+			)
+			switch {
+			case entry.wif != nil:
+				maddr, err = manager.ImportPrivateKey(
+					addrmgrNs, entry.wif, bs,
+				)
+			case entry.pubKey != nil && entry.compressed:
+				maddr, err = manager.ImportPublicKey(
+					addrmgrNs, entry.pubKey, bs,
+				)
+			case entry.pubKey != nil:
+				maddr, err = manager.ImportUncompressedPublicKey(
+					addrmgrNs, entry.pubKey, bs,
+				)
+			default:
+				maddr, err = manager.ImportScript(
+					addrmgrNs, entry.script, bs,
+				)
+			}
+			if waddrmgr.IsError(err, waddrmgr.ErrDuplicateAddress) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			addrs = append(addrs, maddr.Address())
+		}
+
+		if !rescan || len(addrs) == 0 {
+			return nil
+		}
+
+		return w.moveBirthdayBack(addrmgrNs, bs)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(addrs) == 0 {
+		return 0, nil
+	}
+
+	if rescan {
+		job := &RescanJob{
+			Addrs:      addrs,
+			OutPoints:  nil,
+			BlockStamp: *bs,
+		}
+
+		// Submit a single rescan job for all imported addresses.  The
+		// rescan success or failure is logged elsewhere, so the result
+		// channel is not waited on.
+		_ = w.SubmitRescan(job)
+	} else {
+		err := chainClient.NotifyReceived(addrs)
+		if err != nil {
+			return 0, fmt.Errorf("failed to subscribe for address "+
+				"ntfns for imported addresses: %v", err)
+		}
+	}
+
+	log.Infof("Imported %d keys and scripts from wallet dump", len(addrs))
+
+	return len(addrs), nil
+}
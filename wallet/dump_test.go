@@ -0,0 +1,293 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// TestDumpImportWallet checks that the keys of a wallet dump can be imported
+// into another wallet, and that importing them again is a no-op.
+func TestDumpImportWallet(t *testing.T) {
+	w1, cleanup1 := testWallet(t)
+	defer cleanup1()
+	w2, cleanup2 := testWallet(t)
+	defer cleanup2()
+
+	addr, err := w1.CurrentAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+
+	var dump bytes.Buffer
+	if err := w1.DumpWallet(&dump); err != nil {
+		t.Fatalf("unable to dump wallet: %v", err)
+	}
+	if !strings.Contains(dump.String(), "hdkeypath=m/44'/0'/0'/0/0") {
+		t.Fatalf("dump is missing the derivation path of the first " +
+			"key:\n" + dump.String())
+	}
+
+	entries, err := parseWalletDump(
+		bytes.NewReader(dump.Bytes()), w1.ChainParams(),
+	)
+	if err != nil {
+		t.Fatalf("unable to parse wallet dump: %v", err)
+	}
+	if len(entries) == 0 {
+		t.Fatalf("wallet dump contains no keys")
+	}
+
+	n, err := w2.ImportWallet(bytes.NewReader(dump.Bytes()), false)
+	if err != nil {
+		t.Fatalf("unable to import wallet dump: %v", err)
+	}
+	if n != len(entries) {
+		t.Fatalf("expected %d imported keys, found %d", len(entries), n)
+	}
+
+	have, err := w2.HaveAddress(addr)
+	if err != nil {
+		t.Fatalf("unable to look up address: %v", err)
+	}
+	if !have {
+		t.Fatalf("address %v not imported", addr)
+	}
+	wif1, err := w1.DumpWIFPrivateKey(addr)
+	if err != nil {
+		t.Fatalf("unable to dump private key: %v", err)
+	}
+	wif2, err := w2.DumpWIFPrivateKey(addr)
+	if err != nil {
+		t.Fatalf("unable to dump private key: %v", err)
+	}
+	if wif1 != wif2 {
+		t.Fatalf("imported private key does not match")
+	}
+
+	// Keys already known to the wallet are skipped.
+	n, err = w2.ImportWallet(bytes.NewReader(dump.Bytes()), false)
+	if err != nil {
+		t.Fatalf("unable to import wallet dump again: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("expected no imported keys, found %d", n)
+	}
+}
+
+// TestDumpImportWalletAccounts checks that importing the dump of a wallet with
+// the same seed restores its accounts, and that keys without a private key are
+// imported as public keys.
+func TestDumpImportWalletAccounts(t *testing.T) {
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.MinSeedBytes)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	w1, cleanup1 := testWalletFromSeed(t, seed)
+	defer cleanup1()
+	w2, cleanup2 := testWalletFromSeed(t, seed)
+	defer cleanup2()
+
+	// The key of the default account shows that the dump is of the same
+	// seed.
+	scope := waddrmgr.KeyScopeBIP0044
+	if _, err := w1.CurrentAddress(0, scope); err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	account, err := w1.NextAccount(scope, "savings")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	addr, err := w1.NewAddress(account, scope)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+
+	// A public key imported by the first wallet is dumped without a
+	// private key.
+	privKey, err := czzec.NewPrivateKey(czzec.S256())
+	if err != nil {
+		t.Fatalf("unable to create private key: %v", err)
+	}
+	scopedMgr, err := w1.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		t.Fatalf("unable to fetch scoped key manager: %v", err)
+	}
+	uncompressedKey, err := czzec.NewPrivateKey(czzec.S256())
+	if err != nil {
+		t.Fatalf("unable to create private key: %v", err)
+	}
+	var pubKeyAddr, uncompressedAddr czzutil.Address
+	err = walletdb.Update(w1.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		maddr, err := scopedMgr.ImportPublicKey(ns, privKey.PubKey(), nil)
+		if err != nil {
+			return err
+		}
+		pubKeyAddr = maddr.Address()
+
+		maddr, err = scopedMgr.ImportUncompressedPublicKey(
+			ns, uncompressedKey.PubKey(), nil,
+		)
+		if err != nil {
+			return err
+		}
+		uncompressedAddr = maddr.Address()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to import public key: %v", err)
+	}
+
+	var dump bytes.Buffer
+	if err := w1.DumpWallet(&dump); err != nil {
+		t.Fatalf("unable to dump wallet: %v", err)
+	}
+	if !strings.Contains(dump.String(), "pubkey=1") {
+		t.Fatalf("dump is missing the imported public key:\n" +
+			dump.String())
+	}
+
+	_, err = w2.ImportWallet(bytes.NewReader(dump.Bytes()), false)
+	if err != nil {
+		t.Fatalf("unable to import wallet dump: %v", err)
+	}
+
+	// The account is restored with its name, and owns its address.
+	restored, err := w2.AccountNumber(scope, "savings")
+	if err != nil {
+		t.Fatalf("account not restored: %v", err)
+	}
+	if restored != account {
+		t.Fatalf("account restored as %d, expected %d", restored,
+			account)
+	}
+	owner, err := w2.AccountOfAddress(addr)
+	if err != nil {
+		t.Fatalf("unable to look up address: %v", err)
+	}
+	if owner != account {
+		t.Fatalf("address restored into account %d, expected %d",
+			owner, account)
+	}
+
+	owner, err = w2.AccountOfAddress(pubKeyAddr)
+	if err != nil {
+		t.Fatalf("unable to look up address: %v", err)
+	}
+	if owner != waddrmgr.ImportedAddrAccount {
+		t.Fatalf("public key imported into account %d", owner)
+	}
+	if _, err := w2.DumpWIFPrivateKey(pubKeyAddr); err == nil {
+		t.Fatalf("private key of imported public key was exported")
+	}
+
+	// The uncompressed public key keeps its address.
+	owner, err = w2.AccountOfAddress(uncompressedAddr)
+	if err != nil {
+		t.Fatalf("unable to look up uncompressed address: %v", err)
+	}
+	if owner != waddrmgr.ImportedAddrAccount {
+		t.Fatalf("uncompressed public key imported into account %d",
+			owner)
+	}
+}
+
+// TestParseWalletDumpErrors checks that malformed wallet dumps are rejected.
+func TestParseWalletDumpErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		dump string
+	}{
+		{
+			name: "missing timestamp",
+			dump: "cVt4o7BGAig1UXywgGSmARhxMdzP5qvQsxKkSsc1XEkw3tDTQFpy\n",
+		},
+		{
+			name: "invalid timestamp",
+			dump: "cVt4o7BGAig1UXywgGSmARhxMdzP5qvQsxKkSsc1XEkw3tDTQFpy " +
+				"yesterday label=default\n",
+		},
+		{
+			name: "invalid key",
+			dump: "notakey 2021-03-31T00:00:00Z label=default\n",
+		},
+		{
+			name: "wrong network",
+			dump: "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ " +
+				"2021-03-31T00:00:00Z label=default\n",
+		},
+		{
+			name: "invalid public key",
+			dump: "02ff 2021-03-31T00:00:00Z pubkey=1 label=imported\n",
+		},
+		{
+			name: "invalid key path",
+			dump: "cVt4o7BGAig1UXywgGSmARhxMdzP5qvQsxKkSsc1XEkw3tDTQFpy " +
+				"2021-03-31T00:00:00Z label=default " +
+				"hdkeypath=m/44'/0'/0'/2/0\n",
+		},
+		{
+			name: "invalid script",
+			dump: "zz 2021-03-31T00:00:00Z script=1 label=imported\n",
+		},
+	}
+
+	for _, test := range tests {
+		_, err := parseWalletDump(
+			strings.NewReader(test.dump), &chaincfg.TestNet3Params,
+		)
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
+
+// TestBackupWallet checks that a wallet backup can be opened as a wallet
+// database.
+func TestBackupWallet(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "backup_test")
+	if err != nil {
+		t.Fatalf("Failed to create backup dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "wallet.db")
+	if err := w.BackupWallet(path); err != nil {
+		t.Fatalf("unable to back up wallet: %v", err)
+	}
+
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, 250)
+	w2, err := loader.OpenExistingWallet([]byte("hello"), false)
+	if err != nil {
+		t.Fatalf("unable to open wallet backup: %v", err)
+	}
+	defer w2.db.Close()
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("unable to read backup dir: %v", err)
+	}
+	for _, f := range files {
+		if strings.Contains(f.Name(), ".tmp") {
+			t.Fatalf("temporary backup file %s left behind", f.Name())
+		}
+	}
+}
@@ -0,0 +1,374 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/chain"
+	"github.com/classzz/czzwallet/wallet/txrules"
+)
+
+// DefaultConfTarget is the confirmation target, in blocks, used to estimate
+// the fee rate of transactions created without one.
+const DefaultConfTarget = 6
+
+// defaultFeeEstimateBlocks is the number of recently mined blocks sampled by
+// a BlockFeeEstimator created without a number of blocks.  A day of blocks is
+// sampled, so that the outputs spent by most transactions are created in one
+// of the sampled blocks.
+const defaultFeeEstimateBlocks = 144
+
+// FeeEstimator estimates the fee rate transactions must pay to be mined.
+type FeeEstimator interface {
+	// EstimateFeeRate returns the fee rate, per kilobyte, a transaction
+	// must pay to be mined within confTarget blocks.
+	EstimateFeeRate(confTarget uint32) (czzutil.Amount, error)
+}
+
+// Chain backends estimate fee rates by querying the fee estimate of their
+// consensus server.
+var _ FeeEstimator = (chain.Interface)(nil)
+
+// FeeEstimators is a FeeEstimator returning the estimate of the first of its
+// estimators that is able to estimate the fee rate.
+type FeeEstimators []FeeEstimator
+
+// EstimateFeeRate returns the first fee rate estimated by the estimators.
+func (e FeeEstimators) EstimateFeeRate(confTarget uint32) (czzutil.Amount, error) {
+	err := chain.ErrFeeEstimateUnavailable
+	for _, estimator := range e {
+		var feeRate czzutil.Amount
+		feeRate, err = estimator.EstimateFeeRate(confTarget)
+		if err == nil {
+			return feeRate, nil
+		}
+	}
+	return 0, err
+}
+
+// BlockFeeEstimator is a FeeEstimator building its estimate from the fee rates
+// paid by the transactions of recently mined blocks, fetched from a chain
+// backend.
+//
+// The fee of a transaction is only known when the outputs it spends are
+// created in one of the sampled blocks, so the fee rates of other
+// transactions are not taken into account.  The fee rate of a block is the
+// lower quartile of the known fee rates of its transactions rather than the
+// lowest one, so that a few transactions mined for a lower fee rate, such as
+// parents paid for by their children or the miner's own transactions, do not
+// lower the estimate.
+type BlockFeeEstimator struct {
+	chain  chain.Interface
+	blocks int
+
+	// tip is the best block the fee rates were sampled at, and feeRates
+	// are the fee rates of each of the sampled blocks, in ascending order.
+	// sampled holds the sampled blocks by hash, so that only the blocks
+	// mined since are fetched when the best block changes.
+	tip      chainhash.Hash
+	feeRates []czzutil.Amount
+	sampled  map[chainhash.Hash]*sampledBlock
+	mtx      sync.Mutex
+}
+
+// sampledBlock records the parts of a block needed to calculate the fee rates
+// of its transactions.
+type sampledBlock struct {
+	// values are the values of the outputs created in the block.
+	values map[wire.OutPoint]int64
+
+	// txs are the transactions of the block, excluding the coinbase.
+	txs []sampledTx
+}
+
+// sampledTx records the parts of a transaction needed to calculate its fee
+// rate.
+type sampledTx struct {
+	prevOuts    []wire.OutPoint
+	outputValue int64
+	size        int
+}
+
+// newSampledBlock records the parts of a block needed to calculate the fee
+// rates of its transactions.
+func newSampledBlock(block *wire.MsgBlock) *sampledBlock {
+	b := &sampledBlock{
+		values: make(map[wire.OutPoint]int64),
+	}
+	for i, tx := range block.Transactions {
+		txHash := tx.TxHash()
+		var outputValue int64
+		for j, output := range tx.TxOut {
+			outPoint := wire.OutPoint{Hash: txHash, Index: uint32(j)}
+			b.values[outPoint] = output.Value
+			outputValue += output.Value
+		}
+
+		// The first transaction of a block is the coinbase, which pays
+		// no fee.
+		if i == 0 {
+			continue
+		}
+		prevOuts := make([]wire.OutPoint, len(tx.TxIn))
+		for j, input := range tx.TxIn {
+			prevOuts[j] = input.PreviousOutPoint
+		}
+		b.txs = append(b.txs, sampledTx{
+			prevOuts:    prevOuts,
+			outputValue: outputValue,
+			size:        tx.SerializeSize(),
+		})
+	}
+	return b
+}
+
+// NewBlockFeeEstimator returns a BlockFeeEstimator sampling the given number
+// of most recently mined blocks of a chain backend.  A default number of
+// blocks is sampled if blocks is not positive.
+func NewBlockFeeEstimator(chainClient chain.Interface,
+	blocks int) *BlockFeeEstimator {
+
+	if blocks <= 0 {
+		blocks = defaultFeeEstimateBlocks
+	}
+	return &BlockFeeEstimator{
+		chain:   chainClient,
+		blocks:  blocks,
+		sampled: make(map[chainhash.Hash]*sampledBlock),
+	}
+}
+
+// EstimateFeeRate returns the lowest fee rate that is at least the fee rate
+// of one out of every confTarget sampled blocks.  A transaction paying this
+// fee rate would have been mined in those blocks.
+func (e *BlockFeeEstimator) EstimateFeeRate(confTarget uint32) (czzutil.Amount, error) {
+	feeRates, err := e.blockFeeRates()
+	if err != nil {
+		return 0, err
+	}
+	if len(feeRates) == 0 {
+		return 0, chain.ErrFeeEstimateUnavailable
+	}
+
+	if confTarget == 0 {
+		confTarget = 1
+	}
+	n := (len(feeRates) + int(confTarget) - 1) / int(confTarget)
+	return feeRates[n-1], nil
+}
+
+// blockFeeRates returns the fee rates of each of the sampled blocks, in
+// ascending order.  The fee rates are only sampled again once the best block
+// of the chain backend changes, and only the blocks that were not sampled
+// before are fetched then.  Blocks are fetched without holding the mutex, so
+// that estimates of the current fee rates are not blocked by them.
+func (e *BlockFeeEstimator) blockFeeRates() ([]czzutil.Amount, error) {
+	tipHash, tipHeight, err := e.chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	e.mtx.Lock()
+	if e.feeRates != nil && e.tip == *tipHash {
+		feeRates := e.feeRates
+		e.mtx.Unlock()
+		return feeRates, nil
+	}
+	cached := e.sampled
+	e.mtx.Unlock()
+
+	sampled := make(map[chainhash.Hash]*sampledBlock, e.blocks)
+	blocks := make([]*sampledBlock, 0, e.blocks)
+	for i := 0; i < e.blocks && int64(tipHeight)-int64(i) >= 0; i++ {
+		hash, err := e.chain.GetBlockHash(int64(tipHeight) - int64(i))
+		if err != nil {
+			return nil, err
+		}
+
+		// The cached blocks are only replaced, never modified, so they
+		// can be read without holding the mutex.
+		b, ok := cached[*hash]
+		if !ok {
+			block, err := e.chain.GetBlock(hash)
+			if err != nil {
+				return nil, err
+			}
+			b = newSampledBlock(block)
+		}
+		sampled[*hash] = b
+		blocks = append(blocks, b)
+	}
+
+	// Collect the values of all outputs created in the sampled blocks, so
+	// that the fees of the transactions spending them can be calculated.
+	values := make(map[wire.OutPoint]int64)
+	for _, b := range blocks {
+		for outPoint, value := range b.values {
+			values[outPoint] = value
+		}
+	}
+
+	feeRates := make([]czzutil.Amount, 0, len(blocks))
+	for _, b := range blocks {
+		if feeRate, ok := blockFeeRate(b, values); ok {
+			feeRates = append(feeRates, feeRate)
+		}
+	}
+	sort.Slice(feeRates, func(i, j int) bool {
+		return feeRates[i] < feeRates[j]
+	})
+
+	e.mtx.Lock()
+	e.tip = *tipHash
+	e.feeRates = feeRates
+	e.sampled = sampled
+	e.mtx.Unlock()
+	return feeRates, nil
+}
+
+// blockFeeRate returns the lower quartile of the fee rates, per kilobyte, paid
+// by the transactions of a block spending outputs of known value.  False is
+// returned if the fee of none of the transactions is known.
+func blockFeeRate(b *sampledBlock,
+	values map[wire.OutPoint]int64) (czzutil.Amount, bool) {
+
+	var feeRates []czzutil.Amount
+	for _, tx := range b.txs {
+		var inputValue int64
+		known := true
+		for _, prevOut := range tx.prevOuts {
+			value, ok := values[prevOut]
+			if !ok {
+				known = false
+				break
+			}
+			inputValue += value
+		}
+		if !known {
+			continue
+		}
+
+		fee := czzutil.Amount(inputValue - tx.outputValue)
+		feeRates = append(feeRates, fee*1000/czzutil.Amount(tx.size))
+	}
+	if len(feeRates) == 0 {
+		return 0, false
+	}
+
+	sort.Slice(feeRates, func(i, j int) bool {
+		return feeRates[i] < feeRates[j]
+	})
+	return feeRates[len(feeRates)/4], true
+}
+
+// SetFeeEstimator sets the fee estimator used to estimate the fee rate of
+// transactions created with a confirmation target.  A nil estimator uses the
+// fee estimate of the chain backend, or an estimate from the blocks it most
+// recently mined when the backend has none.
+func (w *Wallet) SetFeeEstimator(estimator FeeEstimator) {
+	w.feeEstimatorMtx.Lock()
+	w.feeEstimator = estimator
+	w.feeEstimatorMtx.Unlock()
+}
+
+// SetFallbackFeeRate sets the fee rate, per kilobyte, of transactions whose
+// fee rate can not be estimated.
+func (w *Wallet) SetFallbackFeeRate(feeRate czzutil.Amount) {
+	w.feeEstimatorMtx.Lock()
+	w.fallbackFeeRate = feeRate
+	w.feeEstimatorMtx.Unlock()
+}
+
+// FallbackFeeRate returns the fee rate, per kilobyte, of transactions whose
+// fee rate can not be estimated.
+func (w *Wallet) FallbackFeeRate() czzutil.Amount {
+	w.feeEstimatorMtx.Lock()
+	defer w.feeEstimatorMtx.Unlock()
+	return w.fallbackFeeRate
+}
+
+// SetTxFeeRate sets the fee rate, per kilobyte, paid by transactions instead
+// of an estimated fee rate.  A zero fee rate estimates the fee rate of
+// transactions again.
+func (w *Wallet) SetTxFeeRate(feeRate czzutil.Amount) {
+	w.feeEstimatorMtx.Lock()
+	w.txFeeRate = feeRate
+	w.feeEstimatorMtx.Unlock()
+}
+
+// TxFeeRate returns the fee rate, per kilobyte, paid by transactions instead
+// of an estimated fee rate, or zero if the fee rate is estimated.
+func (w *Wallet) TxFeeRate() czzutil.Amount {
+	w.feeEstimatorMtx.Lock()
+	defer w.feeEstimatorMtx.Unlock()
+	return w.txFeeRate
+}
+
+// SetMaxFeeRate sets the highest estimated fee rate, per kilobyte, paid by
+// transactions.  Higher estimates are lowered to this fee rate.  A zero fee
+// rate does not limit estimates.
+func (w *Wallet) SetMaxFeeRate(feeRate czzutil.Amount) {
+	w.feeEstimatorMtx.Lock()
+	w.maxFeeRate = feeRate
+	w.feeEstimatorMtx.Unlock()
+}
+
+// EstimateFeeRate returns the fee rate, per kilobyte, a transaction must pay
+// to be mined within confTarget blocks, as estimated by the wallet's fee
+// estimator and limited to the maximum fee rate.  The fee rate set with
+// SetTxFeeRate is returned instead when set, and the fallback fee rate is
+// returned when the fee rate can not be estimated.  The fee rate is never
+// below the default relay fee.
+func (w *Wallet) EstimateFeeRate(confTarget uint32) czzutil.Amount {
+	w.feeEstimatorMtx.Lock()
+	if w.txFeeRate != 0 {
+		feeRate := w.txFeeRate
+		w.feeEstimatorMtx.Unlock()
+		if feeRate < txrules.DefaultRelayFeePerKb {
+			feeRate = txrules.DefaultRelayFeePerKb
+		}
+		return feeRate
+	}
+	estimator := w.feeEstimator
+	feeRate := w.fallbackFeeRate
+	maxFeeRate := w.maxFeeRate
+	if estimator == nil {
+		if chainClient := w.ChainClient(); chainClient != nil {
+			if w.blockFeeEstimator == nil ||
+				w.blockFeeEstimator.chain != chainClient {
+
+				w.blockFeeEstimator = NewBlockFeeEstimator(
+					chainClient, 0,
+				)
+			}
+			estimator = FeeEstimators{chainClient, w.blockFeeEstimator}
+		}
+	}
+	w.feeEstimatorMtx.Unlock()
+
+	if estimator != nil {
+		estimate, err := estimator.EstimateFeeRate(confTarget)
+		switch {
+		case err != nil:
+			log.Debugf("Using fallback fee rate %v: %v", feeRate, err)
+		case maxFeeRate != 0 && estimate > maxFeeRate:
+			log.Debugf("Lowering estimated fee rate %v to the "+
+				"maximum fee rate %v", estimate, maxFeeRate)
+			feeRate = maxFeeRate
+		default:
+			feeRate = estimate
+		}
+	}
+
+	if feeRate < txrules.DefaultRelayFeePerKb {
+		feeRate = txrules.DefaultRelayFeePerKb
+	}
+	return feeRate
+}
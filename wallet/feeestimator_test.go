@@ -0,0 +1,248 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/chain"
+	"github.com/classzz/czzwallet/wallet/txrules"
+)
+
+// feeChainClient is a chain client serving a chain of blocks and, when
+// feeRate is set, a fee estimate.
+type feeChainClient struct {
+	mockChainClient
+
+	blocks  []*wire.MsgBlock
+	feeRate czzutil.Amount
+	fetched int
+}
+
+func (c *feeChainClient) GetBestBlock() (*chainhash.Hash, int32, error) {
+	hash := c.blocks[len(c.blocks)-1].BlockHash()
+	return &hash, int32(len(c.blocks) - 1), nil
+}
+
+func (c *feeChainClient) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	hash := c.blocks[height].BlockHash()
+	return &hash, nil
+}
+
+func (c *feeChainClient) GetBlock(hash *chainhash.Hash) (*wire.MsgBlock, error) {
+	c.fetched++
+	for _, block := range c.blocks {
+		if block.BlockHash() == *hash {
+			return block, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *feeChainClient) EstimateFeeRate(uint32) (czzutil.Amount, error) {
+	if c.feeRate == 0 {
+		return 0, chain.ErrFeeEstimateUnavailable
+	}
+	return c.feeRate, nil
+}
+
+// testFeeChain returns a chain client whose first block creates outputs spent
+// by the following blocks, each paying one of the given fees.  The fee rates
+// of the spending transactions are returned as well.
+func testFeeChain(fees ...int64) (*feeChainClient, []czzutil.Amount) {
+	coinbase := func(height int) *wire.MsgTx {
+		return &wire.MsgTx{
+			TxIn: []*wire.TxIn{{SignatureScript: []byte{byte(height)}}},
+			TxOut: []*wire.TxOut{
+				wire.NewTxOut(1e6, []byte{byte(height)}),
+			},
+		}
+	}
+
+	funding := coinbase(0)
+	for range fees {
+		funding.TxOut = append(funding.TxOut, wire.NewTxOut(1e6, nil))
+	}
+	fundingHash := funding.TxHash()
+	client := &feeChainClient{
+		blocks: []*wire.MsgBlock{{Transactions: []*wire.MsgTx{funding}}},
+	}
+
+	feeRates := make([]czzutil.Amount, len(fees))
+	for i, fee := range fees {
+		prevOut := wire.OutPoint{Hash: fundingHash, Index: uint32(i + 1)}
+		tx := &wire.MsgTx{
+			TxIn:  []*wire.TxIn{wire.NewTxIn(&prevOut, nil)},
+			TxOut: []*wire.TxOut{wire.NewTxOut(1e6-fee, nil)},
+		}
+		feeRates[i] = czzutil.Amount(fee * 1000 / int64(tx.SerializeSize()))
+
+		// A transaction spending an output created outside the sampled
+		// blocks is of unknown fee, and is not taken into account.
+		unknown := &wire.MsgTx{
+			TxIn: []*wire.TxIn{
+				{PreviousOutPoint: wire.OutPoint{Index: uint32(i)}},
+			},
+			TxOut: []*wire.TxOut{wire.NewTxOut(0, nil)},
+		}
+
+		block := &wire.MsgBlock{
+			Header:       wire.BlockHeader{Nonce: uint32(i + 1)},
+			Transactions: []*wire.MsgTx{coinbase(i + 1), tx, unknown},
+		}
+		client.blocks = append(client.blocks, block)
+	}
+	return client, feeRates
+}
+
+// TestBlockFeeEstimator checks that the fee rate estimated from recent blocks
+// decreases with the confirmation target, and that blocks are not fetched
+// again while the chain tip is unchanged.
+func TestBlockFeeEstimator(t *testing.T) {
+	client, feeRates := testFeeChain(2000, 300, 1000)
+	estimator := NewBlockFeeEstimator(client, 0)
+
+	tests := []struct {
+		confTarget uint32
+		feeRate    czzutil.Amount
+	}{
+		{0, feeRates[0]},
+		{1, feeRates[0]},
+		{2, feeRates[2]},
+		{3, feeRates[1]},
+		{100, feeRates[1]},
+	}
+	for _, test := range tests {
+		feeRate, err := estimator.EstimateFeeRate(test.confTarget)
+		if err != nil {
+			t.Fatalf("unable to estimate fee rate: %v", err)
+		}
+		if feeRate != test.feeRate {
+			t.Fatalf("target %d: expected fee rate %v, found %v",
+				test.confTarget, test.feeRate, feeRate)
+		}
+	}
+	if client.fetched != len(client.blocks) {
+		t.Fatalf("expected %d fetched blocks, found %d",
+			len(client.blocks), client.fetched)
+	}
+
+	// Once a block is mined, only that block is fetched.
+	client.blocks = append(client.blocks, &wire.MsgBlock{
+		Header: wire.BlockHeader{Nonce: uint32(len(client.blocks))},
+		Transactions: []*wire.MsgTx{{
+			TxIn:  []*wire.TxIn{{SignatureScript: []byte{0xff}}},
+			TxOut: []*wire.TxOut{wire.NewTxOut(1e6, nil)},
+		}},
+	})
+	fetched := client.fetched
+	if _, err := estimator.EstimateFeeRate(1); err != nil {
+		t.Fatalf("unable to estimate fee rate: %v", err)
+	}
+	if client.fetched != fetched+1 {
+		t.Fatalf("expected 1 fetched block, found %d",
+			client.fetched-fetched)
+	}
+
+	// Only the most recent blocks are sampled, and the fees of their
+	// transactions are not known without the block creating the outputs
+	// they spend.
+	estimator = NewBlockFeeEstimator(client, 2)
+	_, err := estimator.EstimateFeeRate(1)
+	if err != chain.ErrFeeEstimateUnavailable {
+		t.Fatalf("expected unavailable estimate, found %v", err)
+	}
+}
+
+// TestBlockFeeRate checks that the fee rate of a block is the lower quartile of
+// the known fee rates of its transactions.
+func TestBlockFeeRate(t *testing.T) {
+	values := make(map[wire.OutPoint]int64)
+	block := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{{TxIn: []*wire.TxIn{{}}}},
+	}
+	var feeRates []czzutil.Amount
+	for i, fee := range []int64{5000, 0, 1000, 3000, 2000} {
+		prevOut := wire.OutPoint{Index: uint32(i)}
+		values[prevOut] = 1e6
+		tx := &wire.MsgTx{
+			TxIn:  []*wire.TxIn{wire.NewTxIn(&prevOut, nil)},
+			TxOut: []*wire.TxOut{wire.NewTxOut(1e6-fee, nil)},
+		}
+		feeRates = append(feeRates,
+			czzutil.Amount(fee*1000/int64(tx.SerializeSize())))
+		block.Transactions = append(block.Transactions, tx)
+	}
+
+	feeRate, ok := blockFeeRate(newSampledBlock(block), values)
+	if !ok {
+		t.Fatalf("expected a block fee rate")
+	}
+	if feeRate != feeRates[2] {
+		t.Fatalf("expected fee rate %v, found %v", feeRates[2], feeRate)
+	}
+
+	_, ok = blockFeeRate(newSampledBlock(block), nil)
+	if ok {
+		t.Fatalf("expected no fee rate without known fees")
+	}
+}
+
+// TestWalletEstimateFeeRate checks that the wallet prefers the estimate of the
+// chain backend, estimates from recent blocks otherwise, and falls back to its
+// fallback fee rate, but never below the relay fee.  Estimates are limited to
+// the maximum fee rate, and the fee rate set with SetTxFeeRate is used instead
+// of estimates.
+func TestWalletEstimateFeeRate(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	client, feeRates := testFeeChain(2000, 3000)
+	w.chainClient = client
+
+	if feeRate := w.EstimateFeeRate(1); feeRate != feeRates[1] {
+		t.Fatalf("expected block estimate %v, found %v", feeRates[1],
+			feeRate)
+	}
+
+	client.feeRate = 40000
+	if feeRate := w.EstimateFeeRate(1); feeRate != client.feeRate {
+		t.Fatalf("expected backend estimate %v, found %v",
+			client.feeRate, feeRate)
+	}
+
+	w.SetMaxFeeRate(30000)
+	if feeRate := w.EstimateFeeRate(1); feeRate != 30000 {
+		t.Fatalf("expected maximum fee rate, found %v", feeRate)
+	}
+	w.SetMaxFeeRate(0)
+
+	w.SetTxFeeRate(7000)
+	if feeRate := w.EstimateFeeRate(1); feeRate != 7000 {
+		t.Fatalf("expected set fee rate, found %v", feeRate)
+	}
+	w.SetTxFeeRate(0)
+	if feeRate := w.EstimateFeeRate(1); feeRate != client.feeRate {
+		t.Fatalf("expected backend estimate %v, found %v",
+			client.feeRate, feeRate)
+	}
+
+	// An estimator without estimates uses the fallback fee rate.
+	w.SetFeeEstimator(FeeEstimators{})
+	if feeRate := w.EstimateFeeRate(1); feeRate != txrules.DefaultRelayFeePerKb {
+		t.Fatalf("expected default fallback fee rate, found %v", feeRate)
+	}
+	w.SetFallbackFeeRate(5000)
+	if feeRate := w.EstimateFeeRate(1); feeRate != 5000 {
+		t.Fatalf("expected fallback fee rate, found %v", feeRate)
+	}
+	w.SetFallbackFeeRate(0)
+	if feeRate := w.EstimateFeeRate(1); feeRate != txrules.DefaultRelayFeePerKb {
+		t.Fatalf("expected relay fee rate, found %v", feeRate)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// FreezeOutput freezes an output for the given reason, preventing it from
+// being available for coin selection.  Frozen outputs remain unspent outputs
+// of the wallet, and their value is reported as a frozen balance.  Unlike
+// outpoints locked with LockOutpoint, frozen outputs are persisted and remain
+// frozen after the wallet is restarted.  The freeze expires at expiry, or
+// never if expiry is zero.
+func (w *Wallet) FreezeOutput(op wire.OutPoint, reason string,
+	expiry time.Time) error {
+
+	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.FreezeOutput(ns, op, reason, expiry)
+	})
+}
+
+// UnfreezeOutput unfreezes an output, allowing it to be available for coin
+// selection if it remains unspent.
+func (w *Wallet) UnfreezeOutput(op wire.OutPoint) error {
+	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.UnfreezeOutput(ns, op)
+	})
+}
+
+// ResetFrozenOutputs unfreezes all frozen outputs.
+func (w *Wallet) ResetFrozenOutputs() error {
+	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		frozen, err := w.TxStore.ListFrozenOutputs(ns)
+		if err != nil {
+			return err
+		}
+		for _, output := range frozen {
+			err := w.TxStore.UnfreezeOutput(ns, output.Outpoint)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Expired freezes no longer freeze their outputs, but are
+		// removed as well.
+		return w.TxStore.DeleteExpiredFrozenOutputs(ns)
+	})
+}
+
+// ListFrozenOutputs returns a list of objects representing the currently
+// frozen outputs.
+func (w *Wallet) ListFrozenOutputs() ([]*wtxmgr.FrozenOutput, error) {
+	var outputs []*wtxmgr.FrozenOutput
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		outputs, err = w.TxStore.ListFrozenOutputs(ns)
+		return err
+	})
+	return outputs, err
+}
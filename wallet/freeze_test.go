@@ -0,0 +1,144 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// TestFreezeOutput checks that frozen outputs are not selected as inputs but
+// are reported as a frozen balance, and that they remain frozen after the
+// wallet is reopened while locked outpoints do not.
+func TestFreezeOutput(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	pkScript := addTestCredits(t, w, 100000, 50000)
+	unspent, err := w.UnspentOutputs(OutputSelectionPolicy{})
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	outPoints := make(map[int64]wire.OutPoint)
+	for _, output := range unspent {
+		outPoints[output.Output.Value] = output.OutPoint
+	}
+	frozen := outPoints[100000]
+	locked := outPoints[50000]
+
+	unknown := wire.OutPoint{Hash: chainhash.Hash{1}}
+	if err := w.FreezeOutput(unknown, "", time.Time{}); err == nil {
+		t.Fatalf("froze an output unknown to the wallet")
+	}
+
+	if err := w.FreezeOutput(frozen, "cold storage", time.Time{}); err != nil {
+		t.Fatalf("unable to freeze output: %v", err)
+	}
+	w.LockOutpoint(locked)
+
+	// The frozen output remains part of the balances.
+	bals, err := w.CalculateAccountBalances(0, 0)
+	if err != nil {
+		t.Fatalf("unable to calculate balances: %v", err)
+	}
+	if bals.Total != 150000 || bals.Frozen != 100000 ||
+		bals.Spendable != 50000 {
+
+		t.Fatalf("unexpected balances %+v", bals)
+	}
+	info, err := w.Info()
+	if err != nil {
+		t.Fatalf("unable to get wallet info: %v", err)
+	}
+	if info.FrozenBalance != 100000 {
+		t.Fatalf("frozen balance %v, expected %v", info.FrozenBalance,
+			czzutil.Amount(100000))
+	}
+
+	// Neither output is listed as unspent.
+	listed, err := w.ListUnspent(0, 9999999, "")
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	if len(listed) != 0 {
+		t.Fatalf("expected no listed unspent outputs, found %d",
+			len(listed))
+	}
+
+	// The frozen output cannot fund a transaction.
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(60000, pkScript))
+	_, err = w.FundRawTransaction(tx, &FundRawTxOptions{FeeRate: 1000})
+	if err == nil {
+		t.Fatalf("funded a transaction with frozen and locked outputs")
+	}
+
+	// Reopen the wallet from its database.  Only the freeze survives.
+	w2, err := Open(w.db, []byte("hello"), nil, w.chainParams, 250)
+	if err != nil {
+		t.Fatalf("unable to reopen wallet: %v", err)
+	}
+	w2.chainClient = &mockChainClient{}
+
+	unspent, err = w2.UnspentOutputs(OutputSelectionPolicy{})
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	if len(unspent) != 1 || unspent[0].OutPoint != locked {
+		t.Fatalf("expected only the locked output to be unspent, "+
+			"found %d outputs", len(unspent))
+	}
+
+	outputs, err := w2.ListFrozenOutputs()
+	if err != nil {
+		t.Fatalf("unable to list frozen outputs: %v", err)
+	}
+	if len(outputs) != 1 || outputs[0].Outpoint != frozen ||
+		outputs[0].Reason != "cold storage" ||
+		!outputs[0].Expiration.IsZero() {
+
+		t.Fatalf("unexpected frozen outputs %v", outputs)
+	}
+
+	// Unfreezing the output makes it available again.
+	if err := w2.UnfreezeOutput(frozen); err != nil {
+		t.Fatalf("unable to unfreeze output: %v", err)
+	}
+	unspent, err = w2.UnspentOutputs(OutputSelectionPolicy{})
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	if len(unspent) != 2 {
+		t.Fatalf("expected 2 unspent outputs, found %d", len(unspent))
+	}
+	listed, err = w2.ListUnspent(0, 9999999, "")
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	if len(listed) != 2 {
+		t.Fatalf("expected 2 listed unspent outputs, found %d",
+			len(listed))
+	}
+
+	// An expired freeze no longer freezes the output.
+	err = w2.FreezeOutput(frozen, "", time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("unable to freeze output: %v", err)
+	}
+	outputs, err = w2.ListFrozenOutputs()
+	if err != nil {
+		t.Fatalf("unable to list frozen outputs: %v", err)
+	}
+	if len(outputs) != 0 {
+		t.Fatalf("expected no frozen outputs, found %d", len(outputs))
+	}
+	if err := w2.ResetFrozenOutputs(); err != nil {
+		t.Fatalf("unable to reset frozen outputs: %v", err)
+	}
+}
@@ -213,6 +213,63 @@ func (w *Wallet) ImportAccount(name string, accountPubKey *hdkeychain.ExtendedKe
 	return accountProps, err
 }
 
+// ImportAccountWithScope imports an account backed by an account extended
+// public key into the given key scope, which is created if the wallet does not
+// have it yet.  Unlike ImportAccount, the key scope is not inferred from the
+// version of the key, and the account derives the pay-to-pubkey-hash addresses
+// of the BIP0044 key scope unless the key scope has its own address schema.
+//
+// The master key fingerprint denotes the fingerprint of the root key the
+// account public key is derived from, or zero if it is unknown.
+func (w *Wallet) ImportAccountWithScope(name string,
+	accountPubKey *hdkeychain.ExtendedKey, masterKeyFingerprint uint32,
+	keyScope waddrmgr.KeyScope) (*waddrmgr.AccountProperties, error) {
+
+	var accountProps *waddrmgr.AccountProperties
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		var err error
+		accountProps, err = w.importAccountWithScope(
+			ns, name, accountPubKey, masterKeyFingerprint, keyScope,
+		)
+		return err
+	})
+	return accountProps, err
+}
+
+// importAccountWithScope imports an account backed by an account extended
+// public key into the given key scope, which is created if needed, within the
+// database transaction of ns.
+func (w *Wallet) importAccountWithScope(ns walletdb.ReadWriteBucket,
+	name string, accountPubKey *hdkeychain.ExtendedKey,
+	masterKeyFingerprint uint32, keyScope waddrmgr.KeyScope) (
+	*waddrmgr.AccountProperties, error) {
+
+	if err := w.validateExtendedPubKey(accountPubKey, true); err != nil {
+		return nil, err
+	}
+
+	scopedMgr, err := w.Manager.FetchScopedKeyManager(keyScope)
+	if waddrmgr.IsError(err, waddrmgr.ErrScopeNotFound) {
+		scopedMgr, err = w.Manager.NewScopedKeyManager(
+			ns, keyScope,
+			waddrmgr.ScopeAddrMap[waddrmgr.KeyScopeBIP0044],
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := scopedMgr.NewAccountWatchingOnly(
+		ns, name, accountPubKey, masterKeyFingerprint, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scopedMgr.AccountProperties(ns, account)
+}
+
 // ImportPublicKey imports a single derived public key into the address manager.
 // The address type can usually be inferred from the key's version, but in the
 // case of legacy versions (xpub, tpub), an address type must be specified as we
@@ -0,0 +1,154 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// ScopeAccounts records the number of accounts of a key scope, not counting
+// the imported account.
+type ScopeAccounts struct {
+	Scope       waddrmgr.KeyScope
+	NumAccounts uint32
+}
+
+// Info describes the state of a wallet.  It is gathered from the wallet
+// database and does not require a chain server.
+type Info struct {
+	// ConfirmedBalance is the value of all mature unspent outputs with at
+	// least one confirmation.
+	ConfirmedBalance czzutil.Amount
+
+	// UnconfirmedBalance is the value of all unspent outputs of unmined
+	// transactions.
+	UnconfirmedBalance czzutil.Amount
+
+	// ImmatureBalance is the value of all unspent coinbase outputs that
+	// have not reached maturity yet.
+	ImmatureBalance czzutil.Amount
+
+	// FrozenBalance is the value of all frozen unspent outputs, which is
+	// not included in the other balances.
+	FrozenBalance czzutil.Amount
+
+	// TxCount is the number of transactions recorded by the wallet.
+	TxCount int
+
+	// Accounts is the number of accounts of every active key scope,
+	// ordered by key scope.
+	Accounts []ScopeAccounts
+
+	// Locked is whether the wallet is locked.  If it is unlocked,
+	// UnlockedUntil is the time it will be relocked, or zero if that is
+	// not known.
+	Locked        bool
+	UnlockedUntil time.Time
+
+	// WatchOnly is whether the wallet is watching-only.
+	WatchOnly bool
+
+	// Birthday is the earliest time any key of the wallet may have been
+	// used, and BirthdayBlock the block matching it.  BirthdayBlock is the
+	// zero value until the wallet has synced far enough to find the block.
+	Birthday      time.Time
+	BirthdayBlock waddrmgr.BlockStamp
+
+	// SyncedTo is the block the wallet is synced to.
+	SyncedTo waddrmgr.BlockStamp
+
+	// Rescanning and Recovering are whether a rescan or a recovery is
+	// currently running.
+	Rescanning bool
+	Recovering bool
+}
+
+// Info returns the current state of the wallet.
+func (w *Wallet) Info() (*Info, error) {
+	info := &Info{
+		Locked:        w.Manager.IsLocked(),
+		UnlockedUntil: w.UnlockedUntil(),
+		WatchOnly:     w.Manager.WatchOnly(),
+		Birthday:      w.Manager.Birthday(),
+		SyncedTo:      w.Manager.SyncedTo(),
+		Rescanning:    w.Rescanning(),
+		Recovering:    w.Recovering(),
+	}
+
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		var err error
+		info.BirthdayBlock, _, err = w.Manager.BirthdayBlock(addrmgrNs)
+		if waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet) {
+			info.BirthdayBlock = waddrmgr.BlockStamp{}
+		} else if err != nil {
+			return err
+		}
+
+		info.TxCount, err = w.TxStore.TxCount(txmgrNs)
+		if err != nil {
+			return err
+		}
+
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		syncHeight := info.SyncedTo.Height
+		maturity := int32(w.chainParams.CoinbaseMaturity)
+		for i := range unspent {
+			output := &unspent[i]
+			switch {
+			case w.TxStore.IsFrozenOutput(txmgrNs, output.OutPoint):
+				info.FrozenBalance += output.Amount
+			case output.FromCoinBase &&
+				!confirmed(maturity, output.Height, syncHeight):
+				info.ImmatureBalance += output.Amount
+			case confirmed(1, output.Height, syncHeight):
+				info.ConfirmedBalance += output.Amount
+			default:
+				info.UnconfirmedBalance += output.Amount
+			}
+		}
+
+		for _, scopedMgr := range w.Manager.ActiveScopedKeyManagers() {
+			var numAccounts uint32
+			err := scopedMgr.ForEachAccount(addrmgrNs, func(account uint32) error {
+				if account != waddrmgr.ImportedAddrAccount {
+					numAccounts++
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			info.Accounts = append(info.Accounts, ScopeAccounts{
+				Scope:       scopedMgr.Scope(),
+				NumAccounts: numAccounts,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(info.Accounts, func(i, j int) bool {
+		si, sj := info.Accounts[i].Scope, info.Accounts[j].Scope
+		if si.Purpose != sj.Purpose {
+			return si.Purpose < sj.Purpose
+		}
+		return si.Coin < sj.Coin
+	})
+
+	return info, nil
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// TestWalletInfo checks that the wallet info reports unmined credits, the
+// number of transactions and the lock state of the wallet.
+func TestWalletInfo(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+
+	// Add an unmined output paying to the wallet's address.
+	incomingTx := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(100000, pkScript)},
+	}
+	var b bytes.Buffer
+	if err := incomingTx.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+	if err != nil {
+		t.Fatalf("unable to create tx record: %v", err)
+	}
+	if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := w.TxStore.InsertTx(ns, rec, nil)
+		if err != nil {
+			return err
+		}
+		return w.TxStore.AddCredit(ns, rec, nil, 0, false)
+	}); err != nil {
+		t.Fatalf("failed inserting tx: %v", err)
+	}
+
+	info, err := w.Info()
+	if err != nil {
+		t.Fatalf("unable to get wallet info: %v", err)
+	}
+	if info.UnconfirmedBalance != 100000 {
+		t.Fatalf("expected unconfirmed balance 100000, found %v",
+			info.UnconfirmedBalance)
+	}
+	if info.ConfirmedBalance != 0 || info.ImmatureBalance != 0 {
+		t.Fatalf("unexpected confirmed balance %v or immature "+
+			"balance %v", info.ConfirmedBalance, info.ImmatureBalance)
+	}
+	if info.TxCount != 1 {
+		t.Fatalf("expected 1 transaction, found %d", info.TxCount)
+	}
+	if len(info.Accounts) == 0 {
+		t.Fatalf("no key scopes reported")
+	}
+	if info.Locked {
+		t.Fatalf("wallet reported as locked")
+	}
+
+	// Unlocking with a timeout reports the time the wallet relocks.
+	before := time.Now()
+	err = w.UnlockWithTimeout([]byte("world"), time.Minute)
+	if err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+	until := w.UnlockedUntil()
+	if until.Before(before.Add(time.Minute)) ||
+		until.After(time.Now().Add(time.Minute)) {
+
+		t.Fatalf("unexpected unlock expiry %v", until)
+	}
+
+	w.Lock()
+	info, err = w.Info()
+	if err != nil {
+		t.Fatalf("unable to get wallet info: %v", err)
+	}
+	if !info.Locked || !info.UnlockedUntil.IsZero() {
+		t.Fatalf("expected locked wallet without unlock expiry, "+
+			"found locked=%v until=%v", info.Locked,
+			info.UnlockedUntil)
+	}
+}
+
+// TestWalletInfoUnsynced checks that the wallet info of a wallet that has not
+// synced yet, and so doesn't know its birthday block, reports a zero birthday
+// block.
+func TestWalletInfoUnsynced(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		_, _, err := w.Manager.BirthdayBlock(ns)
+		return err
+	})
+	if !waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet) {
+		t.Fatalf("expected ErrBirthdayBlockNotSet, got %v", err)
+	}
+
+	info, err := w.Info()
+	if err != nil {
+		t.Fatalf("unable to get wallet info: %v", err)
+	}
+	if info.BirthdayBlock != (waddrmgr.BlockStamp{}) {
+		t.Fatalf("expected zero birthday block, found %v",
+			info.BirthdayBlock)
+	}
+	if info.Birthday.IsZero() {
+		t.Fatalf("wallet birthday not reported")
+	}
+}
+
+// TestWalletInfoNotRunning checks that the wallet info can be gathered from a
+// wallet that has been stopped, and still reports its lock state.
+func TestWalletInfoNotRunning(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	err := w.UnlockWithTimeout([]byte("world"), time.Minute)
+	if err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+	w.Stop()
+	w.WaitForShutdown()
+
+	infoErr := make(chan error, 1)
+	var info *Info
+	go func() {
+		var err error
+		info, err = w.Info()
+		infoErr <- err
+	}()
+	select {
+	case err := <-infoErr:
+		if err != nil {
+			t.Fatalf("unable to get wallet info: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("wallet info blocked on a stopped wallet")
+	}
+
+	if info.Locked || info.UnlockedUntil.IsZero() {
+		t.Fatalf("expected unlocked wallet with unlock expiry, "+
+			"found locked=%v until=%v", info.Locked,
+			info.UnlockedUntil)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+const (
+	// DefaultLeaseDuration is the duration outputs leased through the RPC
+	// servers remain leased when no duration is requested.
+	DefaultLeaseDuration = 10 * time.Minute
+
+	// leaseSweepInterval is the interval at which expired output leases
+	// and freezes are deleted from the wallet database.
+	leaseSweepInterval = time.Minute
+)
+
+// leaseSweeper periodically deletes expired output leases and freezes until
+// the wallet shuts down.  Expired leases no longer lock their outputs, but
+// remain stored until they are deleted.
+func (w *Wallet) leaseSweeper() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(leaseSweepInterval)
+	defer ticker.Stop()
+
+	quit := w.quitChan()
+	for {
+		select {
+		case <-ticker.C:
+		case <-quit:
+			return
+		}
+
+		if err := w.deleteExpiredLocks(); err != nil {
+			log.Errorf("Unable to delete expired output leases: %v",
+				err)
+		}
+	}
+}
+
+// deleteExpiredLocks deletes the expired output leases and freezes from the
+// wallet database.
+func (w *Wallet) deleteExpiredLocks() error {
+	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.TxStore.DeleteExpiredLockedOutputs(ns); err != nil {
+			return err
+		}
+		return w.TxStore.DeleteExpiredFrozenOutputs(ns)
+	})
+}
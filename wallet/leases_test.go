@@ -0,0 +1,70 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// TestDeleteExpiredLocks checks that sweeping expired locks keeps the leases
+// and freezes which have not expired.
+func TestDeleteExpiredLocks(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addTestCredits(t, w, 100000, 50000, 20000)
+	unspent, err := w.UnspentOutputs(OutputSelectionPolicy{})
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	outPoints := make(map[int64]wire.OutPoint)
+	for _, output := range unspent {
+		outPoints[output.Output.Value] = output.OutPoint
+	}
+
+	id := wtxmgr.LockID{1}
+	_, err = w.LeaseOutput(id, outPoints[100000], -time.Minute)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	_, err = w.LeaseOutput(id, outPoints[50000], time.Hour)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	err = w.FreezeOutput(outPoints[20000], "", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unable to freeze output: %v", err)
+	}
+
+	if err := w.deleteExpiredLocks(); err != nil {
+		t.Fatalf("unable to delete expired locks: %v", err)
+	}
+
+	leases, err := w.ListLeasedOutputs()
+	if err != nil {
+		t.Fatalf("unable to list leases: %v", err)
+	}
+	if len(leases) != 1 || leases[0].Outpoint != outPoints[50000] {
+		t.Fatalf("expected the unexpired lease only, found %d leases",
+			len(leases))
+	}
+	frozen, err := w.ListFrozenOutputs()
+	if err != nil {
+		t.Fatalf("unable to list frozen outputs: %v", err)
+	}
+	if len(frozen) != 1 {
+		t.Fatalf("expected 1 frozen output, found %d", len(frozen))
+	}
+
+	// The output of the expired lease can be leased to another ID.
+	_, err = w.LeaseOutput(wtxmgr.LockID{2}, outPoints[100000], time.Hour)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+}
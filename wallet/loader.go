@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/czzutil/hdkeychain"
 	"github.com/classzz/czzwallet/internal/prompt"
 	"github.com/classzz/czzwallet/waddrmgr"
 	"github.com/classzz/czzwallet/walletdb"
@@ -109,7 +110,7 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte,
 	bday time.Time) (*Wallet, error) {
 
 	return l.createNewWallet(
-		pubPassphrase, privPassphrase, seed, bday, false,
+		pubPassphrase, privPassphrase, seed, bday, false, nil,
 	)
 }
 
@@ -120,12 +121,48 @@ func (l *Loader) CreateNewWatchingOnlyWallet(pubPassphrase []byte,
 	bday time.Time) (*Wallet, error) {
 
 	return l.createNewWallet(
-		pubPassphrase, nil, nil, bday, true,
+		pubPassphrase, nil, nil, bday, true, nil,
+	)
+}
+
+// WatchingOnlyAccount describes an account imported into a new watching-only
+// wallet by its account public key.
+type WatchingOnlyAccount struct {
+	// Name is the name of the account.
+	Name string
+
+	// KeyScope is the key scope the account is imported into.
+	KeyScope waddrmgr.KeyScope
+
+	// AccountPubKey is the extended public key of the account, with the
+	// path m/purpose'/coin_type'/account'.
+	AccountPubKey *hdkeychain.ExtendedKey
+
+	// MasterKeyFingerprint is the fingerprint of the master key the
+	// account public key is derived from, or zero if it is unknown.
+	MasterKeyFingerprint uint32
+}
+
+// CreateWatchingOnlyWalletFromAccounts creates a new watching-only wallet using
+// the provided public passphrase and imports the accounts into it.  The
+// accounts are imported before the wallet is loaded, so that the recovery
+// performed when the wallet first syncs with the chain finds the addresses
+// used by every account since the birthday.  The wallet is not created if any
+// account cannot be imported.
+func (l *Loader) CreateWatchingOnlyWalletFromAccounts(pubPassphrase []byte,
+	accounts []*WatchingOnlyAccount, bday time.Time) (*Wallet, error) {
+
+	if len(accounts) == 0 {
+		return nil, errors.New("no accounts to import")
+	}
+	return l.createNewWallet(
+		pubPassphrase, nil, nil, bday, true, accounts,
 	)
 }
 
 func (l *Loader) createNewWallet(pubPassphrase, privPassphrase,
-	seed []byte, bday time.Time, isWatchingOnly bool) (*Wallet, error) {
+	seed []byte, bday time.Time, isWatchingOnly bool,
+	accounts []*WatchingOnlyAccount) (*Wallet, error) {
 
 	defer l.mu.Unlock()
 	l.mu.Lock()
@@ -173,6 +210,25 @@ func (l *Loader) createNewWallet(pubPassphrase, privPassphrase,
 	if err != nil {
 		return nil, err
 	}
+
+	// Import the accounts of a watching-only wallet before it can be
+	// synced.  When an account cannot be imported, the wallet database is
+	// removed so that the wallet may be created again.
+	for _, account := range accounts {
+		_, err := w.ImportAccountWithScope(
+			account.Name, account.AccountPubKey,
+			account.MasterKeyFingerprint, account.KeyScope,
+		)
+		if err != nil {
+			if e := db.Close(); e != nil {
+				log.Warnf("Error closing database: %v", e)
+			}
+			if e := os.Remove(dbPath); e != nil {
+				log.Warnf("Error removing database: %v", e)
+			}
+			return nil, err
+		}
+	}
 	w.Start()
 
 	l.onLoaded(w, db)
@@ -0,0 +1,109 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/czzwallet/waddrmgr"
+)
+
+// TestCreateWatchingOnlyWalletFromAccounts checks that a watching-only wallet
+// created from the account public key of another wallet derives the same
+// addresses, and that no wallet is created when an account is rejected.
+func TestCreateWatchingOnlyWalletFromAccounts(t *testing.T) {
+	w1, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	xpub, err := w1.AccountXpub(scope, 0)
+	if err != nil {
+		t.Fatalf("unable to get account xpub: %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "wallet_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, 250)
+	w2, err := loader.CreateWatchingOnlyWalletFromAccounts(
+		[]byte("hello"), []*WatchingOnlyAccount{{
+			Name:                 "watched",
+			KeyScope:             scope,
+			AccountPubKey:        xpub.AccountPubKey,
+			MasterKeyFingerprint: xpub.Origin.Fingerprint,
+		}}, time.Now(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create watching-only wallet: %v", err)
+	}
+	defer w2.db.Close()
+	w2.chainClient = &mockChainClient{}
+
+	if !w2.Manager.WatchOnly() {
+		t.Fatalf("created wallet is not watching-only")
+	}
+	account, err := w2.AccountNumber(scope, "watched")
+	if err != nil {
+		t.Fatalf("unable to find imported account: %v", err)
+	}
+	addr1, err := w1.CurrentAddress(0, scope)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	addr2, err := w2.CurrentAddress(account, scope)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	if addr1.String() != addr2.String() {
+		t.Fatalf("watching-only wallet derived address %v, expected %v",
+			addr2, addr1)
+	}
+	xpub2, err := w2.AccountXpub(scope, account)
+	if err != nil {
+		t.Fatalf("unable to get account xpub: %v", err)
+	}
+	if xpub2.Origin.Fingerprint != xpub.Origin.Fingerprint {
+		t.Fatalf("master key fingerprint %08x, expected %08x",
+			xpub2.Origin.Fingerprint, xpub.Origin.Fingerprint)
+	}
+
+	// Keys which are not account keys are rejected, and no wallet is
+	// left behind.
+	branchKey, err := xpub.AccountPubKey.DeriveNonStandard(0) // nolint:staticcheck
+	if err != nil {
+		t.Fatalf("unable to derive branch key: %v", err)
+	}
+	dir2, err := ioutil.TempDir("", "wallet_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+	defer os.RemoveAll(dir2)
+
+	loader = NewLoader(&chaincfg.TestNet3Params, dir2, 250)
+	_, err = loader.CreateWatchingOnlyWalletFromAccounts(
+		[]byte("hello"), []*WatchingOnlyAccount{{
+			Name:          "branch",
+			KeyScope:      scope,
+			AccountPubKey: branchKey,
+		}}, time.Now(),
+	)
+	if err == nil {
+		t.Fatalf("created watching-only wallet from a branch key")
+	}
+	exists, err := loader.WalletExists()
+	if err != nil {
+		t.Fatalf("unable to check wallet existence: %v", err)
+	}
+	if exists {
+		t.Fatalf("wallet exists after failed creation")
+	}
+}
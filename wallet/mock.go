@@ -63,6 +63,10 @@ func (m *mockChainClient) SendRawTransaction(*wire.MsgTx, bool) (
 	return nil, nil
 }
 
+func (m *mockChainClient) EstimateFeeRate(uint32) (czzutil.Amount, error) {
+	return 0, chain.ErrFeeEstimateUnavailable
+}
+
 func (m *mockChainClient) Rescan(*chainhash.Hash, []czzutil.Address,
 	map[wire.OutPoint]czzutil.Address) error {
 	return nil
@@ -6,14 +6,38 @@
 package wallet
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/czzec"
 	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
 	"github.com/classzz/czzwallet/waddrmgr"
 	"github.com/classzz/czzwallet/walletdb"
 )
 
+var (
+	// ErrMissingSignatures is recorded for inputs spending multisig scripts
+	// which are signed by fewer cosigners than the script requires.
+	ErrMissingSignatures = errors.New("missing signatures")
+
+	// ErrCombineMismatch is returned when the transactions combined by
+	// CombineTransactions are not copies of the same transaction.
+	ErrCombineMismatch = errors.New("transactions to combine differ " +
+		"in more than their signature scripts")
+
+	// ErrRedeemScriptMismatch is returned when a signature script combined
+	// by CombineTransactions redeems a script other than the one hashed by
+	// the pay-to-script-hash output the input spends.
+	ErrRedeemScriptMismatch = errors.New("redeem script does not match " +
+		"the script hash of the spent output")
+)
+
 // MakeMultiSigScript creates a multi-signature script that can be redeemed with
 // nRequired signatures of the passed keys and addresses.  If the address is a
 // P2PKH address, the associated pubkey is looked up by the wallet if possible,
@@ -77,38 +101,335 @@ func (w *Wallet) ImportP2SHRedeemScript(script []byte) (*czzutil.AddressScriptHa
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 
-		// TODO(oga) blockstamp current block?
-		bs := &waddrmgr.BlockStamp{
-			Hash:   *w.ChainParams().GenesisHash,
-			Height: 0,
+		var err error
+		p2shAddr, err = w.importP2SHRedeemScript(addrmgrNs, script)
+		return err
+	})
+	return p2shAddr, err
+}
+
+// importP2SHRedeemScript adds a P2SH redeem script to the address manager
+// within an existing database transaction.
+func (w *Wallet) importP2SHRedeemScript(addrmgrNs walletdb.ReadWriteBucket,
+	script []byte) (*czzutil.AddressScriptHash, error) {
+
+	// TODO(oga) blockstamp current block?
+	bs := &waddrmgr.BlockStamp{
+		Hash:   *w.ChainParams().GenesisHash,
+		Height: 0,
+	}
+
+	// As this is a regular P2SH script, we'll import this into the
+	// BIP0044 scope.
+	bip44Mgr, err := w.Manager.FetchScopedKeyManager(
+		waddrmgr.KeyScopeBIP0044,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	addrInfo, err := bip44Mgr.ImportScript(addrmgrNs, script, bs)
+	if err != nil {
+		// Don't care if it's already there, but still have to set the
+		// p2shAddr since the address manager didn't return anything
+		// useful.
+		if waddrmgr.IsError(err, waddrmgr.ErrDuplicateAddress) {
+			// This function will never error as it always hashes
+			// the script to the correct length.
+			p2shAddr, _ := czzutil.NewAddressScriptHash(script,
+				w.chainParams)
+			return p2shAddr, nil
 		}
+		return nil, err
+	}
+
+	return addrInfo.Address().(*czzutil.AddressScriptHash), nil
+}
+
+// NewMultiSigAccount creates a multisig account for the key scope whose
+// addresses are nRequired-of-n pay-to-script-hash multisig addresses of the
+// keys derived from the account extended public keys of the n cosigners.
+// Cosigners may include accounts of the same scope of this wallet, as reported
+// by AccountProperties, in which case the wallet is able to sign for them.
+func (w *Wallet) NewMultiSigAccount(scope waddrmgr.KeyScope, name string,
+	nRequired int, cosignerKeys []*hdkeychain.ExtendedKey) (
+	*waddrmgr.AccountProperties, error) {
+
+	var props *waddrmgr.AccountProperties
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 
-		// As this is a regular P2SH script, we'll import this into the
-		// BIP0044 scope.
-		bip44Mgr, err := w.Manager.FetchScopedKeyManager(
-			waddrmgr.KeyScopeBIP0084,
+		var err error
+		props, err = w.newMultiSigAccount(
+			ns, scope, name, nRequired, cosignerKeys,
 		)
-		if err != nil {
-			return err
+		return err
+	})
+	return props, err
+}
+
+// newMultiSigAccount creates a multisig account for the key scope within the
+// database transaction of ns.
+func (w *Wallet) newMultiSigAccount(ns walletdb.ReadWriteBucket,
+	scope waddrmgr.KeyScope, name string, nRequired int,
+	cosignerKeys []*hdkeychain.ExtendedKey) (
+	*waddrmgr.AccountProperties, error) {
+
+	for _, key := range cosignerKeys {
+		if err := w.validateExtendedPubKey(key, true); err != nil {
+			return nil, err
+		}
+	}
+
+	scopedMgr, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+	account, err := scopedMgr.NewMultiSigAccount(
+		ns, name, nRequired, cosignerKeys,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scopedMgr.AccountProperties(ns, account)
+}
+
+// multiSigKeys returns the private keys held by the wallet for the cosigners of
+// the multisig account address paid to by pkScript, keyed by their serialized
+// public keys.  No keys are returned when pkScript does not pay to the address
+// of a multisig account.
+func (w *Wallet) multiSigKeys(addrmgrNs walletdb.ReadBucket,
+	pkScript []byte) (map[string]*czzec.PrivateKey, error) {
+
+	if !txscript.IsPayToScriptHash(pkScript) {
+		return nil, nil
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		pkScript, w.chainParams,
+	)
+	if err != nil || len(addrs) != 1 {
+		return nil, nil
+	}
+	addr, err := w.Manager.Address(addrmgrNs, addrs[0])
+	if err != nil {
+		return nil, nil
+	}
+	msa, ok := addr.(waddrmgr.ManagedMultiSigAddress)
+	if !ok {
+		return nil, nil
+	}
+
+	privKeys, err := msa.PrivKeys(addrmgrNs)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*czzec.PrivateKey, len(privKeys))
+	for _, privKey := range privKeys {
+		keys[string(privKey.PubKey().SerializeCompressed())] = privKey
+	}
+	return keys, nil
+}
+
+// CombineTransactions combines copies of the same transaction which are
+// partially signed by different cosigners into a single transaction.  The
+// signatures of inputs spending multisig scripts are merged and ordered as the
+// keys of the script, while other inputs keep the first of their signature
+// scripts which satisfies the output they spend.
+//
+// The outputs spent by the transaction are looked up in prevOutputs, and then
+// in the wallet, as the signatures commit to the amounts they spend.
+func (w *Wallet) CombineTransactions(txs []*wire.MsgTx,
+	prevOutputs map[wire.OutPoint]*wire.TxOut) (*wire.MsgTx, error) {
+
+	if len(txs) == 0 {
+		return nil, errors.New("no transactions to combine")
+	}
+	unsignedHash := unsignedTxHash(txs[0])
+	for _, tx := range txs[1:] {
+		if unsignedTxHash(tx) != unsignedHash {
+			return nil, ErrCombineMismatch
 		}
+	}
+
+	combined := txs[0].Copy()
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 
-		addrInfo, err := bip44Mgr.ImportScript(addrmgrNs, script, bs)
-		if err != nil {
-			// Don't care if it's already there, but still have to
-			// set the p2shAddr since the address manager didn't
-			// return anything useful.
-			if waddrmgr.IsError(err, waddrmgr.ErrDuplicateAddress) {
-				// This function will never error as it always
-				// hashes the script to the correct length.
-				p2shAddr, _ = czzutil.NewAddressScriptHash(script,
-					w.chainParams)
-				return nil
+		for i, txIn := range combined.TxIn {
+			var sigScripts [][]byte
+			for _, tx := range txs {
+				sigScript := tx.TxIn[i].SignatureScript
+				if len(sigScript) != 0 {
+					sigScripts = append(sigScripts, sigScript)
+				}
+			}
+			if len(sigScripts) < 2 {
+				continue
 			}
-			return err
-		}
 
-		p2shAddr = addrInfo.Address().(*czzutil.AddressScriptHash)
+			op := txIn.PreviousOutPoint
+			prevOut, ok := prevOutputs[op]
+			if !ok {
+				txDetails, err := w.TxStore.TxDetails(
+					txmgrNs, &op.Hash,
+				)
+				if err != nil {
+					return err
+				}
+				if txDetails == nil ||
+					int(op.Index) >= len(txDetails.MsgTx.TxOut) {
+
+					return fmt.Errorf("output spent by input "+
+						"%v not found", op)
+				}
+				prevOut = txDetails.MsgTx.TxOut[op.Index]
+			}
+
+			sigScript, err := mergeSignatureScripts(
+				w.chainParams, combined, i, prevOut, sigScripts,
+			)
+			if err != nil {
+				return err
+			}
+			txIn.SignatureScript = sigScript
+		}
 		return nil
 	})
-	return p2shAddr, err
+	if err != nil {
+		return nil, err
+	}
+	return combined, nil
+}
+
+// unsignedTxHash returns the hash of tx without its signature scripts, which
+// identifies the copies of a transaction signed by different cosigners.
+func unsignedTxHash(tx *wire.MsgTx) chainhash.Hash {
+	unsigned := tx.Copy()
+	for _, txIn := range unsigned.TxIn {
+		txIn.SignatureScript = nil
+	}
+	return unsigned.TxHash()
+}
+
+// mergeSignatureScripts merges the signature scripts of input idx of tx, which
+// spends prevOut.  The signatures of multisig scripts, either bare or nested in
+// a pay-to-script-hash script, are verified and merged in the order of the keys
+// of the script.  For any other script, the first signature script satisfying
+// prevOut is returned.  ErrRedeemScriptMismatch is returned if the redeem
+// script of a signature script spending a pay-to-script-hash output does not
+// hash to the script hash of the output.
+func mergeSignatureScripts(chainParams *chaincfg.Params, tx *wire.MsgTx,
+	idx int, prevOut *wire.TxOut, sigScripts [][]byte) ([]byte, error) {
+
+	isP2SH := txscript.IsPayToScriptHash(prevOut.PkScript)
+	script := prevOut.PkScript
+	var sigs [][]byte
+	for _, sigScript := range sigScripts {
+		pushes, err := txscript.PushedData(sigScript)
+		if err != nil || len(pushes) == 0 {
+			continue
+		}
+		if isP2SH {
+			// The script hash of a pay-to-script-hash script is
+			// pushed after its OP_HASH160 opcode.
+			script = pushes[len(pushes)-1]
+			scriptHash := prevOut.PkScript[2:22]
+			if !bytes.Equal(czzutil.Hash160(script), scriptHash) {
+				return nil, fmt.Errorf("%w: input %d",
+					ErrRedeemScriptMismatch, idx)
+			}
+			pushes = pushes[:len(pushes)-1]
+		}
+		for _, push := range pushes {
+			if len(push) != 0 {
+				sigs = append(sigs, push)
+			}
+		}
+	}
+
+	if txscript.GetScriptClass(script) != txscript.MultiSigTy {
+		for _, sigScript := range sigScripts {
+			if inputScriptValid(tx, idx, prevOut, sigScript) {
+				return sigScript, nil
+			}
+		}
+		return sigScripts[0], nil
+	}
+
+	// Signing the input without any keys merges every signature found in
+	// the previous script which verifies against one of the keys of the
+	// multisig script, in the order of the keys.
+	builder := txscript.NewScriptBuilder().AddOp(txscript.OP_0)
+	for _, sig := range sigs {
+		builder.AddData(sig)
+	}
+	if isP2SH {
+		builder.AddData(script)
+	}
+	prevScript, err := builder.Script()
+	if err != nil {
+		return nil, err
+	}
+	getKey := txscript.KeyClosure(func(czzutil.Address) (*czzec.PrivateKey, bool, error) {
+		return nil, false, errors.New("no key for address")
+	})
+	getScript := txscript.ScriptClosure(func(czzutil.Address) ([]byte, error) {
+		return script, nil
+	})
+	return txscript.SignTxOutput(
+		chainParams, tx, idx, prevOut.Value, prevOut.PkScript,
+		txscript.SigHashAll, getKey, getScript, prevScript,
+	)
+}
+
+// inputScriptValid returns whether sigScript satisfies prevOut when used as
+// the signature script of input idx of tx.
+func inputScriptValid(tx *wire.MsgTx, idx int, prevOut *wire.TxOut,
+	sigScript []byte) bool {
+
+	tx = tx.Copy()
+	tx.TxIn[idx].SignatureScript = sigScript
+	vm, err := txscript.NewEngine(
+		prevOut.PkScript, tx, idx, txscript.StandardVerifyFlags, nil,
+		nil, prevOut.Value,
+	)
+	if err != nil {
+		return false
+	}
+	return vm.Execute() == nil
+}
+
+// multiSigCompleteness returns the number of signatures of a signature script
+// spending a multisig script, either bare or nested in a pay-to-script-hash
+// script, and the number of signatures required by the script.  False is
+// returned if pkScript does not pay to a multisig script.
+func multiSigCompleteness(chainParams *chaincfg.Params, pkScript,
+	sigScript []byte) (int, int, bool) {
+
+	pushes, err := txscript.PushedData(sigScript)
+	if err != nil {
+		return 0, 0, false
+	}
+	script := pkScript
+	if txscript.IsPayToScriptHash(pkScript) {
+		if len(pushes) == 0 {
+			return 0, 0, false
+		}
+		script = pushes[len(pushes)-1]
+		pushes = pushes[:len(pushes)-1]
+	}
+	class, _, nRequired, err := txscript.ExtractPkScriptAddrs(
+		script, chainParams,
+	)
+	if err != nil || class != txscript.MultiSigTy {
+		return 0, 0, false
+	}
+
+	signed := 0
+	for _, push := range pushes {
+		if len(push) != 0 {
+			signed++
+		}
+	}
+	return signed, nRequired, true
 }
@@ -0,0 +1,294 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/waddrmgr"
+)
+
+// TestMultiSigAccount checks that cosigners of a multisig account derive the
+// same addresses regardless of the order of the cosigner keys, and that the
+// partial signatures of enough cosigners, either added in turn or combined,
+// spend outputs paying to them.
+func TestMultiSigAccount(t *testing.T) {
+	w1, cleanup1 := testWallet(t)
+	defer cleanup1()
+	w2, cleanup2 := testWallet(t)
+	defer cleanup2()
+	w3, cleanup3 := testWallet(t)
+	defer cleanup3()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	var keys []*hdkeychain.ExtendedKey
+	for _, w := range []*Wallet{w1, w2, w3} {
+		props, err := w.AccountProperties(scope, 0)
+		if err != nil {
+			t.Fatalf("unable to get account properties: %v", err)
+		}
+		keys = append(keys, props.AccountPubKey)
+	}
+
+	if _, err := w1.NewMultiSigAccount(scope, "multisig", 4, keys); err == nil {
+		t.Fatalf("created multisig account requiring more " +
+			"signatures than cosigners")
+	}
+	dupKeys := []*hdkeychain.ExtendedKey{keys[0], keys[1], keys[0]}
+	if _, err := w1.NewMultiSigAccount(scope, "multisig", 2, dupKeys); err == nil {
+		t.Fatalf("created multisig account with duplicate cosigners")
+	}
+
+	// Each of the first two cosigners creates the account with its keys in
+	// a different order.
+	props1, err := w1.NewMultiSigAccount(scope, "multisig", 2, keys)
+	if err != nil {
+		t.Fatalf("unable to create multisig account: %v", err)
+	}
+	reversed := []*hdkeychain.ExtendedKey{keys[2], keys[1], keys[0]}
+	props2, err := w2.NewMultiSigAccount(scope, "multisig", 2, reversed)
+	if err != nil {
+		t.Fatalf("unable to create multisig account: %v", err)
+	}
+	if props1.RequiredSigs != 2 || len(props1.CosignerKeys) != 3 {
+		t.Fatalf("unexpected multisig account properties %v", props1)
+	}
+
+	// The cosigner keys of the properties are copies, so zeroing them
+	// leaves the keys the account derives its addresses from intact.
+	for _, key := range props1.CosignerKeys {
+		key.Zero()
+	}
+
+	for i := 0; i < 3; i++ {
+		addr1, err := w1.NewAddress(props1.AccountNumber, scope)
+		if err != nil {
+			t.Fatalf("unable to get new address: %v", err)
+		}
+		addr2, err := w2.NewAddress(props2.AccountNumber, scope)
+		if err != nil {
+			t.Fatalf("unable to get new address: %v", err)
+		}
+		if _, ok := addr1.(*czzutil.AddressScriptHash); !ok {
+			t.Fatalf("expected P2SH address, got %T", addr1)
+		}
+		if addr1.String() != addr2.String() {
+			t.Fatalf("cosigners derived different addresses %v "+
+				"and %v", addr1, addr2)
+		}
+	}
+	addr, err := w1.CurrentAddress(props1.AccountNumber, scope)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	current, err := w2.CurrentAddress(props2.AccountNumber, scope)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	if addr.String() != current.String() {
+		t.Fatalf("cosigners have different current addresses %v and "+
+			"%v", addr, current)
+	}
+
+	info, err := w1.AddressInfo(addr)
+	if err != nil {
+		t.Fatalf("unable to get address info: %v", err)
+	}
+	msa, ok := info.(waddrmgr.ManagedMultiSigAddress)
+	if !ok {
+		t.Fatalf("expected multisig address, got %T", info)
+	}
+	if msa.RequiredSigs() != 2 || len(msa.PubKeys()) != 3 {
+		t.Fatalf("unexpected multisig address %d of %d",
+			msa.RequiredSigs(), len(msa.PubKeys()))
+	}
+
+	// Spend an output paying to the address.  A single cosigner only
+	// partially signs the input, which the second cosigner completes.
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+	prevOut := wire.OutPoint{Hash: chainhash.Hash{1}}
+	prevScripts := map[wire.OutPoint][]byte{prevOut: pkScript}
+	inputValues := []int64{100000}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(&prevOut, nil))
+	tx.AddTxOut(wire.NewTxOut(90000, pkScript))
+
+	prevValues := []czzutil.Amount{czzutil.Amount(inputValues[0])}
+	unsigned := tx.Copy()
+	signErrs, err := w1.SignTransaction(
+		tx, inputValues, txscript.SigHashAll, prevScripts, nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to sign transaction: %v", err)
+	}
+	if len(signErrs) != 1 ||
+		!errors.Is(signErrs[0].Error, ErrMissingSignatures) {
+
+		t.Fatalf("expected input to be missing signatures, got %v",
+			signErrs)
+	}
+	err = validateMsgTx(tx, [][]byte{pkScript}, prevValues)
+	if err == nil {
+		t.Fatalf("input signed by a single cosigner is valid")
+	}
+	partial := tx.Copy()
+
+	// The second cosigner merges its signature with the first one.
+	signErrs, err = w2.SignTransaction(
+		tx, inputValues, txscript.SigHashAll, prevScripts, nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to sign transaction: %v", err)
+	}
+	if len(signErrs) != 0 {
+		t.Fatalf("unable to complete signatures: %v", signErrs[0].Error)
+	}
+	err = validateMsgTx(tx, [][]byte{pkScript}, prevValues)
+	if err != nil {
+		t.Fatalf("invalid input script: %v", err)
+	}
+
+	// Copies signed separately by each cosigner are combined as well.
+	_, err = w2.SignTransaction(
+		unsigned, inputValues, txscript.SigHashAll, prevScripts, nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to sign transaction: %v", err)
+	}
+	prevOutputs := map[wire.OutPoint]*wire.TxOut{
+		prevOut: wire.NewTxOut(inputValues[0], pkScript),
+	}
+	combined, err := w3.CombineTransactions(
+		[]*wire.MsgTx{unsigned, partial}, prevOutputs,
+	)
+	if err != nil {
+		t.Fatalf("unable to combine transactions: %v", err)
+	}
+	err = validateMsgTx(combined, [][]byte{pkScript}, prevValues)
+	if err != nil {
+		t.Fatalf("invalid combined input script: %v", err)
+	}
+	if !bytes.Equal(combined.TxIn[0].SignatureScript,
+		tx.TxIn[0].SignatureScript) {
+
+		t.Fatalf("combined signatures are not in key order")
+	}
+
+	other := unsigned.Copy()
+	other.TxOut[0].Value--
+	_, err = w3.CombineTransactions(
+		[]*wire.MsgTx{unsigned, other}, prevOutputs,
+	)
+	if !errors.Is(err, ErrCombineMismatch) {
+		t.Fatalf("expected ErrCombineMismatch, got %v", err)
+	}
+
+	// A copy redeeming a script other than the one paid to is rejected.
+	forged := unsigned.Copy()
+	forged.TxIn[0].SignatureScript, err = txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData([]byte{txscript.OP_TRUE}).
+		Script()
+	if err != nil {
+		t.Fatalf("unable to create signature script: %v", err)
+	}
+	_, err = w3.CombineTransactions(
+		[]*wire.MsgTx{partial, forged}, prevOutputs,
+	)
+	if !errors.Is(err, ErrRedeemScriptMismatch) {
+		t.Fatalf("expected ErrRedeemScriptMismatch, got %v", err)
+	}
+}
+
+// TestMultiSigAccountLateCosigner checks that a wallet signs for a multisig
+// account with the keys of a cosigner account created after the multisig
+// account.
+func TestMultiSigAccountLateCosigner(t *testing.T) {
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.MinSeedBytes)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	w1, cleanup1 := testWalletFromSeed(t, seed)
+	defer cleanup1()
+	w2, cleanup2 := testWalletFromSeed(t, seed)
+	defer cleanup2()
+	w3, cleanup3 := testWallet(t)
+	defer cleanup3()
+
+	// The second wallet derives the key of the account the first wallet
+	// creates after its multisig account, which takes account number 1.
+	scope := waddrmgr.KeyScopeBIP0044
+	for _, name := range []string{"unused", "cosigner"} {
+		if _, err := w2.NextAccount(scope, name); err != nil {
+			t.Fatalf("unable to create account: %v", err)
+		}
+	}
+	props, err := w2.AccountProperties(scope, 2)
+	if err != nil {
+		t.Fatalf("unable to get account properties: %v", err)
+	}
+	otherProps, err := w3.AccountProperties(scope, 0)
+	if err != nil {
+		t.Fatalf("unable to get account properties: %v", err)
+	}
+	keys := []*hdkeychain.ExtendedKey{
+		props.AccountPubKey, otherProps.AccountPubKey,
+	}
+
+	msProps, err := w1.NewMultiSigAccount(scope, "multisig", 1, keys)
+	if err != nil {
+		t.Fatalf("unable to create multisig account: %v", err)
+	}
+	addr, err := w1.CurrentAddress(msProps.AccountNumber, scope)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	account, err := w1.NextAccount(scope, "cosigner")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	if account != 2 {
+		t.Fatalf("created account %d, expected 2", account)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+	prevOut := wire.OutPoint{Hash: chainhash.Hash{1}}
+	prevScripts := map[wire.OutPoint][]byte{prevOut: pkScript}
+	inputValues := []int64{100000}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(&prevOut, nil))
+	tx.AddTxOut(wire.NewTxOut(90000, pkScript))
+
+	signErrs, err := w1.SignTransaction(
+		tx, inputValues, txscript.SigHashAll, prevScripts, nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to sign transaction: %v", err)
+	}
+	if len(signErrs) != 0 {
+		t.Fatalf("unable to sign input: %v", signErrs[0].Error)
+	}
+	prevValues := []czzutil.Amount{czzutil.Amount(inputValues[0])}
+	err = validateMsgTx(tx, [][]byte{pkScript}, prevValues)
+	if err != nil {
+		t.Fatalf("invalid input script: %v", err)
+	}
+}
@@ -0,0 +1,817 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txrules"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+const (
+	// DefaultPayoutInterval is the time between two flushes of the payout
+	// queue when no interval is configured.
+	DefaultPayoutInterval = time.Minute
+
+	// DefaultPayoutBatchSize is the number of queued payouts of an account
+	// that triggers a flush, and the largest number of payouts sent by a
+	// single transaction, when no batch size is configured.
+	DefaultPayoutBatchSize = 100
+
+	// DefaultPayoutRetention is how long confirmed and cancelled payouts
+	// remain recorded when no retention is configured.
+	DefaultPayoutRetention = 30 * 24 * time.Hour
+
+	// MaxPayoutKeyLen is the maximum length of the idempotency key of a
+	// payout.
+	MaxPayoutKeyLen = 255
+)
+
+var (
+	// ErrPayoutQueueStopped is returned when a payout is enqueued while
+	// the payout queue is not running.
+	ErrPayoutQueueStopped = errors.New("payout queue is not running")
+
+	// ErrInvalidPayoutKey is returned when a payout is enqueued with an
+	// empty or overly long idempotency key.
+	ErrInvalidPayoutKey = fmt.Errorf("payout key must be between 1 and "+
+		"%d bytes", MaxPayoutKeyLen)
+
+	// ErrPayoutKeyConflict is returned when a payout is enqueued with the
+	// idempotency key of a different payout.
+	ErrPayoutKeyConflict = errors.New("payout key is already used by a " +
+		"different payout")
+
+	// ErrPayoutNotFound is returned when no payout is recorded for an
+	// idempotency key.
+	ErrPayoutNotFound = errors.New("payout not found")
+
+	// ErrPayoutNotQueued is returned when a payout that has already been
+	// sent or cancelled is cancelled.  Failed payouts are still queued.
+	ErrPayoutNotQueued = errors.New("payout is no longer queued")
+
+	// payoutsNamespaceKey is the key of the bucket recording the payout
+	// queue.
+	payoutsNamespaceKey = []byte("payouts")
+)
+
+// PayoutState describes the progress of a queued payout.
+type PayoutState uint8
+
+// These constants define the states of a payout.
+const (
+	// PayoutQueued is the state of a payout waiting for the next flush of
+	// the queue.
+	PayoutQueued PayoutState = iota
+
+	// PayoutBroadcast is the state of a payout whose transaction has been
+	// recorded by the wallet but not mined.  The transaction is rebroadcast
+	// until it is mined, even if its first broadcast failed.
+	PayoutBroadcast
+
+	// PayoutConfirmed is the state of a payout whose transaction has been
+	// mined.
+	PayoutConfirmed
+
+	// PayoutCancelled is the state of a payout cancelled before being
+	// sent.
+	PayoutCancelled
+
+	// PayoutFailed is the state of a payout whose last send attempt
+	// failed.  It is sent again at the next flush of the queue.
+	PayoutFailed
+)
+
+// String returns the name of a payout state.
+func (s PayoutState) String() string {
+	switch s {
+	case PayoutQueued:
+		return "queued"
+	case PayoutBroadcast:
+		return "broadcast"
+	case PayoutConfirmed:
+		return "confirmed"
+	case PayoutCancelled:
+		return "cancelled"
+	case PayoutFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// Payout describes a payment requested through the payout queue.
+type Payout struct {
+	// Key is the idempotency key the payout was enqueued with.
+	Key string
+
+	// Account is the account funding the payout, PkScript the output
+	// script paid, and Amount the value paid.
+	Account  uint32
+	PkScript []byte
+	Amount   czzutil.Amount
+
+	// State is the progress of the payout, and TxHash the hash of the
+	// transaction sending it once it is broadcast.
+	State  PayoutState
+	TxHash chainhash.Hash
+
+	// Error describes why the last attempt to send a failed payout did
+	// not succeed.
+	Error string
+
+	// Created is the time the payout was enqueued, and Updated the time
+	// its state was last recorded.
+	Created time.Time
+	Updated time.Time
+}
+
+// PayoutQueueConfig configures the flushing of the payout queue.
+type PayoutQueueConfig struct {
+	// Interval is the time between two flushes of the queue.
+	// DefaultPayoutInterval is used if zero.
+	Interval time.Duration
+
+	// BatchSize is the number of queued payouts of an account that
+	// triggers a flush before the interval elapses, and the largest number
+	// of payouts sent by a single transaction.  DefaultPayoutBatchSize is
+	// used if zero.
+	BatchSize int
+
+	// RequiredConfirmations is the number of confirmations of the outputs
+	// spent by payout transactions.
+	RequiredConfirmations int32
+
+	// ConfTarget is the confirmation target the fee rate of payout
+	// transactions is estimated for.  DefaultConfTarget is used if zero.
+	ConfTarget uint32
+
+	// Retention is how long payouts remain recorded once their
+	// transaction is mined or they are cancelled.  Payouts are no longer
+	// idempotent once pruned.  DefaultPayoutRetention is used if zero.
+	Retention time.Duration
+}
+
+// payoutQueue is the state of a running payout queue.
+type payoutQueue struct {
+	cfg   PayoutQueueConfig
+	flush chan struct{}
+	quit  chan struct{}
+
+	// pending is the number of queued and failed payouts of each account,
+	// so that full batches are detected without reading every recorded
+	// payout.  It is protected by the payoutMtx of the wallet.
+	pending map[uint32]int
+}
+
+// StartPayoutQueue starts flushing the payout queue.  Every configured
+// interval, or as soon as an account has a full batch of queued payouts, the
+// queued payouts of each account are sent together by a single transaction.
+// Payouts whose transaction is no longer recorded by the wallet are marked as
+// failed and sent again.  Any previously started queue is stopped.
+func (w *Wallet) StartPayoutQueue(cfg PayoutQueueConfig) error {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultPayoutInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultPayoutBatchSize
+	}
+	if cfg.ConfTarget == 0 {
+		cfg.ConfTarget = DefaultConfTarget
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = DefaultPayoutRetention
+	}
+
+	w.payoutMtx.Lock()
+	defer w.payoutMtx.Unlock()
+
+	pending, err := w.reconcilePayouts(cfg.Retention)
+	if err != nil {
+		return err
+	}
+
+	if w.payoutQueue != nil {
+		close(w.payoutQueue.quit)
+	}
+	queue := &payoutQueue{
+		cfg:     cfg,
+		flush:   make(chan struct{}, 1),
+		quit:    make(chan struct{}),
+		pending: make(map[uint32]int),
+	}
+	for _, payout := range pending {
+		queue.pending[payout.Account]++
+	}
+	w.payoutQueue = queue
+
+	w.wg.Add(1)
+	go w.payoutHandler(queue)
+	return nil
+}
+
+// StopPayoutQueue stops flushing the payout queue.  Queued payouts remain
+// recorded and are sent once the queue is started again.
+func (w *Wallet) StopPayoutQueue() {
+	w.payoutMtx.Lock()
+	if w.payoutQueue != nil {
+		close(w.payoutQueue.quit)
+		w.payoutQueue = nil
+	}
+	w.payoutMtx.Unlock()
+}
+
+// payoutHandler flushes the payout queue every configured interval, and when
+// triggered by a full batch, until the queue is stopped or the wallet shuts
+// down.
+func (w *Wallet) payoutHandler(queue *payoutQueue) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(queue.cfg.Interval)
+	defer ticker.Stop()
+
+	quit := w.quitChan()
+	for {
+		select {
+		case <-ticker.C:
+		case <-queue.flush:
+		case <-queue.quit:
+			return
+		case <-quit:
+			return
+		}
+
+		if err := w.flushPayouts(queue); err != nil {
+			log.Errorf("Unable to flush payout queue: %v", err)
+		}
+	}
+}
+
+// EnqueuePayout queues a payment of amount to pkScript funded by an account,
+// to be sent with the other queued payouts at the next flush.  The key makes
+// the request idempotent: enqueueing a payout with the key of an identical
+// payout returns the recorded payout, in whatever state it is, while a key
+// used by a different payout results in ErrPayoutKeyConflict.  Keys are only
+// remembered until their payout is pruned, once the configured retention has
+// elapsed since it was mined or cancelled.
+func (w *Wallet) EnqueuePayout(key string, account uint32, pkScript []byte,
+	amount czzutil.Amount) (*Payout, error) {
+
+	if len(key) == 0 || len(key) > MaxPayoutKeyLen {
+		return nil, ErrInvalidPayoutKey
+	}
+	output := wire.NewTxOut(int64(amount), pkScript)
+	err := txrules.CheckOutput(output, txrules.DefaultRelayFeePerKb)
+	if err != nil {
+		return nil, err
+	}
+
+	w.payoutMtx.Lock()
+	defer w.payoutMtx.Unlock()
+
+	queue := w.payoutQueue
+	if queue == nil {
+		return nil, ErrPayoutQueueStopped
+	}
+
+	var (
+		payout *Payout
+		added  bool
+	)
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		ns, err := payoutsBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		payout, err = fetchPayout(ns, key)
+		switch {
+		case err == ErrPayoutNotFound:
+		case err != nil:
+			return err
+		case payout.Account != account || payout.Amount != amount ||
+			string(payout.PkScript) != string(pkScript):
+
+			return ErrPayoutKeyConflict
+		default:
+			return nil
+		}
+
+		// Payouts are funded by accounts of the default key scope.
+		manager, err := w.Manager.FetchScopedKeyManager(
+			waddrmgr.KeyScopeBIP0044,
+		)
+		if err != nil {
+			return err
+		}
+		if _, err := manager.AccountName(addrmgrNs, account); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		payout = &Payout{
+			Key:      key,
+			Account:  account,
+			PkScript: pkScript,
+			Amount:   amount,
+			State:    PayoutQueued,
+			Created:  now,
+			Updated:  now,
+		}
+		added = true
+		return putPayout(ns, payout)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !added {
+		return w.payoutWithState(payout)
+	}
+
+	// Flush the queue early once the account has a full batch.
+	queue.pending[account]++
+	if queue.pending[account] >= queue.cfg.BatchSize {
+		select {
+		case queue.flush <- struct{}{}:
+		default:
+		}
+	}
+	return w.payoutWithState(payout)
+}
+
+// CancelPayout cancels a queued or failed payout.  ErrPayoutNotQueued is
+// returned if the payout has already been sent or cancelled.
+func (w *Wallet) CancelPayout(key string) (*Payout, error) {
+	w.payoutMtx.Lock()
+	defer w.payoutMtx.Unlock()
+
+	var payout *Payout
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := payoutsBucket(tx)
+		if err != nil {
+			return err
+		}
+		payout, err = fetchPayout(ns, key)
+		if err != nil {
+			return err
+		}
+		switch payout.State {
+		case PayoutQueued, PayoutFailed:
+		default:
+			return ErrPayoutNotQueued
+		}
+		payout.State = PayoutCancelled
+		payout.Updated = time.Now()
+		return putPayout(ns, payout)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if w.payoutQueue != nil {
+		w.payoutQueue.pending[payout.Account]--
+	}
+	return payout, nil
+}
+
+// Payout returns the payout enqueued with a key.
+func (w *Wallet) Payout(key string) (*Payout, error) {
+	var payout *Payout
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(payoutsNamespaceKey)
+		if ns == nil {
+			return ErrPayoutNotFound
+		}
+		var err error
+		payout, err = fetchPayout(ns, key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return w.payoutWithState(payout)
+}
+
+// Payouts returns all recorded payouts, oldest first.
+func (w *Wallet) Payouts() ([]*Payout, error) {
+	var payouts []*Payout
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(payoutsNamespaceKey)
+		if ns == nil {
+			return nil
+		}
+		return forEachPayout(ns, func(p *Payout) error {
+			payouts = append(payouts, p)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortPayouts(payouts)
+	for i, payout := range payouts {
+		payouts[i], err = w.payoutWithState(payout)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return payouts, nil
+}
+
+// FlushPayouts sends the queued payouts without waiting for the next flush
+// of the running payout queue.
+func (w *Wallet) FlushPayouts() error {
+	w.payoutMtx.Lock()
+	queue := w.payoutQueue
+	w.payoutMtx.Unlock()
+
+	if queue == nil {
+		return ErrPayoutQueueStopped
+	}
+	return w.flushPayouts(queue)
+}
+
+// payoutWithState returns a payout marked as confirmed once the transaction
+// sending it has been mined.
+func (w *Wallet) payoutWithState(payout *Payout) (*Payout, error) {
+	if payout.State != PayoutBroadcast {
+		return payout, nil
+	}
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		details, err := w.TxStore.TxDetails(txmgrNs, &payout.TxHash)
+		if err != nil {
+			return err
+		}
+		if details != nil && details.Block.Height != -1 {
+			payout.State = PayoutConfirmed
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+// flushPayouts sends the queued and failed payouts of each account, in batches
+// of at most the configured batch size.  A batch that cannot be sent does not
+// prevent the others from being sent: its payouts are marked as failed, and
+// the first error is returned once every batch has been attempted.
+func (w *Wallet) flushPayouts(queue *payoutQueue) error {
+	w.payoutMtx.Lock()
+	defer w.payoutMtx.Unlock()
+
+	cfg := queue.cfg
+	pending, err := w.reconcilePayouts(cfg.Retention)
+	if err != nil {
+		return err
+	}
+	queue.pending = make(map[uint32]int)
+	if len(pending) == 0 {
+		return nil
+	}
+	sortPayouts(pending)
+
+	var (
+		accounts []uint32
+		batches  = make(map[uint32][]*Payout)
+	)
+	for _, payout := range pending {
+		if _, ok := batches[payout.Account]; !ok {
+			accounts = append(accounts, payout.Account)
+		}
+		batches[payout.Account] = append(batches[payout.Account], payout)
+	}
+
+	var firstErr error
+	feeRate := w.EstimateFeeRate(cfg.ConfTarget)
+	for _, account := range accounts {
+		payouts := batches[account]
+		for len(payouts) > 0 {
+			n := len(payouts)
+			if n > cfg.BatchSize {
+				n = cfg.BatchSize
+			}
+			err := w.sendPayouts(
+				payouts[:n], account, cfg.RequiredConfirmations,
+				feeRate,
+			)
+			if err != nil {
+				log.Errorf("Unable to send %d payouts of "+
+					"account %d: %v", n, account, err)
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+			payouts = payouts[n:]
+		}
+	}
+
+	// Only the failed payouts are still waiting to be sent.
+	for _, payout := range pending {
+		if payout.State == PayoutFailed {
+			queue.pending[payout.Account]++
+		}
+	}
+	return firstErr
+}
+
+// sendPayouts sends payouts of an account with a single transaction.  The
+// payouts are marked as broadcast by the database transaction recording the
+// transaction sending them.  Once recorded, the transaction is rebroadcast
+// until it is mined, so the payouts are never sent twice, even if its first
+// broadcast fails.  The payouts are marked as failed if the transaction
+// cannot be created or is not recorded.
+func (w *Wallet) sendPayouts(payouts []*Payout, account uint32,
+	minconf int32, feeRate czzutil.Amount) error {
+
+	outputs := make([]*wire.TxOut, len(payouts))
+	for i, payout := range payouts {
+		outputs[i] = wire.NewTxOut(int64(payout.Amount), payout.PkScript)
+	}
+	authoredTx, err := w.CreateSimpleTx(
+		&waddrmgr.KeyScopeBIP0044, account, outputs, minconf, feeRate,
+		false,
+	)
+	if err != nil {
+		return w.failPayouts(payouts, err)
+	}
+
+	txHash := authoredTx.Tx.TxHash()
+	_, err = w.reliablyPublishTransaction(
+		authoredTx.Tx, "", func(dbTx walletdb.ReadWriteTx) error {
+			ns, err := payoutsBucket(dbTx)
+			if err != nil {
+				return err
+			}
+			return putPayoutsState(
+				ns, payouts, PayoutBroadcast, txHash,
+			)
+		},
+	)
+	if err != nil {
+		return w.failPayouts(payouts, err)
+	}
+
+	log.Infof("Sent %d payouts of account %d in transaction %v",
+		len(payouts), account, txHash)
+	return nil
+}
+
+// failPayouts records that payouts could not be sent because of sendErr, which
+// is returned.  Payouts whose transaction is recorded by the wallet remain
+// broadcast, since the transaction is rebroadcast until it is mined.
+func (w *Wallet) failPayouts(payouts []*Payout, sendErr error) error {
+	now := time.Now()
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := payoutsBucket(tx)
+		if err != nil {
+			return err
+		}
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		for _, payout := range payouts {
+			recorded, err := fetchPayout(ns, payout.Key)
+			if err != nil {
+				return err
+			}
+			if recorded.State == PayoutBroadcast {
+				details, err := w.TxStore.TxDetails(
+					txmgrNs, &recorded.TxHash,
+				)
+				if err != nil {
+					return err
+				}
+				if details != nil {
+					*payout = *recorded
+					continue
+				}
+			}
+
+			recorded.State = PayoutFailed
+			recorded.TxHash = chainhash.Hash{}
+			recorded.Error = sendErr.Error()
+			recorded.Updated = now
+			if err := putPayout(ns, recorded); err != nil {
+				return err
+			}
+			*payout = *recorded
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Unable to record failed payouts: %v", err)
+	}
+	return sendErr
+}
+
+// reconcilePayouts returns the payouts waiting to be sent.  Broadcast payouts
+// whose transaction is no longer recorded by the wallet, because it was
+// rejected or double spent, are marked as failed so that they are sent again.
+// Payouts whose transaction was mined, or that were cancelled, before the
+// retention period are pruned.
+func (w *Wallet) reconcilePayouts(retention time.Duration) ([]*Payout,
+	error) {
+
+	var pending []*Payout
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := payoutsBucket(tx)
+		if err != nil {
+			return err
+		}
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		var (
+			now    = time.Now()
+			cutoff = now.Add(-retention)
+			unsent []*Payout
+			pruned []string
+		)
+		err = forEachPayout(ns, func(p *Payout) error {
+			switch p.State {
+			case PayoutQueued, PayoutFailed:
+				pending = append(pending, p)
+
+			case PayoutCancelled:
+				if p.Updated.Before(cutoff) {
+					pruned = append(pruned, p.Key)
+				}
+
+			case PayoutBroadcast:
+				details, err := w.TxStore.TxDetails(
+					txmgrNs, &p.TxHash,
+				)
+				if err != nil {
+					return err
+				}
+				switch {
+				case details == nil:
+					unsent = append(unsent, p)
+				case details.Block.Height != -1 &&
+					details.Block.Time.Before(cutoff):
+
+					pruned = append(pruned, p.Key)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, payout := range unsent {
+			log.Infof("Transaction %v of payout %q is no longer "+
+				"recorded, sending the payout again",
+				payout.TxHash, payout.Key)
+
+			payout.Error = fmt.Sprintf("transaction %v is no "+
+				"longer recorded", payout.TxHash)
+			payout.State = PayoutFailed
+			payout.TxHash = chainhash.Hash{}
+			payout.Updated = now
+			if err := putPayout(ns, payout); err != nil {
+				return err
+			}
+			pending = append(pending, payout)
+		}
+		for _, key := range pruned {
+			if err := ns.Delete([]byte(key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// putPayoutsState records the state of payouts, and the hash of the
+// transaction sending them.
+func putPayoutsState(ns walletdb.ReadWriteBucket, payouts []*Payout,
+	state PayoutState, txHash chainhash.Hash) error {
+
+	now := time.Now()
+	for _, payout := range payouts {
+		payout.State = state
+		payout.TxHash = txHash
+		payout.Error = ""
+		payout.Updated = now
+		if err := putPayout(ns, payout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortPayouts sorts payouts by the time they were enqueued, then by key.
+func sortPayouts(payouts []*Payout) {
+	sort.Slice(payouts, func(i, j int) bool {
+		a, b := payouts[i], payouts[j]
+		if !a.Created.Equal(b.Created) {
+			return a.Created.Before(b.Created)
+		}
+		return a.Key < b.Key
+	})
+}
+
+// payoutsBucket returns the bucket recording the payout queue, creating it
+// for wallets that do not have one yet.
+func payoutsBucket(tx walletdb.ReadWriteTx) (walletdb.ReadWriteBucket, error) {
+	ns := tx.ReadWriteBucket(payoutsNamespaceKey)
+	if ns != nil {
+		return ns, nil
+	}
+	return tx.CreateTopLevelBucket(payoutsNamespaceKey)
+}
+
+// Payouts are recorded in the payouts bucket, keyed by their idempotency key.
+// The value of a payout is serialized as follows:
+//
+//	[0]        State
+//	[1:5]      Account (4 bytes)
+//	[5:13]     Amount (8 bytes)
+//	[13:21]    Created, as a Unix time in nanoseconds (8 bytes)
+//	[21:29]    Updated, as a Unix time in nanoseconds (8 bytes)
+//	[29:61]    Transaction hash (32 bytes)
+//	[61:65]    Output script length (4 bytes)
+//	[65:65+n]  Output script
+//	[65+n:]    Error of the last failed send attempt
+const payoutHeaderSize = 65
+
+// putPayout records a payout.
+func putPayout(ns walletdb.ReadWriteBucket, payout *Payout) error {
+	scriptEnd := payoutHeaderSize + len(payout.PkScript)
+	v := make([]byte, scriptEnd+len(payout.Error))
+	v[0] = byte(payout.State)
+	binary.BigEndian.PutUint32(v[1:5], payout.Account)
+	binary.BigEndian.PutUint64(v[5:13], uint64(payout.Amount))
+	binary.BigEndian.PutUint64(v[13:21], uint64(payout.Created.UnixNano()))
+	binary.BigEndian.PutUint64(v[21:29], uint64(payout.Updated.UnixNano()))
+	copy(v[29:61], payout.TxHash[:])
+	binary.BigEndian.PutUint32(v[61:65], uint32(len(payout.PkScript)))
+	copy(v[65:scriptEnd], payout.PkScript)
+	copy(v[scriptEnd:], payout.Error)
+	return ns.Put([]byte(payout.Key), v)
+}
+
+// fetchPayout returns the payout recorded for a key, or ErrPayoutNotFound.
+func fetchPayout(ns walletdb.ReadBucket, key string) (*Payout, error) {
+	v := ns.Get([]byte(key))
+	if v == nil {
+		return nil, ErrPayoutNotFound
+	}
+	return deserializePayout([]byte(key), v)
+}
+
+// forEachPayout calls f with every recorded payout.
+func forEachPayout(ns walletdb.ReadBucket, f func(*Payout) error) error {
+	return ns.ForEach(func(k, v []byte) error {
+		payout, err := deserializePayout(k, v)
+		if err != nil {
+			return err
+		}
+		return f(payout)
+	})
+}
+
+// deserializePayout deserializes the payout recorded under a key.
+func deserializePayout(k, v []byte) (*Payout, error) {
+	if len(v) < payoutHeaderSize {
+		return nil, fmt.Errorf("payout %q: short read (expected at "+
+			"least %d bytes, read %d)", k, payoutHeaderSize, len(v))
+	}
+	scriptLen := binary.BigEndian.Uint32(v[61:65])
+	if uint64(len(v)-payoutHeaderSize) < uint64(scriptLen) {
+		return nil, fmt.Errorf("payout %q: short read (expected at "+
+			"least %d bytes, read %d)", k,
+			payoutHeaderSize+uint64(scriptLen), len(v))
+	}
+	scriptEnd := payoutHeaderSize + int(scriptLen)
+	payout := &Payout{
+		Key:      string(k),
+		State:    PayoutState(v[0]),
+		Account:  binary.BigEndian.Uint32(v[1:5]),
+		Amount:   czzutil.Amount(binary.BigEndian.Uint64(v[5:13])),
+		Created:  time.Unix(0, int64(binary.BigEndian.Uint64(v[13:21]))),
+		Updated:  time.Unix(0, int64(binary.BigEndian.Uint64(v[21:29]))),
+		PkScript: append([]byte(nil), v[65:scriptEnd]...),
+		Error:    string(v[scriptEnd:]),
+	}
+	copy(payout.TxHash[:], v[29:61])
+	return payout, nil
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// TestPayoutQueue checks that payouts are enqueued idempotently, that
+// cancelled payouts are not sent, and that the queued payouts are sent by a
+// single transaction whose state is tracked until it is mined.
+func TestPayoutQueue(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	w.SetFeeEstimator(FeeEstimators{})
+	pkScript := addTestCredits(t, w, 1000000)
+
+	_, err := w.EnqueuePayout("a", 0, pkScript, 10000)
+	if err != ErrPayoutQueueStopped {
+		t.Fatalf("expected stopped queue error, found %v", err)
+	}
+	err = w.StartPayoutQueue(PayoutQueueConfig{
+		Interval:              time.Hour,
+		BatchSize:             10,
+		RequiredConfirmations: 1,
+	})
+	if err != nil {
+		t.Fatalf("unable to start payout queue: %v", err)
+	}
+	defer w.StopPayoutQueue()
+
+	payouts := []struct {
+		key    string
+		amount int64
+	}{
+		{"a", 10000},
+		{"b", 20000},
+		{"c", 30000},
+	}
+	for _, p := range payouts {
+		_, err := w.EnqueuePayout(p.key, 0, pkScript, 0)
+		if err == nil {
+			t.Fatalf("enqueued payout %q of dust amount", p.key)
+		}
+		payout, err := w.EnqueuePayout(
+			p.key, 0, pkScript, czzutil.Amount(p.amount),
+		)
+		if err != nil {
+			t.Fatalf("unable to enqueue payout %q: %v", p.key, err)
+		}
+		if payout.State != PayoutQueued {
+			t.Fatalf("payout %q enqueued in state %v", p.key,
+				payout.State)
+		}
+	}
+
+	// Enqueueing the same payout again returns the recorded payout, while
+	// reusing its key for a different payout fails.
+	first, err := w.Payout("a")
+	if err != nil {
+		t.Fatalf("unable to fetch payout: %v", err)
+	}
+	again, err := w.EnqueuePayout("a", 0, pkScript, 10000)
+	if err != nil {
+		t.Fatalf("unable to enqueue payout again: %v", err)
+	}
+	if !again.Created.Equal(first.Created) {
+		t.Fatalf("payout recorded twice")
+	}
+	_, err = w.EnqueuePayout("a", 0, pkScript, 10001)
+	if err != ErrPayoutKeyConflict {
+		t.Fatalf("expected key conflict error, found %v", err)
+	}
+
+	if _, err := w.CancelPayout("c"); err != nil {
+		t.Fatalf("unable to cancel payout: %v", err)
+	}
+	if _, err := w.CancelPayout("c"); err != ErrPayoutNotQueued {
+		t.Fatalf("expected payout not queued error, found %v", err)
+	}
+	if _, err := w.CancelPayout("d"); err != ErrPayoutNotFound {
+		t.Fatalf("expected payout not found error, found %v", err)
+	}
+
+	if err := w.FlushPayouts(); err != nil {
+		t.Fatalf("unable to flush payouts: %v", err)
+	}
+
+	recorded, err := w.Payouts()
+	if err != nil {
+		t.Fatalf("unable to list payouts: %v", err)
+	}
+	if len(recorded) != 3 {
+		t.Fatalf("expected 3 payouts, found %d", len(recorded))
+	}
+	a, b, c := recorded[0], recorded[1], recorded[2]
+	if a.Key != "a" || b.Key != "b" || c.Key != "c" {
+		t.Fatalf("payouts listed out of order: %v, %v, %v", a.Key,
+			b.Key, c.Key)
+	}
+	if a.State != PayoutBroadcast || b.State != PayoutBroadcast ||
+		c.State != PayoutCancelled {
+
+		t.Fatalf("unexpected payout states %v, %v, %v", a.State,
+			b.State, c.State)
+	}
+	if a.TxHash != b.TxHash {
+		t.Fatalf("payouts sent by different transactions")
+	}
+
+	// The transaction pays both payouts and change.
+	var details *wtxmgr.TxDetails
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		details, err = w.TxStore.TxDetails(ns, &a.TxHash)
+		return err
+	})
+	if err != nil || details == nil {
+		t.Fatalf("payout transaction not recorded: %v", err)
+	}
+	if len(details.MsgTx.TxOut) != 3 {
+		t.Fatalf("expected 3 outputs, found %d",
+			len(details.MsgTx.TxOut))
+	}
+
+	// Nothing is left to send.
+	if err := w.FlushPayouts(); err != nil {
+		t.Fatalf("unable to flush payouts: %v", err)
+	}
+
+	// Once mined, the payouts are confirmed.
+	var buf bytes.Buffer
+	if err := details.MsgTx.Serialize(&buf); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	rec, err := wtxmgr.NewTxRecord(buf.Bytes(), time.Now())
+	if err != nil {
+		t.Fatalf("unable to create tx record: %v", err)
+	}
+	blockHash, _ := chainhash.NewHashFromStr(
+		"00000000000000017188b968a371bab95aa43522665353b646e41865abae02a4")
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *blockHash, Height: 276425},
+		Time:  time.Unix(1387737310, 0),
+	}
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.InsertTx(ns, rec, block)
+	})
+	if err != nil {
+		t.Fatalf("unable to mine payout transaction: %v", err)
+	}
+	payout, err := w.Payout("b")
+	if err != nil {
+		t.Fatalf("unable to fetch payout: %v", err)
+	}
+	if payout.State != PayoutConfirmed {
+		t.Fatalf("expected confirmed payout, found %v", payout.State)
+	}
+
+	// The payouts were mined before the retention period, so they are
+	// pruned at the next flush, while the cancelled payout is kept.
+	if err := w.FlushPayouts(); err != nil {
+		t.Fatalf("unable to flush payouts: %v", err)
+	}
+	if _, err := w.Payout("b"); err != ErrPayoutNotFound {
+		t.Fatalf("expected payout not found error, found %v", err)
+	}
+	if _, err := w.Payout("c"); err != nil {
+		t.Fatalf("unable to fetch cancelled payout: %v", err)
+	}
+}
+
+// TestPayoutQueueFailure checks that payouts that cannot be sent are marked
+// as failed without preventing the payouts of other accounts from being sent,
+// and that failed payouts are sent again at the next flush.
+func TestPayoutQueueFailure(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	w.SetFeeEstimator(FeeEstimators{})
+	pkScript := addTestCredits(t, w, 1000000)
+	account, err := w.NextAccount(waddrmgr.KeyScopeBIP0044, "empty")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+
+	err = w.StartPayoutQueue(PayoutQueueConfig{
+		Interval:              time.Hour,
+		RequiredConfirmations: 1,
+	})
+	if err != nil {
+		t.Fatalf("unable to start payout queue: %v", err)
+	}
+	defer w.StopPayoutQueue()
+
+	// The empty account is enqueued first, so that its batch is sent
+	// first.
+	_, err = w.EnqueuePayout("a", account, pkScript, 10000)
+	if err != nil {
+		t.Fatalf("unable to enqueue payout: %v", err)
+	}
+	if _, err := w.EnqueuePayout("b", 0, pkScript, 20000); err != nil {
+		t.Fatalf("unable to enqueue payout: %v", err)
+	}
+	if err := w.FlushPayouts(); err == nil {
+		t.Fatalf("flushed payouts of an empty account")
+	}
+
+	a, err := w.Payout("a")
+	if err != nil {
+		t.Fatalf("unable to fetch payout: %v", err)
+	}
+	if a.State != PayoutFailed || a.Error == "" {
+		t.Fatalf("expected failed payout, found %v (%q)", a.State,
+			a.Error)
+	}
+	b, err := w.Payout("b")
+	if err != nil {
+		t.Fatalf("unable to fetch payout: %v", err)
+	}
+	if b.State != PayoutBroadcast {
+		t.Fatalf("expected broadcast payout, found %v", b.State)
+	}
+
+	// The failed payout is attempted again, and can still be cancelled.
+	if err := w.FlushPayouts(); err == nil {
+		t.Fatalf("flushed payouts of an empty account")
+	}
+	if _, err := w.CancelPayout("a"); err != nil {
+		t.Fatalf("unable to cancel failed payout: %v", err)
+	}
+	if err := w.FlushPayouts(); err != nil {
+		t.Fatalf("unable to flush payouts: %v", err)
+	}
+}
+
+// TestPayoutQueueRequeue checks that payouts marked as broadcast by a
+// transaction the wallet no longer records are marked as failed, to be sent
+// again, when the queue starts.
+func TestPayoutQueueRequeue(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	pkScript := addTestCredits(t, w, 1000000)
+	err := w.StartPayoutQueue(PayoutQueueConfig{Interval: time.Hour})
+	if err != nil {
+		t.Fatalf("unable to start payout queue: %v", err)
+	}
+	payout, err := w.EnqueuePayout("a", 0, pkScript, 10000)
+	if err != nil {
+		t.Fatalf("unable to enqueue payout: %v", err)
+	}
+
+	// Mark the payout as sent by a transaction that is not recorded, as if
+	// it was removed after being rejected.
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(payoutsNamespaceKey)
+		return putPayoutsState(
+			ns, []*Payout{payout}, PayoutBroadcast,
+			chainhash.Hash{1},
+		)
+	})
+	if err != nil {
+		t.Fatalf("unable to record payout state: %v", err)
+	}
+	w.StopPayoutQueue()
+
+	err = w.StartPayoutQueue(PayoutQueueConfig{Interval: time.Hour})
+	if err != nil {
+		t.Fatalf("unable to start payout queue: %v", err)
+	}
+	defer w.StopPayoutQueue()
+
+	payout, err = w.Payout("a")
+	if err != nil {
+		t.Fatalf("unable to fetch payout: %v", err)
+	}
+	if payout.State != PayoutFailed || payout.Error == "" ||
+		payout.TxHash != (chainhash.Hash{}) {
+
+		t.Fatalf("expected failed payout, found %v in %v",
+			payout.State, payout.TxHash)
+	}
+}
@@ -0,0 +1,624 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	btctxscript "github.com/btcsuite/btcd/txscript"
+	btcwire "github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil/psbt"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txauthor"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+const (
+	// DefaultPsbtLeaseDuration is the duration the inputs of a packet
+	// funded by FundPsbt remain leased when the caller doesn't specify
+	// one.
+	DefaultPsbtLeaseDuration = 10 * time.Minute
+)
+
+var (
+	// PsbtLockID is the lock ID used by the RPC servers to lease the inputs
+	// of a packet funded by FundPsbt when the caller doesn't specify one.
+	PsbtLockID = wtxmgr.LockID{
+		'c', 'z', 'z', 'w', 'a', 'l', 'l', 'e', 't', '-', 'p', 's', 'b', 't',
+	}
+
+	// ErrPsbtIncomplete is returned by FinalizePsbt when one or more inputs
+	// of a packet are still missing signatures after the wallet has signed
+	// everything it is able to.
+	ErrPsbtIncomplete = errors.New("psbt is missing signatures for one or " +
+		"more inputs")
+)
+
+// toPsbtTx converts a transaction to the wire representation used by the psbt
+// package.  Both share the same serialization, so the conversion is a round
+// trip through it.
+func toPsbtTx(tx *wire.MsgTx) (*btcwire.MsgTx, error) {
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	ptx := new(btcwire.MsgTx)
+	if err := ptx.DeserializeNoWitness(&buf); err != nil {
+		return nil, err
+	}
+	return ptx, nil
+}
+
+// fromPsbtTx converts a transaction from the wire representation used by the
+// psbt package.
+func fromPsbtTx(ptx *btcwire.MsgTx) (*wire.MsgTx, error) {
+	var buf bytes.Buffer
+	buf.Grow(ptx.SerializeSizeStripped())
+	if err := ptx.SerializeNoWitness(&buf); err != nil {
+		return nil, err
+	}
+
+	tx := new(wire.MsgTx)
+	if err := tx.Deserialize(&buf); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// NewPsbt creates a new packet for the unsigned transaction.  Any signature
+// scripts of the transaction are ignored.
+func NewPsbt(tx *wire.MsgTx) (*psbt.Packet, error) {
+	unsignedTx := tx.Copy()
+	for _, txIn := range unsignedTx.TxIn {
+		txIn.SignatureScript = nil
+	}
+
+	ptx, err := toPsbtTx(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	return psbt.NewFromUnsignedTx(ptx)
+}
+
+// ExtractPsbtTx returns the network serializable transaction of a finalized
+// packet.
+func ExtractPsbtTx(packet *psbt.Packet) (*wire.MsgTx, error) {
+	ptx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, err
+	}
+	return fromPsbtTx(ptx)
+}
+
+// FinalizePsbtInputs finalizes every input of the packet that carries enough
+// signatures to do so, and reports whether the packet is complete afterwards.
+// Inputs still lacking signatures are left untouched so that other signers
+// may complete them later.
+func FinalizePsbtInputs(packet *psbt.Packet) bool {
+	for i := range packet.UnsignedTx.TxIn {
+		_, _ = psbt.MaybeFinalize(packet, i)
+	}
+	return packet.IsComplete()
+}
+
+// FundPsbt adds enough inputs to the passed packet to pay for its outputs at
+// the given fee rate.  If there is change left, a change output from the
+// wallet is added and its index is returned.  Otherwise no additional output
+// is created and the index -1 is returned.
+//
+// If the packet doesn't contain any inputs, coin selection is performed
+// automatically, only selecting outputs of the given account and key scope
+// with at least minconf confirmations.  If the packet does contain inputs, it
+// is assumed that coin selection happened externally and no additional inputs
+// are added.  Every such input must be controlled by the wallet, and an error
+// is returned if they don't pay for the outputs at the given fee rate.
+//
+// Every input of the funded packet is leased under lockID for leaseDuration so
+// that it isn't selected again until the packet is published, which releases
+// the leases, or abandoned with AbandonPsbt.  Inputs provided by the caller
+// must be unspent, and must not be frozen or leased under another ID.  Every
+// input carries its previous transaction and, where known, its BIP0032
+// derivation.  The leases taken by a call that fails are released.
+func (w *Wallet) FundPsbt(packet *psbt.Packet, keyScope *waddrmgr.KeyScope,
+	account uint32, minconf int32, feeSatPerKB czzutil.Amount,
+	lockID wtxmgr.LockID, leaseDuration time.Duration) (_ int32, err error) {
+
+	if len(packet.UnsignedTx.TxOut) == 0 {
+		return 0, errors.New("psbt must contain at least one output")
+	}
+	if len(packet.UnsignedTx.TxIn) != len(packet.Inputs) ||
+		len(packet.UnsignedTx.TxOut) != len(packet.Outputs) {
+
+		return 0, errors.New("psbt inputs or outputs do not match the " +
+			"unsigned transaction")
+	}
+
+	unsignedTx, err := fromPsbtTx(packet.UnsignedTx)
+	if err != nil {
+		return 0, err
+	}
+
+	// The current chain tip is only needed to determine the confirmations
+	// of the outputs eligible for coin selection.
+	var bs *waddrmgr.BlockStamp
+	if len(unsignedTx.TxIn) == 0 {
+		chainClient, err := w.requireChainClient()
+		if err != nil {
+			return 0, err
+		}
+		bs, err = chainClient.BlockStamp()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var (
+		tx      *txauthor.AuthoredTx
+		prevTxs []*wire.MsgTx
+		leased  []wire.OutPoint
+	)
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs, changeSource, err := w.addrMgrWithChangeSource(
+			dbtx, keyScope, account,
+		)
+		if err != nil {
+			return err
+		}
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		var inputSource txauthor.InputSource
+		if len(unsignedTx.TxIn) > 0 {
+			inputSource, err = w.constantInputSource(
+				addrmgrNs, txmgrNs, unsignedTx.TxIn, &lockID,
+			)
+		} else {
+			var eligible []wtxmgr.Credit
+			eligible, err = w.findEligibleOutputs(
+				dbtx, keyScope, account, minconf, bs,
+			)
+			inputSource = makeInputSource(
+				eligible, w.CoinSelector(), coinSelectionParams(
+					unsignedTx.TxOut, feeSatPerKB,
+					changeSource.ScriptSize,
+					w.redeemScriptSource(addrmgrNs),
+				),
+			)
+		}
+		if err != nil {
+			return err
+		}
+
+		tx, err = txauthor.NewUnsignedTransaction(
+			unsignedTx.TxOut, feeSatPerKB, inputSource, changeSource,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Lease every input so that concurrent coin selection doesn't
+		// double spend the packet before it is published, and gather
+		// the previous transactions to include in the packet.  Inputs
+		// already leased by the caller are only extended, and are not
+		// released should the packet fail to be completed.
+		prevTxs = make([]*wire.MsgTx, len(tx.Tx.TxIn))
+		for i, txIn := range tx.Tx.TxIn {
+			op := txIn.PreviousOutPoint
+			_, isLeased := w.TxStore.IsLockedOutput(txmgrNs, op)
+			_, err := w.TxStore.LockOutput(
+				txmgrNs, lockID, op, leaseDuration,
+			)
+			if err != nil {
+				return err
+			}
+			if !isLeased {
+				leased = append(leased, op)
+			}
+
+			details, err := w.TxStore.TxDetails(txmgrNs, &op.Hash)
+			if err != nil {
+				return err
+			}
+			if details == nil {
+				return fmt.Errorf("previous transaction of input "+
+					"%v not found", op)
+			}
+			prevTxs[i] = &details.MsgTx
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if err := w.releasePsbtLeases(lockID, leased); err != nil {
+			log.Errorf("Unable to release the inputs of an "+
+				"unfunded PSBT: %v", err)
+		}
+	}()
+
+	// Keep the caller's version and lock time.  Inputs provided by the
+	// caller were reused as is, so their sequence numbers are kept too,
+	// while selected inputs are made non-final for the lock time to be
+	// enforced.
+	tx.Tx.Version = unsignedTx.Version
+	tx.Tx.LockTime = unsignedTx.LockTime
+	if len(unsignedTx.TxIn) == 0 && unsignedTx.LockTime != 0 {
+		for _, txIn := range tx.Tx.TxIn {
+			txIn.Sequence = wire.MaxTxInSequenceNum - 1
+		}
+	}
+
+	pInputs := packet.Inputs
+	if len(pInputs) == 0 {
+		pInputs = make([]psbt.PInput, len(tx.Tx.TxIn))
+	}
+	pOutputs := packet.Outputs
+	if tx.ChangeIndex >= 0 {
+		// The change output is appended last, so move its (empty)
+		// packet output along with it when randomizing its position.
+		pOutputs = append(pOutputs, psbt.POutput{})
+		oldIndex := tx.ChangeIndex
+		tx.RandomizeChangePosition()
+		pOutputs[oldIndex], pOutputs[tx.ChangeIndex] =
+			pOutputs[tx.ChangeIndex], pOutputs[oldIndex]
+	}
+
+	ptx, err := toPsbtTx(tx.Tx)
+	if err != nil {
+		return 0, err
+	}
+	packet.UnsignedTx = ptx
+	packet.Inputs = pInputs
+	packet.Outputs = pOutputs
+
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return 0, err
+	}
+	for i, prevTx := range prevTxs {
+		if packet.Inputs[i].NonWitnessUtxo == nil {
+			prevPtx, err := toPsbtTx(prevTx)
+			if err != nil {
+				return 0, err
+			}
+			err = updater.AddInNonWitnessUtxo(prevPtx, i)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		if len(packet.Inputs[i].Bip32Derivation) != 0 {
+			continue
+		}
+		prevOut := prevTx.TxOut[tx.Tx.TxIn[i].PreviousOutPoint.Index]
+		err := w.addPsbtDerivation(updater, i, prevOut.PkScript)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return int32(tx.ChangeIndex), nil
+}
+
+// constantInputSource creates an input source that always returns the passed
+// inputs, which were selected by the caller.  Every input must spend an output
+// controlled by the wallet that is available as checked by ownedInputs.
+func (w *Wallet) constantInputSource(addrmgrNs, txmgrNs walletdb.ReadBucket,
+	txIns []*wire.TxIn, lockID *wtxmgr.LockID) (txauthor.InputSource, error) {
+
+	total, inputValues, scripts, err := w.ownedInputs(
+		addrmgrNs, txmgrNs, txIns, lockID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(czzutil.Amount) (czzutil.Amount, []*wire.TxIn,
+		[]czzutil.Amount, [][]byte, error) {
+
+		return total, txIns, inputValues, scripts, nil
+	}, nil
+}
+
+// ownedInputs returns the total value, and the value and output script of
+// every previous output spent by txIns.  Every input must spend an unspent
+// output controlled by the wallet which is neither locked nor frozen.  Outputs
+// leased under lockID are accepted, while outputs leased under any other ID,
+// or under any ID if lockID is nil, are not.
+func (w *Wallet) ownedInputs(addrmgrNs, txmgrNs walletdb.ReadBucket,
+	txIns []*wire.TxIn, lockID *wtxmgr.LockID) (czzutil.Amount,
+	[]czzutil.Amount, [][]byte, error) {
+
+	var (
+		total       czzutil.Amount
+		inputValues = make([]czzutil.Amount, 0, len(txIns))
+		scripts     = make([][]byte, 0, len(txIns))
+	)
+	for _, txIn := range txIns {
+		op := txIn.PreviousOutPoint
+		details, err := w.TxStore.TxDetails(txmgrNs, &op.Hash)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		if details == nil || op.Index >= uint32(len(details.MsgTx.TxOut)) {
+			return 0, nil, nil, fmt.Errorf("input %v: %v", op, ErrNotMine)
+		}
+
+		txOut := details.MsgTx.TxOut[op.Index]
+		if !w.isOwnedScript(addrmgrNs, txOut.PkScript) {
+			return 0, nil, nil, fmt.Errorf("input %v: %v", op, ErrNotMine)
+		}
+		if !w.TxStore.IsUnspentOutput(txmgrNs, op) {
+			return 0, nil, nil, fmt.Errorf("input %v is already "+
+				"spent", op)
+		}
+		if w.LockedOutpoint(op) {
+			return 0, nil, nil, fmt.Errorf("input %v is locked", op)
+		}
+		id, isLeased := w.TxStore.IsLockedOutput(txmgrNs, op)
+		if isLeased && (lockID == nil || id != *lockID) {
+			return 0, nil, nil, fmt.Errorf("input %v: %v", op,
+				wtxmgr.ErrOutputAlreadyLocked)
+		}
+		if w.TxStore.IsFrozenOutput(txmgrNs, op) {
+			return 0, nil, nil, fmt.Errorf("input %v is frozen", op)
+		}
+
+		total += czzutil.Amount(txOut.Value)
+		inputValues = append(inputValues, czzutil.Amount(txOut.Value))
+		scripts = append(scripts, txOut.PkScript)
+	}
+	return total, inputValues, scripts, nil
+}
+
+// AbandonPsbt releases the leases held under lockID on the inputs of a packet
+// funded by FundPsbt that will not be published, making them available to coin
+// selection again.  Inputs leased under other IDs are left untouched.
+func (w *Wallet) AbandonPsbt(packet *psbt.Packet, lockID wtxmgr.LockID) error {
+	unsignedTx, err := fromPsbtTx(packet.UnsignedTx)
+	if err != nil {
+		return err
+	}
+
+	ops := make([]wire.OutPoint, len(unsignedTx.TxIn))
+	for i, txIn := range unsignedTx.TxIn {
+		ops[i] = txIn.PreviousOutPoint
+	}
+	return w.releasePsbtLeases(lockID, ops)
+}
+
+// releasePsbtLeases releases the leases held under lockID on the outputs.
+// Outputs leased under other IDs are left untouched.
+func (w *Wallet) releasePsbtLeases(lockID wtxmgr.LockID,
+	ops []wire.OutPoint) error {
+
+	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for _, op := range ops {
+			id, isLeased := w.TxStore.IsLockedOutput(ns, op)
+			if !isLeased || id != lockID {
+				continue
+			}
+			if err := w.TxStore.UnlockOutput(ns, id, op); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// isOwnedScript returns whether any address the output script pays to is
+// controlled by the wallet.
+func (w *Wallet) isOwnedScript(addrmgrNs walletdb.ReadBucket, pkScript []byte) bool {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, w.chainParams)
+	if err != nil {
+		return false
+	}
+	for _, addr := range addrs {
+		if _, err := w.Manager.Address(addrmgrNs, addr); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// addPsbtDerivation adds the BIP0032 derivation of the wallet key controlling
+// the output script to the packet input.  Nothing is added for scripts that are
+// not paid to a key derived by the wallet.
+func (w *Wallet) addPsbtDerivation(updater *psbt.Updater, inIndex int,
+	pkScript []byte) error {
+
+	addr, err := w.fetchOutputAddr(pkScript)
+	if err != nil {
+		return nil
+	}
+	pubKeyAddr, ok := addr.(waddrmgr.ManagedPubKeyAddress)
+	if !ok {
+		return nil
+	}
+	keyScope, derivationPath, ok := pubKeyAddr.DerivationInfo()
+	if !ok {
+		return nil
+	}
+
+	return updater.AddInBip32Derivation(
+		derivationPath.MasterKeyFingerprint,
+		[]uint32{
+			keyScope.Purpose + hdkeychain.HardenedKeyStart,
+			keyScope.Coin + hdkeychain.HardenedKeyStart,
+			derivationPath.Account,
+			derivationPath.Branch,
+			derivationPath.Index,
+		},
+		pubKeyAddr.PubKey().SerializeCompressed(), inIndex,
+	)
+}
+
+// psbtPrevOutput returns the output spent by a packet input, as recorded in the
+// input's UTXO fields.
+func psbtPrevOutput(packet *psbt.Packet, inIndex int) (*wire.TxOut, error) {
+	pInput := &packet.Inputs[inIndex]
+	op := packet.UnsignedTx.TxIn[inIndex].PreviousOutPoint
+
+	switch {
+	case pInput.NonWitnessUtxo != nil:
+		if pInput.NonWitnessUtxo.TxHash() != op.Hash {
+			return nil, fmt.Errorf("previous transaction of input %d "+
+				"does not match its outpoint", inIndex)
+		}
+		if op.Index >= uint32(len(pInput.NonWitnessUtxo.TxOut)) {
+			return nil, fmt.Errorf("invalid output index %d for "+
+				"input %d", op.Index, inIndex)
+		}
+		txOut := pInput.NonWitnessUtxo.TxOut[op.Index]
+		return wire.NewTxOut(txOut.Value, txOut.PkScript), nil
+
+	case pInput.WitnessUtxo != nil:
+		txOut := pInput.WitnessUtxo
+		return wire.NewTxOut(txOut.Value, txOut.PkScript), nil
+
+	default:
+		return nil, fmt.Errorf("input %d is missing its previous "+
+			"output", inIndex)
+	}
+}
+
+// PsbtFee returns the fee paid by the packet's transaction, computed from the
+// outputs recorded for its inputs.
+func PsbtFee(packet *psbt.Packet) (czzutil.Amount, error) {
+	var inputTotal, outputTotal int64
+	for i := range packet.UnsignedTx.TxIn {
+		prevOut, err := psbtPrevOutput(packet, i)
+		if err != nil {
+			return 0, err
+		}
+		inputTotal += prevOut.Value
+	}
+	for _, txOut := range packet.UnsignedTx.TxOut {
+		outputTotal += txOut.Value
+	}
+	return czzutil.Amount(inputTotal - outputTotal), nil
+}
+
+// SignPsbt adds a partial signature to every input of the packet that spends a
+// pay-to-pubkey-hash output controlled by the wallet and that isn't already
+// signed or finalized.  Inputs must record the output they spend, as done by
+// FundPsbt.  The indexes of the signed inputs are returned.  The wallet must be
+// unlocked to sign.
+func (w *Wallet) SignPsbt(packet *psbt.Packet) ([]uint32, error) {
+	tx, err := fromPsbtTx(packet.UnsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	sigHashes := txscript.NewTxSigHashes(tx)
+
+	var signed []uint32
+	for i := range tx.TxIn {
+		pInput := &packet.Inputs[i]
+		if pInput.FinalScriptSig != nil || len(pInput.PartialSigs) != 0 {
+			continue
+		}
+		if pInput.NonWitnessUtxo == nil && pInput.WitnessUtxo == nil {
+			continue
+		}
+
+		prevOut, err := psbtPrevOutput(packet, i)
+		if err != nil {
+			return nil, err
+		}
+		if txscript.GetScriptClass(prevOut.PkScript) != txscript.PubKeyHashTy {
+			continue
+		}
+
+		_, sigScript, err := w.ComputeInputScript(
+			tx, prevOut, i, sigHashes, txscript.SigHashAll, nil,
+		)
+		if err == ErrNotMine {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// The signature script of a pay-to-pubkey-hash input is
+		// <sig> <pubkey>, which are added to the packet separately.
+		pushes, err := txscript.PushedData(sigScript)
+		if err != nil {
+			return nil, err
+		}
+		if len(pushes) != 2 {
+			return nil, fmt.Errorf("unexpected signature script for "+
+				"input %d", i)
+		}
+
+		// The psbt package checks signatures against the sighash type
+		// recorded on the input, which defaults to SigHashAll without
+		// any flags, so record the type the signature commits to.
+		sig := pushes[0]
+		hashType := btctxscript.SigHashType(sig[len(sig)-1])
+		if pInput.SighashType != hashType {
+			err := updater.AddInSighashType(hashType, i)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = updater.Sign(i, sig, pushes[1], nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		signed = append(signed, uint32(i))
+	}
+
+	return signed, nil
+}
+
+// FinalizePsbt signs every input of the packet the wallet is able to sign, and
+// then finalizes all inputs.  ErrPsbtIncomplete is returned if any input is
+// still missing signatures afterwards.  The final transaction is verified
+// against the outputs it spends and may be obtained with ExtractPsbtTx.
+func (w *Wallet) FinalizePsbt(packet *psbt.Packet) error {
+	if _, err := w.SignPsbt(packet); err != nil {
+		return err
+	}
+	if !FinalizePsbtInputs(packet) {
+		return ErrPsbtIncomplete
+	}
+
+	tx, err := ExtractPsbtTx(packet)
+	if err != nil {
+		return err
+	}
+
+	prevScripts := make([][]byte, len(tx.TxIn))
+	inputValues := make([]czzutil.Amount, len(tx.TxIn))
+	for i := range tx.TxIn {
+		prevOut, err := psbtPrevOutput(packet, i)
+		if err != nil {
+			return err
+		}
+		prevScripts[i] = prevOut.PkScript
+		inputValues[i] = czzutil.Amount(prevOut.Value)
+	}
+	return validateMsgTx(tx, prevScripts, inputValues)
+}
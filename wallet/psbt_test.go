@@ -0,0 +1,217 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+	_ "github.com/classzz/czzwallet/walletdb/bdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+// TestFundSignFinalizePsbt checks that a packet funded by the wallet is leased,
+// carries the information needed to sign it, and can be signed, finalized and
+// extracted into a valid transaction.  The leases of its inputs are released
+// when it is abandoned.
+func TestFundSignFinalizePsbt(t *testing.T) {
+	// Set up a wallet.
+	dir, err := ioutil.TempDir("", "psbt_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.MinSeedBytes)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+
+	pubPass := []byte("hello")
+	privPass := []byte("world")
+
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, 250)
+	w, err := loader.CreateNewWallet(pubPass, privPass, seed, time.Now())
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	w.chainClient = &mockChainClient{}
+	if err := w.Unlock(privPass, time.After(10*time.Minute)); err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+
+	// Add a confirmed output paying to the wallet's address to the
+	// database.
+	incomingTx := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(100000, pkScript)},
+	}
+	var b bytes.Buffer
+	if err := incomingTx.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+	if err != nil {
+		t.Fatalf("unable to create tx record: %v", err)
+	}
+	blockHash, _ := chainhash.NewHashFromStr(
+		"00000000000000017188b968a371bab95aa43522665353b646e41865abae02a4")
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *blockHash, Height: 276425},
+		Time:  time.Unix(1387737310, 0),
+	}
+	if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := w.TxStore.InsertTx(ns, rec, block)
+		if err != nil {
+			return err
+		}
+		return w.TxStore.AddCredit(ns, rec, block, 0, false)
+	}); err != nil {
+		t.Fatalf("failed inserting tx: %v", err)
+	}
+
+	// Fund a packet without inputs, letting the wallet select them.
+	unsignedTx := wire.NewMsgTx(wire.TxVersion)
+	unsignedTx.AddTxOut(wire.NewTxOut(30000, pkScript))
+	unsignedTx.LockTime = 1234
+	packet, err := NewPsbt(unsignedTx)
+	if err != nil {
+		t.Fatalf("unable to create psbt: %v", err)
+	}
+	lockID := wtxmgr.LockID{1}
+	changeIndex, err := w.FundPsbt(
+		packet, &waddrmgr.KeyScopeBIP0044, 0, 1, 1000, lockID, time.Hour,
+	)
+	if err != nil {
+		t.Fatalf("unable to fund psbt: %v", err)
+	}
+
+	if len(packet.UnsignedTx.TxIn) != 1 || len(packet.Inputs) != 1 {
+		t.Fatalf("expected 1 input, found %d",
+			len(packet.UnsignedTx.TxIn))
+	}
+	if len(packet.UnsignedTx.TxOut) != 2 || len(packet.Outputs) != 2 {
+		t.Fatalf("expected 2 outputs, found %d",
+			len(packet.UnsignedTx.TxOut))
+	}
+	if changeIndex < 0 || changeIndex > 1 {
+		t.Fatalf("unexpected change index %d", changeIndex)
+	}
+	if packet.UnsignedTx.LockTime != 1234 {
+		t.Fatalf("lock time not kept, found %d",
+			packet.UnsignedTx.LockTime)
+	}
+	if packet.Inputs[0].NonWitnessUtxo == nil {
+		t.Fatalf("input is missing its previous transaction")
+	}
+	if len(packet.Inputs[0].Bip32Derivation) != 1 {
+		t.Fatalf("input is missing its derivation path")
+	}
+
+	fee, err := PsbtFee(packet)
+	if err != nil {
+		t.Fatalf("unable to compute fee: %v", err)
+	}
+	if fee <= 0 {
+		t.Fatalf("expected a positive fee, found %v", fee)
+	}
+
+	// The selected input must be leased so it isn't selected again.
+	leases, err := w.ListLeasedOutputs()
+	if err != nil {
+		t.Fatalf("unable to list leases: %v", err)
+	}
+	if len(leases) != 1 {
+		t.Fatalf("expected 1 lease, found %d", len(leases))
+	}
+	if leases[0].LockID != lockID {
+		t.Fatalf("unexpected lock id %x", leases[0].LockID)
+	}
+	if leases[0].Expiration.Before(time.Now().Add(50 * time.Minute)) {
+		t.Fatalf("unexpected lease expiration %v", leases[0].Expiration)
+	}
+	if leases[0].Outpoint != packet.UnsignedTx.TxIn[0].PreviousOutPoint {
+		t.Fatalf("unexpected leased outpoint %v", leases[0].Outpoint)
+	}
+
+	// Signing adds a partial signature for the wallet's input.
+	signed, err := w.SignPsbt(packet)
+	if err != nil {
+		t.Fatalf("unable to sign psbt: %v", err)
+	}
+	if len(signed) != 1 || signed[0] != 0 {
+		t.Fatalf("expected input 0 to be signed, found %v", signed)
+	}
+	if len(packet.Inputs[0].PartialSigs) != 1 {
+		t.Fatalf("expected 1 partial signature, found %d",
+			len(packet.Inputs[0].PartialSigs))
+	}
+
+	// Finalizing validates the final transaction against the outputs it
+	// spends.
+	if err := w.FinalizePsbt(packet); err != nil {
+		t.Fatalf("unable to finalize psbt: %v", err)
+	}
+	finalTx, err := ExtractPsbtTx(packet)
+	if err != nil {
+		t.Fatalf("unable to extract tx: %v", err)
+	}
+	if len(finalTx.TxIn[0].SignatureScript) == 0 {
+		t.Fatalf("final tx is missing its signature script")
+	}
+
+	// The leased input can't be spent by a packet funded under another
+	// lock ID.
+	leasedOp := packet.UnsignedTx.TxIn[0].PreviousOutPoint
+	otherTx := wire.NewMsgTx(wire.TxVersion)
+	otherTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash:  chainhash.Hash(leasedOp.Hash),
+		Index: leasedOp.Index,
+	}, nil))
+	otherTx.AddTxOut(wire.NewTxOut(30000, pkScript))
+	other, err := NewPsbt(otherTx)
+	if err != nil {
+		t.Fatalf("unable to create psbt: %v", err)
+	}
+	_, err = w.FundPsbt(
+		other, &waddrmgr.KeyScopeBIP0044, 0, 1, 1000, PsbtLockID,
+		DefaultPsbtLeaseDuration,
+	)
+	if err == nil {
+		t.Fatalf("funded psbt with an input leased under another id")
+	}
+
+	// Abandoning the packet releases the leases of its inputs.
+	if err := w.AbandonPsbt(packet, lockID); err != nil {
+		t.Fatalf("unable to abandon psbt: %v", err)
+	}
+	leases, err = w.ListLeasedOutputs()
+	if err != nil {
+		t.Fatalf("unable to list leases: %v", err)
+	}
+	if len(leases) != 0 {
+		t.Fatalf("expected no leases, found %d", len(leases))
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"errors"
+
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txauthor"
+	"github.com/classzz/czzwallet/walletdb"
+	"github.com/classzz/czzwallet/wtxmgr"
+)
+
+var (
+	// ErrChangePosition is returned when the requested position of the
+	// change output is past the outputs of the funded transaction.
+	ErrChangePosition = errors.New("change position out of bounds")
+)
+
+// FundRawTxOptions describes how a raw transaction is funded by
+// FundRawTransaction.
+type FundRawTxOptions struct {
+	// KeyScope and Account are the key scope and account the added inputs
+	// are selected from.  KeyScopeBIP0044 is used if KeyScope is nil.
+	KeyScope *waddrmgr.KeyScope
+	Account  uint32
+
+	// ChangeAccount is the account of the change address.  Change is
+	// returned to Account if nil.
+	ChangeAccount *uint32
+
+	// ChangePosition is the index of the change output in the funded
+	// transaction.  The change output is placed at a random position if
+	// nil.
+	ChangePosition *int
+
+	// RequiredConfirmations is the minimum number of confirmations of the
+	// added inputs.
+	RequiredConfirmations int32
+
+	// FeeRate is the fee rate paid by the funded transaction.
+	FeeRate czzutil.Amount
+
+	// CoinSelector selects the added inputs.  The wallet's coin selector
+	// is used if nil.
+	CoinSelector CoinSelector
+}
+
+// FundRawTransaction adds inputs and a change output to tx so that it pays for
+// its outputs and the fee at the requested fee rate.  The inputs of tx are kept
+// as they are and must spend outputs controlled by the wallet.  More inputs are
+// only selected from the account when they do not pay for the transaction.
+// The version and lock time of tx are kept.
+//
+// The funded transaction is returned unsigned, and tx is not modified.  A
+// change address is derived if the transaction has change, but the selected
+// outputs are neither locked nor leased.
+func (w *Wallet) FundRawTransaction(tx *wire.MsgTx,
+	opts *FundRawTxOptions) (*txauthor.AuthoredTx, error) {
+
+	if len(tx.TxOut) == 0 {
+		return nil, errors.New("transaction must contain at least one " +
+			"output")
+	}
+	if opts.ChangePosition != nil && (*opts.ChangePosition < 0 ||
+		*opts.ChangePosition > len(tx.TxOut)) {
+
+		return nil, ErrChangePosition
+	}
+
+	keyScope := opts.KeyScope
+	if keyScope == nil {
+		keyScope = &waddrmgr.KeyScopeBIP0044
+	}
+	changeAccount := opts.Account
+	if opts.ChangeAccount != nil {
+		changeAccount = *opts.ChangeAccount
+	}
+	coinSelector := opts.CoinSelector
+	if coinSelector == nil {
+		coinSelector = w.CoinSelector()
+	}
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+	bs, err := chainClient.BlockStamp()
+	if err != nil {
+		return nil, err
+	}
+
+	// The inputs of the transaction are always spent, so they are copied
+	// to keep their sequence numbers without sharing them with the
+	// caller's transaction.
+	requiredIns := make([]*wire.TxIn, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		txInCopy := *txIn
+		requiredIns[i] = &txInCopy
+	}
+
+	var authoredTx *txauthor.AuthoredTx
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs, changeSource, err := w.addrMgrWithChangeSource(
+			dbtx, keyScope, changeAccount,
+		)
+		if err != nil {
+			return err
+		}
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		required, err := w.requiredInputs(addrmgrNs, txmgrNs, requiredIns)
+		if err != nil {
+			return err
+		}
+
+		eligible, err := w.findEligibleOutputs(
+			dbtx, keyScope, opts.Account,
+			opts.RequiredConfirmations, bs,
+		)
+		if err != nil {
+			return err
+		}
+		spent := make(map[wire.OutPoint]struct{}, len(requiredIns))
+		for _, txIn := range requiredIns {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+		candidates := eligible[:0]
+		for _, credit := range eligible {
+			if _, ok := spent[credit.OutPoint]; !ok {
+				candidates = append(candidates, credit)
+			}
+		}
+
+		params := coinSelectionParams(
+			tx.TxOut, opts.FeeRate, changeSource.ScriptSize,
+			w.redeemScriptSource(addrmgrNs),
+		)
+		inputSource := makeFundingInputSource(
+			required, candidates, coinSelector, params,
+		)
+		authoredTx, err = txauthor.NewUnsignedTransaction(
+			tx.TxOut, opts.FeeRate, inputSource, changeSource,
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the caller's version and lock time.  The added inputs must not
+	// be final for a lock time to be enforced.
+	authoredTx.Tx.Version = tx.Version
+	authoredTx.Tx.LockTime = tx.LockTime
+	if tx.LockTime != 0 {
+		for _, txIn := range authoredTx.Tx.TxIn[len(requiredIns):] {
+			txIn.Sequence = wire.MaxTxInSequenceNum - 1
+		}
+	}
+
+	if authoredTx.ChangeIndex >= 0 {
+		if opts.ChangePosition != nil {
+			authoredTx.ChangeIndex = moveOutput(
+				authoredTx.Tx.TxOut, authoredTx.ChangeIndex,
+				*opts.ChangePosition,
+			)
+		} else {
+			authoredTx.RandomizeChangePosition()
+		}
+
+		// Request notifications of the transaction paying to the change
+		// address once it is published.
+		changePkScript := authoredTx.Tx.TxOut[authoredTx.ChangeIndex].PkScript
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			changePkScript, w.chainParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := chainClient.NotifyReceived(addrs); err != nil {
+			return nil, err
+		}
+	}
+
+	return authoredTx, nil
+}
+
+// fundingInputs describes the inputs a transaction is required to spend.
+type fundingInputs struct {
+	total       czzutil.Amount
+	txIns       []*wire.TxIn
+	inputValues []czzutil.Amount
+	scripts     [][]byte
+}
+
+// requiredInputs looks up the previous outputs spent by txIns, which must all
+// be unspent outputs controlled by the wallet that are not locked, leased or
+// frozen.
+func (w *Wallet) requiredInputs(addrmgrNs, txmgrNs walletdb.ReadBucket,
+	txIns []*wire.TxIn) (*fundingInputs, error) {
+
+	total, inputValues, scripts, err := w.ownedInputs(
+		addrmgrNs, txmgrNs, txIns, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &fundingInputs{
+		total:       total,
+		txIns:       txIns,
+		inputValues: inputValues,
+		scripts:     scripts,
+	}, nil
+}
+
+// makeFundingInputSource creates an input source that always spends the
+// required inputs, and selects more inputs from the eligible outputs with the
+// passed coin selector when the required inputs do not reach the target.
+func makeFundingInputSource(required *fundingInputs, eligible []wtxmgr.Credit,
+	selector CoinSelector, params CoinSelectionParams) txauthor.InputSource {
+
+	// The required inputs contribute their effective value to the target
+	// of the selection.
+	params.Target -= required.total
+	for _, pkScript := range required.scripts {
+		params.Target += params.InputFee(pkScript)
+	}
+
+	var firstTarget czzutil.Amount
+	return func(target czzutil.Amount) (czzutil.Amount, []*wire.TxIn,
+		[]czzutil.Amount, [][]byte, error) {
+
+		// As in makeInputSource, a rising target requested by the
+		// caller raises the selection target by the same amount.
+		if firstTarget == 0 {
+			firstTarget = target
+		}
+		selectionParams := params
+		selectionParams.Target += target - firstTarget
+		if selectionParams.Target <= 0 {
+			return required.total, required.txIns,
+				required.inputValues, required.scripts, nil
+		}
+
+		selected, err := selector.SelectCoins(eligible, selectionParams)
+		if err != nil {
+			return 0, nil, nil, nil, err
+		}
+
+		total := required.total
+		n := len(required.txIns) + len(selected)
+		inputs := append(make([]*wire.TxIn, 0, n), required.txIns...)
+		inputValues := append(
+			make([]czzutil.Amount, 0, n), required.inputValues...,
+		)
+		scripts := append(make([][]byte, 0, n), required.scripts...)
+		for i := range selected {
+			credit := &selected[i]
+			total += credit.Amount
+			inputs = append(inputs, wire.NewTxIn(&credit.OutPoint, nil))
+			inputValues = append(inputValues, credit.Amount)
+			scripts = append(scripts, credit.PkScript)
+		}
+		return total, inputs, inputValues, scripts, nil
+	}
+}
+
+// moveOutput moves the output at index from to index to, shifting the outputs
+// in between.  The new index of the output is returned.
+func moveOutput(outputs []*wire.TxOut, from, to int) int {
+	output := outputs[from]
+	if from < to {
+		copy(outputs[from:to], outputs[from+1:to+1])
+	} else {
+		copy(outputs[to+1:from+1], outputs[to:from])
+	}
+	outputs[to] = output
+	return to
+}
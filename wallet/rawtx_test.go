@@ -0,0 +1,108 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// TestFundRawTransaction checks that the inputs of a raw transaction are kept
+// when funding it, that more inputs are only added when needed, and that the
+// change output is placed at the requested position.
+func TestFundRawTransaction(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	pkScript := addTestCredits(t, w, 100000, 50000, 20000)
+	unspent, err := w.UnspentOutputs(OutputSelectionPolicy{})
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	outPoints := make(map[int64]wire.OutPoint)
+	for _, output := range unspent {
+		outPoints[output.Output.Value] = output.OutPoint
+	}
+
+	// The required input does not pay for the output, so the largest
+	// output is added.
+	op := outPoints[20000]
+	txIn := wire.NewTxIn(&op, nil)
+	txIn.Sequence = 5
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(txIn)
+	tx.AddTxOut(wire.NewTxOut(60000, pkScript))
+	tx.LockTime = 500
+
+	changePosition := 0
+	opts := &FundRawTxOptions{
+		RequiredConfirmations: 1,
+		FeeRate:               1000,
+		ChangePosition:        &changePosition,
+	}
+	funded, err := w.FundRawTransaction(tx, opts)
+	if err != nil {
+		t.Fatalf("unable to fund transaction: %v", err)
+	}
+	if len(tx.TxIn) != 1 || len(tx.TxOut) != 1 {
+		t.Fatalf("funding modified the raw transaction")
+	}
+	fundedTx := funded.Tx
+	if len(fundedTx.TxIn) != 2 ||
+		fundedTx.TxIn[0].PreviousOutPoint != op ||
+		fundedTx.TxIn[0].Sequence != 5 ||
+		fundedTx.TxIn[1].PreviousOutPoint != outPoints[100000] {
+
+		t.Fatalf("unexpected inputs %v", fundedTx.TxIn)
+	}
+	if fundedTx.TxIn[1].Sequence != wire.MaxTxInSequenceNum-1 {
+		t.Fatalf("added input is final despite the lock time")
+	}
+	if fundedTx.LockTime != 500 {
+		t.Fatalf("expected lock time 500, found %d", fundedTx.LockTime)
+	}
+	if funded.ChangeIndex != 0 || len(fundedTx.TxOut) != 2 ||
+		fundedTx.TxOut[1].Value != 60000 {
+
+		t.Fatalf("expected change output at index 0, found %d",
+			funded.ChangeIndex)
+	}
+	fee := funded.TotalInput - 60000 -
+		czzutil.Amount(fundedTx.TxOut[funded.ChangeIndex].Value)
+	if funded.TotalInput != 120000 || fee <= 0 {
+		t.Fatalf("unexpected total input %v and fee %v",
+			funded.TotalInput, fee)
+	}
+
+	// An input reaching the target is enough.
+	tx = wire.NewMsgTx(wire.TxVersion)
+	op = outPoints[100000]
+	tx.AddTxIn(wire.NewTxIn(&op, nil))
+	tx.AddTxOut(wire.NewTxOut(10000, pkScript))
+	funded, err = w.FundRawTransaction(tx, &FundRawTxOptions{FeeRate: 1000})
+	if err != nil {
+		t.Fatalf("unable to fund transaction: %v", err)
+	}
+	if len(funded.Tx.TxIn) != 1 || funded.ChangeIndex < 0 {
+		t.Fatalf("expected a single input and change, found %d inputs",
+			len(funded.Tx.TxIn))
+	}
+
+	changePosition = 2
+	_, err = w.FundRawTransaction(tx, opts)
+	if err != ErrChangePosition {
+		t.Fatalf("expected change position error, found %v", err)
+	}
+
+	// Inputs must be controlled by the wallet.
+	tx.TxIn[0].PreviousOutPoint = wire.OutPoint{Hash: chainhash.Hash{1}}
+	_, err = w.FundRawTransaction(tx, &FundRawTxOptions{FeeRate: 1000})
+	if err == nil {
+		t.Fatalf("funded a transaction spending a foreign output")
+	}
+}
@@ -60,16 +60,19 @@ func (rm *RecoveryManager) Resurrect(ns walletdb.ReadBucket,
 	scopedMgrs map[waddrmgr.KeyScope]*waddrmgr.ScopedKeyManager,
 	credits []wtxmgr.Credit) error {
 
-	// First, for each scope that we are recovering, rederive all of the
+	accounts, err := recoveryAccounts(ns, scopedMgrs)
+	if err != nil {
+		return err
+	}
+
+	// First, for each account that we are recovering, rederive all of the
 	// addresses up to the last found address known to each branch.
-	for keyScope, scopedMgr := range scopedMgrs {
-		// Load the current account properties for this scope, using the
-		// the default account number.
-		// TODO(conner): rescan for all created accounts if we allow
-		// users to use non-default address
-		scopeState := rm.state.StateForScope(keyScope)
+	for _, scopedAccount := range accounts {
+		// Load the current account properties for this account.
+		scopedMgr := scopedMgrs[scopedAccount.Scope]
+		scopeState := rm.state.StateForAccount(scopedAccount)
 		acctProperties, err := scopedMgr.AccountProperties(
-			ns, waddrmgr.DefaultAccountNum,
+			ns, scopedAccount.Account,
 		)
 		if err != nil {
 			return err
@@ -83,7 +86,7 @@ func (rm *RecoveryManager) Resurrect(ns walletdb.ReadBucket,
 		// deriving each address and adding it to the external branch
 		// recovery state's set of addresses to look for.
 		for i := uint32(0); i < externalCount; i++ {
-			keyPath := externalKeyPath(i)
+			keyPath := externalKeyPath(scopedAccount.Account, i)
 			addr, err := scopedMgr.DeriveFromKeyPath(ns, keyPath)
 			if err != nil && err != hdkeychain.ErrInvalidChild {
 				return err
@@ -102,7 +105,7 @@ func (rm *RecoveryManager) Resurrect(ns walletdb.ReadBucket,
 		// deriving each address and adding it to the internal branch
 		// recovery state's set of addresses to look for.
 		for i := uint32(0); i < internalCount; i++ {
-			keyPath := internalKeyPath(i)
+			keyPath := internalKeyPath(scopedAccount.Account, i)
 			addr, err := scopedMgr.DeriveFromKeyPath(ns, keyPath)
 			if err != nil && err != hdkeychain.ErrInvalidChild {
 				return err
@@ -141,6 +144,48 @@ func (rm *RecoveryManager) Resurrect(ns walletdb.ReadBucket,
 	return nil
 }
 
+// recoveryAccounts returns the accounts whose addresses are recovered within
+// the given scoped managers.  These are the default account of each scope, as
+// well as all multisig accounts and accounts imported with their account public
+// keys, which are not created on demand like the other accounts.
+func recoveryAccounts(ns walletdb.ReadBucket,
+	scopedMgrs map[waddrmgr.KeyScope]*waddrmgr.ScopedKeyManager) (
+	[]waddrmgr.ScopedAccount, error) {
+
+	var accounts []waddrmgr.ScopedAccount
+	for keyScope, scopedMgr := range scopedMgrs {
+		accounts = append(accounts, waddrmgr.ScopedAccount{
+			Scope:   keyScope,
+			Account: waddrmgr.DefaultAccountNum,
+		})
+
+		err := scopedMgr.ForEachAccount(ns, func(account uint32) error {
+			if account == waddrmgr.DefaultAccountNum ||
+				account == waddrmgr.ImportedAddrAccount {
+
+				return nil
+			}
+
+			props, err := scopedMgr.AccountProperties(ns, account)
+			if err != nil {
+				return err
+			}
+			if props.RequiredSigs > 0 || props.IsImported {
+				accounts = append(accounts, waddrmgr.ScopedAccount{
+					Scope:   keyScope,
+					Account: account,
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return accounts, nil
+}
+
 // AddToBlockBatch appends the block information, consisting of hash and height,
 // to the batch of blocks to be searched.
 func (rm *RecoveryManager) AddToBlockBatch(hash *chainhash.Hash, height int32,
@@ -198,9 +243,9 @@ type RecoveryState struct {
 	// used to instantiate a new RecoveryState for each requested scope.
 	recoveryWindow uint32
 
-	// scopes maintains a map of each requested key scope to its active
-	// RecoveryState.
-	scopes map[waddrmgr.KeyScope]*ScopeRecoveryState
+	// accounts maintains a map of each requested scoped account to its
+	// active RecoveryState.
+	accounts map[waddrmgr.ScopedAccount]*ScopeRecoveryState
 
 	// watchedOutPoints contains the set of all outpoints known to the
 	// wallet. This is updated iteratively as new outpoints are found during
@@ -210,33 +255,45 @@ type RecoveryState struct {
 
 // NewRecoveryState creates a new RecoveryState using the provided
 // recoveryWindow. Each RecoveryState that is subsequently initialized for a
-// particular key scope will receive the same recoveryWindow.
+// particular account will receive the same recoveryWindow.
 func NewRecoveryState(recoveryWindow uint32) *RecoveryState {
-	scopes := make(map[waddrmgr.KeyScope]*ScopeRecoveryState)
+	accounts := make(map[waddrmgr.ScopedAccount]*ScopeRecoveryState)
 
 	return &RecoveryState{
 		recoveryWindow:   recoveryWindow,
-		scopes:           scopes,
+		accounts:         accounts,
 		watchedOutPoints: make(map[wire.OutPoint]czzutil.Address),
 	}
 }
 
-// StateForScope returns a ScopeRecoveryState for the provided key scope. If one
-// does not already exist, a new one will be generated with the RecoveryState's
-// recoveryWindow.
+// StateForScope returns a ScopeRecoveryState for the default account of the
+// provided key scope. If one does not already exist, a new one will be
+// generated with the RecoveryState's recoveryWindow.
 func (rs *RecoveryState) StateForScope(
 	keyScope waddrmgr.KeyScope) *ScopeRecoveryState {
 
+	return rs.StateForAccount(waddrmgr.ScopedAccount{
+		Scope:   keyScope,
+		Account: waddrmgr.DefaultAccountNum,
+	})
+}
+
+// StateForAccount returns a ScopeRecoveryState for the provided scoped account.
+// If one does not already exist, a new one will be generated with the
+// RecoveryState's recoveryWindow.
+func (rs *RecoveryState) StateForAccount(
+	scopedAccount waddrmgr.ScopedAccount) *ScopeRecoveryState {
+
 	// If the account recovery state already exists, return it.
-	if scopeState, ok := rs.scopes[keyScope]; ok {
+	if scopeState, ok := rs.accounts[scopedAccount]; ok {
 		return scopeState
 	}
 
-	// Otherwise, initialize the recovery state for this scope with the
+	// Otherwise, initialize the recovery state for this account with the
 	// chosen recovery window.
-	rs.scopes[keyScope] = NewScopeRecoveryState(rs.recoveryWindow)
+	rs.accounts[scopedAccount] = NewScopeRecoveryState(rs.recoveryWindow)
 
-	return rs.scopes[keyScope]
+	return rs.accounts[scopedAccount]
 }
 
 // WatchedOutPoints returns the global set of outpoints that are known to belong
@@ -119,6 +119,7 @@ func (w *Wallet) rescanBatchHandler() {
 				// Set current batch as this job and send
 				// request.
 				curBatch = job.batch()
+				w.setRescanning(true)
 				select {
 				case w.rescanBatch <- curBatch:
 				case <-quit:
@@ -176,6 +177,7 @@ func (w *Wallet) rescanBatchHandler() {
 				}
 
 				curBatch, nextBatch = nextBatch, nil
+				w.setRescanning(curBatch != nil)
 
 				if curBatch != nil {
 					select {
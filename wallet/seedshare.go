@@ -0,0 +1,83 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/internal/shamir"
+	"github.com/classzz/czzwallet/waddrmgr"
+)
+
+// ErrSeedMismatch is returned when a seed is not the seed the wallet was
+// created from.
+var ErrSeedMismatch = errors.New("seed does not match the wallet")
+
+// SplitSeed splits the seed of the wallet into count shares, any threshold of
+// which restore the wallet when they are entered during wallet creation.
+//
+// The wallet does not store its seed, so the seed is provided by the caller.
+// It is only split while the wallet is unlocked, and only when it derives the
+// keys of the default account, so shares are never created for another seed.
+func (w *Wallet) SplitSeed(seed []byte, threshold,
+	count int) ([]*shamir.Share, error) {
+
+	heldUnlock, err := w.holdUnlock()
+	if err != nil {
+		return nil, err
+	}
+	defer heldUnlock.release()
+
+	if err := w.checkSeed(seed); err != nil {
+		return nil, err
+	}
+	return shamir.Split(seed, threshold, count)
+}
+
+// checkSeed returns ErrSeedMismatch unless seed derives the account key of the
+// default BIP0044 account of the wallet.
+func (w *Wallet) checkSeed(seed []byte) error {
+	props, err := w.AccountProperties(
+		waddrmgr.KeyScopeBIP0044, waddrmgr.DefaultAccountNum,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The account key is derived along the path
+	//   m/44'/<coin type>'/0'
+	scope := waddrmgr.KeyScopeBIP0044
+	key, err := hdkeychain.NewMaster(seed, w.chainParams)
+	if err != nil {
+		return err
+	}
+	path := []uint32{scope.Purpose, scope.Coin, waddrmgr.DefaultAccountNum}
+	for _, index := range path {
+		key, err = key.DeriveNonStandard( // nolint:staticcheck
+			index + hdkeychain.HardenedKeyStart,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	pubKey, err := key.ECPubKey()
+	if err != nil {
+		return err
+	}
+	acctPubKey, err := props.AccountPubKey.ECPubKey()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pubKey.SerializeCompressed(),
+		acctPubKey.SerializeCompressed()) ||
+		!bytes.Equal(key.ChainCode(), props.AccountPubKey.ChainCode()) {
+
+		return ErrSeedMismatch
+	}
+	return nil
+}
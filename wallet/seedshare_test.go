@@ -0,0 +1,59 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/classzz/czzutil/hdkeychain"
+	"github.com/classzz/czzwallet/internal/shamir"
+	"github.com/classzz/czzwallet/waddrmgr"
+)
+
+// TestSplitSeed checks that only the seed of an unlocked wallet is split, and
+// that any threshold of its shares recover it.
+func TestSplitSeed(t *testing.T) {
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.RecommendedSeedLen)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	w, cleanup := testWalletFromSeed(t, seed)
+	defer cleanup()
+
+	other, err := hdkeychain.GenerateSeed(hdkeychain.RecommendedSeedLen)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	if _, err := w.SplitSeed(other, 2, 3); !errors.Is(err, ErrSeedMismatch) {
+		t.Fatalf("expected ErrSeedMismatch, got %v", err)
+	}
+
+	shares, err := w.SplitSeed(seed, 2, 3)
+	if err != nil {
+		t.Fatalf("unable to split seed: %v", err)
+	}
+	if len(shares) != 3 {
+		t.Fatalf("expected 3 shares, got %d", len(shares))
+	}
+	for i := range shares {
+		pair := []*shamir.Share{shares[i], shares[(i+1)%len(shares)]}
+		recovered, err := shamir.Combine(pair)
+		if err != nil {
+			t.Fatalf("unable to combine shares: %v", err)
+		}
+		if !bytes.Equal(recovered, seed) {
+			t.Fatalf("shares recovered seed %x, expected %x",
+				recovered, seed)
+		}
+	}
+
+	w.Lock()
+	_, err = w.SplitSeed(seed, 2, 3)
+	if !waddrmgr.IsError(err, waddrmgr.ErrLocked) {
+		t.Fatalf("expected ErrLocked, got %v", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
 )
 
 // scriptForOutput returns the address, witness program and redeem script for a
@@ -83,14 +84,41 @@ type PrivKeyTweaker func(*czzec.PrivateKey) (*czzec.PrivateKey, error)
 
 // ComputeInputScript generates a complete InputScript for the passed
 // transaction with the signature as defined within the passed SignDescriptor.
-// This method is capable of generating the proper input script for both
-// regular p2wkh output and p2wkh outputs nested within a regular p2sh output.
+// The output being spent must pay to a pubkey hash controlled by the wallet,
+// or to a time-locked script imported with ImportTimeLockScript, and its value
+// is committed to by the signature.  Time-locked outputs may only be spent
+// once the lock time of tx, or the sequence number of the input, satisfies the
+// lock.  No witness is ever returned, as the chain does not support segregated
+// witness.
 func (w *Wallet) ComputeInputScript(tx *wire.MsgTx, output *wire.TxOut,
 	inputIndex int, sigHashes *txscript.TxSigHashes,
 	hashType txscript.SigHashType, tweaker PrivKeyTweaker) (wire.TxWitness,
 	[]byte, error) {
 
-	walletAddr, _, sigScript, err := w.scriptForOutput(output)
+	if txscript.IsPayToScriptHash(output.PkScript) {
+		var sigScript []byte
+		err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+			lock, err := w.timeLockForOutput(dbtx, output.PkScript)
+			if err != nil {
+				return err
+			}
+			if lock == nil {
+				return ErrNotMine
+			}
+			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			sigScript, err = w.timeLockSignatureScript(
+				addrmgrNs, tx, inputIndex, output.Value, lock,
+				hashType, tweaker,
+			)
+			return err
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, sigScript, nil
+	}
+
+	walletAddr, pkScript, _, err := w.scriptForOutput(output)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,5 +145,13 @@ func (w *Wallet) ComputeInputScript(tx *wire.MsgTx, output *wire.TxOut,
 	//	return nil, nil, err
 	//}
 
+	sigScript, err := txscript.SignatureScript(
+		tx, inputIndex, output.Value, pkScript, hashType, privKey,
+		walletAddr.Compressed(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return nil, sigScript, nil
 }
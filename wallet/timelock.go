@@ -0,0 +1,378 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// TimeLockType describes how the lock value of a time-locked script is
+// enforced.
+type TimeLockType uint8
+
+// These constants define the supported time lock types.
+const (
+	// TimeLockAbsolute locks an output until the block height or time of
+	// the lock value with OP_CHECKLOCKTIMEVERIFY (BIP0065).  The spending
+	// transaction must have a lock time of at least the lock value.
+	TimeLockAbsolute TimeLockType = iota
+
+	// TimeLockRelative locks an output until a number of blocks or
+	// 512-second intervals have passed since it was confirmed with
+	// OP_CHECKSEQUENCEVERIFY (BIP0112).  The spending input must have a
+	// sequence number of at least the lock value.
+	TimeLockRelative
+)
+
+// String returns the name of the time lock type.
+func (t TimeLockType) String() string {
+	switch t {
+	case TimeLockAbsolute:
+		return "cltv"
+	case TimeLockRelative:
+		return "csv"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+var (
+	// ErrInvalidTimeLock is returned when a time-locked script is created
+	// with a lock value that cannot be enforced by its lock type.
+	ErrInvalidTimeLock = errors.New("invalid time lock")
+
+	// ErrTimeLockNotSatisfied is returned when an input spending a
+	// time-locked output is signed before its transaction satisfies the
+	// lock.
+	ErrTimeLockNotSatisfied = errors.New("transaction does not satisfy " +
+		"the time lock of the spent output")
+
+	// timeLocksNamespaceKey is the key of the bucket recording the lock
+	// parameters of imported time-locked scripts.
+	timeLocksNamespaceKey = []byte("timelocks")
+)
+
+// TimeLock describes a P2SH script paying to a single public key once a time
+// lock has expired.  The redeem script is
+//
+//	<Value> OP_CHECKLOCKTIMEVERIFY|OP_CHECKSEQUENCEVERIFY OP_DROP
+//	<PubKey> OP_CHECKSIG
+type TimeLock struct {
+	Type   TimeLockType
+	Value  uint32
+	PubKey *czzutil.AddressPubKey
+}
+
+// validate checks that the lock value can be enforced by the lock type.
+func (l *TimeLock) validate() error {
+	switch l.Type {
+	case TimeLockAbsolute:
+		if l.Value == 0 {
+			return fmt.Errorf("%w: lock time must be nonzero",
+				ErrInvalidTimeLock)
+		}
+	case TimeLockRelative:
+		mask := uint32(wire.SequenceLockTimeIsSeconds |
+			wire.SequenceLockTimeMask)
+		if l.Value == 0 || l.Value&^mask != 0 {
+			return fmt.Errorf("%w: relative lock %d is not a "+
+				"valid sequence lock", ErrInvalidTimeLock,
+				l.Value)
+		}
+	default:
+		return fmt.Errorf("%w: unknown lock type %v",
+			ErrInvalidTimeLock, l.Type)
+	}
+	if l.PubKey == nil {
+		return fmt.Errorf("%w: missing public key", ErrInvalidTimeLock)
+	}
+	return nil
+}
+
+// Script returns the redeem script of the time lock.
+func (l *TimeLock) Script() ([]byte, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+	op := byte(txscript.OP_CHECKLOCKTIMEVERIFY)
+	if l.Type == TimeLockRelative {
+		op = txscript.OP_CHECKSEQUENCEVERIFY
+	}
+	return txscript.NewScriptBuilder().
+		AddInt64(int64(l.Value)).
+		AddOp(op).
+		AddOp(txscript.OP_DROP).
+		AddData(l.PubKey.ScriptAddress()).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+}
+
+// satisfiedBy checks that input inputIndex of tx may spend an output locked
+// by the time lock, returning ErrTimeLockNotSatisfied otherwise.
+func (l *TimeLock) satisfiedBy(tx *wire.MsgTx, inputIndex int) error {
+	sequence := tx.TxIn[inputIndex].Sequence
+
+	switch l.Type {
+	case TimeLockAbsolute:
+		// The lock time of the transaction is only enforced when the
+		// input is not final, and must be of the same kind (height or
+		// time) as the lock value.
+		if sequence == wire.MaxTxInSequenceNum {
+			return fmt.Errorf("%w: input %d has a final sequence "+
+				"number", ErrTimeLockNotSatisfied, inputIndex)
+		}
+		isTime := l.Value >= txscript.LockTimeThreshold
+		if (tx.LockTime >= txscript.LockTimeThreshold) != isTime ||
+			tx.LockTime < l.Value {
+
+			return fmt.Errorf("%w: lock time %d is before %d",
+				ErrTimeLockNotSatisfied, tx.LockTime, l.Value)
+		}
+
+	case TimeLockRelative:
+		// Relative lock times are only enforced for version 2
+		// transactions whose input sequence numbers do not disable
+		// them, and must be of the same kind (blocks or seconds) as
+		// the lock value.
+		if tx.Version < 2 {
+			return fmt.Errorf("%w: transaction version %d does "+
+				"not enforce relative lock times",
+				ErrTimeLockNotSatisfied, tx.Version)
+		}
+		if sequence&wire.SequenceLockTimeDisabled != 0 {
+			return fmt.Errorf("%w: input %d disables relative "+
+				"lock times", ErrTimeLockNotSatisfied,
+				inputIndex)
+		}
+		isSeconds := uint32(wire.SequenceLockTimeIsSeconds)
+		if sequence&isSeconds != l.Value&isSeconds ||
+			sequence&wire.SequenceLockTimeMask <
+				l.Value&wire.SequenceLockTimeMask {
+
+			return fmt.Errorf("%w: sequence number %d of input "+
+				"%d is before %d", ErrTimeLockNotSatisfied,
+				sequence, inputIndex, l.Value)
+		}
+	}
+	return nil
+}
+
+// NewTimeLock returns a time lock of the given type and value paying to the
+// key of addr.  If addr is a P2PKH address, the associated pubkey is looked up
+// by the wallet.
+func (w *Wallet) NewTimeLock(addr czzutil.Address, lockType TimeLockType,
+	value uint32) (*TimeLock, error) {
+
+	var pubKey *czzutil.AddressPubKey
+	switch addr := addr.(type) {
+	case *czzutil.AddressPubKey:
+		pubKey = addr
+
+	case *czzutil.AddressPubKeyHash:
+		err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+			addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+			addrInfo, err := w.Manager.Address(addrmgrNs, addr)
+			if err != nil {
+				return err
+			}
+			pka, ok := addrInfo.(waddrmgr.ManagedPubKeyAddress)
+			if !ok {
+				return fmt.Errorf("address %v is not a pubkey "+
+					"address", addr)
+			}
+			pubKey, err = czzutil.NewAddressPubKey(
+				pka.PubKey().SerializeCompressed(),
+				w.chainParams,
+			)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, errors.New("cannot make time-locked script for " +
+			"a non-secp256k1 public key or P2PKH address")
+	}
+
+	lock := &TimeLock{Type: lockType, Value: value, PubKey: pubKey}
+	if err := lock.validate(); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// ImportTimeLockScript adds the P2SH redeem script of a time lock to the
+// wallet, recording the lock parameters alongside so that outputs paying to
+// the returned address can be spent with ComputeInputScript or
+// SignTransaction once the lock has expired.  Time-locked outputs are never
+// selected to fund transactions created by the wallet.
+func (w *Wallet) ImportTimeLockScript(lock *TimeLock) (
+	*czzutil.AddressScriptHash, []byte, error) {
+
+	script, err := lock.Script()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var p2shAddr *czzutil.AddressScriptHash
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		var err error
+		p2shAddr, err = w.importP2SHRedeemScript(addrmgrNs, script)
+		if err != nil {
+			return err
+		}
+
+		ns, err := timeLocksBucket(tx)
+		if err != nil {
+			return err
+		}
+		return putTimeLock(ns, p2shAddr.ScriptAddress(), lock)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if chainClient, err := w.requireChainClient(); err == nil {
+		err := chainClient.NotifyReceived([]czzutil.Address{p2shAddr})
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to subscribe for "+
+				"address notifications: %v", err)
+		}
+	}
+
+	return p2shAddr, script, nil
+}
+
+// timeLockForOutput returns the time lock of an imported time-locked script
+// paid to by pkScript, or nil if pkScript does not pay to one.
+func (w *Wallet) timeLockForOutput(dbtx walletdb.ReadTx,
+	pkScript []byte) (*TimeLock, error) {
+
+	ns := dbtx.ReadBucket(timeLocksNamespaceKey)
+	if ns == nil || !txscript.IsPayToScriptHash(pkScript) {
+		return nil, nil
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		pkScript, w.chainParams,
+	)
+	if err != nil || len(addrs) != 1 {
+		return nil, err
+	}
+	return fetchTimeLock(ns, addrs[0].ScriptAddress(), w.chainParams)
+}
+
+// timeLockSignatureScript returns the signature script of input inputIndex of
+// tx spending an output of the given value locked by lock.  The transaction
+// must satisfy the lock, as the signature commits to its lock time and input
+// sequence numbers.
+func (w *Wallet) timeLockSignatureScript(addrmgrNs walletdb.ReadBucket,
+	tx *wire.MsgTx, inputIndex int, value int64, lock *TimeLock,
+	hashType txscript.SigHashType, tweaker PrivKeyTweaker) ([]byte, error) {
+
+	if err := lock.satisfiedBy(tx, inputIndex); err != nil {
+		return nil, err
+	}
+
+	script, err := lock.Script()
+	if err != nil {
+		return nil, err
+	}
+
+	addrInfo, err := w.Manager.Address(
+		addrmgrNs, lock.PubKey.AddressPubKeyHash(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pka, ok := addrInfo.(waddrmgr.ManagedPubKeyAddress)
+	if !ok {
+		return nil, fmt.Errorf("address %v is not a pubkey address",
+			addrInfo.Address())
+	}
+	privKey, err := pka.PrivKey()
+	if err != nil {
+		return nil, err
+	}
+	if tweaker != nil {
+		privKey, err = tweaker(privKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sig, err := txscript.RawTxInSignature(
+		tx, inputIndex, value, script, hashType, privKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return txscript.NewScriptBuilder().AddData(sig).AddData(script).Script()
+}
+
+// timeLocksBucket returns the bucket recording time locks, creating it if it
+// does not exist yet.
+func timeLocksBucket(tx walletdb.ReadWriteTx) (walletdb.ReadWriteBucket, error) {
+	ns := tx.ReadWriteBucket(timeLocksNamespaceKey)
+	if ns != nil {
+		return ns, nil
+	}
+	return tx.CreateTopLevelBucket(timeLocksNamespaceKey)
+}
+
+// Time locks are recorded in the time locks bucket, keyed by the hash of their
+// redeem script.  The value of a time lock is serialized as follows:
+//
+//	[0]      Type
+//	[1:5]    Value (4 bytes)
+//	[5:]     Serialized public key
+const timeLockHeaderSize = 5
+
+// putTimeLock records a time lock.
+func putTimeLock(ns walletdb.ReadWriteBucket, scriptHash []byte,
+	lock *TimeLock) error {
+
+	pubKey := lock.PubKey.ScriptAddress()
+	v := make([]byte, timeLockHeaderSize+len(pubKey))
+	v[0] = byte(lock.Type)
+	binary.BigEndian.PutUint32(v[1:5], lock.Value)
+	copy(v[5:], pubKey)
+	return ns.Put(scriptHash, v)
+}
+
+// fetchTimeLock returns the time lock recorded for a script hash, or nil if
+// there is none.
+func fetchTimeLock(ns walletdb.ReadBucket, scriptHash []byte,
+	net *chaincfg.Params) (*TimeLock, error) {
+
+	v := ns.Get(scriptHash)
+	if v == nil {
+		return nil, nil
+	}
+	if len(v) < timeLockHeaderSize {
+		return nil, fmt.Errorf("time lock for script hash %x is "+
+			"corrupt", scriptHash)
+	}
+	pubKeyAddr, err := czzutil.NewAddressPubKey(v[5:], net)
+	if err != nil {
+		return nil, err
+	}
+	return &TimeLock{
+		Type:   TimeLockType(v[0]),
+		Value:  binary.BigEndian.Uint32(v[1:5]),
+		PubKey: pubKeyAddr,
+	}, nil
+}
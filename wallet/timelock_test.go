@@ -0,0 +1,175 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// TestTimeLockScript checks that time-locked scripts are imported with their
+// lock parameters, and that inputs spending them are only signed once the
+// spending transaction satisfies the lock.
+func TestTimeLockScript(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+
+	_, err = w.NewTimeLock(addr, TimeLockRelative, 1<<31)
+	if !errors.Is(err, ErrInvalidTimeLock) {
+		t.Fatalf("expected ErrInvalidTimeLock, got %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		lockType TimeLockType
+		value    uint32
+		version  int32
+		lockTime uint32
+		sequence uint32
+		valid    bool
+	}{{
+		name:     "cltv before lock time",
+		lockType: TimeLockAbsolute,
+		value:    1000,
+		version:  1,
+		lockTime: 999,
+		sequence: wire.MaxTxInSequenceNum - 1,
+	}, {
+		name:     "cltv final input",
+		lockType: TimeLockAbsolute,
+		value:    1000,
+		version:  1,
+		lockTime: 1000,
+		sequence: wire.MaxTxInSequenceNum,
+	}, {
+		name:     "cltv time instead of height",
+		lockType: TimeLockAbsolute,
+		value:    1000,
+		version:  1,
+		lockTime: txscript.LockTimeThreshold,
+		sequence: wire.MaxTxInSequenceNum - 1,
+	}, {
+		name:     "cltv satisfied",
+		lockType: TimeLockAbsolute,
+		value:    1000,
+		version:  1,
+		lockTime: 1000,
+		sequence: wire.MaxTxInSequenceNum - 1,
+		valid:    true,
+	}, {
+		name:     "csv version 1",
+		lockType: TimeLockRelative,
+		value:    10,
+		version:  1,
+		sequence: 10,
+	}, {
+		name:     "csv disabled",
+		lockType: TimeLockRelative,
+		value:    10,
+		version:  2,
+		sequence: wire.SequenceLockTimeDisabled | 10,
+	}, {
+		name:     "csv before sequence lock",
+		lockType: TimeLockRelative,
+		value:    10,
+		version:  2,
+		sequence: 9,
+	}, {
+		name:     "csv satisfied",
+		lockType: TimeLockRelative,
+		value:    10,
+		version:  2,
+		sequence: 10,
+		valid:    true,
+	}}
+
+	for _, test := range tests {
+		lock, err := w.NewTimeLock(addr, test.lockType, test.value)
+		if err != nil {
+			t.Fatalf("%s: unable to create time lock: %v",
+				test.name, err)
+		}
+		p2shAddr, script, err := w.ImportTimeLockScript(lock)
+		if err != nil {
+			t.Fatalf("%s: unable to import time lock: %v",
+				test.name, err)
+		}
+		if !bytes.Equal(p2shAddr.ScriptAddress(),
+			czzutil.Hash160(script)) {
+
+			t.Fatalf("%s: address does not pay to the redeem "+
+				"script", test.name)
+		}
+		if _, err := w.AddressInfo(p2shAddr); err != nil {
+			t.Fatalf("%s: imported address is unknown: %v",
+				test.name, err)
+		}
+
+		pkScript, err := txscript.PayToAddrScript(p2shAddr)
+		if err != nil {
+			t.Fatalf("%s: unable to create output script: %v",
+				test.name, err)
+		}
+		var stored *TimeLock
+		err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+			var err error
+			stored, err = w.timeLockForOutput(tx, pkScript)
+			return err
+		})
+		if err != nil || stored == nil || stored.Type != lock.Type ||
+			stored.Value != lock.Value ||
+			stored.PubKey.String() != lock.PubKey.String() {
+
+			t.Fatalf("%s: unexpected stored time lock %v (%v)",
+				test.name, stored, err)
+		}
+
+		output := wire.NewTxOut(100000, pkScript)
+		tx := wire.NewMsgTx(test.version)
+		tx.LockTime = test.lockTime
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+			Sequence:         test.sequence,
+		})
+		tx.AddTxOut(wire.NewTxOut(90000, pkScript))
+
+		_, sigScript, err := w.ComputeInputScript(
+			tx, output, 0, nil, txscript.SigHashAll, nil,
+		)
+		if !test.valid {
+			if !errors.Is(err, ErrTimeLockNotSatisfied) {
+				t.Fatalf("%s: expected ErrTimeLockNotSatisfied, "+
+					"got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unable to compute input script: %v",
+				test.name, err)
+		}
+
+		tx.TxIn[0].SignatureScript = sigScript
+		err = validateMsgTx(
+			tx, [][]byte{pkScript},
+			[]czzutil.Amount{czzutil.Amount(output.Value)},
+		)
+		if err != nil {
+			t.Fatalf("%s: invalid input script: %v", test.name, err)
+		}
+	}
+}
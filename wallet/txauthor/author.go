@@ -7,6 +7,8 @@ package txauthor
 
 import (
 	"errors"
+	"math/big"
+
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
@@ -49,6 +51,11 @@ func (insufficientFundsError) Error() string {
 	return "insufficient funds available to construct transaction"
 }
 
+// ErrOutputsTooSmall is returned when the outputs a transaction fee is
+// subtracted from are too small to pay the fee.
+var ErrOutputsTooSmall = errors.New("outputs too small to pay the " +
+	"transaction fee")
+
 // AuthoredTx holds the state of a newly-created transaction and the change
 // output (if one was added).
 type AuthoredTx struct {
@@ -69,6 +76,10 @@ type ChangeSource struct {
 	ScriptSize int
 }
 
+// RedeemScriptSource returns the redeem script an output script paying to a
+// script hash commits to, or nil if it is not known.
+type RedeemScriptSource func(pkScript []byte) []byte
+
 // NewUnsignedTransaction creates an unsigned transaction paying to one or more
 // non-change outputs.  An appropriate transaction fee is included based on the
 // transaction size.
@@ -81,7 +92,8 @@ type ChangeSource struct {
 // appended to the transaction outputs.  Since the change output may not be
 // necessary, fetchChange is called zero or one times to generate this script.
 // This function must return a P2WPKH script or smaller, otherwise fee estimation
-// will be incorrect.
+// will be incorrect.  If the remaining value doesn't even pay for the change
+// output itself, it is added to the fee instead of fetching more inputs.
 //
 // If successful, the transaction, total input value spent, and all previous
 // output scripts are returned.  If the input source was unable to provide
@@ -129,8 +141,21 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, feeRatePerKb czzutil.Amount,
 		maxRequiredFee := txrules.FeeForSerializeSize(feeRatePerKb, maxSignedSize)
 		remainingAmount := inputAmount - targetAmount
 		if remainingAmount < maxRequiredFee {
-			targetFee = maxRequiredFee
-			continue
+			// The inputs may still pay for a transaction without
+			// a change output.  The remaining value is then less
+			// than the fee of a change output, and is added to the
+			// fee instead.
+			noChangeSize := txsizes.EstimateVirtualSize(
+				p2pkh, p2wpkh, nested, outputs, 0,
+			)
+			noChangeFee := txrules.FeeForSerializeSize(
+				feeRatePerKb, noChangeSize,
+			)
+			if len(outputs) == 0 || remainingAmount < noChangeFee {
+				targetFee = maxRequiredFee
+				continue
+			}
+			maxRequiredFee = remainingAmount
 		}
 
 		unsignedTransaction := &wire.MsgTx{
@@ -165,6 +190,206 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, feeRatePerKb czzutil.Amount,
 	}
 }
 
+// NewUnsignedTransactionSubtractFee creates an unsigned transaction paying to
+// one or more non-change outputs, like NewUnsignedTransaction, except that the
+// transaction fee is subtracted from the outputs at the subtractFrom indexes
+// rather than paid on top of the outputs.  The fee is split between these
+// outputs in proportion to their values, so a single index takes the whole fee
+// out of the chosen output.  The outputs passed in are not modified.
+//
+// Transaction inputs are chosen from a single call to fetchInputs with the
+// total output value as the target amount.  Remaining input value is returned
+// to the wallet via a change output, unless it is dust, in which case it is
+// added to the fee and less is subtracted from the outputs.  If an output
+// would be left with a dust value after paying its share of the fee,
+// ErrOutputsTooSmall is returned.
+//
+// The inputs redeeming outputs paying to a script hash are sized from the
+// redeem scripts returned by redeemScripts, which may be nil if none are
+// known.
+func NewUnsignedTransactionSubtractFee(outputs []*wire.TxOut,
+	subtractFrom []int, feeRatePerKb czzutil.Amount,
+	fetchInputs InputSource, changeSource *ChangeSource,
+	redeemScripts RedeemScriptSource) (*AuthoredTx, error) {
+
+	if len(subtractFrom) == 0 {
+		return nil, errors.New("no outputs to subtract the fee from")
+	}
+	seen := make(map[int]struct{}, len(subtractFrom))
+	for _, i := range subtractFrom {
+		if i < 0 || i >= len(outputs) {
+			return nil, errors.New("fee subtracted from a " +
+				"nonexistent output")
+		}
+		if _, ok := seen[i]; ok {
+			return nil, errors.New("fee subtracted from an " +
+				"output more than once")
+		}
+		seen[i] = struct{}{}
+	}
+
+	targetAmount := SumOutputValues(outputs)
+	inputAmount, inputs, inputValues, scripts, err := fetchInputs(targetAmount)
+	if err != nil {
+		return nil, err
+	}
+	if inputAmount < targetAmount {
+		return nil, insufficientFundsError{}
+	}
+
+	// The outputs pay the fee, so all remaining input value is change.
+	// Change that is dust is paid as fee instead.
+	changeAmount := inputAmount - targetAmount
+	hasChange := changeAmount != 0 && !txrules.IsDustAmount(changeAmount,
+		changeSource.ScriptSize, txrules.DefaultRelayFeePerKb)
+
+	changeScriptSize := 0
+	if hasChange {
+		changeScriptSize = changeSource.ScriptSize
+	}
+	maxSignedSize := estimateSignedSize(
+		scripts, redeemScripts, outputs, changeScriptSize,
+	)
+	fee := txrules.FeeForSerializeSize(feeRatePerKb, maxSignedSize)
+	if !hasChange {
+		fee -= changeAmount
+		if fee < 0 {
+			fee = 0
+		}
+	}
+
+	txOuts, err := subtractFee(outputs, subtractFrom, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTransaction := &wire.MsgTx{
+		Version:  wire.TxVersion,
+		TxIn:     inputs,
+		TxOut:    txOuts,
+		LockTime: 0,
+	}
+
+	changeIndex := -1
+	if hasChange {
+		changeScript, err := changeSource.NewScript()
+		if err != nil {
+			return nil, err
+		}
+		change := wire.NewTxOut(int64(changeAmount), changeScript)
+		unsignedTransaction.TxOut = append(txOuts, change)
+		changeIndex = len(txOuts)
+	}
+
+	return &AuthoredTx{
+		Tx:              unsignedTransaction,
+		PrevScripts:     scripts,
+		PrevInputValues: inputValues,
+		TotalInput:      inputAmount,
+		ChangeIndex:     changeIndex,
+	}, nil
+}
+
+// NewUnsignedSweepTransaction creates an unsigned transaction spending all
+// inputs provided by fetchInputs to a single output paying to pkScript.  The
+// output pays the total input value minus the transaction fee, and no change
+// output is created.  fetchInputs is called once with a zero target amount,
+// and must provide every input to spend.  Inputs are sized as by
+// NewUnsignedTransactionSubtractFee.
+func NewUnsignedSweepTransaction(pkScript []byte, feeRatePerKb czzutil.Amount,
+	fetchInputs InputSource,
+	redeemScripts RedeemScriptSource) (*AuthoredTx, error) {
+
+	inputAmount, inputs, inputValues, scripts, err := fetchInputs(0)
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) == 0 {
+		return nil, insufficientFundsError{}
+	}
+
+	outputs := []*wire.TxOut{wire.NewTxOut(int64(inputAmount), pkScript)}
+	sweepInputs := func(czzutil.Amount) (czzutil.Amount, []*wire.TxIn,
+		[]czzutil.Amount, [][]byte, error) {
+
+		return inputAmount, inputs, inputValues, scripts, nil
+	}
+	return NewUnsignedTransactionSubtractFee(
+		outputs, []int{0}, feeRatePerKb, sweepInputs, &ChangeSource{},
+		redeemScripts,
+	)
+}
+
+// estimateSignedSize returns the estimated size of a signed transaction
+// redeeming outputs with the given scripts, paying to outputs and a change
+// output with a script of changeScriptSize bytes, or no change output if zero.
+// Outputs paying to a script hash are sized from the redeem scripts returned
+// by redeemScripts, if not nil.
+func estimateSignedSize(scripts [][]byte, redeemScripts RedeemScriptSource,
+	outputs []*wire.TxOut, changeScriptSize int) int {
+
+	inputSizes := make([]int, len(scripts))
+	for i, pkScript := range scripts {
+		var script []byte
+		isP2SH := txscript.IsPayToScriptHash(pkScript)
+		if isP2SH && redeemScripts != nil {
+			script = redeemScripts(pkScript)
+		}
+		inputSizes[i] = txsizes.EstimateInputSize(pkScript, script)
+	}
+	return txsizes.EstimateSerializeSizeForInputs(
+		inputSizes, outputs, changeScriptSize,
+	)
+}
+
+// subtractFee returns copies of outputs where fee is subtracted from the
+// outputs at the subtractFrom indexes, in proportion to their values.  The
+// last of these outputs pays what is left after rounding down the shares of
+// the others.
+func subtractFee(outputs []*wire.TxOut, subtractFrom []int,
+	fee czzutil.Amount) ([]*wire.TxOut, error) {
+
+	txOuts := make([]*wire.TxOut, len(outputs))
+	for i, output := range outputs {
+		txOuts[i] = wire.NewTxOut(output.Value, output.PkScript)
+	}
+
+	var total int64
+	for _, i := range subtractFrom {
+		total += txOuts[i].Value
+	}
+	if total <= 0 {
+		return nil, ErrOutputsTooSmall
+	}
+
+	remaining := int64(fee)
+	for n, i := range subtractFrom {
+		share := remaining
+		if n != len(subtractFrom)-1 {
+			// The product of the fee and an output value may not
+			// fit in an int64.
+			share = new(big.Int).Div(
+				new(big.Int).Mul(
+					big.NewInt(int64(fee)),
+					big.NewInt(txOuts[i].Value),
+				),
+				big.NewInt(total),
+			).Int64()
+		}
+		remaining -= share
+
+		txOut := txOuts[i]
+		txOut.Value -= share
+		if txOut.Value <= 0 || txrules.IsDustAmount(
+			czzutil.Amount(txOut.Value), len(txOut.PkScript),
+			txrules.DefaultRelayFeePerKb) {
+
+			return nil, ErrOutputsTooSmall
+		}
+	}
+	return txOuts, nil
+}
+
 // RandomizeOutputPosition randomizes the position of a transaction's output by
 // swapping it with a random output.  The new index is returned.  This should be
 // done before signing.
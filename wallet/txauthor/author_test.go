@@ -0,0 +1,667 @@
+// Copyright (c) 2016 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"testing"
+
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/wallet/txrules"
+	"github.com/classzz/czzwallet/wallet/txsizes"
+)
+
+// testFeeRate is the fee rate, in satoshis per kilobyte, of the transactions
+// created by the tests.
+const testFeeRate = czzutil.Amount(1e3)
+
+func p2pkhOutputs(amounts ...czzutil.Amount) []*wire.TxOut {
+	v := make([]*wire.TxOut, 0, len(amounts))
+	for _, a := range amounts {
+		outScript := make([]byte, txsizes.P2PKHPkScriptSize)
+		v = append(v, wire.NewTxOut(int64(a), outScript))
+	}
+	return v
+}
+
+// makeInputSource returns an input source spending the unspent outputs in
+// order until the target is reached.
+func makeInputSource(unspents []*wire.TxOut) InputSource {
+	currentTotal := czzutil.Amount(0)
+	currentInputs := make([]*wire.TxIn, 0, len(unspents))
+	currentInputValues := make([]czzutil.Amount, 0, len(unspents))
+	currentScripts := make([][]byte, 0, len(unspents))
+	f := func(target czzutil.Amount) (czzutil.Amount, []*wire.TxIn,
+		[]czzutil.Amount, [][]byte, error) {
+
+		for currentTotal < target && len(unspents) != 0 {
+			u := unspents[0]
+			unspents = unspents[1:]
+			nextInput := wire.NewTxIn(&wire.OutPoint{}, nil)
+			currentTotal += czzutil.Amount(u.Value)
+			currentInputs = append(currentInputs, nextInput)
+			currentInputValues = append(currentInputValues,
+				czzutil.Amount(u.Value))
+			currentScripts = append(currentScripts, u.PkScript)
+		}
+		return currentTotal, currentInputs, currentInputValues,
+			currentScripts, nil
+	}
+	return InputSource(f)
+}
+
+// testChangeSource returns a change source of P2PKH sized scripts.
+func testChangeSource() *ChangeSource {
+	return &ChangeSource{
+		NewScript: func() ([]byte, error) {
+			// Only length matters for these tests.
+			return make([]byte, txsizes.P2PKHPkScriptSize), nil
+		},
+		ScriptSize: txsizes.P2PKHPkScriptSize,
+	}
+}
+
+// p2pkhFee returns the fee of a transaction spending inputs P2PKH outputs,
+// paying to outputs and a change output with a script of changeScriptSize
+// bytes, or no change output if zero.
+func p2pkhFee(inputs int, outputs []*wire.TxOut,
+	changeScriptSize int) czzutil.Amount {
+
+	size := txsizes.EstimateVirtualSize(
+		inputs, 0, 0, outputs, changeScriptSize,
+	)
+	return txrules.FeeForSerializeSize(testFeeRate, size)
+}
+
+func TestNewUnsignedTransaction(t *testing.T) {
+	outputs := p2pkhOutputs(1e6)
+	changeFee := p2pkhFee(1, outputs, txsizes.P2PKHPkScriptSize)
+	noChangeFee := p2pkhFee(1, outputs, 0)
+	twoInputFee := p2pkhFee(2, outputs, txsizes.P2PKHPkScriptSize)
+
+	tests := []struct {
+		name             string
+		UnspentOutputs   []*wire.TxOut
+		Outputs          []*wire.TxOut
+		ChangeAmount     czzutil.Amount
+		Fee              czzutil.Amount
+		InputSourceError bool
+		InputCount       int
+	}{
+		{
+			name:             "insufficient funds",
+			UnspentOutputs:   p2pkhOutputs(1e6),
+			Outputs:          outputs,
+			InputSourceError: true,
+		},
+		{
+			name:           "change",
+			UnspentOutputs: p2pkhOutputs(1e8),
+			Outputs:        outputs,
+			ChangeAmount:   1e8 - 1e6 - changeFee,
+			Fee:            changeFee,
+			InputCount:     1,
+		},
+		{
+			name:           "exact amount without change",
+			UnspentOutputs: p2pkhOutputs(1e6 + noChangeFee),
+			Outputs:        outputs,
+			Fee:            noChangeFee,
+			InputCount:     1,
+		},
+		{
+			// The remaining value is less than the fee of a change
+			// output, but the inputs pay for the transaction
+			// without change.  The remaining value is added to the
+			// fee instead of spending more inputs.
+			name: "remaining value below change output fee",
+			UnspentOutputs: p2pkhOutputs(
+				1e6+noChangeFee+(changeFee-noChangeFee)/2, 1e8,
+			),
+			Outputs:    outputs,
+			Fee:        noChangeFee + (changeFee-noChangeFee)/2,
+			InputCount: 1,
+		},
+		{
+			// Change below the dust limit is added to the fee.
+			name:           "dust change",
+			UnspentOutputs: p2pkhOutputs(1e6+changeFee+100, 1e8),
+			Outputs:        outputs,
+			Fee:            changeFee + 100,
+			InputCount:     1,
+		},
+		{
+			// When the inputs don't even pay for the transaction
+			// without change, another input is spent.
+			name:           "remaining value below fee",
+			UnspentOutputs: p2pkhOutputs(1e6+noChangeFee-1, 1e6),
+			Outputs:        outputs,
+			ChangeAmount:   1e6 + noChangeFee - 1 - twoInputFee,
+			Fee:            twoInputFee,
+			InputCount:     2,
+		},
+		{
+			name:           "multiple inputs",
+			UnspentOutputs: p2pkhOutputs(6e5, 6e5, 6e5),
+			Outputs:        outputs,
+			ChangeAmount:   1.2e6 - 1e6 - twoInputFee,
+			Fee:            twoInputFee,
+			InputCount:     2,
+		},
+	}
+
+	for _, test := range tests {
+		inputSource := makeInputSource(test.UnspentOutputs)
+		tx, err := NewUnsignedTransaction(
+			test.Outputs, testFeeRate, inputSource,
+			testChangeSource(),
+		)
+		switch e := err.(type) {
+		case nil:
+		case InputSourceError:
+			if !test.InputSourceError {
+				t.Errorf("Test %s: Returned InputSourceError but "+
+					"expected change output with amount %v",
+					test.name, test.ChangeAmount)
+			}
+			continue
+		default:
+			t.Errorf("Test %s: Unexpected error: %v", test.name, e)
+			continue
+		}
+		if test.InputSourceError {
+			t.Errorf("Test %s: Expected InputSourceError", test.name)
+			continue
+		}
+
+		if tx.ChangeIndex < 0 {
+			if test.ChangeAmount != 0 {
+				t.Errorf("Test %s: No change output added but "+
+					"expected output with amount %v",
+					test.name, test.ChangeAmount)
+				continue
+			}
+		} else {
+			changeOutput := tx.Tx.TxOut[tx.ChangeIndex]
+			changeAmount := czzutil.Amount(changeOutput.Value)
+			if changeAmount != test.ChangeAmount {
+				t.Errorf("Test %s: Got change amount %v, "+
+					"Expected %v", test.name, changeAmount,
+					test.ChangeAmount)
+				continue
+			}
+		}
+		fee := tx.TotalInput - SumOutputValues(tx.Tx.TxOut)
+		if fee != test.Fee {
+			t.Errorf("Test %s: Got fee %v, Expected %v", test.name,
+				fee, test.Fee)
+		}
+		if len(tx.Tx.TxIn) != test.InputCount {
+			t.Errorf("Test %s: Used %d outputs from input source, "+
+				"Expected %d", test.name, len(tx.Tx.TxIn),
+				test.InputCount)
+		}
+	}
+}
+
+// sweepInputSource returns an input source spending all of the unspent
+// outputs, whatever the target.
+func sweepInputSource(unspents []*wire.TxOut) InputSource {
+	return func(czzutil.Amount) (czzutil.Amount, []*wire.TxIn,
+		[]czzutil.Amount, [][]byte, error) {
+
+		var (
+			total  czzutil.Amount
+			inputs []*wire.TxIn
+			values []czzutil.Amount
+			script [][]byte
+		)
+		for _, u := range unspents {
+			total += czzutil.Amount(u.Value)
+			inputs = append(inputs, wire.NewTxIn(&wire.OutPoint{}, nil))
+			values = append(values, czzutil.Amount(u.Value))
+			script = append(script, u.PkScript)
+		}
+		return total, inputs, values, script, nil
+	}
+}
+
+// testMultiSig returns a 2-of-3 multisig redeem script and the P2SH output
+// script committing to it.  Only the lengths of the public keys matter for
+// these tests.
+func testMultiSig(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	builder := txscript.NewScriptBuilder().AddOp(txscript.OP_2)
+	for i := 0; i < 3; i++ {
+		pubKey := make([]byte, 33)
+		pubKey[0], pubKey[1] = 0x02, byte(i)
+		builder.AddData(pubKey)
+	}
+	redeemScript, err := builder.AddOp(txscript.OP_3).
+		AddOp(txscript.OP_CHECKMULTISIG).Script()
+	if err != nil {
+		t.Fatalf("unable to create redeem script: %v", err)
+	}
+	pkScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_HASH160).
+		AddData(czzutil.Hash160(redeemScript)).
+		AddOp(txscript.OP_EQUAL).Script()
+	if err != nil {
+		t.Fatalf("unable to create P2SH script: %v", err)
+	}
+	return redeemScript, pkScript
+}
+
+// p2shFee returns the fee of a transaction spending a single output paying to
+// the hash of redeemScript, paying to outputs and no change output.
+func p2shFee(pkScript, redeemScript []byte,
+	outputs []*wire.TxOut) czzutil.Amount {
+
+	size := txsizes.EstimateSerializeSizeForInputs(
+		[]int{txsizes.EstimateInputSize(pkScript, redeemScript)},
+		outputs, 0,
+	)
+	return txrules.FeeForSerializeSize(testFeeRate, size)
+}
+
+func TestSubtractFee(t *testing.T) {
+	tests := []struct {
+		name         string
+		values       []int64
+		subtractFrom []int
+		fee          czzutil.Amount
+		expected     []int64
+		err          error
+	}{
+		{
+			name:         "single output",
+			values:       []int64{1e6},
+			subtractFrom: []int{0},
+			fee:          1000,
+			expected:     []int64{999000},
+		},
+		{
+			name:         "zero fee",
+			values:       []int64{1e6},
+			subtractFrom: []int{0},
+			fee:          0,
+			expected:     []int64{1e6},
+		},
+		{
+			// The fee is split in proportion to the output values,
+			// the last output paying the rounding remainder.
+			name:         "proportional split",
+			values:       []int64{1e6, 2e6},
+			subtractFrom: []int{0, 1},
+			fee:          1000,
+			expected:     []int64{1e6 - 333, 2e6 - 667},
+		},
+		{
+			name:         "rounding split",
+			values:       []int64{1e6, 1e6, 1e6},
+			subtractFrom: []int{0, 1, 2},
+			fee:          100,
+			expected:     []int64{1e6 - 33, 1e6 - 33, 1e6 - 34},
+		},
+		{
+			// The remainder is paid by the last index given, not
+			// the last output.
+			name:         "rounding split in index order",
+			values:       []int64{1e6, 1e6, 1e6},
+			subtractFrom: []int{2, 0, 1},
+			fee:          100,
+			expected:     []int64{1e6 - 33, 1e6 - 34, 1e6 - 33},
+		},
+		{
+			name:         "other outputs unchanged",
+			values:       []int64{1e6, 5e5},
+			subtractFrom: []int{1},
+			fee:          1000,
+			expected:     []int64{1e6, 499000},
+		},
+		{
+			name:         "dust output",
+			values:       []int64{1000},
+			subtractFrom: []int{0},
+			fee:          500,
+			err:          ErrOutputsTooSmall,
+		},
+		{
+			name:         "dust share",
+			values:       []int64{1e6, 1000},
+			subtractFrom: []int{0, 1},
+			fee:          600000,
+			err:          ErrOutputsTooSmall,
+		},
+		{
+			name:         "fee above output value",
+			values:       []int64{1000},
+			subtractFrom: []int{0},
+			fee:          2000,
+			err:          ErrOutputsTooSmall,
+		},
+		{
+			name:         "zero output values",
+			values:       []int64{0},
+			subtractFrom: []int{0},
+			fee:          0,
+			err:          ErrOutputsTooSmall,
+		},
+	}
+
+	for _, test := range tests {
+		outputs := p2pkhOutputs()
+		for _, value := range test.values {
+			outputs = append(outputs, p2pkhOutputs(
+				czzutil.Amount(value))...)
+		}
+
+		txOuts, err := subtractFee(outputs, test.subtractFrom, test.fee)
+		if err != test.err {
+			t.Errorf("Test %s: Got error %v, Expected %v",
+				test.name, err, test.err)
+			continue
+		}
+		for i, output := range outputs {
+			if output.Value != test.values[i] {
+				t.Errorf("Test %s: Output %d modified",
+					test.name, i)
+			}
+		}
+		if err != nil {
+			continue
+		}
+		for i, txOut := range txOuts {
+			if txOut.Value != test.expected[i] {
+				t.Errorf("Test %s: Got output %d value %v, "+
+					"Expected %v", test.name, i, txOut.Value,
+					test.expected[i])
+			}
+		}
+	}
+}
+
+func TestNewUnsignedTransactionSubtractFee(t *testing.T) {
+	redeemScript, p2shScript := testMultiSig(t)
+	redeemScripts := func(pkScript []byte) []byte {
+		if string(pkScript) == string(p2shScript) {
+			return redeemScript
+		}
+		return nil
+	}
+
+	outputs := p2pkhOutputs(1e6)
+	twoOutputs := p2pkhOutputs(1e6, 2e6)
+	noChangeFee := p2pkhFee(1, outputs, 0)
+	changeFee := p2pkhFee(1, outputs, txsizes.P2PKHPkScriptSize)
+	splitFee := p2pkhFee(1, twoOutputs, 0)
+	splitShare := splitFee * 1e6 / 3e6
+	multiSigFee := p2shFee(p2shScript, redeemScript, outputs)
+	p2shUnspent := []*wire.TxOut{wire.NewTxOut(1e6, p2shScript)}
+
+	tests := []struct {
+		name             string
+		UnspentOutputs   []*wire.TxOut
+		Outputs          []*wire.TxOut
+		SubtractFrom     []int
+		RedeemScripts    RedeemScriptSource
+		OutputValues     []czzutil.Amount
+		ChangeAmount     czzutil.Amount
+		Fee              czzutil.Amount
+		InputSourceError bool
+		TooSmall         bool
+		Invalid          bool
+	}{
+		{
+			name:           "exact amount",
+			UnspentOutputs: p2pkhOutputs(1e6),
+			Outputs:        outputs,
+			SubtractFrom:   []int{0},
+			OutputValues:   []czzutil.Amount{1e6 - noChangeFee},
+			Fee:            noChangeFee,
+		},
+		{
+			name:           "change",
+			UnspentOutputs: p2pkhOutputs(2e6),
+			Outputs:        outputs,
+			SubtractFrom:   []int{0},
+			OutputValues:   []czzutil.Amount{1e6 - changeFee},
+			ChangeAmount:   1e6,
+			Fee:            changeFee,
+		},
+		{
+			// Dust change is paid as fee, so less is subtracted
+			// from the output.
+			name:           "dust change",
+			UnspentOutputs: p2pkhOutputs(1e6 + 100),
+			Outputs:        outputs,
+			SubtractFrom:   []int{0},
+			OutputValues:   []czzutil.Amount{1e6 - noChangeFee + 100},
+			Fee:            noChangeFee,
+		},
+		{
+			name:           "split between outputs",
+			UnspentOutputs: p2pkhOutputs(3e6),
+			Outputs:        twoOutputs,
+			SubtractFrom:   []int{0, 1},
+			OutputValues: []czzutil.Amount{
+				1e6 - splitShare, 2e6 - (splitFee - splitShare),
+			},
+			Fee: splitFee,
+		},
+		{
+			name:           "subtracted from one of two outputs",
+			UnspentOutputs: p2pkhOutputs(3e6),
+			Outputs:        twoOutputs,
+			SubtractFrom:   []int{1},
+			OutputValues:   []czzutil.Amount{1e6, 2e6 - splitFee},
+			Fee:            splitFee,
+		},
+		{
+			name:           "output too small",
+			UnspentOutputs: p2pkhOutputs(600),
+			Outputs:        p2pkhOutputs(600),
+			SubtractFrom:   []int{0},
+			TooSmall:       true,
+		},
+		{
+			name:             "insufficient funds",
+			UnspentOutputs:   p2pkhOutputs(5e5),
+			Outputs:          outputs,
+			SubtractFrom:     []int{0},
+			InputSourceError: true,
+		},
+		{
+			name:           "nonexistent output",
+			UnspentOutputs: p2pkhOutputs(1e6),
+			Outputs:        outputs,
+			SubtractFrom:   []int{1},
+			Invalid:        true,
+		},
+		{
+			name:           "output subtracted from twice",
+			UnspentOutputs: p2pkhOutputs(3e6),
+			Outputs:        twoOutputs,
+			SubtractFrom:   []int{0, 0},
+			Invalid:        true,
+		},
+		{
+			name:           "no outputs subtracted from",
+			UnspentOutputs: p2pkhOutputs(1e6),
+			Outputs:        outputs,
+			Invalid:        true,
+		},
+		{
+			// P2SH inputs are sized from their redeem script.
+			name:           "multisig input",
+			UnspentOutputs: p2shUnspent,
+			Outputs:        outputs,
+			SubtractFrom:   []int{0},
+			RedeemScripts:  redeemScripts,
+			OutputValues:   []czzutil.Amount{1e6 - multiSigFee},
+			Fee:            multiSigFee,
+		},
+		{
+			// Without a redeem script, P2SH inputs are sized as
+			// P2PKH inputs.
+			name:           "unknown redeem script",
+			UnspentOutputs: p2shUnspent,
+			Outputs:        outputs,
+			SubtractFrom:   []int{0},
+			OutputValues:   []czzutil.Amount{1e6 - noChangeFee},
+			Fee:            noChangeFee,
+		},
+	}
+
+	for _, test := range tests {
+		tx, err := NewUnsignedTransactionSubtractFee(
+			test.Outputs, test.SubtractFrom, testFeeRate,
+			makeInputSource(test.UnspentOutputs),
+			testChangeSource(), test.RedeemScripts,
+		)
+		switch {
+		case test.InputSourceError:
+			if _, ok := err.(InputSourceError); !ok {
+				t.Errorf("Test %s: Got error %v, Expected "+
+					"InputSourceError", test.name, err)
+			}
+			continue
+		case test.TooSmall:
+			if err != ErrOutputsTooSmall {
+				t.Errorf("Test %s: Got error %v, Expected %v",
+					test.name, err, ErrOutputsTooSmall)
+			}
+			continue
+		case test.Invalid:
+			if err == nil {
+				t.Errorf("Test %s: Expected error", test.name)
+			}
+			continue
+		case err != nil:
+			t.Errorf("Test %s: Unexpected error: %v", test.name, err)
+			continue
+		}
+
+		for i, value := range test.OutputValues {
+			if czzutil.Amount(tx.Tx.TxOut[i].Value) != value {
+				t.Errorf("Test %s: Got output %d value %v, "+
+					"Expected %v", test.name, i,
+					tx.Tx.TxOut[i].Value, value)
+			}
+		}
+		if tx.ChangeIndex < 0 {
+			if test.ChangeAmount != 0 {
+				t.Errorf("Test %s: No change output added but "+
+					"expected output with amount %v",
+					test.name, test.ChangeAmount)
+			}
+		} else {
+			changeOutput := tx.Tx.TxOut[tx.ChangeIndex]
+			changeAmount := czzutil.Amount(changeOutput.Value)
+			if changeAmount != test.ChangeAmount {
+				t.Errorf("Test %s: Got change amount %v, "+
+					"Expected %v", test.name, changeAmount,
+					test.ChangeAmount)
+			}
+		}
+		fee := tx.TotalInput - SumOutputValues(tx.Tx.TxOut)
+		if fee != test.Fee {
+			t.Errorf("Test %s: Got fee %v, Expected %v", test.name,
+				fee, test.Fee)
+		}
+	}
+}
+
+func TestNewUnsignedSweepTransaction(t *testing.T) {
+	redeemScript, p2shScript := testMultiSig(t)
+	redeemScripts := func(pkScript []byte) []byte {
+		if string(pkScript) == string(p2shScript) {
+			return redeemScript
+		}
+		return nil
+	}
+
+	pkScript := make([]byte, txsizes.P2PKHPkScriptSize)
+	outputs := p2pkhOutputs(0)
+	multiSigFee := p2shFee(p2shScript, redeemScript, outputs)
+	p2shUnspent := []*wire.TxOut{wire.NewTxOut(1e6, p2shScript)}
+
+	tests := []struct {
+		name             string
+		UnspentOutputs   []*wire.TxOut
+		RedeemScripts    RedeemScriptSource
+		Fee              czzutil.Amount
+		InputSourceError bool
+		TooSmall         bool
+	}{
+		{
+			name:           "single input",
+			UnspentOutputs: p2pkhOutputs(1e6),
+			Fee:            p2pkhFee(1, outputs, 0),
+		},
+		{
+			name:           "multiple inputs",
+			UnspentOutputs: p2pkhOutputs(1e6, 2e5, 3e3),
+			Fee:            p2pkhFee(3, outputs, 0),
+		},
+		{
+			name:           "multisig input",
+			UnspentOutputs: p2shUnspent,
+			RedeemScripts:  redeemScripts,
+			Fee:            multiSigFee,
+		},
+		{
+			name:             "no inputs",
+			InputSourceError: true,
+		},
+		{
+			name:           "inputs too small",
+			UnspentOutputs: p2pkhOutputs(400, 400),
+			TooSmall:       true,
+		},
+	}
+
+	for _, test := range tests {
+		tx, err := NewUnsignedSweepTransaction(
+			pkScript, testFeeRate,
+			sweepInputSource(test.UnspentOutputs),
+			test.RedeemScripts,
+		)
+		switch {
+		case test.InputSourceError:
+			if _, ok := err.(InputSourceError); !ok {
+				t.Errorf("Test %s: Got error %v, Expected "+
+					"InputSourceError", test.name, err)
+			}
+			continue
+		case test.TooSmall:
+			if err != ErrOutputsTooSmall {
+				t.Errorf("Test %s: Got error %v, Expected %v",
+					test.name, err, ErrOutputsTooSmall)
+			}
+			continue
+		case err != nil:
+			t.Errorf("Test %s: Unexpected error: %v", test.name, err)
+			continue
+		}
+
+		if len(tx.Tx.TxIn) != len(test.UnspentOutputs) ||
+			len(tx.Tx.TxOut) != 1 || tx.ChangeIndex >= 0 {
+
+			t.Errorf("Test %s: Got %d inputs and %d outputs, "+
+				"Expected %d inputs and 1 output", test.name,
+				len(tx.Tx.TxIn), len(tx.Tx.TxOut),
+				len(test.UnspentOutputs))
+			continue
+		}
+		sweepAmount := tx.TotalInput - test.Fee
+		if czzutil.Amount(tx.Tx.TxOut[0].Value) != sweepAmount {
+			t.Errorf("Test %s: Got output value %v, Expected %v",
+				test.name, tx.Tx.TxOut[0].Value, sweepAmount)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txauthor"
+	"github.com/classzz/czzwallet/wallet/txsizes"
+	"github.com/classzz/czzwallet/walletdb"
+)
+
+// TxPreview describes a transaction as the wallet would create it, without
+// creating it.
+type TxPreview struct {
+	// Tx is the unsigned transaction.
+	Tx *wire.MsgTx
+
+	// PrevScripts and PrevInputValues are the output scripts and values of
+	// the outputs spent by the inputs of Tx, in input order.
+	PrevScripts     [][]byte
+	PrevInputValues []czzutil.Amount
+
+	// EstimatedSize is the estimated serialize size of the signed
+	// transaction.  Inputs are sized by the scripts of the outputs they
+	// spend, as when the fee is estimated.
+	EstimatedSize int
+
+	// Fee is the fee paid by the transaction.
+	Fee czzutil.Amount
+
+	// ChangeAmount is the value of the change output, and ChangeIndex its
+	// index in Tx.  ChangeIndex is -1 if there is no change output.
+	ChangeAmount czzutil.Amount
+	ChangeIndex  int
+}
+
+// PreviewOutputs returns a preview of the transaction SendOutputsWithOptions
+// would create for the same arguments.  Inputs are selected through the same
+// path as transactions that are sent, but the change address is not persisted,
+// no outputs are locked, and the wallet does not need to be unlocked.
+func (w *Wallet) PreviewOutputs(outputs []*wire.TxOut,
+	opts *SendOptions) (*TxPreview, error) {
+
+	return w.previewTx(createTxRequest{
+		outputs: outputs,
+		opts:    *opts,
+	})
+}
+
+// PreviewSweep returns a preview of the transaction SweepOutputs would create
+// for the same arguments, like PreviewOutputs.
+func (w *Wallet) PreviewSweep(policy OutputSelectionPolicy,
+	keyScope *waddrmgr.KeyScope, pkScript []byte,
+	satPerKb czzutil.Amount) (*TxPreview, error) {
+
+	return w.previewTx(createTxRequest{
+		outputs: []*wire.TxOut{wire.NewTxOut(0, pkScript)},
+		opts: SendOptions{
+			KeyScope:              keyScope,
+			Account:               policy.Account,
+			RequiredConfirmations: policy.RequiredConfirmations,
+			FeeRate:               satPerKb,
+		},
+		sweep: true,
+	})
+}
+
+// previewTx creates the transaction of a creation request as a dry run and
+// describes it.
+func (w *Wallet) previewTx(req createTxRequest) (*TxPreview, error) {
+	req.dryRun = true
+	tx, err := w.createTx(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The inputs are sized as by coin selection, from the redeem scripts
+	// of the P2SH outputs they spend.
+	inputSizes := make([]int, len(tx.PrevScripts))
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		params := CoinSelectionParams{
+			RedeemScripts: w.redeemScriptSource(addrmgrNs),
+		}
+		for i, pkScript := range tx.PrevScripts {
+			inputSizes[i] = params.InputSize(pkScript)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &TxPreview{
+		Tx:              tx.Tx,
+		PrevScripts:     tx.PrevScripts,
+		PrevInputValues: tx.PrevInputValues,
+		EstimatedSize: txsizes.EstimateSerializeSizeForInputs(
+			inputSizes, tx.Tx.TxOut, 0,
+		),
+		Fee:         tx.TotalInput - txauthor.SumOutputValues(tx.Tx.TxOut),
+		ChangeIndex: tx.ChangeIndex,
+	}
+	if tx.ChangeIndex >= 0 {
+		preview.ChangeAmount = czzutil.Amount(
+			tx.Tx.TxOut[tx.ChangeIndex].Value,
+		)
+	}
+	return preview, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2021 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/classzz/czzwallet/waddrmgr"
+	"github.com/classzz/czzwallet/wallet/txsizes"
+)
+
+// TestPreviewOutputs checks that a locked wallet previews transactions with
+// their fee and change, without persisting the change address.
+func TestPreviewOutputs(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	pkScript := addTestCredits(t, w, 100000)
+	w.Lock()
+
+	props, err := w.AccountProperties(waddrmgr.KeyScopeBIP0044, 0)
+	if err != nil {
+		t.Fatalf("unable to get account properties: %v", err)
+	}
+	internalKeys := props.InternalKeyCount
+
+	txOuts := []*wire.TxOut{wire.NewTxOut(30000, pkScript)}
+	preview, err := w.PreviewOutputs(txOuts, &SendOptions{
+		KeyScope:              &waddrmgr.KeyScopeBIP0044,
+		RequiredConfirmations: 1,
+		FeeRate:               1000,
+	})
+	if err != nil {
+		t.Fatalf("unable to preview tx: %v", err)
+	}
+
+	if len(preview.Tx.TxIn) != 1 || len(preview.PrevScripts) != 1 ||
+		preview.PrevInputValues[0] != 100000 {
+
+		t.Fatalf("expected the 100000 output to be spent, found %v",
+			preview.PrevInputValues)
+	}
+	if preview.ChangeIndex < 0 {
+		t.Fatalf("expected a change output")
+	}
+	size := txsizes.EstimateSerializeSizeForInputs(
+		[]int{txsizes.EstimateInputSize(preview.PrevScripts[0], nil)},
+		preview.Tx.TxOut, 0,
+	)
+	if preview.EstimatedSize != size {
+		t.Fatalf("expected estimated size %d, found %d", size,
+			preview.EstimatedSize)
+	}
+	if preview.Fee+preview.ChangeAmount+30000 != 100000 {
+		t.Fatalf("fee %v and change %v do not balance the inputs",
+			preview.Fee, preview.ChangeAmount)
+	}
+
+	props, err = w.AccountProperties(waddrmgr.KeyScopeBIP0044, 0)
+	if err != nil {
+		t.Fatalf("unable to get account properties: %v", err)
+	}
+	if props.InternalKeyCount != internalKeys {
+		t.Fatalf("change address was persisted by the preview")
+	}
+}
+
+// TestPreviewSweep checks that a sweep preview spends all eligible outputs to
+// a single output and pays the remaining value after the fee.
+func TestPreviewSweep(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	pkScript := addTestCredits(t, w, 50000, 70000)
+	w.Lock()
+
+	policy := OutputSelectionPolicy{RequiredConfirmations: 1}
+	preview, err := w.PreviewSweep(
+		policy, &waddrmgr.KeyScopeBIP0044, pkScript, 1000,
+	)
+	if err != nil {
+		t.Fatalf("unable to preview sweep: %v", err)
+	}
+
+	if len(preview.Tx.TxIn) != 2 || len(preview.Tx.TxOut) != 1 ||
+		preview.ChangeIndex != -1 || preview.ChangeAmount != 0 {
+
+		t.Fatalf("expected 2 inputs and 1 output without change, "+
+			"found %d inputs, %d outputs and change index %d",
+			len(preview.Tx.TxIn), len(preview.Tx.TxOut),
+			preview.ChangeIndex)
+	}
+	swept := czzutil.Amount(preview.Tx.TxOut[0].Value)
+	if swept+preview.Fee != 120000 {
+		t.Fatalf("swept value %v and fee %v do not balance the inputs",
+			swept, preview.Fee)
+	}
+}
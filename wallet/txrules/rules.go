@@ -7,6 +7,7 @@
 package txrules
 
 import (
+	"bytes"
 	"errors"
 
 	"github.com/classzz/classzz/txscript"
@@ -17,6 +18,10 @@ import (
 // DefaultRelayFeePerKb is the default minimum relay fee policy for a mempool.
 const DefaultRelayFeePerKb czzutil.Amount = 1e3
 
+// MaxNullDataSize is the maximum number of data bytes carried by a null-data
+// output for its transaction to be relayed by mempools with default policies.
+const MaxNullDataSize = 220
+
 // GetDustThreshold is used to define the amount below which output will be
 // determined as dust. Threshold is determined as 3 times the relay fee.
 func GetDustThreshold(scriptSize int, relayFeePerKb czzutil.Amount) czzutil.Amount {
@@ -46,7 +51,7 @@ func IsDustAmount(amount czzutil.Amount, scriptSize int, relayFeePerKb czzutil.A
 // with default policies.
 func IsDustOutput(output *wire.TxOut, relayFeePerKb czzutil.Amount) bool {
 	// Unspendable outputs which solely carry data are not checked for dust.
-	if txscript.GetScriptClass(output.PkScript) == txscript.NullDataTy {
+	if IsNullDataScript(output.PkScript) {
 		return false
 	}
 
@@ -64,6 +69,7 @@ var (
 	ErrAmountNegative   = errors.New("transaction output amount is negative")
 	ErrAmountExceedsMax = errors.New("transaction output amount exceeds maximum value")
 	ErrOutputIsDust     = errors.New("transaction output is dust")
+	ErrNullDataTooLarge = errors.New("transaction output carries too much data")
 )
 
 // CheckOutput performs simple consensus and policy tests on a transaction
@@ -75,12 +81,54 @@ func CheckOutput(output *wire.TxOut, relayFeePerKb czzutil.Amount) error {
 	if output.Value > czzutil.MaxSatoshi {
 		return ErrAmountExceedsMax
 	}
+	if data, ok := NullData(output.PkScript); ok {
+		if len(data) > MaxNullDataSize {
+			return ErrNullDataTooLarge
+		}
+		return nil
+	}
 	if IsDustOutput(output, relayFeePerKb) {
 		return ErrOutputIsDust
 	}
 	return nil
 }
 
+// IsNullDataScript determines whether an output script is an unspendable
+// null-data script, made of OP_RETURN followed by data pushes only.
+func IsNullDataScript(pkScript []byte) bool {
+	return len(pkScript) != 0 && pkScript[0] == txscript.OP_RETURN &&
+		txscript.IsPushOnlyScript(pkScript[1:])
+}
+
+// NullData returns the data carried by a null-data output script.  The
+// boolean is false if the script is not a null-data script.
+func NullData(pkScript []byte) ([]byte, bool) {
+	if !IsNullDataScript(pkScript) {
+		return nil, false
+	}
+	pushes, err := txscript.PushedData(pkScript[1:])
+	if err != nil {
+		return nil, false
+	}
+	return bytes.Join(pushes, nil), true
+}
+
+// NullDataOutput creates a zero-value null-data output carrying data.  Data
+// larger than MaxNullDataSize is rejected with ErrNullDataTooLarge.
+func NullDataOutput(data []byte) (*wire.TxOut, error) {
+	if len(data) > MaxNullDataSize {
+		return nil, ErrNullDataTooLarge
+	}
+	pkScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_RETURN).
+		AddData(data).
+		Script()
+	if err != nil {
+		return nil, err
+	}
+	return wire.NewTxOut(0, pkScript), nil
+}
+
 // FeeForSerializeSize calculates the required fee for a transaction of some
 // arbitrary size given a mempool's relay fee policy.
 func FeeForSerializeSize(relayFeePerKb czzutil.Amount, txSerializeSize int) czzutil.Amount {
@@ -6,6 +6,7 @@ package txsizes
 
 import (
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
 )
 
@@ -21,6 +22,14 @@ const (
 	//   - 33 bytes serialized compressed pubkey
 	RedeemP2PKHSigScriptSize = 1 + 73 + 1 + 33
 
+	// RedeemP2PKSigScriptSize is the worst case (largest) serialize size
+	// of a transaction input script that redeems a P2PK output.  It is
+	// calculated as:
+	//
+	//   - OP_DATA_73
+	//   - 72 bytes DER signature + 1 byte sighash
+	RedeemP2PKSigScriptSize = 1 + 73
+
 	// P2PKHPkScriptSize is the size of a transaction output script that
 	// pays to a compressed pubkey hash.  It is calculated as:
 	//
@@ -200,3 +209,83 @@ func EstimateVirtualSize(numP2PKHIns, numP2WPKHIns, numNestedP2WPKHIns int,
 	// always rounded up.
 	return baseSize + (witnessWeight+3)/blockchain.WitnessScaleFactor
 }
+
+// EstimateSigScriptSize returns a worst case serialize size estimate for the
+// signature script of an input redeeming an output with pkScript.  Outputs
+// paying to a script hash are sized from redeemScript, the script their hash
+// commits to, or as P2PKH outputs when it is nil.  Nonstandard outputs are
+// sized as P2PKH outputs as well.
+func EstimateSigScriptSize(pkScript, redeemScript []byte) int {
+	switch txscript.GetScriptClass(pkScript) {
+	case txscript.ScriptHashTy:
+		if redeemScript == nil {
+			return RedeemP2PKHSigScriptSize
+		}
+		return EstimateSigScriptSize(redeemScript, nil) +
+			pushDataSize(len(redeemScript))
+
+	case txscript.PubKeyTy:
+		return RedeemP2PKSigScriptSize
+
+	case txscript.MultiSigTy:
+		// OP_0 followed by a push of each required signature.
+		_, numSigs, err := txscript.CalcMultiSigStats(pkScript)
+		if err == nil {
+			return 1 + numSigs*RedeemP2PKSigScriptSize
+		}
+	}
+
+	return RedeemP2PKHSigScriptSize
+}
+
+// EstimateInputSize returns a worst case serialize size estimate for a
+// transaction input redeeming an output with pkScript.  The signature script
+// is sized by EstimateSigScriptSize.
+func EstimateInputSize(pkScript, redeemScript []byte) int {
+	sigScriptSize := EstimateSigScriptSize(pkScript, redeemScript)
+
+	// 32 bytes previous tx + 4 bytes output index + signature script +
+	// 4 bytes sequence.
+	return 32 + 4 + wire.VarIntSerializeSize(uint64(sigScriptSize)) +
+		sigScriptSize + 4
+}
+
+// EstimateSerializeSizeForInputs returns a worst case serialize size estimate
+// for a signed transaction with inputs of the given serialize sizes, as
+// estimated by EstimateInputSize, and each transaction output from txOuts.
+// The estimate is incremented for an additional change output with a script
+// of changeScriptSize bytes, unless it is zero.
+func EstimateSerializeSizeForInputs(inputSizes []int, txOuts []*wire.TxOut,
+	changeScriptSize int) int {
+
+	outputCount := len(txOuts)
+	changeOutputSize := 0
+	if changeScriptSize > 0 {
+		changeOutputSize = 8 +
+			wire.VarIntSerializeSize(uint64(changeScriptSize)) +
+			changeScriptSize
+		outputCount++
+	}
+
+	size := 8 + wire.VarIntSerializeSize(uint64(len(inputSizes))) +
+		wire.VarIntSerializeSize(uint64(outputCount)) +
+		SumOutputSerializeSizes(txOuts) + changeOutputSize
+	for _, inputSize := range inputSizes {
+		size += inputSize
+	}
+	return size
+}
+
+// pushDataSize returns the size of a canonical data push of n bytes.
+func pushDataSize(n int) int {
+	switch {
+	case n < txscript.OP_PUSHDATA1:
+		return 1 + n
+	case n <= 0xff:
+		return 2 + n
+	case n <= 0xffff:
+		return 3 + n
+	default:
+		return 5 + n
+	}
+}
@@ -1,8 +1,10 @@
 package txsizes_test
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
 	. "github.com/classzz/czzwallet/wallet/txsizes"
 )
@@ -60,3 +62,85 @@ func TestEstimateSerializeSize(t *testing.T) {
 		}
 	}
 }
+
+func TestEstimateInputSize(t *testing.T) {
+	pubKey := append([]byte{0x02}, bytes.Repeat([]byte{0x01}, 32)...)
+	hash := bytes.Repeat([]byte{0x01}, 20)
+
+	p2pkh := append(append([]byte{txscript.OP_DUP, txscript.OP_HASH160,
+		txscript.OP_DATA_20}, hash...), txscript.OP_EQUALVERIFY,
+		txscript.OP_CHECKSIG)
+	p2pk := append(append([]byte{txscript.OP_DATA_33}, pubKey...),
+		txscript.OP_CHECKSIG)
+	p2sh := append(append([]byte{txscript.OP_HASH160, txscript.OP_DATA_20},
+		hash...), txscript.OP_EQUAL)
+	multiSig := []byte{txscript.OP_2}
+	for i := 0; i < 3; i++ {
+		multiSig = append(multiSig, txscript.OP_DATA_33)
+		multiSig = append(multiSig, pubKey...)
+	}
+	multiSig = append(multiSig, txscript.OP_3, txscript.OP_CHECKMULTISIG)
+
+	tests := []struct {
+		name                 string
+		pkScript             []byte
+		redeemScript         []byte
+		ExpectedSizeEstimate int
+	}{
+		{"p2pkh", p2pkh, nil, RedeemP2PKHInputSize},
+		{"p2pk", p2pk, nil, 32 + 4 + 1 + RedeemP2PKSigScriptSize + 4},
+		{"bare multisig", multiSig, nil, 32 + 4 + 1 + 1 + 2*74 + 4},
+
+		// The redeem script of 105 bytes is pushed with OP_PUSHDATA1,
+		// and the signature script of 256 bytes needs a 3 byte
+		// compact int.
+		{"p2sh multisig", p2sh, multiSig, 32 + 4 + 3 + 1 + 2*74 + 2 +
+			105 + 4},
+		{"p2sh unknown", p2sh, nil, RedeemP2PKHInputSize},
+		{"nonstandard", []byte{txscript.OP_TRUE}, nil,
+			RedeemP2PKHInputSize},
+	}
+	for _, test := range tests {
+		actualEstimate := EstimateInputSize(test.pkScript, test.redeemScript)
+		if actualEstimate != test.ExpectedSizeEstimate {
+			t.Errorf("Test %s: Got %v: Expected %v", test.name,
+				actualEstimate, test.ExpectedSizeEstimate)
+		}
+	}
+}
+
+func TestEstimateSerializeSizeForInputs(t *testing.T) {
+	// Transactions spending P2PKH outputs are estimated like
+	// EstimateSerializeSize estimates them.
+	tests := []struct {
+		InputCount          int
+		OutputScriptLengths []int
+		AddChangeOutput     bool
+	}{
+		{1, []int{}, false},
+		{1, []int{p2pkhScriptSize}, true},
+		{2, []int{p2shScriptSize}, true},
+		{1, makeInts(p2pkhScriptSize, 0xfc), true},
+		{0xfd, []int{}, false},
+	}
+	for i, test := range tests {
+		outputs := make([]*wire.TxOut, 0, len(test.OutputScriptLengths))
+		for _, l := range test.OutputScriptLengths {
+			outputs = append(outputs, &wire.TxOut{PkScript: make([]byte, l)})
+		}
+		changeScriptSize := 0
+		if test.AddChangeOutput {
+			changeScriptSize = P2PKHPkScriptSize
+		}
+		actualEstimate := EstimateSerializeSizeForInputs(
+			makeInts(RedeemP2PKHInputSize, test.InputCount), outputs,
+			changeScriptSize,
+		)
+		expectedEstimate := EstimateSerializeSize(test.InputCount,
+			outputs, test.AddChangeOutput)
+		if actualEstimate != expectedEstimate {
+			t.Errorf("Test %d: Got %v: Expected %v", i, actualEstimate,
+				expectedEstimate)
+		}
+	}
+}
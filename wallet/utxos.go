@@ -34,7 +34,7 @@ func (p *OutputSelectionPolicy) meetsRequiredConfs(txHeight, curHeight int32) bo
 }
 
 // UnspentOutputs fetches all unspent outputs from the wallet that match rules
-// described in the passed policy.
+// described in the passed policy.  Frozen outputs are never selected.
 func (w *Wallet) UnspentOutputs(policy OutputSelectionPolicy) ([]*TransactionOutput, error) {
 	var outputResults []*TransactionOutput
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
@@ -57,6 +57,11 @@ func (w *Wallet) UnspentOutputs(policy OutputSelectionPolicy) ([]*TransactionOut
 				continue
 			}
 
+			// Ignore outputs that are frozen.
+			if w.TxStore.IsFrozenOutput(txmgrNs, output.OutPoint) {
+				continue
+			}
+
 			// Ignore outputs that are not controlled by the account.
 			_, addrs, _, err := txscript.ExtractPkScriptAddrs(output.PkScript,
 				w.chainParams)
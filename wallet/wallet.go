@@ -99,6 +99,41 @@ type Wallet struct {
 
 	recoveryWindow uint32
 
+	// coinSelector selects the inputs of transactions created without a
+	// coin selector of their own.
+	coinSelector    CoinSelector
+	coinSelectorMtx sync.Mutex
+
+	// feeEstimator estimates the fee rate of transactions created with a
+	// confirmation target, and fallbackFeeRate is used when the fee rate
+	// can not be estimated.  blockFeeEstimator estimates fee rates from
+	// the blocks of the chain backend when no fee estimator is set.
+	// txFeeRate, when set, is used instead of estimates, and estimates are
+	// limited to maxFeeRate when it is set.
+	feeEstimator      FeeEstimator
+	blockFeeEstimator *BlockFeeEstimator
+	fallbackFeeRate   czzutil.Amount
+	txFeeRate         czzutil.Amount
+	maxFeeRate        czzutil.Amount
+	feeEstimatorMtx   sync.Mutex
+
+	// consolidationQuit stops the background consolidation of unspent
+	// outputs, and consolidationStatus describes its state.
+	consolidationQuit   chan struct{}
+	consolidationStatus ConsolidationStatus
+	consolidationMtx    sync.Mutex
+
+	// payoutQueue is the running payout queue, if any.  payoutMtx also
+	// serializes the changes to the recorded payouts.
+	payoutQueue *payoutQueue
+	payoutMtx   sync.Mutex
+
+	// rescanning and recovering record whether a rescan or a recovery of
+	// the wallet's outputs is currently running.
+	rescanning   bool
+	recovering   bool
+	syncStateMtx sync.Mutex
+
 	// Channels for rescan processing.  Requests are added and merged with
 	// any waiting requests, before being sent to another goroutine to
 	// call the rescan RPC.
@@ -108,8 +143,12 @@ type Wallet struct {
 	rescanProgress      chan *RescanProgressMsg
 	rescanFinished      chan *RescanFinishedMsg
 
-	// Channel for transaction creation requests.
+	// Channel for transaction creation requests.  txCreatorMtx is held by
+	// the transaction creator while it selects inputs, and by other
+	// wallet operations spending outputs, so they never select the same
+	// outputs.
 	createTxRequests chan createTxRequest
+	txCreatorMtx     sync.Mutex
 
 	// Channels for the manager locker.
 	unlockRequests     chan unlockRequest
@@ -119,6 +158,12 @@ type Wallet struct {
 	changePassphrase   chan changePassphraseRequest
 	changePassphrases  chan changePassphrasesRequest
 
+	// unlockedUntil is the time the manager locker will relock the
+	// wallet.  It is kept behind a mutex rather than served by the locker
+	// so it can be read when the wallet is not running.
+	unlockedUntil    time.Time
+	unlockedUntilMtx sync.Mutex
+
 	NtfnServer *NotificationServer
 
 	chainParams *chaincfg.Params
@@ -147,9 +192,10 @@ func (w *Wallet) Start() {
 	}
 	w.quitMu.Unlock()
 
-	w.wg.Add(2)
+	w.wg.Add(3)
 	go w.txCreator()
 	go w.walletLocker()
+	go w.leaseSweeper()
 }
 
 // SynchronizeRPC associates the wallet with the consensus RPC client,
@@ -294,6 +340,37 @@ func (w *Wallet) ChainSynced() bool {
 	return synced
 }
 
+// Rescanning returns whether a rescan of the wallet's addresses and outputs is
+// currently running.
+func (w *Wallet) Rescanning() bool {
+	w.syncStateMtx.Lock()
+	defer w.syncStateMtx.Unlock()
+	return w.rescanning
+}
+
+// setRescanning marks whether a rescan is currently running.
+func (w *Wallet) setRescanning(rescanning bool) {
+	w.syncStateMtx.Lock()
+	w.rescanning = rescanning
+	w.syncStateMtx.Unlock()
+}
+
+// Recovering returns whether the wallet is currently recovering its outputs
+// from the chain, as started when the wallet is created with a recovery
+// window.
+func (w *Wallet) Recovering() bool {
+	w.syncStateMtx.Lock()
+	defer w.syncStateMtx.Unlock()
+	return w.recovering
+}
+
+// setRecovering marks whether a recovery is currently running.
+func (w *Wallet) setRecovering(recovering bool) {
+	w.syncStateMtx.Lock()
+	w.recovering = recovering
+	w.syncStateMtx.Unlock()
+}
+
 // SetChainSynced marks whether the wallet is connected to and currently in sync
 // with the latest block notified by the chain server.
 //
@@ -644,6 +721,9 @@ func (w *Wallet) recovery(chainClient chain.Interface,
 	log.Infof("RECOVERY MODE ENABLED -- rescanning for used addresses "+
 		"with recovery_window=%d", w.recoveryWindow)
 
+	w.setRecovering(true)
+	defer w.setRecovering(false)
+
 	// We'll initialize the recovery manager with a default batch size of
 	// 2000.
 	recoveryMgr := NewRecoveryManager(
@@ -781,10 +861,18 @@ func (w *Wallet) recoverScopedAddresses(
 
 	log.Infof("Scanning %d blocks for recoverable addresses", len(batch))
 
+	accounts, err := recoveryAccounts(ns, scopedMgrs)
+	if err != nil {
+		return err
+	}
+
 expandHorizons:
-	for scope, scopedMgr := range scopedMgrs {
-		scopeState := recoveryState.StateForScope(scope)
-		err := expandScopeHorizons(ns, scopedMgr, scopeState)
+	for _, scopedAccount := range accounts {
+		scopedMgr := scopedMgrs[scopedAccount.Scope]
+		scopeState := recoveryState.StateForAccount(scopedAccount)
+		err := expandScopeHorizons(
+			ns, scopedMgr, scopedAccount.Account, scopeState,
+		)
 		if err != nil {
 			return err
 		}
@@ -794,7 +882,7 @@ expandHorizons:
 	// construct the filter blocks request. The request includes the range
 	// of blocks we intend to scan, in addition to the scope-index -> addr
 	// map for all internal and external branches.
-	filterReq := newFilterBlocksRequest(batch, scopedMgrs, recoveryState)
+	filterReq := newFilterBlocksRequest(batch, accounts, recoveryState)
 
 	// Initiate the filter blocks request using our chain backend. If an
 	// error occurs, we are unable to proceed with the recovery.
@@ -872,7 +960,7 @@ expandHorizons:
 // horizon will be properly extended such that our lookahead always includes the
 // proper number of valid child keys.
 func expandScopeHorizons(ns walletdb.ReadWriteBucket,
-	scopedMgr *waddrmgr.ScopedKeyManager,
+	scopedMgr *waddrmgr.ScopedKeyManager, account uint32,
 	scopeState *ScopeRecoveryState) error {
 
 	// Compute the current external horizon and the number of addresses we
@@ -881,7 +969,7 @@ func expandScopeHorizons(ns walletdb.ReadWriteBucket,
 	exHorizon, exWindow := scopeState.ExternalBranch.ExtendHorizon()
 	count, childIndex := uint32(0), exHorizon
 	for count < exWindow {
-		keyPath := externalKeyPath(childIndex)
+		keyPath := externalKeyPath(account, childIndex)
 		addr, err := scopedMgr.DeriveFromKeyPath(ns, keyPath)
 		switch {
 		case err == hdkeychain.ErrInvalidChild:
@@ -910,7 +998,7 @@ func expandScopeHorizons(ns walletdb.ReadWriteBucket,
 	inHorizon, inWindow := scopeState.InternalBranch.ExtendHorizon()
 	count, childIndex = 0, inHorizon
 	for count < inWindow {
-		keyPath := internalKeyPath(childIndex)
+		keyPath := internalKeyPath(account, childIndex)
 		addr, err := scopedMgr.DeriveFromKeyPath(ns, keyPath)
 		switch {
 		case err == hdkeychain.ErrInvalidChild:
@@ -936,30 +1024,32 @@ func expandScopeHorizons(ns walletdb.ReadWriteBucket,
 	return nil
 }
 
-// externalKeyPath returns the relative external derivation path /0/0/index.
-func externalKeyPath(index uint32) waddrmgr.DerivationPath {
+// externalKeyPath returns the relative external derivation path
+// /account/0/index.
+func externalKeyPath(account, index uint32) waddrmgr.DerivationPath {
 	return waddrmgr.DerivationPath{
-		InternalAccount: waddrmgr.DefaultAccountNum,
-		Account:         waddrmgr.DefaultAccountNum,
+		InternalAccount: account,
+		Account:         account,
 		Branch:          waddrmgr.ExternalBranch,
 		Index:           index,
 	}
 }
 
-// internalKeyPath returns the relative internal derivation path /0/1/index.
-func internalKeyPath(index uint32) waddrmgr.DerivationPath {
+// internalKeyPath returns the relative internal derivation path
+// /account/1/index.
+func internalKeyPath(account, index uint32) waddrmgr.DerivationPath {
 	return waddrmgr.DerivationPath{
-		InternalAccount: waddrmgr.DefaultAccountNum,
-		Account:         waddrmgr.DefaultAccountNum,
+		InternalAccount: account,
+		Account:         account,
 		Branch:          waddrmgr.InternalBranch,
 		Index:           index,
 	}
 }
 
 // newFilterBlocksRequest constructs FilterBlocksRequests using our current
-// block range, scoped managers, and recovery state.
+// block range, recovered accounts, and recovery state.
 func newFilterBlocksRequest(batch []wtxmgr.BlockMeta,
-	scopedMgrs map[waddrmgr.KeyScope]*waddrmgr.ScopedKeyManager,
+	accounts []waddrmgr.ScopedAccount,
 	recoveryState *RecoveryState) *chain.FilterBlocksRequest {
 
 	filterReq := &chain.FilterBlocksRequest{
@@ -970,20 +1060,22 @@ func newFilterBlocksRequest(batch []wtxmgr.BlockMeta,
 	}
 
 	// Populate the external and internal addresses by merging the addresses
-	// sets belong to all currently tracked scopes.
-	for scope := range scopedMgrs {
-		scopeState := recoveryState.StateForScope(scope)
+	// sets belong to all currently tracked accounts.
+	for _, scopedAccount := range accounts {
+		scopeState := recoveryState.StateForAccount(scopedAccount)
 		for index, addr := range scopeState.ExternalBranch.Addrs() {
 			scopedIndex := waddrmgr.ScopedIndex{
-				Scope: scope,
-				Index: index,
+				Scope:   scopedAccount.Scope,
+				Account: scopedAccount.Account,
+				Index:   index,
 			}
 			filterReq.ExternalAddrs[scopedIndex] = addr
 		}
 		for index, addr := range scopeState.InternalBranch.Addrs() {
 			scopedIndex := waddrmgr.ScopedIndex{
-				Scope: scope,
-				Index: index,
+				Scope:   scopedAccount.Scope,
+				Account: scopedAccount.Account,
+				Index:   index,
 			}
 			filterReq.InternalAddrs[scopedIndex] = addr
 		}
@@ -1001,22 +1093,22 @@ func extendFoundAddresses(ns walletdb.ReadWriteBucket,
 	recoveryState *RecoveryState) error {
 
 	// Mark all recovered external addresses as used. This will be done only
-	// for scopes that reported a non-zero number of external addresses in
+	// for accounts that reported a non-zero number of external addresses in
 	// this block.
-	for scope, indexes := range filterResp.FoundExternalAddrs {
+	for scopedAccount, indexes := range filterResp.FoundExternalAddrs {
 		// First, report all external child indexes found for this
-		// scope. This ensures that the external last-found index will
+		// account. This ensures that the external last-found index will
 		// be updated to include the maximum child index seen thus far.
-		scopeState := recoveryState.StateForScope(scope)
+		scopeState := recoveryState.StateForAccount(scopedAccount)
 		for index := range indexes {
 			scopeState.ExternalBranch.ReportFound(index)
 		}
 
-		scopedMgr := scopedMgrs[scope]
+		scopedMgr := scopedMgrs[scopedAccount.Scope]
 
 		// Now, with all found addresses reported, derive and extend all
 		// external addresses up to and including the current last found
-		// index for this scope.
+		// index for this account.
 		exNextUnfound := scopeState.ExternalBranch.NextUnfound()
 
 		exLastFound := exNextUnfound
@@ -1025,7 +1117,7 @@ func extendFoundAddresses(ns walletdb.ReadWriteBucket,
 		}
 
 		err := scopedMgr.ExtendExternalAddresses(
-			ns, waddrmgr.DefaultAccountNum, exLastFound,
+			ns, scopedAccount.Account, exLastFound,
 		)
 		if err != nil {
 			return err
@@ -1044,22 +1136,22 @@ func extendFoundAddresses(ns walletdb.ReadWriteBucket,
 	}
 
 	// Mark all recovered internal addresses as used. This will be done only
-	// for scopes that reported a non-zero number of internal addresses in
+	// for accounts that reported a non-zero number of internal addresses in
 	// this block.
-	for scope, indexes := range filterResp.FoundInternalAddrs {
+	for scopedAccount, indexes := range filterResp.FoundInternalAddrs {
 		// First, report all internal child indexes found for this
-		// scope. This ensures that the internal last-found index will
+		// account. This ensures that the internal last-found index will
 		// be updated to include the maximum child index seen thus far.
-		scopeState := recoveryState.StateForScope(scope)
+		scopeState := recoveryState.StateForAccount(scopedAccount)
 		for index := range indexes {
 			scopeState.InternalBranch.ReportFound(index)
 		}
 
-		scopedMgr := scopedMgrs[scope]
+		scopedMgr := scopedMgrs[scopedAccount.Scope]
 
 		// Now, with all found addresses reported, derive and extend all
 		// internal addresses up to and including the current last found
-		// index for this scope.
+		// index for this account.
 		inNextUnfound := scopeState.InternalBranch.NextUnfound()
 
 		inLastFound := inNextUnfound
@@ -1067,7 +1159,7 @@ func extendFoundAddresses(ns walletdb.ReadWriteBucket,
 			inLastFound--
 		}
 		err := scopedMgr.ExtendInternalAddresses(
-			ns, waddrmgr.DefaultAccountNum, inLastFound,
+			ns, scopedAccount.Account, inLastFound,
 		)
 		if err != nil {
 			return err
@@ -1124,13 +1216,11 @@ func logFilterBlocksResp(block wtxmgr.BlockMeta,
 
 type (
 	createTxRequest struct {
-		keyScope    *waddrmgr.KeyScope
-		account     uint32
-		outputs     []*wire.TxOut
-		minconf     int32
-		feeSatPerKB czzutil.Amount
-		dryRun      bool
-		resp        chan createTxResponse
+		outputs []*wire.TxOut
+		opts    SendOptions
+		sweep   bool
+		dryRun  bool
+		resp    chan createTxResponse
 	}
 	createTxResponse struct {
 		tx  *txauthor.AuthoredTx
@@ -1154,16 +1244,23 @@ out:
 	for {
 		select {
 		case txr := <-w.createTxRequests:
-			heldUnlock, err := w.holdUnlock()
-			if err != nil {
-				txr.resp <- createTxResponse{nil, err}
-				continue
+			// Dry runs do not sign the transaction, so they do not
+			// require the wallet to be unlocked.
+			var unlock heldUnlock
+			if !txr.dryRun {
+				var err error
+				unlock, err = w.holdUnlock()
+				if err != nil {
+					txr.resp <- createTxResponse{nil, err}
+					continue
+				}
+			}
+			w.txCreatorMtx.Lock()
+			tx, err := w.txToOutputs(txr)
+			w.txCreatorMtx.Unlock()
+			if unlock != nil {
+				unlock.release()
 			}
-			tx, err := w.txToOutputs(
-				txr.outputs, txr.keyScope, txr.account,
-				txr.minconf, txr.feeSatPerKB, txr.dryRun,
-			)
-			heldUnlock.release()
 			txr.resp <- createTxResponse{tx, err}
 		case <-quit:
 			break out
@@ -1185,19 +1282,27 @@ out:
 //
 // NOTE: The dryRun argument can be set true to create a tx that doesn't alter
 // the database. A tx created with this set to true SHOULD NOT be broadcasted.
+// Dry runs do not require the wallet to be unlocked.
 func (w *Wallet) CreateSimpleTx(keyScope *waddrmgr.KeyScope, account uint32,
 	outputs []*wire.TxOut, minconf int32, satPerKb czzutil.Amount,
 	dryRun bool) (*txauthor.AuthoredTx, error) {
 
-	req := createTxRequest{
-		keyScope:    keyScope,
-		account:     account,
-		outputs:     outputs,
-		minconf:     minconf,
-		feeSatPerKB: satPerKb,
-		dryRun:      dryRun,
-		resp:        make(chan createTxResponse),
-	}
+	return w.createTx(createTxRequest{
+		outputs: outputs,
+		opts: SendOptions{
+			KeyScope:              keyScope,
+			Account:               account,
+			RequiredConfirmations: minconf,
+			FeeRate:               satPerKb,
+		},
+		dryRun: dryRun,
+	})
+}
+
+// createTx serializes the creation of a transaction through the wallet's
+// transaction creator.
+func (w *Wallet) createTx(req createTxRequest) (*txauthor.AuthoredTx, error) {
+	req.resp = make(chan createTxResponse)
 	w.createTxRequests <- req
 	resp := <-req.resp
 	return resp.tx, resp.err
@@ -1207,6 +1312,7 @@ type (
 	unlockRequest struct {
 		passphrase []byte
 		lockAfter  <-chan time.Time // nil prevents the timeout.
+		lockTime   time.Time        // zero if unknown or no timeout.
 		err        chan error
 	}
 
@@ -1248,6 +1354,7 @@ out:
 				continue
 			}
 			timeout = req.lockAfter
+			w.setUnlockedUntil(req.lockTime)
 			if timeout == nil {
 				log.Info("The wallet has been unlocked without a time limit")
 			} else {
@@ -1320,6 +1427,7 @@ out:
 		// Select statement fell through by an explicit lock or the
 		// timer expiring.  Lock the manager here.
 		timeout = nil
+		w.setUnlockedUntil(time.Time{})
 		err := w.Manager.Lock()
 		if err != nil && !waddrmgr.IsError(err, waddrmgr.ErrLocked) {
 			log.Errorf("Could not lock wallet: %v", err)
@@ -1345,6 +1453,44 @@ func (w *Wallet) Unlock(passphrase []byte, lock <-chan time.Time) error {
 	return <-err
 }
 
+// UnlockWithTimeout unlocks the wallet's address manager and relocks it after
+// timeout has expired, or never if timeout is zero.  Unlike Unlock, the time
+// the wallet will be relocked is known and reported by UnlockedUntil.
+func (w *Wallet) UnlockWithTimeout(passphrase []byte, timeout time.Duration) error {
+	var lockAfter <-chan time.Time
+	var lockTime time.Time
+	if timeout != 0 {
+		lockAfter = time.After(timeout)
+		lockTime = time.Now().Add(timeout)
+	}
+
+	err := make(chan error, 1)
+	w.unlockRequests <- unlockRequest{
+		passphrase: passphrase,
+		lockAfter:  lockAfter,
+		lockTime:   lockTime,
+		err:        err,
+	}
+	return <-err
+}
+
+// UnlockedUntil returns the time the wallet will be relocked.  The zero time
+// is returned if the wallet is locked, was unlocked without a time limit, or
+// was unlocked with Unlock, which does not report when it relocks.
+func (w *Wallet) UnlockedUntil() time.Time {
+	w.unlockedUntilMtx.Lock()
+	defer w.unlockedUntilMtx.Unlock()
+
+	return w.unlockedUntil
+}
+
+// setUnlockedUntil records the time the wallet will be relocked.
+func (w *Wallet) setUnlockedUntil(t time.Time) {
+	w.unlockedUntilMtx.Lock()
+	w.unlockedUntil = t
+	w.unlockedUntilMtx.Unlock()
+}
+
 // Lock locks the wallet's address manager.
 func (w *Wallet) Lock() {
 	w.lockRequests <- struct{}{}
@@ -1459,12 +1605,14 @@ func (w *Wallet) CalculateBalance(confirms int32) (czzutil.Amount, error) {
 	return balance, err
 }
 
-// Balances records total, spendable (by policy), and immature coinbase
-// reward balance amounts.
+// Balances records total, spendable (by policy), immature coinbase reward and
+// frozen balance amounts.  Frozen outputs are included in the total but not in
+// the spendable balance.
 type Balances struct {
 	Total          czzutil.Amount
 	Spendable      czzutil.Amount
 	ImmatureReward czzutil.Amount
+	Frozen         czzutil.Amount
 }
 
 // CalculateAccountBalances sums the amounts of all unspent transaction
@@ -1504,6 +1652,8 @@ func (w *Wallet) CalculateAccountBalances(account uint32, confirms int32) (Balan
 			if output.FromCoinBase && !confirmed(int32(w.chainParams.CoinbaseMaturity),
 				output.Height, syncBlock.Height) {
 				bals.ImmatureReward += output.Amount
+			} else if w.TxStore.IsFrozenOutput(txmgrNs, output.OutPoint) {
+				bals.Frozen += output.Amount
 			} else if confirmed(confirms, output.Height, syncBlock.Height) {
 				bals.Spendable += output.Amount
 			}
@@ -1905,12 +2055,24 @@ func RecvCategory(details *wtxmgr.TxDetails, syncHeight int32, net *chaincfg.Par
 	return CreditReceive
 }
 
+// ListTransactionsResult describes a credit or debit of a transaction listed by
+// ListSinceBlock, ListTransactions, ListAddressTransactions and
+// ListAllTransactions.  It extends btcjson.ListTransactionsResult with the data
+// carried by null-data outputs.
+type ListTransactionsResult struct {
+	btcjson.ListTransactionsResult
+
+	// Data is the hex-encoded data carried by the output if it is a
+	// null-data output, or empty otherwise.
+	Data string
+}
+
 // listTransactions creates a object that may be marshalled to a response result
 // for a listtransactions RPC.
 //
 // TODO: This should be moved to the legacyrpc package.
 func listTransactions(tx walletdb.ReadTx, details *wtxmgr.TxDetails, addrMgr *waddrmgr.Manager,
-	syncHeight int32, net *chaincfg.Params) []btcjson.ListTransactionsResult {
+	syncHeight int32, net *chaincfg.Params) []ListTransactionsResult {
 
 	addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 
@@ -1925,7 +2087,7 @@ func listTransactions(tx walletdb.ReadTx, details *wtxmgr.TxDetails, addrMgr *wa
 		confirmations = int64(confirms(details.Block.Height, syncHeight))
 	}
 
-	results := []btcjson.ListTransactionsResult{}
+	results := []ListTransactionsResult{}
 	txHashStr := details.Hash.String()
 	received := details.Received.Unix()
 	generated := blockchain.IsCoinBaseTx(&details.MsgTx)
@@ -1984,27 +2146,37 @@ outputs:
 			}
 		}
 
+		// The data carried by null-data outputs is included as a
+		// hexadecimal string.
+		var data string
+		if payload, ok := txrules.NullData(output.PkScript); ok {
+			data = hex.EncodeToString(payload)
+		}
+
 		amountF64 := czzutil.Amount(output.Value).ToCZZ()
-		result := btcjson.ListTransactionsResult{
-			// Fields left zeroed:
-			//   InvolvesWatchOnly
-			//   BlockIndex
-			//
-			// Fields set below:
-			//   Account (only for non-"send" categories)
-			//   Category
-			//   Amount
-			//   Fee
-			Address:         address,
-			Vout:            uint32(i),
-			Confirmations:   confirmations,
-			Generated:       generated,
-			BlockHash:       blockHashStr,
-			BlockTime:       blockTime,
-			TxID:            txHashStr,
-			WalletConflicts: []string{},
-			Time:            received,
-			TimeReceived:    received,
+		result := ListTransactionsResult{
+			ListTransactionsResult: btcjson.ListTransactionsResult{
+				// Fields left zeroed:
+				//   InvolvesWatchOnly
+				//   BlockIndex
+				//
+				// Fields set below:
+				//   Account (only for non-"send" categories)
+				//   Category
+				//   Amount
+				//   Fee
+				Address:         address,
+				Vout:            uint32(i),
+				Confirmations:   confirmations,
+				Generated:       generated,
+				BlockHash:       blockHashStr,
+				BlockTime:       blockTime,
+				TxID:            txHashStr,
+				WalletConflicts: []string{},
+				Time:            received,
+				TimeReceived:    received,
+			},
+			Data: data,
 		}
 
 		// Add a received/generated/immature result if this is a credit.
@@ -2034,11 +2206,60 @@ outputs:
 	return results
 }
 
+// removedTransactions creates the listtransactions results of the abandoned and
+// conflicted transactions that were removed from the transaction store, grouped
+// by transaction.  Abandoned transactions are returned first, most recently
+// abandoned first, followed by conflicted transactions, most recently
+// conflicted first.
+//
+// The results of abandoned transactions are marked abandoned.  The results of
+// conflicted transactions are reported under the conflicted category, with the
+// hash of the mined transaction they conflict with as wallet conflict, and the
+// confirmations of that transaction as negative confirmations.
+func (w *Wallet) removedTransactions(tx walletdb.ReadTx,
+	syncHeight int32) ([][]ListTransactionsResult, error) {
+
+	txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+	abandoned, err := w.TxStore.AbandonedTxs(txmgrNs)
+	if err != nil {
+		return nil, err
+	}
+	conflicted, err := w.TxStore.ConflictedTxs(txmgrNs)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make([][]ListTransactionsResult, 0,
+		len(abandoned)+len(conflicted))
+	for i := len(abandoned) - 1; i >= 0; i-- {
+		jsonResults := listTransactions(tx, &abandoned[i].TxDetails,
+			w.Manager, syncHeight, w.chainParams)
+		for j := range jsonResults {
+			jsonResults[j].Abandoned = true
+		}
+		removed = append(removed, jsonResults)
+	}
+	for i := len(conflicted) - 1; i >= 0; i-- {
+		jsonResults := listTransactions(tx, &conflicted[i].TxDetails,
+			w.Manager, syncHeight, w.chainParams)
+		conflicts := []string{conflicted[i].ConflictingHash.String()}
+		confs := confirms(conflicted[i].ConflictingHeight, syncHeight)
+		for j := range jsonResults {
+			jsonResults[j].Category = "conflicted"
+			jsonResults[j].WalletConflicts = conflicts
+			jsonResults[j].Confirmations = -int64(confs)
+		}
+		removed = append(removed, jsonResults)
+	}
+	return removed, nil
+}
+
 // ListSinceBlock returns a slice of objects with details about transactions
 // since the given block. If the block is -1 then all transactions are included.
 // This is intended to be used for listsinceblock RPC replies.
-func (w *Wallet) ListSinceBlock(start, end, syncHeight int32) ([]btcjson.ListTransactionsResult, error) {
-	txList := []btcjson.ListTransactionsResult{}
+func (w *Wallet) ListSinceBlock(start, end, syncHeight int32) ([]ListTransactionsResult, error) {
+	txList := []ListTransactionsResult{}
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
 
@@ -2063,8 +2284,8 @@ func (w *Wallet) ListSinceBlock(start, end, syncHeight int32) ([]btcjson.ListTra
 // ListTransactions returns a slice of objects with details about a recorded
 // transaction.  This is intended to be used for listtransactions RPC
 // replies.
-func (w *Wallet) ListTransactions(from, count int) ([]btcjson.ListTransactionsResult, error) {
-	txList := []btcjson.ListTransactionsResult{}
+func (w *Wallet) ListTransactions(from, count int) ([]ListTransactionsResult, error) {
+	txList := []ListTransactionsResult{}
 
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
@@ -2106,6 +2327,30 @@ func (w *Wallet) ListTransactions(from, count int) ([]btcjson.ListTransactionsRe
 			return false, nil
 		}
 
+		// Transactions removed from the store were last known to be
+		// unmined, so they are returned first.
+		removed, err := w.removedTransactions(tx, syncBlock.Height)
+		if err != nil {
+			return err
+		}
+		for _, jsonResults := range removed {
+			if from > skipped {
+				skipped++
+				continue
+			}
+
+			n++
+			if n > count {
+				return nil
+			}
+
+			txList = append(txList, jsonResults...)
+
+			if len(jsonResults) > 0 {
+				n++
+			}
+		}
+
 		// Return newer results first by starting at mempool height and working
 		// down to the genesis block.
 		return w.TxStore.RangeTransactions(txmgrNs, -1, 0, rangeFn)
@@ -2116,8 +2361,8 @@ func (w *Wallet) ListTransactions(from, count int) ([]btcjson.ListTransactionsRe
 // ListAddressTransactions returns a slice of objects with details about
 // recorded transactions to or from any address belonging to a set.  This is
 // intended to be used for listaddresstransactions RPC replies.
-func (w *Wallet) ListAddressTransactions(pkHashes map[string]struct{}) ([]btcjson.ListTransactionsResult, error) {
-	txList := []btcjson.ListTransactionsResult{}
+func (w *Wallet) ListAddressTransactions(pkHashes map[string]struct{}) ([]ListTransactionsResult, error) {
+	txList := []ListTransactionsResult{}
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
 
@@ -2162,8 +2407,8 @@ func (w *Wallet) ListAddressTransactions(pkHashes map[string]struct{}) ([]btcjso
 // ListAllTransactions returns a slice of objects with details about a recorded
 // transaction.  This is intended to be used for listalltransactions RPC
 // replies.
-func (w *Wallet) ListAllTransactions() ([]btcjson.ListTransactionsResult, error) {
-	txList := []btcjson.ListTransactionsResult{}
+func (w *Wallet) ListAllTransactions() ([]ListTransactionsResult, error) {
+	txList := []ListTransactionsResult{}
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
 
@@ -2184,6 +2429,16 @@ func (w *Wallet) ListAllTransactions() ([]btcjson.ListTransactionsResult, error)
 			return false, nil
 		}
 
+		// Transactions removed from the store were last known to be
+		// unmined, so they are returned first.
+		removed, err := w.removedTransactions(tx, syncBlock.Height)
+		if err != nil {
+			return err
+		}
+		for _, jsonResults := range removed {
+			txList = append(txList, jsonResults...)
+		}
+
 		// Return newer results first by starting at mempool height and
 		// working down to the genesis block.
 		return w.TxStore.RangeTransactions(txmgrNs, -1, 0, rangeFn)
@@ -2210,8 +2465,18 @@ func NewBlockIdentifierFromHash(hash *chainhash.Hash) *BlockIdentifier {
 // GetTransactionsResult is the result of the wallet's GetTransactions method.
 // See GetTransactions for more details.
 type GetTransactionsResult struct {
-	MinedTransactions   []Block
-	UnminedTransactions []TransactionSummary
+	MinedTransactions      []Block
+	UnminedTransactions    []TransactionSummary
+	ConflictedTransactions []ConflictedTransaction
+}
+
+// ConflictedTransaction describes a transaction removed from the wallet
+// because it conflicts with a mined transaction, along with the hash of that
+// transaction and the height of the block it was mined in.
+type ConflictedTransaction struct {
+	TransactionSummary
+	ConflictingHash   *chainhash.Hash
+	ConflictingHeight int32
 }
 
 // GetTransactions returns transaction results between a starting and ending
@@ -2224,7 +2489,10 @@ type GetTransactionsResult struct {
 //
 // Transaction results are organized by blocks in ascending order and unmined
 // transactions in an unspecified order.  Mined transactions are saved in a
-// Block structure which records properties about the block.
+// Block structure which records properties about the block.  Transactions
+// removed from the wallet because they conflict with a mined transaction are
+// included, in ascending order of the conflicting block, when the range
+// includes unmined transactions.
 func (w *Wallet) GetTransactions(startBlock, endBlock *BlockIdentifier,
 	accountName string, cancel <-chan struct{}) (*GetTransactionsResult, error) {
 
@@ -2336,6 +2604,26 @@ func (w *Wallet) GetTransactions(startBlock, endBlock *BlockIdentifier,
 			}
 		}
 
+		if start == -1 || end == -1 {
+			conflicted, err := w.TxStore.ConflictedTxs(txmgrNs)
+			if err != nil {
+				return err
+			}
+			for i := range conflicted {
+				tx := &conflicted[i]
+				res.ConflictedTransactions = append(
+					res.ConflictedTransactions,
+					ConflictedTransaction{
+						TransactionSummary: makeTxSummary(
+							dbtx, w, &tx.TxDetails,
+						),
+						ConflictingHash:   &tx.ConflictingHash,
+						ConflictingHeight: tx.ConflictingHeight,
+					},
+				)
+			}
+		}
+
 		return w.TxStore.RangeTransactions(txmgrNs, start, end, rangeFn)
 	})
 	return &res, err
@@ -2586,8 +2874,10 @@ func (w *Wallet) ListUnspent(minconf, maxconf int32,
 				}
 			}
 
-			// Exclude locked outputs from the result set.
-			if w.LockedOutpoint(output.OutPoint) {
+			// Exclude locked and frozen outputs from the result set.
+			if w.LockedOutpoint(output.OutPoint) ||
+				w.TxStore.IsFrozenOutput(txmgrNs, output.OutPoint) {
+
 				continue
 			}
 
@@ -2814,7 +3104,8 @@ func (w *Wallet) LockedOutpoints() []btcjson.TransactionInput {
 //
 // Outputs can be unlocked before their expiration through `UnlockOutput`.
 // Otherwise, they are unlocked lazily through calls which iterate through all
-// known outputs, e.g., `CalculateBalance`, `ListUnspent`.
+// known outputs, e.g., `CalculateBalance`, `ListUnspent`, and expired leases
+// are deleted periodically while the wallet is running.
 //
 // If the output is not known, ErrUnknownOutput is returned. If the output has
 // already been locked to a different ID, then ErrOutputAlreadyLocked is
@@ -3146,25 +3437,104 @@ func (w *Wallet) TotalReceivedForAddr(addr czzutil.Address, minConf int32) (czzu
 	return amount, err
 }
 
+// SendOptions describes how a payment transaction is created by
+// SendOutputsWithOptions or previewed by PreviewOutputs.
+type SendOptions struct {
+	// KeyScope and Account are the key scope and account the inputs are
+	// selected from, and the change address is derived from.  If KeyScope
+	// is nil, inputs of the account are selected from all key scopes.
+	KeyScope *waddrmgr.KeyScope
+	Account  uint32
+
+	// RequiredConfirmations is the minimum number of confirmations of the
+	// selected inputs.
+	RequiredConfirmations int32
+
+	// FeeRate is the fee rate paid by the transaction.
+	FeeRate czzutil.Amount
+
+	// CoinSelector selects the inputs.  The wallet's coin selector is used
+	// if nil.
+	CoinSelector CoinSelector
+
+	// SubtractFeeFrom are the indexes of the outputs the fee is subtracted
+	// from, split in proportion to their values.  The fee is paid on top
+	// of the outputs if empty.
+	SubtractFeeFrom []int
+
+	// LockTime is the lock time of the transaction, if not zero.  Lock
+	// times below txscript.LockTimeThreshold are block heights, and unix
+	// times otherwise.  The transaction is published right away, so a lock
+	// time after the next block (or the current median time past) makes it
+	// non-final, and the backend rejects it until the lock time matures.
+	LockTime uint32
+}
+
 // SendOutputs creates and sends payment transactions. Coin selection is
 // performed by the wallet, choosing inputs that belong to the given key scope
 // and account, unless a key scope is not specified. In that case, inputs from
 // accounts matching the account number provided across all key scopes may be
 // selected. This is done to handle the default account case, where a user wants
-// to fund a PSBT with inputs regardless of their type (NP2WKH, P2WKH, etc.). It
-// returns the transaction upon success.
+// to fund a PSBT with inputs regardless of their type (NP2WKH, P2WKH, etc.).
+// It returns the transaction upon success.
 func (w *Wallet) SendOutputs(outputs []*wire.TxOut, keyScope *waddrmgr.KeyScope,
 	account uint32, minconf int32, satPerKb czzutil.Amount,
 	label string) (*wire.MsgTx, error) {
 
+	return w.SendOutputsWithOptions(outputs, &SendOptions{
+		KeyScope:              keyScope,
+		Account:               account,
+		RequiredConfirmations: minconf,
+		FeeRate:               satPerKb,
+	}, label)
+}
+
+// SendOutputsWithOptions creates and sends payment transactions like
+// SendOutputs, as described by opts.  It returns the transaction upon success.
+func (w *Wallet) SendOutputsWithOptions(outputs []*wire.TxOut,
+	opts *SendOptions, label string) (*wire.MsgTx, error) {
+
+	return w.sendOutputs(createTxRequest{
+		outputs: outputs,
+		opts:    *opts,
+	}, label)
+}
+
+// SweepOutputs creates and sends a transaction spending every unspent output
+// matching the output selection policy, from the given key scope or all key
+// scopes if nil, to a single output paying to pkScript.  The fee is subtracted
+// from the output and no change is created.
+func (w *Wallet) SweepOutputs(policy OutputSelectionPolicy,
+	keyScope *waddrmgr.KeyScope, pkScript []byte, satPerKb czzutil.Amount,
+	label string) (*wire.MsgTx, error) {
+
+	return w.sendOutputs(createTxRequest{
+		outputs: []*wire.TxOut{wire.NewTxOut(0, pkScript)},
+		opts: SendOptions{
+			KeyScope:              keyScope,
+			Account:               policy.Account,
+			RequiredConfirmations: policy.RequiredConfirmations,
+			FeeRate:               satPerKb,
+		},
+		sweep: true,
+	}, label)
+}
+
+// sendOutputs creates and sends the transaction of a creation request.
+func (w *Wallet) sendOutputs(req createTxRequest,
+	label string) (*wire.MsgTx, error) {
+
 	// Ensure the outputs to be created adhere to the network's consensus
-	// rules.
-	for _, output := range outputs {
-		err := txrules.CheckOutput(
-			output, txrules.DefaultRelayFeePerKb,
-		)
-		if err != nil {
-			return nil, err
+	// rules.  The value of a swept output is only known once its inputs
+	// are selected, and is checked when the transaction is authored.
+	if !req.sweep {
+		for _, output := range req.outputs {
+			err := txrules.CheckOutput(
+				output, txrules.DefaultRelayFeePerKb,
+			)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -3172,14 +3542,12 @@ func (w *Wallet) SendOutputs(outputs []*wire.TxOut, keyScope *waddrmgr.KeyScope,
 	// transaction will be added to the database in order to ensure that we
 	// continue to re-broadcast the transaction upon restarts until it has
 	// been confirmed.
-	createdTx, err := w.CreateSimpleTx(
-		keyScope, account, outputs, minconf, satPerKb, false,
-	)
+	createdTx, err := w.createTx(req)
 	if err != nil {
 		return nil, err
 	}
 
-	txHash, err := w.reliablyPublishTransaction(createdTx.Tx, label)
+	txHash, err := w.reliablyPublishTransaction(createdTx.Tx, label, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3207,6 +3575,11 @@ type SignatureError struct {
 // The final error return is reserved for unexpected or fatal errors, such as
 // being unable to determine a previous output script to redeem.
 //
+// Signatures already present in the signature script of an input spending a
+// multisig script, such as those of other cosigners, are merged with the new
+// signatures in the order of the keys of the script.  Inputs which are not
+// signed by enough cosigners yet are reported with ErrMissingSignatures.
+//
 // The transaction pointed to by tx is modified by this function.
 func (w *Wallet) SignTransaction(tx *wire.MsgTx, inputValues []int64, hashType txscript.SigHashType,
 	additionalPrevScripts map[wire.OutPoint][]byte,
@@ -3243,10 +3616,18 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, inputValues []int64, hashType t
 						txIn.PreviousOutPoint)
 				}
 				prevOutScript = txDetails.MsgTx.TxOut[prevIndex].PkScript
+
+				// Signatures commit to the amount spent, which is
+				// needed to merge them with the signatures of
+				// other cosigners.
+				if lookupInputValues {
+					amount = txDetails.MsgTx.TxOut[prevIndex].Value
+				}
 			}
 
 			// Set up our callbacks that we pass to txscript so it can
 			// look up the appropriate keys and scripts by address.
+			var multiSigKeys map[string]*czzec.PrivateKey
 			getKey := txscript.KeyClosure(func(addr czzutil.Address) (*czzec.PrivateKey, bool, error) {
 				if len(additionalKeysByAddress) != 0 {
 					addrStr := addr.EncodeAddress()
@@ -3257,6 +3638,9 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, inputValues []int64, hashType t
 					}
 					return wif.PrivKey, wif.CompressPubKey, nil
 				}
+				if key, ok := multiSigKeys[string(addr.ScriptAddress())]; ok {
+					return key, true, nil
+				}
 				address, err := w.Manager.Address(addrmgrNs, addr)
 				if err != nil {
 					return nil, false, err
@@ -3302,9 +3686,51 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, inputValues []int64, hashType t
 			// SigHashSingle inputs can only be signed if there's a
 			// corresponding output. However this could be already signed,
 			// so we always verify the output.
-			if (hashType&txscript.SigHashSingle) !=
-				txscript.SigHashSingle || i < len(tx.TxOut) {
+			canSign := (hashType&txscript.SigHashSingle) !=
+				txscript.SigHashSingle || i < len(tx.TxOut)
 
+			// Time-locked scripts imported by the wallet are not
+			// standard, so they are signed by the wallet itself.
+			var timeLock *TimeLock
+			if canSign && len(additionalKeysByAddress) == 0 {
+				var err error
+				timeLock, err = w.timeLockForOutput(
+					dbtx, prevOutScript,
+				)
+				if err != nil {
+					return err
+				}
+
+				// Keys of multisig account addresses are derived
+				// from the cosigner accounts of the wallet.
+				multiSigKeys, err = w.multiSigKeys(
+					addrmgrNs, prevOutScript,
+				)
+				if err != nil {
+					signErrors = append(signErrors, SignatureError{
+						InputIndex: uint32(i),
+						Error:      err,
+					})
+					continue
+				}
+			}
+
+			switch {
+			case timeLock != nil:
+				script, err := w.timeLockSignatureScript(
+					addrmgrNs, tx, i, amount, timeLock,
+					hashType, nil,
+				)
+				if err != nil {
+					signErrors = append(signErrors, SignatureError{
+						InputIndex: uint32(i),
+						Error:      err,
+					})
+					continue
+				}
+				txIn.SignatureScript = script
+
+			case canSign:
 				script, err := txscript.SignTxOutput(w.ChainParams(),
 					tx, i, amount, prevOutScript, hashType, getKey,
 					getScript, txIn.SignatureScript)
@@ -3323,11 +3749,23 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, inputValues []int64, hashType t
 			// Either it was already signed or we just signed it.
 			// Find out if it is completely satisfied or still needs more.
 			vm, err := txscript.NewEngine(prevOutScript, tx, i,
-				txscript.StandardVerifyFlags, nil, nil, 0)
+				txscript.StandardVerifyFlags, nil, nil, amount)
 			if err == nil {
 				err = vm.Execute()
 			}
 			if err != nil {
+				// Multisig inputs are complete once signed by
+				// enough cosigners, so report how many signatures
+				// are still missing.
+				signed, required, ok := multiSigCompleteness(
+					w.chainParams, prevOutScript,
+					txIn.SignatureScript,
+				)
+				if ok && signed < required {
+					err = fmt.Errorf("%w: %d of %d signatures",
+						ErrMissingSignatures, signed,
+						required)
+				}
 				signErrors = append(signErrors, SignatureError{
 					InputIndex: uint32(i),
 					Error:      err,
@@ -3383,7 +3821,7 @@ func (e *ErrReplacement) Unwrap() error {
 // This function is unstable and will be removed once syncing code is moved out
 // of the wallet.
 func (w *Wallet) PublishTransaction(tx *wire.MsgTx, label string) error {
-	_, err := w.reliablyPublishTransaction(tx, label)
+	_, err := w.reliablyPublishTransaction(tx, label, nil)
 	return err
 }
 
@@ -3391,9 +3829,11 @@ func (w *Wallet) PublishTransaction(tx *wire.MsgTx, label string) error {
 // the primary logic required for publishing a transaction, updating the
 // relevant database state, and finally possible removing the transaction from
 // the database (along with cleaning up all inputs used, and outputs created) if
-// the transaction is rejected by the backend.
-func (w *Wallet) reliablyPublishTransaction(tx *wire.MsgTx,
-	label string) (*chainhash.Hash, error) {
+// the transaction is rejected by the backend.  If record is not nil, it is
+// called within the database transaction recording the transaction, so that
+// callers can update their own state atomically with it.
+func (w *Wallet) reliablyPublishTransaction(tx *wire.MsgTx, label string,
+	record func(walletdb.ReadWriteTx) error) (*chainhash.Hash, error) {
 
 	chainClient, err := w.requireChainClient()
 	if err != nil {
@@ -3440,6 +3880,11 @@ func (w *Wallet) reliablyPublishTransaction(tx *wire.MsgTx,
 		if err := w.addRelevantTx(dbTx, txRec, nil); err != nil {
 			return err
 		}
+		if record != nil {
+			if err := record(dbTx); err != nil {
+				return err
+			}
+		}
 
 		// If the tx label is empty, we can return early.
 		if len(label) == 0 {
@@ -3462,7 +3907,23 @@ func (w *Wallet) reliablyPublishTransaction(tx *wire.MsgTx,
 		return nil, err
 	}
 
-	return w.publishTransaction(tx)
+	txid, err := w.publishTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The outputs spent by the published transaction, such as the inputs
+	// of a funded PSBT, no longer need to be leased.
+	err = walletdb.Update(w.db, func(dbTx walletdb.ReadWriteTx) error {
+		txmgrNs := dbTx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.UnlockSpentOutputs(txmgrNs, tx)
+	})
+	if err != nil {
+		log.Warnf("Unable to release the leases of the inputs of "+
+			"transaction %v: %v", txid, err)
+	}
+
+	return txid, nil
 }
 
 // publishTransaction attempts to send an unconfirmed transaction to the
@@ -3792,6 +4253,8 @@ func Open(db walletdb.DB, pubPass []byte, cbs *waddrmgr.OpenCallbacks,
 		TxStore:             txMgr,
 		lockedOutpoints:     map[wire.OutPoint]struct{}{},
 		recoveryWindow:      recoveryWindow,
+		coinSelector:        LargestFirst{},
+		fallbackFeeRate:     txrules.DefaultRelayFeePerKb,
 		rescanAddJob:        make(chan *RescanJob),
 		rescanBatch:         make(chan *rescanBatch),
 		rescanNotifications: make(chan interface{}),
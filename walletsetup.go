@@ -198,6 +198,61 @@ func createWallet(cfg *config) error {
 	return nil
 }
 
+// createWatchingOnlyWallet prompts the user for the extended public keys of the
+// accounts of a new watching-only wallet and its birthday, and creates the
+// wallet accordingly.  The wallet holds no private keys, and the addresses of
+// the accounts are recovered from the birthday when the wallet is first
+// started.
+func createWatchingOnlyWallet(cfg *config) error {
+	dbDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
+	loader := wallet.NewLoader(
+		activeNet.Params, dbDir, true, cfg.DBTimeout, 250,
+	)
+
+	// Ascertain the public passphrase, which is the only passphrase of a
+	// watching-only wallet.
+	reader := bufio.NewReader(os.Stdin)
+	pubPass, err := prompt.PublicPass(reader, nil,
+		[]byte(wallet.InsecurePubPassphrase), []byte(cfg.WalletPass))
+	if err != nil {
+		return err
+	}
+
+	accountKeys, err := prompt.AccountKeys(reader)
+	if err != nil {
+		return err
+	}
+	accounts := make([]*wallet.WatchingOnlyAccount, 0, len(accountKeys))
+	for _, key := range accountKeys {
+		accounts = append(accounts, &wallet.WatchingOnlyAccount{
+			Name: key.Name,
+			KeyScope: waddrmgr.KeyScope{
+				Purpose: key.Purpose,
+				Coin:    key.Coin,
+			},
+			AccountPubKey:        key.ExtendedKey,
+			MasterKeyFingerprint: key.MasterKeyFingerprint,
+		})
+	}
+
+	bday, err := prompt.Birthday(reader)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Creating the watching-only wallet...")
+	w, err := loader.CreateWatchingOnlyWalletFromAccounts(
+		pubPass, accounts, bday,
+	)
+	if err != nil {
+		return err
+	}
+
+	w.Manager.Close()
+	fmt.Println("The watching-only wallet has been created successfully.")
+	return nil
+}
+
 // createSimulationWallet is intended to be called from the rpcclient
 // and used to create a wallet for actors involved in simulations.
 func createSimulationWallet(cfg *config) error {
@@ -70,6 +70,9 @@ var (
 	bucketUnminedCredits = []byte("mc")
 	bucketUnminedInputs  = []byte("mi")
 	bucketLockedOutputs  = []byte("lo")
+	bucketFrozenOutputs  = []byte("fo")
+	bucketAbandoned      = []byte("ab")
+	bucketConflicted     = []byte("cf")
 )
 
 // Root (namespace) bucket keys
@@ -1406,6 +1409,457 @@ func forEachLockedOutput(ns walletdb.ReadBucket,
 	})
 }
 
+// serializeFrozenOutput serializes the value of a frozen output.  A zero
+// expiry is serialized as zero, meaning that the freeze does not expire.
+func serializeFrozenOutput(reason string, expiry time.Time) []byte {
+	v := make([]byte, 8+len(reason))
+	if !expiry.IsZero() {
+		byteOrder.PutUint64(v[:8], uint64(expiry.Unix()))
+	}
+	copy(v[8:], reason)
+	return v
+}
+
+// deserializeFrozenOutput deserializes the value of a frozen output.
+func deserializeFrozenOutput(v []byte) (string, time.Time, error) {
+	if len(v) < 8 {
+		str := "short frozen output value"
+		return "", time.Time{}, storeError(ErrData, str, nil)
+	}
+	var expiry time.Time
+	if unix := byteOrder.Uint64(v[:8]); unix != 0 {
+		expiry = time.Unix(int64(unix), 0)
+	}
+	return string(v[8:]), expiry, nil
+}
+
+// isFrozenOutput determines whether an output is frozen.  If it is, the reason
+// of the freeze is returned, along with its expiration time, which is zero if
+// the freeze does not expire.  A freeze whose expiration has been met no longer
+// freezes the output.
+func isFrozenOutput(ns walletdb.ReadBucket, op wire.OutPoint,
+	timeNow time.Time) (string, time.Time, bool) {
+
+	// The bucket may not exist, indicating that no outputs have ever been
+	// frozen, so we can just return now.
+	frozenOutputs := ns.NestedReadBucket(bucketFrozenOutputs)
+	if frozenOutputs == nil {
+		return "", time.Time{}, false
+	}
+
+	k := canonicalOutPoint(&op.Hash, op.Index)
+	v := frozenOutputs.Get(k)
+	if v == nil {
+		return "", time.Time{}, false
+	}
+	reason, expiry, err := deserializeFrozenOutput(v)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	if !expiry.IsZero() && !timeNow.Before(expiry) {
+		return "", time.Time{}, false
+	}
+
+	return reason, expiry, true
+}
+
+// freezeOutput freezes an output for the given reason until expiry, or
+// indefinitely if expiry is zero.
+func freezeOutput(ns walletdb.ReadWriteBucket, op wire.OutPoint, reason string,
+	expiry time.Time) error {
+
+	// Create the corresponding bucket if necessary.
+	frozenOutputs, err := ns.CreateBucketIfNotExists(bucketFrozenOutputs)
+	if err != nil {
+		str := "failed to create frozen outputs bucket"
+		return storeError(ErrDatabase, str, err)
+	}
+
+	// Store a mapping of outpoint -> (expiry, reason).
+	k := canonicalOutPoint(&op.Hash, op.Index)
+	v := serializeFrozenOutput(reason, expiry)
+
+	if err := frozenOutputs.Put(k, v); err != nil {
+		str := fmt.Sprintf("%s: put failed for %v", bucketFrozenOutputs,
+			op)
+		return storeError(ErrDatabase, str, err)
+	}
+
+	return nil
+}
+
+// unfreezeOutput removes the freeze of an output.
+func unfreezeOutput(ns walletdb.ReadWriteBucket, op wire.OutPoint) error {
+	// The bucket may not exist, indicating that no outputs have ever been
+	// frozen, so we can just return now.
+	frozenOutputs := ns.NestedReadWriteBucket(bucketFrozenOutputs)
+	if frozenOutputs == nil {
+		return nil
+	}
+
+	k := canonicalOutPoint(&op.Hash, op.Index)
+	if err := frozenOutputs.Delete(k); err != nil {
+		str := fmt.Sprintf("%s: delete failed for %v",
+			bucketFrozenOutputs, op)
+		return storeError(ErrDatabase, str, err)
+	}
+
+	return nil
+}
+
+// forEachFrozenOutput iterates over all existing frozen outputs, including
+// those whose freeze has expired, and invokes the callback `f` for each.
+func forEachFrozenOutput(ns walletdb.ReadBucket,
+	f func(wire.OutPoint, string, time.Time)) error {
+
+	// The bucket may not exist, indicating that no outputs have ever been
+	// frozen, so we can just return now.
+	frozenOutputs := ns.NestedReadBucket(bucketFrozenOutputs)
+	if frozenOutputs == nil {
+		return nil
+	}
+
+	return frozenOutputs.ForEach(func(k, v []byte) error {
+		var op wire.OutPoint
+		if err := readCanonicalOutPoint(k, &op); err != nil {
+			return err
+		}
+		reason, expiry, err := deserializeFrozenOutput(v)
+		if err != nil {
+			return err
+		}
+
+		f(op, reason, expiry)
+
+		return nil
+	})
+}
+
+// Transactions removed from the store, either by abandoning them or because
+// they conflict with another transaction, are recorded with the credits and
+// debits they had when they were removed.  These details are serialized as
+// follows:
+//
+//   [0:4]     Number of credits (4 bytes)
+//   [4:X]     Credits, each an output index (4 bytes), an amount (8 bytes)
+//             and a change flag (1 byte)
+//   [X:X+4]   Number of debits (4 bytes)
+//   [X+4:Y]   Debits, each an input index (4 bytes) and an amount (8 bytes)
+//   [Y:]      Transaction record value, as in the transaction records bucket
+
+// valueRemovedTxDetails serializes the details of a removed transaction.
+func valueRemovedTxDetails(details *TxDetails) ([]byte, error) {
+	recVal, err := valueTxRecord(&details.TxRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	size := 4 + 13*len(details.Credits) + 4 + 12*len(details.Debits) +
+		len(recVal)
+	v := make([]byte, size)
+	off := 0
+	byteOrder.PutUint32(v[off:], uint32(len(details.Credits)))
+	off += 4
+	for _, credit := range details.Credits {
+		byteOrder.PutUint32(v[off:], credit.Index)
+		byteOrder.PutUint64(v[off+4:], uint64(credit.Amount))
+		if credit.Change {
+			v[off+12] = 1
+		}
+		off += 13
+	}
+	byteOrder.PutUint32(v[off:], uint32(len(details.Debits)))
+	off += 4
+	for _, debit := range details.Debits {
+		byteOrder.PutUint32(v[off:], debit.Index)
+		byteOrder.PutUint64(v[off+4:], uint64(debit.Amount))
+		off += 12
+	}
+	copy(v[off:], recVal)
+	return v, nil
+}
+
+// readRemovedTxDetails deserializes the details of a removed transaction.
+// The block of the details is unset.
+func readRemovedTxDetails(bucket []byte, txHash *chainhash.Hash, v []byte,
+	details *TxDetails) error {
+
+	shortRead := func() error {
+		str := fmt.Sprintf("%s: short read for %v", bucket, txHash)
+		return storeError(ErrData, str, nil)
+	}
+
+	if len(v) < 4 {
+		return shortRead()
+	}
+	details.Block = BlockMeta{Block: Block{Height: -1}}
+	off := 0
+	numCredits := int(byteOrder.Uint32(v[off:]))
+	off += 4
+	if len(v) < off+13*numCredits+4 {
+		return shortRead()
+	}
+	details.Credits = make([]CreditRecord, numCredits)
+	for i := range details.Credits {
+		details.Credits[i] = CreditRecord{
+			Index:  byteOrder.Uint32(v[off:]),
+			Amount: czzutil.Amount(byteOrder.Uint64(v[off+4:])),
+			Change: v[off+12] != 0,
+		}
+		off += 13
+	}
+	numDebits := int(byteOrder.Uint32(v[off:]))
+	off += 4
+	if len(v) < off+12*numDebits {
+		return shortRead()
+	}
+	details.Debits = make([]DebitRecord, numDebits)
+	for i := range details.Debits {
+		details.Debits[i] = DebitRecord{
+			Index:  byteOrder.Uint32(v[off:]),
+			Amount: czzutil.Amount(byteOrder.Uint64(v[off+4:])),
+		}
+		off += 12
+	}
+	return readRawTxRecord(txHash, v[off:], &details.TxRecord)
+}
+
+// The abandoned bucket records transactions that were removed from the store
+// by abandoning them.  Its keys are transaction hashes, and its values are
+// serialized as follows:
+//
+//   [0:8]     Time the transaction was abandoned (8 bytes)
+//   [8:]      Details of the removed transaction
+
+// valueAbandonedTx serializes the record of an abandoned transaction.
+func valueAbandonedTx(details *TxDetails, abandoned time.Time) ([]byte, error) {
+	detailsVal, err := valueRemovedTxDetails(details)
+	if err != nil {
+		return nil, err
+	}
+
+	v := make([]byte, 8+len(detailsVal))
+	byteOrder.PutUint64(v, uint64(abandoned.Unix()))
+	copy(v[8:], detailsVal)
+	return v, nil
+}
+
+// readAbandonedTx deserializes the record of an abandoned transaction.
+func readAbandonedTx(txHash *chainhash.Hash, v []byte, tx *AbandonedTx) error {
+	if len(v) < 8 {
+		str := fmt.Sprintf("%s: short read for %v", bucketAbandoned,
+			txHash)
+		return storeError(ErrData, str, nil)
+	}
+	tx.Abandoned = time.Unix(int64(byteOrder.Uint64(v)), 0)
+	return readRemovedTxDetails(bucketAbandoned, txHash, v[8:], &tx.TxDetails)
+}
+
+// putAbandonedTx records a transaction as abandoned.
+func putAbandonedTx(ns walletdb.ReadWriteBucket, details *TxDetails,
+	abandoned time.Time) error {
+
+	// Create the corresponding bucket if necessary.
+	abandonedTxs, err := ns.CreateBucketIfNotExists(bucketAbandoned)
+	if err != nil {
+		str := "failed to create abandoned transactions bucket"
+		return storeError(ErrDatabase, str, err)
+	}
+
+	v, err := valueAbandonedTx(details, abandoned)
+	if err != nil {
+		return err
+	}
+	if err := abandonedTxs.Put(details.Hash[:], v); err != nil {
+		str := fmt.Sprintf("%s: put failed for %v", bucketAbandoned,
+			details.Hash)
+		return storeError(ErrDatabase, str, err)
+	}
+
+	return nil
+}
+
+// deleteAbandonedTx removes the record of an abandoned transaction, if any.
+func deleteAbandonedTx(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash) error {
+	// The bucket may not exist, indicating that no transactions have ever
+	// been abandoned, so we can just return now.
+	abandonedTxs := ns.NestedReadWriteBucket(bucketAbandoned)
+	if abandonedTxs == nil {
+		return nil
+	}
+
+	if err := abandonedTxs.Delete(txHash[:]); err != nil {
+		str := fmt.Sprintf("%s: delete failed for %v", bucketAbandoned,
+			txHash)
+		return storeError(ErrDatabase, str, err)
+	}
+
+	return nil
+}
+
+// forEachAbandonedTx iterates over all abandoned transactions and invokes the
+// callback `f` for each.
+func forEachAbandonedTx(ns walletdb.ReadBucket, f func(*AbandonedTx)) error {
+	// The bucket may not exist, indicating that no transactions have ever
+	// been abandoned, so we can just return now.
+	abandonedTxs := ns.NestedReadBucket(bucketAbandoned)
+	if abandonedTxs == nil {
+		return nil
+	}
+
+	return abandonedTxs.ForEach(func(k, v []byte) error {
+		if len(k) != 32 {
+			str := fmt.Sprintf("%s: short key", bucketAbandoned)
+			return storeError(ErrData, str, nil)
+		}
+		var txHash chainhash.Hash
+		copy(txHash[:], k)
+
+		tx := new(AbandonedTx)
+		if err := readAbandonedTx(&txHash, v, tx); err != nil {
+			return err
+		}
+
+		f(tx)
+
+		return nil
+	})
+}
+
+// The conflicted bucket records transactions that were removed from the store
+// because they conflict with a mined transaction.  Its keys are transaction
+// hashes, and its values are serialized as follows:
+//
+//   [0:32]    Hash of the mined transaction that conflicts with it (32 bytes)
+//   [32:36]   Height of the block the conflicting transaction was mined in
+//             (4 bytes)
+//   [36:]     Details of the removed transaction
+
+// valueConflictedTx serializes the record of a conflicted transaction.
+func valueConflictedTx(details *TxDetails, conflictingHash *chainhash.Hash,
+	height int32) ([]byte, error) {
+
+	detailsVal, err := valueRemovedTxDetails(details)
+	if err != nil {
+		return nil, err
+	}
+
+	v := make([]byte, 36+len(detailsVal))
+	copy(v, conflictingHash[:])
+	byteOrder.PutUint32(v[32:], uint32(height))
+	copy(v[36:], detailsVal)
+	return v, nil
+}
+
+// readConflictedTx deserializes the record of a conflicted transaction.
+func readConflictedTx(txHash *chainhash.Hash, v []byte, tx *ConflictedTx) error {
+	if len(v) < 36 {
+		str := fmt.Sprintf("%s: short read for %v", bucketConflicted,
+			txHash)
+		return storeError(ErrData, str, nil)
+	}
+	copy(tx.ConflictingHash[:], v)
+	tx.ConflictingHeight = int32(byteOrder.Uint32(v[32:]))
+	return readRemovedTxDetails(bucketConflicted, txHash, v[36:], &tx.TxDetails)
+}
+
+// putConflictedTx records a transaction as conflicted by a mined transaction.
+func putConflictedTx(ns walletdb.ReadWriteBucket, details *TxDetails,
+	conflictingHash *chainhash.Hash, height int32) error {
+
+	conflictedTxs := ns.NestedReadWriteBucket(bucketConflicted)
+	if conflictedTxs == nil {
+		str := fmt.Sprintf("%s: bucket not found", bucketConflicted)
+		return storeError(ErrData, str, nil)
+	}
+	v, err := valueConflictedTx(details, conflictingHash, height)
+	if err != nil {
+		return err
+	}
+	err = conflictedTxs.Put(details.Hash[:], v)
+	if err != nil {
+		str := fmt.Sprintf("%s: put failed for %v", bucketConflicted,
+			details.Hash)
+		return storeError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// deleteConflictedTx removes the record of a conflicted transaction, if any.
+func deleteConflictedTx(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash) error {
+	conflictedTxs := ns.NestedReadWriteBucket(bucketConflicted)
+	if conflictedTxs == nil {
+		str := fmt.Sprintf("%s: bucket not found", bucketConflicted)
+		return storeError(ErrData, str, nil)
+	}
+	err := conflictedTxs.Delete(txHash[:])
+	if err != nil {
+		str := fmt.Sprintf("%s: delete failed for %v", bucketConflicted,
+			txHash)
+		return storeError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// deleteConflictedTxs removes the records of all conflicted transactions for
+// which match returns true, and returns the removed records.
+func deleteConflictedTxs(ns walletdb.ReadWriteBucket,
+	match func(*ConflictedTx) bool) ([]*ConflictedTx, error) {
+
+	conflictedTxs := ns.NestedReadWriteBucket(bucketConflicted)
+	if conflictedTxs == nil {
+		str := fmt.Sprintf("%s: bucket not found", bucketConflicted)
+		return nil, storeError(ErrData, str, nil)
+	}
+
+	// The records are collected first, as the bucket can not be modified
+	// while iterating over it.
+	var removed []*ConflictedTx
+	err := forEachConflictedTx(ns, func(tx *ConflictedTx) {
+		if match(tx) {
+			removed = append(removed, tx)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range removed {
+		if err := conflictedTxs.Delete(tx.Hash[:]); err != nil {
+			str := fmt.Sprintf("%s: delete failed for %v",
+				bucketConflicted, tx.Hash)
+			return nil, storeError(ErrDatabase, str, err)
+		}
+	}
+	return removed, nil
+}
+
+// forEachConflictedTx iterates over all conflicted transactions and invokes
+// the callback `f` for each.
+func forEachConflictedTx(ns walletdb.ReadBucket, f func(*ConflictedTx)) error {
+	conflictedTxs := ns.NestedReadBucket(bucketConflicted)
+	if conflictedTxs == nil {
+		str := fmt.Sprintf("%s: bucket not found", bucketConflicted)
+		return storeError(ErrData, str, nil)
+	}
+	return conflictedTxs.ForEach(func(k, v []byte) error {
+		if len(k) != 32 {
+			str := fmt.Sprintf("%s: short key", bucketConflicted)
+			return storeError(ErrData, str, nil)
+		}
+		var txHash chainhash.Hash
+		copy(txHash[:], k)
+
+		tx := new(ConflictedTx)
+		if err := readConflictedTx(&txHash, v, tx); err != nil {
+			return err
+		}
+
+		f(tx)
+
+		return nil
+	})
+}
+
 // openStore opens an existing transaction store from the passed namespace.
 func openStore(ns walletdb.ReadBucket) error {
 	version, err := fetchVersion(ns)
@@ -1505,6 +1959,10 @@ func createBuckets(ns walletdb.ReadWriteBucket) error {
 		str := "failed to create locked outputs bucket"
 		return storeError(ErrDatabase, str, err)
 	}
+	if _, err := ns.CreateBucket(bucketConflicted); err != nil {
+		str := "failed to create conflicted transactions bucket"
+		return storeError(ErrDatabase, str, err)
+	}
 
 	return nil
 }
@@ -1549,6 +2007,21 @@ func deleteBuckets(ns walletdb.ReadWriteBucket) error {
 		str := "failed to delete locked outputs bucket"
 		return storeError(ErrDatabase, str, err)
 	}
+	err = ns.DeleteNestedBucket(bucketFrozenOutputs)
+	if err != nil && err != walletdb.ErrBucketNotFound {
+		str := "failed to delete frozen outputs bucket"
+		return storeError(ErrDatabase, str, err)
+	}
+	err = ns.DeleteNestedBucket(bucketAbandoned)
+	if err != nil && err != walletdb.ErrBucketNotFound {
+		str := "failed to delete abandoned transactions bucket"
+		return storeError(ErrDatabase, str, err)
+	}
+	err = ns.DeleteNestedBucket(bucketConflicted)
+	if err != nil && err != walletdb.ErrBucketNotFound {
+		str := "failed to delete conflicted transactions bucket"
+		return storeError(ErrDatabase, str, err)
+	}
 
 	return nil
 }
@@ -18,6 +18,10 @@ var versions = []migration.Version{
 		Number:    2,
 		Migration: dropTransactionHistory,
 	},
+	{
+		Number:    3,
+		Migration: createConflictedBucket,
+	},
 }
 
 // getLatestVersion returns the version number of the latest database version.
@@ -108,3 +112,18 @@ func dropTransactionHistory(ns walletdb.ReadWriteBucket) error {
 	// Finally, we'll insert a 0 value for our mined balance.
 	return putMinedBalance(ns, 0)
 }
+
+// createConflictedBucket is a migration that creates the bucket recording the
+// transactions removed from the store because they conflict with a mined
+// transaction.
+func createConflictedBucket(ns walletdb.ReadWriteBucket) error {
+	log.Info("Creating conflicted transactions bucket")
+
+	// The bucket already exists if the transaction history was dropped by
+	// a previous migration, as the buckets are then recreated.
+	if _, err := ns.CreateBucketIfNotExists(bucketConflicted); err != nil {
+		str := "failed to create conflicted transactions bucket"
+		return storeError(ErrDatabase, str, err)
+	}
+	return nil
+}
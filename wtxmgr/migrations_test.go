@@ -185,3 +185,46 @@ func TestMigrationDropTransactionHistory(t *testing.T) {
 		false,
 	)
 }
+
+// TestMigrationCreateConflictedBucket ensures that the conflicted transactions
+// bucket is created for stores that do not have it yet, and that the migration
+// does not fail for stores that already have it.
+func TestMigrationCreateConflictedBucket(t *testing.T) {
+	t.Parallel()
+
+	beforeMigration := func(ns walletdb.ReadWriteBucket, s *Store) error {
+		// Stores created before the migration did not have the
+		// bucket, so we'll remove it first.
+		return ns.DeleteNestedBucket(bucketConflicted)
+	}
+
+	afterMigration := func(ns walletdb.ReadWriteBucket, s *Store) error {
+		if ns.NestedReadBucket(bucketConflicted) == nil {
+			return errors.New("conflicted transactions bucket " +
+				"not found")
+		}
+
+		// The bucket can be queried, and is empty.
+		conflicted, err := s.ConflictedTxs(ns)
+		if err != nil {
+			return err
+		}
+		if len(conflicted) != 0 {
+			return fmt.Errorf("expected no conflicted "+
+				"transactions, found %d", len(conflicted))
+		}
+		return nil
+	}
+
+	applyMigration(
+		t, beforeMigration, afterMigration, createConflictedBucket,
+		false,
+	)
+
+	// Stores with the bucket, such as those whose transaction history was
+	// dropped, are migrated as well.
+	noop := func(walletdb.ReadWriteBucket, *Store) error { return nil }
+	applyMigration(
+		t, noop, afterMigration, createConflictedBucket, false,
+	)
+}
@@ -456,3 +456,23 @@ func (s *Store) PreviousPkScripts(ns walletdb.ReadBucket, rec *TxRecord, block *
 
 	return pkScripts, nil
 }
+
+// TxCount returns the number of transactions recorded by the store, including
+// unmined transactions.
+func (s *Store) TxCount(ns walletdb.ReadBucket) (int, error) {
+	count := 0
+	countKeys := func(k, v []byte) error {
+		count++
+		return nil
+	}
+
+	err := ns.NestedReadBucket(bucketTxRecords).ForEach(countKeys)
+	if err != nil {
+		return 0, err
+	}
+	err = ns.NestedReadBucket(bucketUnmined).ForEach(countKeys)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
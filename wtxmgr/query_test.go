@@ -144,6 +144,19 @@ func (q *queryState) compare(s *Store, ns walletdb.ReadBucket,
 		}
 	}
 
+	expCount := 0
+	for _, blockDetails := range q.blocks {
+		expCount += len(blockDetails)
+	}
+	count, err := s.TxCount(ns)
+	if err != nil {
+		return fmt.Errorf("%s: failed in TxCount: %v", changeDesc, err)
+	}
+	if count != expCount {
+		return fmt.Errorf("%s: got TxCount %d, expected %d",
+			changeDesc, count, expCount)
+	}
+
 	return nil
 }
 
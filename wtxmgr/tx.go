@@ -131,6 +131,15 @@ type LockedOutput struct {
 	Expiration time.Time
 }
 
+// FrozenOutput is a type that contains an outpoint of an UTXO and the reason
+// and expiration of its freeze.  A zero expiration means that the freeze does
+// not expire.
+type FrozenOutput struct {
+	Outpoint   wire.OutPoint
+	Reason     string
+	Expiration time.Time
+}
+
 // NewTxRecord creates a new transaction record that may be inserted into the
 // store.  It uses memoization to save the transaction hash and the serialized
 // transaction.
@@ -411,6 +420,14 @@ func (s *Store) insertMinedTx(ns walletdb.ReadWriteBucket, rec *TxRecord,
 		return err
 	}
 
+	// A mined transaction is no longer abandoned or conflicted.
+	if err := deleteAbandonedTx(ns, &rec.Hash); err != nil {
+		return err
+	}
+	if err := deleteConflictedTx(ns, &rec.Hash); err != nil {
+		return err
+	}
+
 	// Determine if this transaction has affected our balance, and if so,
 	// update it.
 	if err := s.updateMinedBalance(ns, rec, block); err != nil {
@@ -433,7 +450,7 @@ func (s *Store) insertMinedTx(ns walletdb.ReadWriteBucket, rec *TxRecord,
 	// from the unconfirmed set.  This also handles removing unconfirmed
 	// transaction spend chains if any other unconfirmed transactions spend
 	// outputs of the removed double spend.
-	if err := s.removeDoubleSpends(ns, rec); err != nil {
+	if err := s.removeDoubleSpends(ns, rec, block.Height); err != nil {
 		return err
 	}
 
@@ -548,6 +565,10 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, height int32) error {
 	var coinBaseCredits []wire.OutPoint
 	var heightsToRemove []int32
 
+	// Transactions conflicted by the removed transactions are restored as
+	// unmined once all blocks are detached.
+	var conflicted []*ConflictedTx
+
 	it := makeReverseBlockIterator(ns)
 	for it.prev() {
 		b := &it.elem
@@ -576,6 +597,19 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, height int32) error {
 				return err
 			}
 
+			// Transactions conflicted by this one are no longer
+			// conflicted once it is unmined or removed.
+			var removed []*ConflictedTx
+			removed, err = deleteConflictedTxs(
+				ns, func(tx *ConflictedTx) bool {
+					return tx.ConflictingHash == *txHash
+				},
+			)
+			if err != nil {
+				return err
+			}
+			conflicted = append(conflicted, removed...)
+
 			// Handle coinbase transactions specially since they are
 			// not moved to the unconfirmed store.  A coinbase cannot
 			// contain any debits, but all credits should be removed
@@ -738,6 +772,14 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, height int32) error {
 		}
 	}
 
+	// Conflicted transactions are restored before the spenders of removed
+	// coinbase outputs are removed, so that those spending them are
+	// removed as well.
+	err = s.restoreConflictedTxs(ns, conflicted)
+	if err != nil {
+		return err
+	}
+
 	for _, op := range coinBaseCredits {
 		opKey := canonicalOutPoint(&op.Hash, op.Index)
 		unminedSpendTxHashKeys := fetchUnminedInputSpendTxHashes(ns, opKey)
@@ -773,7 +815,7 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, height int32) error {
 }
 
 // UnspentOutputs returns all unspent received transaction outputs.
-// The order is undefined.
+// Frozen outputs are included.  The order is undefined.
 func (s *Store) UnspentOutputs(ns walletdb.ReadBucket) ([]Credit, error) {
 	var unspent []Credit
 
@@ -1205,6 +1247,41 @@ func (s *Store) UnlockOutput(ns walletdb.ReadWriteBucket, id LockID,
 	return unlockOutput(ns, op)
 }
 
+// IsLockedOutput returns whether an output is currently locked, and the ID it
+// is locked to.
+func (s *Store) IsLockedOutput(ns walletdb.ReadBucket, op wire.OutPoint) (
+	LockID, bool) {
+
+	id, _, isLocked := isLockedOutput(ns, op, s.clock.Now())
+	return id, isLocked
+}
+
+// UnlockSpentOutputs unlocks the outputs spent by a transaction, regardless of
+// the IDs they are locked to.  Locks only keep unspent outputs from being
+// selected, so they are no longer needed once the spending transaction is
+// published.
+func (s *Store) UnlockSpentOutputs(ns walletdb.ReadWriteBucket,
+	tx *wire.MsgTx) error {
+
+	for _, txIn := range tx.TxIn {
+		if err := unlockOutput(ns, txIn.PreviousOutPoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsUnspentOutput returns whether an output is known and not spent by any
+// mined or unmined transaction.
+func (s *Store) IsUnspentOutput(ns walletdb.ReadBucket, op wire.OutPoint) bool {
+	k := canonicalOutPoint(&op.Hash, op.Index)
+	if existsRawUnminedInput(ns, k) != nil {
+		return false
+	}
+	return existsRawUnspent(ns, k) != nil ||
+		existsRawUnminedCredit(ns, k) != nil
+}
+
 // DeleteExpiredLockedOutputs iterates through all existing locked outputs and
 // deletes those which have already expired.
 func (s *Store) DeleteExpiredLockedOutputs(ns walletdb.ReadWriteBucket) error {
@@ -1233,13 +1310,17 @@ func (s *Store) DeleteExpiredLockedOutputs(ns walletdb.ReadWriteBucket) error {
 }
 
 // ListLockedOutputs returns a list of objects representing the currently locked
-// utxos.
+// utxos.  Locks which have expired are not returned.
 func (s *Store) ListLockedOutputs(ns walletdb.ReadBucket) ([]*LockedOutput,
 	error) {
 
+	now := s.clock.Now()
 	var outputs []*LockedOutput
 	err := forEachLockedOutput(
 		ns, func(op wire.OutPoint, id LockID, expiration time.Time) {
+			if !now.Before(expiration) {
+				return
+			}
 			outputs = append(outputs, &LockedOutput{
 				Outpoint:   op,
 				LockID:     id,
@@ -1253,3 +1334,92 @@ func (s *Store) ListLockedOutputs(ns walletdb.ReadBucket) ([]*LockedOutput,
 
 	return outputs, nil
 }
+
+// FreezeOutput freezes an output for the given reason until it is unfrozen
+// through `UnfreezeOutput`.  Frozen outputs are still returned by
+// `UnspentOutputs`, since they remain part of the balance of the wallet, and
+// callers selecting outputs to spend must skip them using `IsFrozenOutput`.
+// Unlike output locks, freezes are not tied to an ID and do not expire unless a
+// non-zero expiry is passed.  Freezing a frozen output replaces the reason and
+// expiry of its freeze.
+//
+// If the output is not known, ErrUnknownOutput is returned.
+func (s *Store) FreezeOutput(ns walletdb.ReadWriteBucket, op wire.OutPoint,
+	reason string, expiry time.Time) error {
+
+	// Make sure the output is known.
+	if !isKnownOutput(ns, op) {
+		return ErrUnknownOutput
+	}
+
+	return freezeOutput(ns, op, reason, expiry)
+}
+
+// UnfreezeOutput unfreezes an output, allowing it to be available for coin
+// selection if it remains unspent.  Unfreezing an output which is not frozen
+// is not an error.
+func (s *Store) UnfreezeOutput(ns walletdb.ReadWriteBucket,
+	op wire.OutPoint) error {
+
+	return unfreezeOutput(ns, op)
+}
+
+// IsFrozenOutput returns whether an output is currently frozen.
+func (s *Store) IsFrozenOutput(ns walletdb.ReadBucket, op wire.OutPoint) bool {
+	_, _, isFrozen := isFrozenOutput(ns, op, s.clock.Now())
+	return isFrozen
+}
+
+// DeleteExpiredFrozenOutputs iterates through all existing frozen outputs and
+// deletes those whose freeze has already expired.
+func (s *Store) DeleteExpiredFrozenOutputs(ns walletdb.ReadWriteBucket) error {
+	// Collect all expired freezes first to remove them later on, as
+	// deleting while iterating would invalidate the iterator.
+	var expiredOutputs []wire.OutPoint
+	err := forEachFrozenOutput(
+		ns, func(op wire.OutPoint, _ string, expiration time.Time) {
+			if !expiration.IsZero() &&
+				!s.clock.Now().Before(expiration) {
+
+				expiredOutputs = append(expiredOutputs, op)
+			}
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, op := range expiredOutputs {
+		if err := unfreezeOutput(ns, op); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListFrozenOutputs returns a list of objects representing the currently frozen
+// utxos.  Freezes which have expired are not returned.
+func (s *Store) ListFrozenOutputs(ns walletdb.ReadBucket) ([]*FrozenOutput,
+	error) {
+
+	now := s.clock.Now()
+	var outputs []*FrozenOutput
+	err := forEachFrozenOutput(
+		ns, func(op wire.OutPoint, reason string, expiration time.Time) {
+			if !expiration.IsZero() && !now.Before(expiration) {
+				return
+			}
+			outputs = append(outputs, &FrozenOutput{
+				Outpoint:   op,
+				Reason:     reason,
+				Expiration: expiration,
+			})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/classzz/czzutil"
 	"github.com/classzz/czzwallet/walletdb"
 	_ "github.com/classzz/czzwallet/walletdb/bdb"
+	"github.com/lightningnetwork/lnd/clock"
 )
 
 // Received transaction output for mainnet outpoint
@@ -1460,6 +1461,177 @@ func TestRemoveUnminedTx(t *testing.T) {
 	checkBalance(czzutil.Amount(initialBalance), true)
 }
 
+// TestAbandonTx ensures that abandoning an unmined transaction removes it and
+// its unmined descendants from the store, restores the outputs it spends, and
+// keeps a record of the abandoned transactions until they are inserted again.
+func TestAbandonTx(t *testing.T) {
+	t.Parallel()
+
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	// We'll start off the test by creating a new coinbase output at height
+	// 100 and inserting it into the store.
+	b100 := &BlockMeta{
+		Block: Block{Height: 100},
+		Time:  time.Now(),
+	}
+	initialBalance := int64(1e8)
+	cb := newCoinBase(initialBalance)
+	cbRec, err := NewTxRecordFromMsgTx(cb, b100.Time)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.InsertTx(ns, cbRec, b100); err != nil {
+			t.Fatal(err)
+		}
+		err := store.AddCredit(ns, cbRec, b100, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	coinbaseMaturity := int32(chaincfg.TestNet3Params.CoinbaseMaturity)
+	maturityHeight := b100.Block.Height + coinbaseMaturity
+
+	// Then, we'll create an unconfirmed spend of the coinbase output with
+	// a change output, and an unconfirmed child spending the change.
+	changeAmount := int64(4e7)
+	spendTx := spendOutput(&cbRec.Hash, 0, 5e7, changeAmount)
+	spendTxRec, err := NewTxRecordFromMsgTx(spendTx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	childTx := spendOutput(&spendTxRec.Hash, 1, 3e7)
+	childTxRec, err := NewTxRecordFromMsgTx(childTx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.InsertTx(ns, spendTxRec, nil); err != nil {
+			t.Fatal(err)
+		}
+		err := store.AddCredit(ns, spendTxRec, nil, 1, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := store.InsertTx(ns, childTxRec, nil); err != nil {
+			t.Fatal(err)
+		}
+		err = store.AddCredit(ns, childTxRec, nil, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// Abandoning the spend should abandon the child as well.
+	abandonTime := time.Unix(1600000000, 0)
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		hashes, err := store.AbandonTx(ns, spendTxRec, abandonTime)
+		if err != nil {
+			t.Fatalf("unable to abandon tx: %v", err)
+		}
+		if len(hashes) != 2 || hashes[0] != spendTxRec.Hash ||
+			hashes[1] != childTxRec.Hash {
+
+			t.Fatalf("unexpected abandoned txs %v", hashes)
+		}
+	})
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		unminedTxs, err := store.UnminedTxs(ns)
+		if err != nil {
+			t.Fatalf("unable to query for unmined txs: %v", err)
+		}
+		if len(unminedTxs) != 0 {
+			t.Fatalf("expected 0 unmined txs, got %v",
+				len(unminedTxs))
+		}
+
+		// The coinbase output is spendable again.
+		b, err := store.Balance(ns, 1, maturityHeight)
+		if err != nil {
+			t.Fatalf("unable to retrieve balance: %v", err)
+		}
+		if b != czzutil.Amount(initialBalance) {
+			t.Fatalf("expected balance of %d, got %d",
+				initialBalance, b)
+		}
+
+		// Both transactions are recorded as abandoned, with the
+		// credits and debits they had when they were abandoned.
+		abandoned, err := store.AbandonedTxs(ns)
+		if err != nil {
+			t.Fatalf("unable to fetch abandoned txs: %v", err)
+		}
+		if len(abandoned) != 2 {
+			t.Fatalf("expected 2 abandoned txs, got %v",
+				len(abandoned))
+		}
+		var spend *AbandonedTx
+		for i := range abandoned {
+			if abandoned[i].Hash == spendTxRec.Hash {
+				spend = &abandoned[i]
+			}
+		}
+		if spend == nil {
+			t.Fatalf("spend is not recorded as abandoned")
+		}
+		if !spend.Abandoned.Equal(abandonTime) {
+			t.Fatalf("expected abandon time %v, got %v",
+				abandonTime, spend.Abandoned)
+		}
+		if spend.Block.Height != -1 {
+			t.Fatalf("unexpected block height %v",
+				spend.Block.Height)
+		}
+		if len(spend.Credits) != 1 || spend.Credits[0].Index != 1 ||
+			spend.Credits[0].Amount != czzutil.Amount(changeAmount) ||
+			!spend.Credits[0].Change {
+
+			t.Fatalf("unexpected credits %v", spend.Credits)
+		}
+		if len(spend.Debits) != 1 || spend.Debits[0].Index != 0 ||
+			spend.Debits[0].Amount != czzutil.Amount(initialBalance) {
+
+			t.Fatalf("unexpected debits %v", spend.Debits)
+		}
+		if spend.MsgTx.TxHash() != spendTxRec.Hash {
+			t.Fatalf("unexpected abandoned tx %v",
+				spend.MsgTx.TxHash())
+		}
+	})
+
+	// Inserting the spend again removes its abandoned record, but not the
+	// record of the child.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.InsertTx(ns, spendTxRec, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		abandoned, err := store.AbandonedTxs(ns)
+		if err != nil {
+			t.Fatalf("unable to fetch abandoned txs: %v", err)
+		}
+		if len(abandoned) != 1 || abandoned[0].Hash != childTxRec.Hash {
+			t.Fatalf("expected child to remain abandoned, got %v",
+				abandoned)
+		}
+	})
+
+	// Mined transactions can not be abandoned.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		_, err := store.AbandonTx(ns, cbRec, abandonTime)
+		if !IsNoExists(err) {
+			t.Fatalf("expected no exists error, got %v", err)
+		}
+	})
+}
+
 // commitDBTx is a helper function that allows us to perform multiple operations
 // on a specific database's bucket as a single atomic operation.
 func commitDBTx(t *testing.T, store *Store, db walletdb.DB,
@@ -1616,6 +1788,105 @@ func testInsertMempoolDoubleSpendTx(t *testing.T, first bool) {
 				confirmedSpendRec.Hash, minedTxs[0].Hash)
 		}
 	})
+
+	// The removed spend should be recorded as conflicted by the confirmed
+	// one, along with the credit it had.
+	conflictedSpendRec := secondSpendRec
+	if !first {
+		conflictedSpendRec = firstSpendRec
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		conflicted, err := store.ConflictedTxs(ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(conflicted) != 1 {
+			t.Fatalf("expected 1 conflicted tx, got %v",
+				len(conflicted))
+		}
+		if conflicted[0].Hash != conflictedSpendRec.Hash {
+			t.Fatalf("expected conflicted tx hash %v, got %v",
+				conflictedSpendRec.Hash, conflicted[0].Hash)
+		}
+		if conflicted[0].ConflictingHash != confirmedSpendRec.Hash ||
+			conflicted[0].ConflictingHeight != bMaturity.Height {
+
+			t.Fatalf("expected conflict with %v at height %v, "+
+				"got %v at height %v", confirmedSpendRec.Hash,
+				bMaturity.Height, conflicted[0].ConflictingHash,
+				conflicted[0].ConflictingHeight)
+		}
+		if len(conflicted[0].Credits) != 1 {
+			t.Fatalf("expected 1 credit, got %v",
+				len(conflicted[0].Credits))
+		}
+	})
+
+	// Once the confirmed spend is reorged out, the removed spend is no
+	// longer recorded as conflicted and is restored as unmined with its
+	// credit.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.Rollback(ns, bMaturity.Height); err != nil {
+			t.Fatal(err)
+		}
+		conflicted, err := store.ConflictedTxs(ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(conflicted) != 0 {
+			t.Fatalf("expected no conflicted txs after rollback, "+
+				"got %v", len(conflicted))
+		}
+		unminedTxs, err := store.UnminedTxs(ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(unminedTxs) != 2 {
+			t.Fatalf("expected 2 unmined txs after rollback, "+
+				"got %v", len(unminedTxs))
+		}
+		details, err := store.TxDetails(ns, &conflictedSpendRec.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if details == nil || len(details.Credits) != 1 {
+			t.Fatalf("expected restored tx with 1 credit, got %v",
+				details)
+		}
+	})
+
+	// Mining the spend again conflicts the other spend again.  Its record
+	// is only deleted once its conflict is deep enough.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		err := store.InsertTx(ns, confirmedSpendRec, &bMaturity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = store.DeleteConflictedTxs(ns, bMaturity.Height-1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conflicted, err := store.ConflictedTxs(ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(conflicted) != 1 {
+			t.Fatalf("expected 1 conflicted tx, got %v",
+				len(conflicted))
+		}
+		err = store.DeleteConflictedTxs(ns, bMaturity.Height)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conflicted, err = store.ConflictedTxs(ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(conflicted) != 0 {
+			t.Fatalf("expected no conflicted txs after deleting, "+
+				"got %v", len(conflicted))
+		}
+	})
 }
 
 // TestInsertMempoolDoubleSpendConfirmedFirstTx ensures that when a double spend
@@ -1943,3 +2214,318 @@ func TestAddDuplicateCreditAfterConfirm(t *testing.T) {
 		}
 	})
 }
+
+// TestFrozenOutputs ensures that outputs remain frozen until they are unfrozen
+// or their freeze expires, that frozen outputs are still returned as unspent
+// outputs of the store, and that freezes survive reopening the store.
+func TestFrozenOutputs(t *testing.T) {
+	t.Parallel()
+
+	tmpDir, err := ioutil.TempDir("", "wtxmgr_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	dbPath := filepath.Join(tmpDir, "db")
+	db, err := walletdb.Create("bdb", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var store *Store
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := tx.CreateTopLevelBucket(namespaceKey)
+		if err != nil {
+			return err
+		}
+		if err := Create(ns); err != nil {
+			return err
+		}
+		store, err = Open(ns, &chaincfg.TestNet3Params)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1600000000, 0)
+	store.clock = clock.NewTestClock(now)
+
+	b100 := &BlockMeta{
+		Block: Block{Height: 100},
+		Time:  time.Now(),
+	}
+	cb := newCoinBase(1e8, 2e8)
+	cbRec, err := NewTxRecordFromMsgTx(cb, b100.Time)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.InsertTx(ns, cbRec, b100); err != nil {
+			t.Fatal(err)
+		}
+		for i := uint32(0); i < 2; i++ {
+			err := store.AddCredit(ns, cbRec, b100, i, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
+	frozen := wire.OutPoint{Hash: cbRec.Hash, Index: 0}
+	expiring := wire.OutPoint{Hash: cbRec.Hash, Index: 1}
+	checkFrozen := func(store *Store, expected int) {
+		t.Helper()
+
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			t.Helper()
+
+			unspent, err := store.UnspentOutputs(ns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(unspent) != 2 {
+				t.Fatalf("expected 2 unspent outputs, got %d",
+					len(unspent))
+			}
+			count := 0
+			for _, output := range unspent {
+				if store.IsFrozenOutput(ns, output.OutPoint) {
+					count++
+				}
+			}
+			if count != expected {
+				t.Fatalf("expected %d frozen outputs, got %d",
+					expected, count)
+			}
+		})
+	}
+
+	// Unknown outputs cannot be frozen.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		unknown := wire.OutPoint{Index: 5}
+		err := store.FreezeOutput(ns, unknown, "", time.Time{})
+		if err != ErrUnknownOutput {
+			t.Fatalf("expected ErrUnknownOutput, got %v", err)
+		}
+	})
+
+	// Freeze one output indefinitely and the other for an hour.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		err := store.FreezeOutput(ns, frozen, "cold storage", time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = store.FreezeOutput(ns, expiring, "", now.Add(time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	checkFrozen(store, 2)
+
+	// Reopen the database and store, the freezes must still be in place.
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	db, err = walletdb.Open("bdb", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		var err error
+		store, err = Open(
+			tx.ReadBucket(namespaceKey), &chaincfg.TestNet3Params,
+		)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testClock := clock.NewTestClock(now)
+	store.clock = testClock
+	checkFrozen(store, 2)
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		outputs, err := store.ListFrozenOutputs(ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(outputs) != 2 {
+			t.Fatalf("expected 2 frozen outputs, got %d",
+				len(outputs))
+		}
+		for _, output := range outputs {
+			switch output.Outpoint {
+			case frozen:
+				if output.Reason != "cold storage" ||
+					!output.Expiration.IsZero() {
+
+					t.Fatalf("unexpected freeze %v", output)
+				}
+			case expiring:
+				if !output.Expiration.Equal(now.Add(time.Hour)) {
+					t.Fatalf("unexpected expiration %v",
+						output.Expiration)
+				}
+			default:
+				t.Fatalf("unexpected frozen output %v",
+					output.Outpoint)
+			}
+		}
+	})
+
+	// Once the expiring freeze has expired, its output is no longer frozen
+	// and the expired freeze can be deleted.
+	testClock.SetTime(now.Add(time.Hour))
+	checkFrozen(store, 1)
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.DeleteExpiredFrozenOutputs(ns); err != nil {
+			t.Fatal(err)
+		}
+		count := 0
+		err := forEachFrozenOutput(ns, func(wire.OutPoint, string,
+			time.Time) {
+
+			count++
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Fatalf("expected 1 stored freeze, got %d", count)
+		}
+	})
+
+	// Unfreezing the remaining output leaves no frozen output.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.UnfreezeOutput(ns, frozen); err != nil {
+			t.Fatal(err)
+		}
+		if store.IsFrozenOutput(ns, frozen) {
+			t.Fatal("output still frozen")
+		}
+	})
+	checkFrozen(store, 0)
+}
+
+// TestOutputLeases ensures that leased outputs are excluded from the unspent
+// outputs of the store until they are released or their lease expires, and
+// that expired leases are neither listed nor kept once deleted.
+func TestOutputLeases(t *testing.T) {
+	t.Parallel()
+
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Unix(1600000000, 0)
+	testClock := clock.NewTestClock(now)
+	store.clock = testClock
+
+	b100 := &BlockMeta{
+		Block: Block{Height: 100},
+		Time:  time.Now(),
+	}
+	cb := newCoinBase(1e8, 2e8)
+	cbRec, err := NewTxRecordFromMsgTx(cb, b100.Time)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.InsertTx(ns, cbRec, b100); err != nil {
+			t.Fatal(err)
+		}
+		for i := uint32(0); i < 2; i++ {
+			err := store.AddCredit(ns, cbRec, b100, i, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
+	id1 := LockID{1}
+	id2 := LockID{2}
+	op1 := wire.OutPoint{Hash: cbRec.Hash, Index: 0}
+	op2 := wire.OutPoint{Hash: cbRec.Hash, Index: 1}
+	checkLeases := func(expectedUnspent, expectedLeases int) {
+		t.Helper()
+
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			t.Helper()
+
+			unspent, err := store.UnspentOutputs(ns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(unspent) != expectedUnspent {
+				t.Fatalf("expected %d unspent outputs, got %d",
+					expectedUnspent, len(unspent))
+			}
+			leases, err := store.ListLockedOutputs(ns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(leases) != expectedLeases {
+				t.Fatalf("expected %d leases, got %d",
+					expectedLeases, len(leases))
+			}
+		})
+	}
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		expiry, err := store.LockOutput(ns, id1, op1, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !expiry.Equal(now.Add(time.Minute)) {
+			t.Fatalf("unexpected expiry %v", expiry)
+		}
+		_, err = store.LockOutput(ns, id2, op2, time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Outputs leased to an ID cannot be leased or released with
+		// another.
+		_, err = store.LockOutput(ns, id2, op1, time.Hour)
+		if err != ErrOutputAlreadyLocked {
+			t.Fatalf("expected ErrOutputAlreadyLocked, got %v", err)
+		}
+		err = store.UnlockOutput(ns, id2, op1)
+		if err != ErrOutputUnlockNotAllowed {
+			t.Fatalf("expected ErrOutputUnlockNotAllowed, got %v",
+				err)
+		}
+	})
+	checkLeases(0, 2)
+
+	// Once the first lease has expired, its output is unspent again and
+	// the lease is no longer listed.
+	testClock.SetTime(now.Add(time.Minute))
+	checkLeases(1, 1)
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.DeleteExpiredLockedOutputs(ns); err != nil {
+			t.Fatal(err)
+		}
+		count := 0
+		err := forEachLockedOutput(ns, func(wire.OutPoint, LockID,
+			time.Time) {
+
+			count++
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Fatalf("expected 1 stored lease, got %d", count)
+		}
+
+		if err := store.UnlockOutput(ns, id2, op2); err != nil {
+			t.Fatal(err)
+		}
+	})
+	checkLeases(2, 0)
+}
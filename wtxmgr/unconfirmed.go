@@ -6,6 +6,10 @@
 package wtxmgr
 
 import (
+	"fmt"
+	"sort"
+	"time"
+
 	"github.com/classzz/classzz/chaincfg/chainhash"
 	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzwallet/walletdb"
@@ -41,6 +45,12 @@ func (s *Store) insertMemPoolTx(ns walletdb.ReadWriteBucket, rec *TxRecord) erro
 	if err != nil {
 		return err
 	}
+	if err := deleteAbandonedTx(ns, &rec.Hash); err != nil {
+		return err
+	}
+	if err := deleteConflictedTx(ns, &rec.Hash); err != nil {
+		return err
+	}
 	err = putRawUnmined(ns, rec.Hash[:], v)
 	if err != nil {
 		return err
@@ -62,10 +72,12 @@ func (s *Store) insertMemPoolTx(ns walletdb.ReadWriteBucket, rec *TxRecord) erro
 }
 
 // removeDoubleSpends checks for any unmined transactions which would introduce
-// a double spend if tx was added to the store (either as a confirmed or unmined
-// transaction).  Each conflicting transaction and all transactions which spend
-// it are recursively removed.
-func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord) error {
+// a double spend if tx was added to the store as mined in the block at height.
+// Each conflicting transaction and all transactions which spend it are
+// recursively removed, and recorded as conflicted by tx.
+func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord,
+	height int32) error {
+
 	for _, input := range rec.MsgTx.TxIn {
 		prevOut := &input.PreviousOutPoint
 		prevOutKey := canonicalOutPoint(&prevOut.Hash, prevOut.Index)
@@ -98,12 +110,31 @@ func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord) e
 				return err
 			}
 
+			// The details of the removed transactions must be
+			// fetched before removing them, as their credits are
+			// removed with them.
+			conflicted, err := s.unminedTxDescendants(
+				ns, &doubleSpend.Hash, doubleSpendVal,
+			)
+			if err != nil {
+				return err
+			}
+
 			log.Debugf("Removing double spending transaction %v",
 				doubleSpend.Hash)
 
 			if err := s.removeConflict(ns, &doubleSpend); err != nil {
 				return err
 			}
+
+			for _, details := range conflicted {
+				err := putConflictedTx(
+					ns, details, &rec.Hash, height,
+				)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -165,6 +196,270 @@ func (s *Store) removeConflict(ns walletdb.ReadWriteBucket, rec *TxRecord) error
 	return deleteRawUnmined(ns, rec.Hash[:])
 }
 
+// unminedTxDescendants returns the details of an unmined transaction, with
+// serialized record v, followed by the details of all unmined transactions
+// spending its outputs, directly or through other unmined transactions.
+func (s *Store) unminedTxDescendants(ns walletdb.ReadBucket,
+	txHash *chainhash.Hash, v []byte) ([]*TxDetails, error) {
+
+	var (
+		descendants []*TxDetails
+		seen        = make(map[chainhash.Hash]struct{})
+		collect     func(txHash *chainhash.Hash, v []byte) error
+	)
+	collect = func(txHash *chainhash.Hash, v []byte) error {
+		seen[*txHash] = struct{}{}
+		details, err := s.unminedTxDetails(ns, txHash, v)
+		if err != nil {
+			return err
+		}
+		descendants = append(descendants, details)
+
+		for i := range details.MsgTx.TxOut {
+			k := canonicalOutPoint(txHash, uint32(i))
+			spenderHashes := fetchUnminedInputSpendTxHashes(ns, k)
+			for _, spenderHash := range spenderHashes {
+				spenderHash := spenderHash
+				if _, ok := seen[spenderHash]; ok {
+					continue
+				}
+				spenderVal := existsRawUnmined(ns, spenderHash[:])
+				if spenderVal == nil {
+					continue
+				}
+				if err := collect(&spenderHash, spenderVal); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := collect(txHash, v); err != nil {
+		return nil, err
+	}
+	return descendants, nil
+}
+
+// AbandonedTx describes a transaction that was removed from the store by
+// abandoning it.  The credits and debits of the details are those recorded
+// when the transaction was abandoned, and its block is unset.
+type AbandonedTx struct {
+	TxDetails
+
+	// Abandoned is the time the transaction was abandoned.
+	Abandoned time.Time
+}
+
+// AbandonTx removes an unmined transaction, along with all unmined transactions
+// spending its outputs, from the store using RemoveUnminedTx.  The previous
+// outputs spent by the removed transactions become unspent again.  A record of
+// each removed transaction is kept as abandoned until the transaction is
+// inserted into the store again.  The hashes of the abandoned transactions are
+// returned, starting with rec.
+func (s *Store) AbandonTx(ns walletdb.ReadWriteBucket, rec *TxRecord,
+	abandoned time.Time) ([]chainhash.Hash, error) {
+
+	v := existsRawUnmined(ns, rec.Hash[:])
+	if v == nil {
+		str := fmt.Sprintf("transaction %v is not unmined", rec.Hash)
+		return nil, storeError(ErrNoExists, str, nil)
+	}
+
+	// The details of the transaction and its descendants must be fetched
+	// before removing them, as their credits are removed with them.
+	descendants, err := s.unminedTxDescendants(ns, &rec.Hash, v)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.RemoveUnminedTx(ns, rec); err != nil {
+		return nil, err
+	}
+
+	hashes := make([]chainhash.Hash, 0, len(descendants))
+	for _, details := range descendants {
+		log.Infof("Abandoning unconfirmed transaction %v", details.Hash)
+		if err := putAbandonedTx(ns, details, abandoned); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, details.Hash)
+	}
+	return hashes, nil
+}
+
+// AbandonedTxs returns all transactions recorded as abandoned, ordered by the
+// time they were abandoned.
+func (s *Store) AbandonedTxs(ns walletdb.ReadBucket) ([]AbandonedTx, error) {
+	var txs []AbandonedTx
+	err := forEachAbandonedTx(ns, func(tx *AbandonedTx) {
+		txs = append(txs, *tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range txs {
+		label, err := s.TxLabel(ns, txs[i].Hash)
+		if err != nil {
+			return nil, err
+		}
+		txs[i].Label = label
+	}
+
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].Abandoned.Before(txs[j].Abandoned)
+	})
+	return txs, nil
+}
+
+// ConflictedTx describes a transaction that was removed from the store because
+// it, or an unmined transaction it spends from, double spends an output spent
+// by a mined transaction.  The credits and debits of the details are those
+// recorded when the transaction was removed, and its block is unset.  When the
+// conflicting transaction is rolled back from the chain, the transaction is
+// restored as unmined with these credits.
+type ConflictedTx struct {
+	TxDetails
+
+	// ConflictingHash is the hash of the mined transaction that the
+	// transaction conflicts with.
+	ConflictingHash chainhash.Hash
+
+	// ConflictingHeight is the height of the block the conflicting
+	// transaction was mined in.
+	ConflictingHeight int32
+}
+
+// ConflictedTxs returns all transactions recorded as conflicted, ordered by
+// the height of the block their conflicting transaction was mined in.
+func (s *Store) ConflictedTxs(ns walletdb.ReadBucket) ([]ConflictedTx, error) {
+	var txs []ConflictedTx
+	err := forEachConflictedTx(ns, func(tx *ConflictedTx) {
+		txs = append(txs, *tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range txs {
+		label, err := s.TxLabel(ns, txs[i].Hash)
+		if err != nil {
+			return nil, err
+		}
+		txs[i].Label = label
+	}
+
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].ConflictingHeight < txs[j].ConflictingHeight
+	})
+	return txs, nil
+}
+
+// restoreConflictedTxs inserts transactions whose conflicting transactions
+// were rolled back from the chain as unmined, along with the credits they had
+// when they were removed.  A transaction spending an output that is still spent
+// by a mined transaction, or spending from a transaction that remains
+// conflicted, remains conflicted and is recorded again with its previous
+// conflict.
+func (s *Store) restoreConflictedTxs(ns walletdb.ReadWriteBucket,
+	txs []*ConflictedTx) error {
+
+	conflicted := make(map[chainhash.Hash]struct{})
+	for _, tx := range txs {
+		for _, input := range tx.MsgTx.TxIn {
+			spent, err := spentByMinedTx(ns, &input.PreviousOutPoint)
+			if err != nil {
+				return err
+			}
+			if spent {
+				conflicted[tx.Hash] = struct{}{}
+				break
+			}
+		}
+	}
+
+	// The records are not ordered by dependency, so spenders of
+	// transactions remaining conflicted are searched for until none are
+	// left.
+	for changed := true; changed; {
+		changed = false
+		for _, tx := range txs {
+			if _, ok := conflicted[tx.Hash]; ok {
+				continue
+			}
+			for _, input := range tx.MsgTx.TxIn {
+				prevHash := input.PreviousOutPoint.Hash
+				if _, ok := conflicted[prevHash]; ok {
+					conflicted[tx.Hash] = struct{}{}
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	for _, tx := range txs {
+		if _, ok := conflicted[tx.Hash]; ok {
+			err := putConflictedTx(
+				ns, &tx.TxDetails, &tx.ConflictingHash,
+				tx.ConflictingHeight,
+			)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Debugf("Restoring conflicted transaction %v", tx.Hash)
+
+		if err := s.insertMemPoolTx(ns, &tx.TxRecord); err != nil {
+			return err
+		}
+		for _, credit := range tx.Credits {
+			_, err := s.addCredit(
+				ns, &tx.TxRecord, nil, credit.Index,
+				credit.Change,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// spentByMinedTx returns whether an output is a wallet credit spent by a mined
+// transaction.  Spends of outputs not controlled by the wallet are not known.
+func spentByMinedTx(ns walletdb.ReadBucket, outPoint *wire.OutPoint) (bool,
+	error) {
+
+	recKey, _ := latestTxRecord(ns, &outPoint.Hash)
+	if recKey == nil {
+		return false, nil
+	}
+	credKey := make([]byte, 72)
+	copy(credKey, recKey)
+	byteOrder.PutUint32(credKey[68:72], outPoint.Index)
+	v := existsRawCredit(ns, credKey)
+	if v == nil {
+		return false, nil
+	}
+	_, spent, err := fetchRawCreditAmountSpent(v)
+	return spent, err
+}
+
+// DeleteConflictedTxs deletes the records of transactions conflicted by
+// transactions mined at or below height.  Conflicts this deep are not expected
+// to be rolled back, so their records only serve as history.
+func (s *Store) DeleteConflictedTxs(ns walletdb.ReadWriteBucket,
+	height int32) error {
+
+	_, err := deleteConflictedTxs(ns, func(tx *ConflictedTx) bool {
+		return tx.ConflictingHeight <= height
+	})
+	return err
+}
+
 // UnminedTxs returns the underlying transactions for all unmined transactions
 // which are not known to have been mined in a block.  Transactions are
 // guaranteed to be sorted by their dependency order.